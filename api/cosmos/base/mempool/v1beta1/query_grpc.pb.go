@@ -0,0 +1,150 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/base/mempool/v1beta1/query.proto
+
+package mempoolv1beta1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Service_PendingTxs_FullMethodName   = "/cosmos.base.mempool.v1beta1.Service/PendingTxs"
+	Service_SenderCounts_FullMethodName = "/cosmos.base.mempool.v1beta1.Service/SenderCounts"
+)
+
+// ServiceClient is the client API for Service service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ServiceClient interface {
+	// PendingTxs queries the transactions of the mempool, ordered by sender and nonce.
+	PendingTxs(ctx context.Context, in *PendingTxsRequest, opts ...grpc.CallOption) (*PendingTxsResponse, error)
+	// SenderCounts queries the number of transactions of the mempool by sender.
+	SenderCounts(ctx context.Context, in *SenderCountsRequest, opts ...grpc.CallOption) (*SenderCountsResponse, error)
+}
+
+type serviceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewServiceClient(cc grpc.ClientConnInterface) ServiceClient {
+	return &serviceClient{cc}
+}
+
+func (c *serviceClient) PendingTxs(ctx context.Context, in *PendingTxsRequest, opts ...grpc.CallOption) (*PendingTxsResponse, error) {
+	out := new(PendingTxsResponse)
+	err := c.cc.Invoke(ctx, Service_PendingTxs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) SenderCounts(ctx context.Context, in *SenderCountsRequest, opts ...grpc.CallOption) (*SenderCountsResponse, error) {
+	out := new(SenderCountsResponse)
+	err := c.cc.Invoke(ctx, Service_SenderCounts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServiceServer is the server API for Service service.
+// All implementations must embed UnimplementedServiceServer
+// for forward compatibility
+type ServiceServer interface {
+	// PendingTxs queries the transactions of the mempool, ordered by sender and nonce.
+	PendingTxs(context.Context, *PendingTxsRequest) (*PendingTxsResponse, error)
+	// SenderCounts queries the number of transactions of the mempool by sender.
+	SenderCounts(context.Context, *SenderCountsRequest) (*SenderCountsResponse, error)
+	mustEmbedUnimplementedServiceServer()
+}
+
+// UnimplementedServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedServiceServer struct {
+}
+
+func (UnimplementedServiceServer) PendingTxs(context.Context, *PendingTxsRequest) (*PendingTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingTxs not implemented")
+}
+func (UnimplementedServiceServer) SenderCounts(context.Context, *SenderCountsRequest) (*SenderCountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SenderCounts not implemented")
+}
+func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
+
+// UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ServiceServer will
+// result in compilation errors.
+type UnsafeServiceServer interface {
+	mustEmbedUnimplementedServiceServer()
+}
+
+func RegisterServiceServer(s grpc.ServiceRegistrar, srv ServiceServer) {
+	s.RegisterService(&Service_ServiceDesc, srv)
+}
+
+func _Service_PendingTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PendingTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).PendingTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_PendingTxs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).PendingTxs(ctx, req.(*PendingTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_SenderCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SenderCountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).SenderCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_SenderCounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SenderCounts(ctx, req.(*SenderCountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Service_ServiceDesc is the grpc.ServiceDesc for Service service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Service_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.mempool.v1beta1.Service",
+	HandlerType: (*ServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PendingTxs",
+			Handler:    _Service_PendingTxs_Handler,
+		},
+		{
+			MethodName: "SenderCounts",
+			Handler:    _Service_SenderCounts_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/base/mempool/v1beta1/query.proto",
+}
@@ -707,12 +707,14 @@ func (x *_Metadata_1_list) IsValid() bool {
 var (
 	md_Metadata              protoreflect.MessageDescriptor
 	fd_Metadata_chunk_hashes protoreflect.FieldDescriptor
+	fd_Metadata_base_height  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_store_snapshots_v1_snapshot_proto_init()
 	md_Metadata = File_cosmos_store_snapshots_v1_snapshot_proto.Messages().ByName("Metadata")
 	fd_Metadata_chunk_hashes = md_Metadata.Fields().ByName("chunk_hashes")
+	fd_Metadata_base_height = md_Metadata.Fields().ByName("base_height")
 }
 
 var _ protoreflect.Message = (*fastReflection_Metadata)(nil)
@@ -786,6 +788,12 @@ func (x *fastReflection_Metadata) Range(f func(protoreflect.FieldDescriptor, pro
 			return
 		}
 	}
+	if x.BaseHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BaseHeight)
+		if !f(fd_Metadata_base_height, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -803,6 +811,8 @@ func (x *fastReflection_Metadata) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	case "cosmos.store.snapshots.v1.Metadata.chunk_hashes":
 		return len(x.ChunkHashes) != 0
+	case "cosmos.store.snapshots.v1.Metadata.base_height":
+		return x.BaseHeight != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.Metadata"))
@@ -821,6 +831,8 @@ func (x *fastReflection_Metadata) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "cosmos.store.snapshots.v1.Metadata.chunk_hashes":
 		x.ChunkHashes = nil
+	case "cosmos.store.snapshots.v1.Metadata.base_height":
+		x.BaseHeight = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.Metadata"))
@@ -843,6 +855,9 @@ func (x *fastReflection_Metadata) Get(descriptor protoreflect.FieldDescriptor) p
 		}
 		listValue := &_Metadata_1_list{list: &x.ChunkHashes}
 		return protoreflect.ValueOfList(listValue)
+	case "cosmos.store.snapshots.v1.Metadata.base_height":
+		value := x.BaseHeight
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.Metadata"))
@@ -867,6 +882,8 @@ func (x *fastReflection_Metadata) Set(fd protoreflect.FieldDescriptor, value pro
 		lv := value.List()
 		clv := lv.(*_Metadata_1_list)
 		x.ChunkHashes = *clv.list
+	case "cosmos.store.snapshots.v1.Metadata.base_height":
+		x.BaseHeight = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.Metadata"))
@@ -893,6 +910,8 @@ func (x *fastReflection_Metadata) Mutable(fd protoreflect.FieldDescriptor) proto
 		}
 		value := &_Metadata_1_list{list: &x.ChunkHashes}
 		return protoreflect.ValueOfList(value)
+	case "cosmos.store.snapshots.v1.Metadata.base_height":
+		panic(fmt.Errorf("field base_height of message cosmos.store.snapshots.v1.Metadata is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.Metadata"))
@@ -909,6 +928,8 @@ func (x *fastReflection_Metadata) NewField(fd protoreflect.FieldDescriptor) prot
 	case "cosmos.store.snapshots.v1.Metadata.chunk_hashes":
 		list := [][]byte{}
 		return protoreflect.ValueOfList(&_Metadata_1_list{list: &list})
+	case "cosmos.store.snapshots.v1.Metadata.base_height":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.Metadata"))
@@ -984,6 +1005,9 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.BaseHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BaseHeight))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1013,6 +1037,11 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.BaseHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BaseHeight))
+			i--
+			dAtA[i] = 0x10
+		}
 		if len(x.ChunkHashes) > 0 {
 			for iNdEx := len(x.ChunkHashes) - 1; iNdEx >= 0; iNdEx-- {
 				i -= len(x.ChunkHashes[iNdEx])
@@ -1103,6 +1132,25 @@ func (x *fastReflection_Metadata) ProtoMethods() *protoiface.Methods {
 				x.ChunkHashes = append(x.ChunkHashes, make([]byte, postIndex-iNdEx))
 				copy(x.ChunkHashes[len(x.ChunkHashes)-1], dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseHeight", wireType)
+				}
+				x.BaseHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BaseHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1144,6 +1192,8 @@ var (
 	fd_SnapshotItem_iavl              protoreflect.FieldDescriptor
 	fd_SnapshotItem_extension         protoreflect.FieldDescriptor
 	fd_SnapshotItem_extension_payload protoreflect.FieldDescriptor
+	fd_SnapshotItem_iavl_copy         protoreflect.FieldDescriptor
+	fd_SnapshotItem_kv                protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1153,6 +1203,8 @@ func init() {
 	fd_SnapshotItem_iavl = md_SnapshotItem.Fields().ByName("iavl")
 	fd_SnapshotItem_extension = md_SnapshotItem.Fields().ByName("extension")
 	fd_SnapshotItem_extension_payload = md_SnapshotItem.Fields().ByName("extension_payload")
+	fd_SnapshotItem_iavl_copy = md_SnapshotItem.Fields().ByName("iavl_copy")
+	fd_SnapshotItem_kv = md_SnapshotItem.Fields().ByName("kv")
 }
 
 var _ protoreflect.Message = (*fastReflection_SnapshotItem)(nil)
@@ -1246,6 +1298,18 @@ func (x *fastReflection_SnapshotItem) Range(f func(protoreflect.FieldDescriptor,
 			if !f(fd_SnapshotItem_extension_payload, value) {
 				return
 			}
+		case *SnapshotItem_IavlCopy:
+			v := o.IavlCopy
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_SnapshotItem_iavl_copy, value) {
+				return
+			}
+		case *SnapshotItem_Kv:
+			v := o.Kv
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_SnapshotItem_kv, value) {
+				return
+			}
 		}
 	}
 }
@@ -1295,6 +1359,22 @@ func (x *fastReflection_SnapshotItem) Has(fd protoreflect.FieldDescriptor) bool
 		} else {
 			return false
 		}
+	case "cosmos.store.snapshots.v1.SnapshotItem.iavl_copy":
+		if x.Item == nil {
+			return false
+		} else if _, ok := x.Item.(*SnapshotItem_IavlCopy); ok {
+			return true
+		} else {
+			return false
+		}
+	case "cosmos.store.snapshots.v1.SnapshotItem.kv":
+		if x.Item == nil {
+			return false
+		} else if _, ok := x.Item.(*SnapshotItem_Kv); ok {
+			return true
+		} else {
+			return false
+		}
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotItem"))
@@ -1319,6 +1399,10 @@ func (x *fastReflection_SnapshotItem) Clear(fd protoreflect.FieldDescriptor) {
 		x.Item = nil
 	case "cosmos.store.snapshots.v1.SnapshotItem.extension_payload":
 		x.Item = nil
+	case "cosmos.store.snapshots.v1.SnapshotItem.iavl_copy":
+		x.Item = nil
+	case "cosmos.store.snapshots.v1.SnapshotItem.kv":
+		x.Item = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotItem"))
@@ -1367,6 +1451,22 @@ func (x *fastReflection_SnapshotItem) Get(descriptor protoreflect.FieldDescripto
 		} else {
 			return protoreflect.ValueOfMessage((*SnapshotExtensionPayload)(nil).ProtoReflect())
 		}
+	case "cosmos.store.snapshots.v1.SnapshotItem.iavl_copy":
+		if x.Item == nil {
+			return protoreflect.ValueOfMessage((*SnapshotIAVLCopyItem)(nil).ProtoReflect())
+		} else if v, ok := x.Item.(*SnapshotItem_IavlCopy); ok {
+			return protoreflect.ValueOfMessage(v.IavlCopy.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*SnapshotIAVLCopyItem)(nil).ProtoReflect())
+		}
+	case "cosmos.store.snapshots.v1.SnapshotItem.kv":
+		if x.Item == nil {
+			return protoreflect.ValueOfMessage((*SnapshotKVItem)(nil).ProtoReflect())
+		} else if v, ok := x.Item.(*SnapshotItem_Kv); ok {
+			return protoreflect.ValueOfMessage(v.Kv.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*SnapshotKVItem)(nil).ProtoReflect())
+		}
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotItem"))
@@ -1399,6 +1499,12 @@ func (x *fastReflection_SnapshotItem) Set(fd protoreflect.FieldDescriptor, value
 	case "cosmos.store.snapshots.v1.SnapshotItem.extension_payload":
 		cv := value.Message().Interface().(*SnapshotExtensionPayload)
 		x.Item = &SnapshotItem_ExtensionPayload{ExtensionPayload: cv}
+	case "cosmos.store.snapshots.v1.SnapshotItem.iavl_copy":
+		cv := value.Message().Interface().(*SnapshotIAVLCopyItem)
+		x.Item = &SnapshotItem_IavlCopy{IavlCopy: cv}
+	case "cosmos.store.snapshots.v1.SnapshotItem.kv":
+		cv := value.Message().Interface().(*SnapshotKVItem)
+		x.Item = &SnapshotItem_Kv{Kv: cv}
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotItem"))
@@ -1483,6 +1589,38 @@ func (x *fastReflection_SnapshotItem) Mutable(fd protoreflect.FieldDescriptor) p
 			x.Item = oneofValue
 			return protoreflect.ValueOfMessage(value.ProtoReflect())
 		}
+	case "cosmos.store.snapshots.v1.SnapshotItem.iavl_copy":
+		if x.Item == nil {
+			value := &SnapshotIAVLCopyItem{}
+			oneofValue := &SnapshotItem_IavlCopy{IavlCopy: value}
+			x.Item = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Item.(type) {
+		case *SnapshotItem_IavlCopy:
+			return protoreflect.ValueOfMessage(m.IavlCopy.ProtoReflect())
+		default:
+			value := &SnapshotIAVLCopyItem{}
+			oneofValue := &SnapshotItem_IavlCopy{IavlCopy: value}
+			x.Item = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "cosmos.store.snapshots.v1.SnapshotItem.kv":
+		if x.Item == nil {
+			value := &SnapshotKVItem{}
+			oneofValue := &SnapshotItem_Kv{Kv: value}
+			x.Item = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Item.(type) {
+		case *SnapshotItem_Kv:
+			return protoreflect.ValueOfMessage(m.Kv.ProtoReflect())
+		default:
+			value := &SnapshotKVItem{}
+			oneofValue := &SnapshotItem_Kv{Kv: value}
+			x.Item = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotItem"))
@@ -1508,6 +1646,12 @@ func (x *fastReflection_SnapshotItem) NewField(fd protoreflect.FieldDescriptor)
 	case "cosmos.store.snapshots.v1.SnapshotItem.extension_payload":
 		value := &SnapshotExtensionPayload{}
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.store.snapshots.v1.SnapshotItem.iavl_copy":
+		value := &SnapshotIAVLCopyItem{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.store.snapshots.v1.SnapshotItem.kv":
+		value := &SnapshotKVItem{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotItem"))
@@ -1534,6 +1678,10 @@ func (x *fastReflection_SnapshotItem) WhichOneof(d protoreflect.OneofDescriptor)
 			return x.Descriptor().Fields().ByName("extension")
 		case *SnapshotItem_ExtensionPayload:
 			return x.Descriptor().Fields().ByName("extension_payload")
+		case *SnapshotItem_IavlCopy:
+			return x.Descriptor().Fields().ByName("iavl_copy")
+		case *SnapshotItem_Kv:
+			return x.Descriptor().Fields().ByName("kv")
 		}
 	default:
 		panic(fmt.Errorf("%s is not a oneof field in cosmos.store.snapshots.v1.SnapshotItem", d.FullName()))
@@ -1616,6 +1764,18 @@ func (x *fastReflection_SnapshotItem) ProtoMethods() *protoiface.Methods {
 			}
 			l = options.Size(x.ExtensionPayload)
 			n += 1 + l + runtime.Sov(uint64(l))
+		case *SnapshotItem_IavlCopy:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.IavlCopy)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *SnapshotItem_Kv:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.Kv)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1699,6 +1859,32 @@ func (x *fastReflection_SnapshotItem) ProtoMethods() *protoiface.Methods {
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x22
+		case *SnapshotItem_IavlCopy:
+			encoded, err := options.Marshal(x.IavlCopy)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		case *SnapshotItem_Kv:
+			encoded, err := options.Marshal(x.Kv)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1889,6 +2075,76 @@ func (x *fastReflection_SnapshotItem) ProtoMethods() *protoiface.Methods {
 				}
 				x.Item = &SnapshotItem_ExtensionPayload{v}
 				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IavlCopy", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &SnapshotIAVLCopyItem{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Item = &SnapshotItem_IavlCopy{v}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Kv", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &SnapshotKVItem{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Item = &SnapshotItem_Kv{v}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2929,27 +3185,27 @@ func (x *fastReflection_SnapshotIAVLItem) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_SnapshotExtensionMeta        protoreflect.MessageDescriptor
-	fd_SnapshotExtensionMeta_name   protoreflect.FieldDescriptor
-	fd_SnapshotExtensionMeta_format protoreflect.FieldDescriptor
+	md_SnapshotIAVLCopyItem      protoreflect.MessageDescriptor
+	fd_SnapshotIAVLCopyItem_skip protoreflect.FieldDescriptor
+	fd_SnapshotIAVLCopyItem_copy protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_store_snapshots_v1_snapshot_proto_init()
-	md_SnapshotExtensionMeta = File_cosmos_store_snapshots_v1_snapshot_proto.Messages().ByName("SnapshotExtensionMeta")
-	fd_SnapshotExtensionMeta_name = md_SnapshotExtensionMeta.Fields().ByName("name")
-	fd_SnapshotExtensionMeta_format = md_SnapshotExtensionMeta.Fields().ByName("format")
+	md_SnapshotIAVLCopyItem = File_cosmos_store_snapshots_v1_snapshot_proto.Messages().ByName("SnapshotIAVLCopyItem")
+	fd_SnapshotIAVLCopyItem_skip = md_SnapshotIAVLCopyItem.Fields().ByName("skip")
+	fd_SnapshotIAVLCopyItem_copy = md_SnapshotIAVLCopyItem.Fields().ByName("copy")
 }
 
-var _ protoreflect.Message = (*fastReflection_SnapshotExtensionMeta)(nil)
+var _ protoreflect.Message = (*fastReflection_SnapshotIAVLCopyItem)(nil)
 
-type fastReflection_SnapshotExtensionMeta SnapshotExtensionMeta
+type fastReflection_SnapshotIAVLCopyItem SnapshotIAVLCopyItem
 
-func (x *SnapshotExtensionMeta) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_SnapshotExtensionMeta)(x)
+func (x *SnapshotIAVLCopyItem) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SnapshotIAVLCopyItem)(x)
 }
 
-func (x *SnapshotExtensionMeta) slowProtoReflect() protoreflect.Message {
+func (x *SnapshotIAVLCopyItem) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2961,43 +3217,43 @@ func (x *SnapshotExtensionMeta) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_SnapshotExtensionMeta_messageType fastReflection_SnapshotExtensionMeta_messageType
-var _ protoreflect.MessageType = fastReflection_SnapshotExtensionMeta_messageType{}
+var _fastReflection_SnapshotIAVLCopyItem_messageType fastReflection_SnapshotIAVLCopyItem_messageType
+var _ protoreflect.MessageType = fastReflection_SnapshotIAVLCopyItem_messageType{}
 
-type fastReflection_SnapshotExtensionMeta_messageType struct{}
+type fastReflection_SnapshotIAVLCopyItem_messageType struct{}
 
-func (x fastReflection_SnapshotExtensionMeta_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_SnapshotExtensionMeta)(nil)
+func (x fastReflection_SnapshotIAVLCopyItem_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SnapshotIAVLCopyItem)(nil)
 }
-func (x fastReflection_SnapshotExtensionMeta_messageType) New() protoreflect.Message {
-	return new(fastReflection_SnapshotExtensionMeta)
+func (x fastReflection_SnapshotIAVLCopyItem_messageType) New() protoreflect.Message {
+	return new(fastReflection_SnapshotIAVLCopyItem)
 }
-func (x fastReflection_SnapshotExtensionMeta_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_SnapshotExtensionMeta
+func (x fastReflection_SnapshotIAVLCopyItem_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotIAVLCopyItem
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_SnapshotExtensionMeta) Descriptor() protoreflect.MessageDescriptor {
-	return md_SnapshotExtensionMeta
+func (x *fastReflection_SnapshotIAVLCopyItem) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotIAVLCopyItem
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_SnapshotExtensionMeta) Type() protoreflect.MessageType {
-	return _fastReflection_SnapshotExtensionMeta_messageType
+func (x *fastReflection_SnapshotIAVLCopyItem) Type() protoreflect.MessageType {
+	return _fastReflection_SnapshotIAVLCopyItem_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_SnapshotExtensionMeta) New() protoreflect.Message {
-	return new(fastReflection_SnapshotExtensionMeta)
+func (x *fastReflection_SnapshotIAVLCopyItem) New() protoreflect.Message {
+	return new(fastReflection_SnapshotIAVLCopyItem)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_SnapshotExtensionMeta) Interface() protoreflect.ProtoMessage {
-	return (*SnapshotExtensionMeta)(x)
+func (x *fastReflection_SnapshotIAVLCopyItem) Interface() protoreflect.ProtoMessage {
+	return (*SnapshotIAVLCopyItem)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3005,16 +3261,16 @@ func (x *fastReflection_SnapshotExtensionMeta) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_SnapshotExtensionMeta) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Name != "" {
-		value := protoreflect.ValueOfString(x.Name)
-		if !f(fd_SnapshotExtensionMeta_name, value) {
+func (x *fastReflection_SnapshotIAVLCopyItem) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Skip != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Skip)
+		if !f(fd_SnapshotIAVLCopyItem_skip, value) {
 			return
 		}
 	}
-	if x.Format != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.Format)
-		if !f(fd_SnapshotExtensionMeta_format, value) {
+	if x.Copy != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Copy)
+		if !f(fd_SnapshotIAVLCopyItem_copy, value) {
 			return
 		}
 	}
@@ -3031,17 +3287,17 @@ func (x *fastReflection_SnapshotExtensionMeta) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_SnapshotExtensionMeta) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_SnapshotIAVLCopyItem) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.name":
-		return x.Name != ""
-	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.format":
-		return x.Format != uint32(0)
+	case "cosmos.store.snapshots.v1.SnapshotIAVLCopyItem.skip":
+		return x.Skip != uint64(0)
+	case "cosmos.store.snapshots.v1.SnapshotIAVLCopyItem.copy":
+		return x.Copy != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotExtensionMeta"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotIAVLCopyItem"))
 		}
-		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotExtensionMeta does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotIAVLCopyItem does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3051,17 +3307,17 @@ func (x *fastReflection_SnapshotExtensionMeta) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SnapshotExtensionMeta) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_SnapshotIAVLCopyItem) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.name":
-		x.Name = ""
-	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.format":
-		x.Format = uint32(0)
+	case "cosmos.store.snapshots.v1.SnapshotIAVLCopyItem.skip":
+		x.Skip = uint64(0)
+	case "cosmos.store.snapshots.v1.SnapshotIAVLCopyItem.copy":
+		x.Copy = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotExtensionMeta"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotIAVLCopyItem"))
 		}
-		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotExtensionMeta does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotIAVLCopyItem does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3071,19 +3327,19 @@ func (x *fastReflection_SnapshotExtensionMeta) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_SnapshotExtensionMeta) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SnapshotIAVLCopyItem) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.name":
-		value := x.Name
-		return protoreflect.ValueOfString(value)
-	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.format":
-		value := x.Format
-		return protoreflect.ValueOfUint32(value)
+	case "cosmos.store.snapshots.v1.SnapshotIAVLCopyItem.skip":
+		value := x.Skip
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.store.snapshots.v1.SnapshotIAVLCopyItem.copy":
+		value := x.Copy
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotExtensionMeta"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotIAVLCopyItem"))
 		}
-		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotExtensionMeta does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotIAVLCopyItem does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3097,17 +3353,17 @@ func (x *fastReflection_SnapshotExtensionMeta) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SnapshotExtensionMeta) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SnapshotIAVLCopyItem) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.name":
-		x.Name = value.Interface().(string)
-	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.format":
-		x.Format = uint32(value.Uint())
+	case "cosmos.store.snapshots.v1.SnapshotIAVLCopyItem.skip":
+		x.Skip = value.Uint()
+	case "cosmos.store.snapshots.v1.SnapshotIAVLCopyItem.copy":
+		x.Copy = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotExtensionMeta"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotIAVLCopyItem"))
 		}
-		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotExtensionMeta does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotIAVLCopyItem does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3121,44 +3377,44 @@ func (x *fastReflection_SnapshotExtensionMeta) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SnapshotExtensionMeta) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SnapshotIAVLCopyItem) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.name":
-		panic(fmt.Errorf("field name of message cosmos.store.snapshots.v1.SnapshotExtensionMeta is not mutable"))
-	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.format":
-		panic(fmt.Errorf("field format of message cosmos.store.snapshots.v1.SnapshotExtensionMeta is not mutable"))
+	case "cosmos.store.snapshots.v1.SnapshotIAVLCopyItem.skip":
+		panic(fmt.Errorf("field skip of message cosmos.store.snapshots.v1.SnapshotIAVLCopyItem is not mutable"))
+	case "cosmos.store.snapshots.v1.SnapshotIAVLCopyItem.copy":
+		panic(fmt.Errorf("field copy of message cosmos.store.snapshots.v1.SnapshotIAVLCopyItem is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotExtensionMeta"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotIAVLCopyItem"))
 		}
-		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotExtensionMeta does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotIAVLCopyItem does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_SnapshotExtensionMeta) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SnapshotIAVLCopyItem) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.name":
-		return protoreflect.ValueOfString("")
-	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.format":
-		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.store.snapshots.v1.SnapshotIAVLCopyItem.skip":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.store.snapshots.v1.SnapshotIAVLCopyItem.copy":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotExtensionMeta"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotIAVLCopyItem"))
 		}
-		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotExtensionMeta does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotIAVLCopyItem does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_SnapshotExtensionMeta) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SnapshotIAVLCopyItem) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.store.snapshots.v1.SnapshotExtensionMeta", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.store.snapshots.v1.SnapshotIAVLCopyItem", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3166,7 +3422,7 @@ func (x *fastReflection_SnapshotExtensionMeta) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_SnapshotExtensionMeta) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SnapshotIAVLCopyItem) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3177,7 +3433,7 @@ func (x *fastReflection_SnapshotExtensionMeta) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SnapshotExtensionMeta) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SnapshotIAVLCopyItem) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3189,7 +3445,7 @@ func (x *fastReflection_SnapshotExtensionMeta) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_SnapshotExtensionMeta) IsValid() bool {
+func (x *fastReflection_SnapshotIAVLCopyItem) IsValid() bool {
 	return x != nil
 }
 
@@ -3199,9 +3455,9 @@ func (x *fastReflection_SnapshotExtensionMeta) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_SnapshotExtensionMeta) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SnapshotIAVLCopyItem) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*SnapshotExtensionMeta)
+		x := input.Message.Interface().(*SnapshotIAVLCopyItem)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3213,9 +3469,949 @@ func (x *fastReflection_SnapshotExtensionMeta) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		l = len(x.Name)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.Skip != 0 {
+			n += 1 + runtime.Sov(uint64(x.Skip))
+		}
+		if x.Copy != 0 {
+			n += 1 + runtime.Sov(uint64(x.Copy))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SnapshotIAVLCopyItem)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Copy != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Copy))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Skip != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Skip))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SnapshotIAVLCopyItem)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotIAVLCopyItem: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotIAVLCopyItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Skip", wireType)
+				}
+				x.Skip = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Skip |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Copy", wireType)
+				}
+				x.Copy = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Copy |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SnapshotKVItem       protoreflect.MessageDescriptor
+	fd_SnapshotKVItem_key   protoreflect.FieldDescriptor
+	fd_SnapshotKVItem_value protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_store_snapshots_v1_snapshot_proto_init()
+	md_SnapshotKVItem = File_cosmos_store_snapshots_v1_snapshot_proto.Messages().ByName("SnapshotKVItem")
+	fd_SnapshotKVItem_key = md_SnapshotKVItem.Fields().ByName("key")
+	fd_SnapshotKVItem_value = md_SnapshotKVItem.Fields().ByName("value")
+}
+
+var _ protoreflect.Message = (*fastReflection_SnapshotKVItem)(nil)
+
+type fastReflection_SnapshotKVItem SnapshotKVItem
+
+func (x *SnapshotKVItem) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SnapshotKVItem)(x)
+}
+
+func (x *SnapshotKVItem) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SnapshotKVItem_messageType fastReflection_SnapshotKVItem_messageType
+var _ protoreflect.MessageType = fastReflection_SnapshotKVItem_messageType{}
+
+type fastReflection_SnapshotKVItem_messageType struct{}
+
+func (x fastReflection_SnapshotKVItem_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SnapshotKVItem)(nil)
+}
+func (x fastReflection_SnapshotKVItem_messageType) New() protoreflect.Message {
+	return new(fastReflection_SnapshotKVItem)
+}
+func (x fastReflection_SnapshotKVItem_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotKVItem
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SnapshotKVItem) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotKVItem
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SnapshotKVItem) Type() protoreflect.MessageType {
+	return _fastReflection_SnapshotKVItem_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SnapshotKVItem) New() protoreflect.Message {
+	return new(fastReflection_SnapshotKVItem)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SnapshotKVItem) Interface() protoreflect.ProtoMessage {
+	return (*SnapshotKVItem)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SnapshotKVItem) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Key) != 0 {
+		value := protoreflect.ValueOfBytes(x.Key)
+		if !f(fd_SnapshotKVItem_key, value) {
+			return
+		}
+	}
+	if len(x.Value) != 0 {
+		value := protoreflect.ValueOfBytes(x.Value)
+		if !f(fd_SnapshotKVItem_value, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SnapshotKVItem) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.store.snapshots.v1.SnapshotKVItem.key":
+		return len(x.Key) != 0
+	case "cosmos.store.snapshots.v1.SnapshotKVItem.value":
+		return len(x.Value) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotKVItem"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotKVItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotKVItem) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.store.snapshots.v1.SnapshotKVItem.key":
+		x.Key = nil
+	case "cosmos.store.snapshots.v1.SnapshotKVItem.value":
+		x.Value = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotKVItem"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotKVItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SnapshotKVItem) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.store.snapshots.v1.SnapshotKVItem.key":
+		value := x.Key
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.store.snapshots.v1.SnapshotKVItem.value":
+		value := x.Value
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotKVItem"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotKVItem does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotKVItem) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.store.snapshots.v1.SnapshotKVItem.key":
+		x.Key = value.Bytes()
+	case "cosmos.store.snapshots.v1.SnapshotKVItem.value":
+		x.Value = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotKVItem"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotKVItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotKVItem) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.store.snapshots.v1.SnapshotKVItem.key":
+		panic(fmt.Errorf("field key of message cosmos.store.snapshots.v1.SnapshotKVItem is not mutable"))
+	case "cosmos.store.snapshots.v1.SnapshotKVItem.value":
+		panic(fmt.Errorf("field value of message cosmos.store.snapshots.v1.SnapshotKVItem is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotKVItem"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotKVItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SnapshotKVItem) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.store.snapshots.v1.SnapshotKVItem.key":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.store.snapshots.v1.SnapshotKVItem.value":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotKVItem"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotKVItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SnapshotKVItem) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.store.snapshots.v1.SnapshotKVItem", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SnapshotKVItem) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotKVItem) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SnapshotKVItem) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SnapshotKVItem) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SnapshotKVItem)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Key)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Value)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SnapshotKVItem)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Value) > 0 {
+			i -= len(x.Value)
+			copy(dAtA[i:], x.Value)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Value)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Key) > 0 {
+			i -= len(x.Key)
+			copy(dAtA[i:], x.Key)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Key)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SnapshotKVItem)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotKVItem: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotKVItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Key = append(x.Key[:0], dAtA[iNdEx:postIndex]...)
+				if x.Key == nil {
+					x.Key = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Value = append(x.Value[:0], dAtA[iNdEx:postIndex]...)
+				if x.Value == nil {
+					x.Value = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SnapshotExtensionMeta        protoreflect.MessageDescriptor
+	fd_SnapshotExtensionMeta_name   protoreflect.FieldDescriptor
+	fd_SnapshotExtensionMeta_format protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_store_snapshots_v1_snapshot_proto_init()
+	md_SnapshotExtensionMeta = File_cosmos_store_snapshots_v1_snapshot_proto.Messages().ByName("SnapshotExtensionMeta")
+	fd_SnapshotExtensionMeta_name = md_SnapshotExtensionMeta.Fields().ByName("name")
+	fd_SnapshotExtensionMeta_format = md_SnapshotExtensionMeta.Fields().ByName("format")
+}
+
+var _ protoreflect.Message = (*fastReflection_SnapshotExtensionMeta)(nil)
+
+type fastReflection_SnapshotExtensionMeta SnapshotExtensionMeta
+
+func (x *SnapshotExtensionMeta) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SnapshotExtensionMeta)(x)
+}
+
+func (x *SnapshotExtensionMeta) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SnapshotExtensionMeta_messageType fastReflection_SnapshotExtensionMeta_messageType
+var _ protoreflect.MessageType = fastReflection_SnapshotExtensionMeta_messageType{}
+
+type fastReflection_SnapshotExtensionMeta_messageType struct{}
+
+func (x fastReflection_SnapshotExtensionMeta_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SnapshotExtensionMeta)(nil)
+}
+func (x fastReflection_SnapshotExtensionMeta_messageType) New() protoreflect.Message {
+	return new(fastReflection_SnapshotExtensionMeta)
+}
+func (x fastReflection_SnapshotExtensionMeta_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotExtensionMeta
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SnapshotExtensionMeta) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotExtensionMeta
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SnapshotExtensionMeta) Type() protoreflect.MessageType {
+	return _fastReflection_SnapshotExtensionMeta_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SnapshotExtensionMeta) New() protoreflect.Message {
+	return new(fastReflection_SnapshotExtensionMeta)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SnapshotExtensionMeta) Interface() protoreflect.ProtoMessage {
+	return (*SnapshotExtensionMeta)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SnapshotExtensionMeta) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_SnapshotExtensionMeta_name, value) {
+			return
+		}
+	}
+	if x.Format != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Format)
+		if !f(fd_SnapshotExtensionMeta_format, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SnapshotExtensionMeta) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.name":
+		return x.Name != ""
+	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.format":
+		return x.Format != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotExtensionMeta"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotExtensionMeta does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotExtensionMeta) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.name":
+		x.Name = ""
+	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.format":
+		x.Format = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotExtensionMeta"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotExtensionMeta does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SnapshotExtensionMeta) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.format":
+		value := x.Format
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotExtensionMeta"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotExtensionMeta does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotExtensionMeta) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.name":
+		x.Name = value.Interface().(string)
+	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.format":
+		x.Format = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotExtensionMeta"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotExtensionMeta does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotExtensionMeta) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.name":
+		panic(fmt.Errorf("field name of message cosmos.store.snapshots.v1.SnapshotExtensionMeta is not mutable"))
+	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.format":
+		panic(fmt.Errorf("field format of message cosmos.store.snapshots.v1.SnapshotExtensionMeta is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotExtensionMeta"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotExtensionMeta does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SnapshotExtensionMeta) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.name":
+		return protoreflect.ValueOfString("")
+	case "cosmos.store.snapshots.v1.SnapshotExtensionMeta.format":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v1.SnapshotExtensionMeta"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v1.SnapshotExtensionMeta does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SnapshotExtensionMeta) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.store.snapshots.v1.SnapshotExtensionMeta", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SnapshotExtensionMeta) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotExtensionMeta) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SnapshotExtensionMeta) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SnapshotExtensionMeta) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SnapshotExtensionMeta)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.Format != 0 {
 			n += 1 + runtime.Sov(uint64(x.Format))
@@ -3416,7 +4612,7 @@ func (x *SnapshotExtensionPayload) ProtoReflect() protoreflect.Message {
 }
 
 func (x *SnapshotExtensionPayload) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[6]
+	mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3906,6 +5102,10 @@ type Metadata struct {
 	unknownFields protoimpl.UnknownFields
 
 	ChunkHashes [][]byte `protobuf:"bytes,1,rep,name=chunk_hashes,json=chunkHashes,proto3" json:"chunk_hashes,omitempty"` // SHA-256 chunk hashes
+	// base_height is the height of the base snapshot of an incremental snapshot.
+	//
+	// Since: cosmos-sdk 0.50
+	BaseHeight uint64 `protobuf:"varint,2,opt,name=base_height,json=baseHeight,proto3" json:"base_height,omitempty"`
 }
 
 func (x *Metadata) Reset() {
@@ -3935,6 +5135,13 @@ func (x *Metadata) GetChunkHashes() [][]byte {
 	return nil
 }
 
+func (x *Metadata) GetBaseHeight() uint64 {
+	if x != nil {
+		return x.BaseHeight
+	}
+	return 0
+}
+
 // SnapshotItem is an item contained in a rootmulti.Store snapshot.
 //
 // Since: cosmos-sdk 0.46
@@ -3946,11 +5153,12 @@ type SnapshotItem struct {
 	// item is the specific type of snapshot item.
 	//
 	// Types that are assignable to Item:
-	//
 	//	*SnapshotItem_Store
 	//	*SnapshotItem_Iavl
 	//	*SnapshotItem_Extension
 	//	*SnapshotItem_ExtensionPayload
+	//	*SnapshotItem_IavlCopy
+	//	*SnapshotItem_Kv
 	Item isSnapshotItem_Item `protobuf_oneof:"item"`
 }
 
@@ -4009,6 +5217,20 @@ func (x *SnapshotItem) GetExtensionPayload() *SnapshotExtensionPayload {
 	return nil
 }
 
+func (x *SnapshotItem) GetIavlCopy() *SnapshotIAVLCopyItem {
+	if x, ok := x.GetItem().(*SnapshotItem_IavlCopy); ok {
+		return x.IavlCopy
+	}
+	return nil
+}
+
+func (x *SnapshotItem) GetKv() *SnapshotKVItem {
+	if x, ok := x.GetItem().(*SnapshotItem_Kv); ok {
+		return x.Kv
+	}
+	return nil
+}
+
 type isSnapshotItem_Item interface {
 	isSnapshotItem_Item()
 }
@@ -4029,6 +5251,16 @@ type SnapshotItem_ExtensionPayload struct {
 	ExtensionPayload *SnapshotExtensionPayload `protobuf:"bytes,4,opt,name=extension_payload,json=extensionPayload,proto3,oneof"`
 }
 
+type SnapshotItem_IavlCopy struct {
+	// Since: cosmos-sdk 0.50
+	IavlCopy *SnapshotIAVLCopyItem `protobuf:"bytes,5,opt,name=iavl_copy,json=iavlCopy,proto3,oneof"`
+}
+
+type SnapshotItem_Kv struct {
+	// Since: cosmos-sdk 0.50
+	Kv *SnapshotKVItem `protobuf:"bytes,6,opt,name=kv,proto3,oneof"`
+}
+
 func (*SnapshotItem_Store) isSnapshotItem_Item() {}
 
 func (*SnapshotItem_Iavl) isSnapshotItem_Item() {}
@@ -4037,6 +5269,10 @@ func (*SnapshotItem_Extension) isSnapshotItem_Item() {}
 
 func (*SnapshotItem_ExtensionPayload) isSnapshotItem_Item() {}
 
+func (*SnapshotItem_IavlCopy) isSnapshotItem_Item() {}
+
+func (*SnapshotItem_Kv) isSnapshotItem_Item() {}
+
 // SnapshotStoreItem contains metadata about a snapshotted store.
 //
 // Since: cosmos-sdk 0.46
@@ -4139,6 +5375,101 @@ func (x *SnapshotIAVLItem) GetHeight() int32 {
 	return 0
 }
 
+// SnapshotIAVLCopyItem copies exported IAVL nodes from the base snapshot of an
+// incremental snapshot: the next skip nodes of the store in the base snapshot are
+// dropped, and the following copy nodes are copied.
+//
+// Since: cosmos-sdk 0.50
+type SnapshotIAVLCopyItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Skip uint64 `protobuf:"varint,1,opt,name=skip,proto3" json:"skip,omitempty"`
+	Copy uint64 `protobuf:"varint,2,opt,name=copy,proto3" json:"copy,omitempty"`
+}
+
+func (x *SnapshotIAVLCopyItem) Reset() {
+	*x = SnapshotIAVLCopyItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotIAVLCopyItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotIAVLCopyItem) ProtoMessage() {}
+
+// Deprecated: Use SnapshotIAVLCopyItem.ProtoReflect.Descriptor instead.
+func (*SnapshotIAVLCopyItem) Descriptor() ([]byte, []int) {
+	return file_cosmos_store_snapshots_v1_snapshot_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SnapshotIAVLCopyItem) GetSkip() uint64 {
+	if x != nil {
+		return x.Skip
+	}
+	return 0
+}
+
+func (x *SnapshotIAVLCopyItem) GetCopy() uint64 {
+	if x != nil {
+		return x.Copy
+	}
+	return 0
+}
+
+// SnapshotKVItem is an exported key/value pair of a store of a commitment
+// backend.
+//
+// Since: cosmos-sdk 0.50
+type SnapshotKVItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *SnapshotKVItem) Reset() {
+	*x = SnapshotKVItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotKVItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotKVItem) ProtoMessage() {}
+
+// Deprecated: Use SnapshotKVItem.ProtoReflect.Descriptor instead.
+func (*SnapshotKVItem) Descriptor() ([]byte, []int) {
+	return file_cosmos_store_snapshots_v1_snapshot_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SnapshotKVItem) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *SnapshotKVItem) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
 // SnapshotExtensionMeta contains metadata about an external snapshotter.
 //
 // Since: cosmos-sdk 0.46
@@ -4154,7 +5485,7 @@ type SnapshotExtensionMeta struct {
 func (x *SnapshotExtensionMeta) Reset() {
 	*x = SnapshotExtensionMeta{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[5]
+		mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4168,7 +5499,7 @@ func (*SnapshotExtensionMeta) ProtoMessage() {}
 
 // Deprecated: Use SnapshotExtensionMeta.ProtoReflect.Descriptor instead.
 func (*SnapshotExtensionMeta) Descriptor() ([]byte, []int) {
-	return file_cosmos_store_snapshots_v1_snapshot_proto_rawDescGZIP(), []int{5}
+	return file_cosmos_store_snapshots_v1_snapshot_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SnapshotExtensionMeta) GetName() string {
@@ -4199,7 +5530,7 @@ type SnapshotExtensionPayload struct {
 func (x *SnapshotExtensionPayload) Reset() {
 	*x = SnapshotExtensionPayload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[6]
+		mi := &file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4213,7 +5544,7 @@ func (*SnapshotExtensionPayload) ProtoMessage() {}
 
 // Deprecated: Use SnapshotExtensionPayload.ProtoReflect.Descriptor instead.
 func (*SnapshotExtensionPayload) Descriptor() ([]byte, []int) {
-	return file_cosmos_store_snapshots_v1_snapshot_proto_rawDescGZIP(), []int{6}
+	return file_cosmos_store_snapshots_v1_snapshot_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SnapshotExtensionPayload) GetPayload() []byte {
@@ -4242,65 +5573,85 @@ var file_cosmos_store_snapshots_v1_snapshot_proto_rawDesc = []byte{
 	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
 	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e,
 	0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x04, 0xc8, 0xde, 0x1f,
-	0x00, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x2d, 0x0a, 0x08, 0x4d,
+	0x00, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x4e, 0x0a, 0x08, 0x4d,
 	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x75, 0x6e, 0x6b,
 	0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x63,
-	0x68, 0x75, 0x6e, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0xdf, 0x02, 0x0a, 0x0c, 0x53,
-	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x44, 0x0a, 0x05, 0x73,
-	0x74, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
-	0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53,
-	0x74, 0x6f, 0x72, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x72,
-	0x65, 0x12, 0x4b, 0x0a, 0x04, 0x69, 0x61, 0x76, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x2b, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x73,
-	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70,
-	0x73, 0x68, 0x6f, 0x74, 0x49, 0x41, 0x56, 0x4c, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x08, 0xe2, 0xde,
-	0x1f, 0x04, 0x49, 0x41, 0x56, 0x4c, 0x48, 0x00, 0x52, 0x04, 0x69, 0x61, 0x76, 0x6c, 0x12, 0x50,
-	0x0a, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x30, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65,
-	0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x4d,
-	0x65, 0x74, 0x61, 0x48, 0x00, 0x52, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x62, 0x0a, 0x11, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61,
-	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x63, 0x6f,
+	0x68, 0x75, 0x6e, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61,
+	0x73, 0x65, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x62, 0x61, 0x73, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x82, 0x04, 0x0a, 0x0c,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x44, 0x0a, 0x05,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x6f,
 	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73,
 	0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
-	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x48, 0x00, 0x52, 0x10, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79,
-	0x6c, 0x6f, 0x61, 0x64, 0x42, 0x06, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x27, 0x0a, 0x11,
-	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x74, 0x65,
-	0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x6c, 0x0a, 0x10, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
-	0x74, 0x49, 0x41, 0x56, 0x4c, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x12, 0x4b, 0x0a, 0x04, 0x69, 0x61, 0x76, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x41, 0x56, 0x4c, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x08, 0xe2,
+	0xde, 0x1f, 0x04, 0x49, 0x41, 0x56, 0x4c, 0x48, 0x00, 0x52, 0x04, 0x69, 0x61, 0x76, 0x6c, 0x12,
+	0x50, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x30, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x4d, 0x65, 0x74, 0x61, 0x48, 0x00, 0x52, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x62, 0x0a, 0x11, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x48, 0x00, 0x52, 0x10, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x5c, 0x0a, 0x09, 0x69, 0x61, 0x76, 0x6c, 0x5f, 0x63, 0x6f,
+	0x70, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x41, 0x56,
+	0x4c, 0x43, 0x6f, 0x70, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x0c, 0xe2, 0xde, 0x1f, 0x08, 0x49,
+	0x41, 0x56, 0x4c, 0x43, 0x6f, 0x70, 0x79, 0x48, 0x00, 0x52, 0x08, 0x69, 0x61, 0x76, 0x6c, 0x43,
+	0x6f, 0x70, 0x79, 0x12, 0x43, 0x0a, 0x02, 0x6b, 0x76, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x4b, 0x56, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x06, 0xe2, 0xde, 0x1f, 0x02,
+	0x4b, 0x56, 0x48, 0x00, 0x52, 0x02, 0x6b, 0x76, 0x42, 0x06, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d,
+	0x22, 0x27, 0x0a, 0x11, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x74, 0x6f, 0x72,
+	0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x6c, 0x0a, 0x10, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x41, 0x56, 0x4c, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x3e, 0x0a, 0x14, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x49, 0x41, 0x56, 0x4c, 0x43, 0x6f, 0x70, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73,
+	0x6b, 0x69, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x70, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x04, 0x63, 0x6f, 0x70, 0x79, 0x22, 0x38, 0x0a, 0x0e, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x4b, 0x56, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
 	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x22, 0x43, 0x0a, 0x15, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45,
-	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x34, 0x0a, 0x18, 0x53, 0x6e, 0x61, 0x70,
-	0x73, 0x68, 0x6f, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79,
-	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0xed,
-	0x01, 0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74,
-	0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31,
-	0x42, 0x0d, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x36, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65,
-	0x2f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x53, 0x53, 0xaa,
-	0x02, 0x19, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x53,
-	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x19, 0x43, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x5c, 0x53, 0x6e, 0x61, 0x70, 0x73,
-	0x68, 0x6f, 0x74, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x25, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x5c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x5c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73,
-	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x1c, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x3a,
-	0x3a, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x22, 0x43, 0x0a, 0x15, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x34, 0x0a, 0x18, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0xed, 0x01, 0x0a,
+	0x1d, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0d,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x36, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x53, 0x53, 0xaa, 0x02, 0x19,
+	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x19, 0x43, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x5c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x5c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x25, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x5c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x5c, 0x56,
+	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1c,
+	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x3a, 0x3a, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4315,27 +5666,31 @@ func file_cosmos_store_snapshots_v1_snapshot_proto_rawDescGZIP() []byte {
 	return file_cosmos_store_snapshots_v1_snapshot_proto_rawDescData
 }
 
-var file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_cosmos_store_snapshots_v1_snapshot_proto_goTypes = []interface{}{
 	(*Snapshot)(nil),                 // 0: cosmos.store.snapshots.v1.Snapshot
 	(*Metadata)(nil),                 // 1: cosmos.store.snapshots.v1.Metadata
 	(*SnapshotItem)(nil),             // 2: cosmos.store.snapshots.v1.SnapshotItem
 	(*SnapshotStoreItem)(nil),        // 3: cosmos.store.snapshots.v1.SnapshotStoreItem
 	(*SnapshotIAVLItem)(nil),         // 4: cosmos.store.snapshots.v1.SnapshotIAVLItem
-	(*SnapshotExtensionMeta)(nil),    // 5: cosmos.store.snapshots.v1.SnapshotExtensionMeta
-	(*SnapshotExtensionPayload)(nil), // 6: cosmos.store.snapshots.v1.SnapshotExtensionPayload
+	(*SnapshotIAVLCopyItem)(nil),     // 5: cosmos.store.snapshots.v1.SnapshotIAVLCopyItem
+	(*SnapshotKVItem)(nil),           // 6: cosmos.store.snapshots.v1.SnapshotKVItem
+	(*SnapshotExtensionMeta)(nil),    // 7: cosmos.store.snapshots.v1.SnapshotExtensionMeta
+	(*SnapshotExtensionPayload)(nil), // 8: cosmos.store.snapshots.v1.SnapshotExtensionPayload
 }
 var file_cosmos_store_snapshots_v1_snapshot_proto_depIdxs = []int32{
 	1, // 0: cosmos.store.snapshots.v1.Snapshot.metadata:type_name -> cosmos.store.snapshots.v1.Metadata
 	3, // 1: cosmos.store.snapshots.v1.SnapshotItem.store:type_name -> cosmos.store.snapshots.v1.SnapshotStoreItem
 	4, // 2: cosmos.store.snapshots.v1.SnapshotItem.iavl:type_name -> cosmos.store.snapshots.v1.SnapshotIAVLItem
-	5, // 3: cosmos.store.snapshots.v1.SnapshotItem.extension:type_name -> cosmos.store.snapshots.v1.SnapshotExtensionMeta
-	6, // 4: cosmos.store.snapshots.v1.SnapshotItem.extension_payload:type_name -> cosmos.store.snapshots.v1.SnapshotExtensionPayload
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	7, // 3: cosmos.store.snapshots.v1.SnapshotItem.extension:type_name -> cosmos.store.snapshots.v1.SnapshotExtensionMeta
+	8, // 4: cosmos.store.snapshots.v1.SnapshotItem.extension_payload:type_name -> cosmos.store.snapshots.v1.SnapshotExtensionPayload
+	5, // 5: cosmos.store.snapshots.v1.SnapshotItem.iavl_copy:type_name -> cosmos.store.snapshots.v1.SnapshotIAVLCopyItem
+	6, // 6: cosmos.store.snapshots.v1.SnapshotItem.kv:type_name -> cosmos.store.snapshots.v1.SnapshotKVItem
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_store_snapshots_v1_snapshot_proto_init() }
@@ -4405,7 +5760,7 @@ func file_cosmos_store_snapshots_v1_snapshot_proto_init() {
 			}
 		}
 		file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SnapshotExtensionMeta); i {
+			switch v := v.(*SnapshotIAVLCopyItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4417,6 +5772,30 @@ func file_cosmos_store_snapshots_v1_snapshot_proto_init() {
 			}
 		}
 		file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotKVItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotExtensionMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_store_snapshots_v1_snapshot_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SnapshotExtensionPayload); i {
 			case 0:
 				return &v.state
@@ -4434,6 +5813,8 @@ func file_cosmos_store_snapshots_v1_snapshot_proto_init() {
 		(*SnapshotItem_Iavl)(nil),
 		(*SnapshotItem_Extension)(nil),
 		(*SnapshotItem_ExtensionPayload)(nil),
+		(*SnapshotItem_IavlCopy)(nil),
+		(*SnapshotItem_Kv)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -4441,7 +5822,7 @@ func file_cosmos_store_snapshots_v1_snapshot_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_store_snapshots_v1_snapshot_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -1530,6 +1530,573 @@ func (x *BlockMetadata_DeliverTx) ProtoReflect() protoreflect.Message {
 }
 
 func (x *BlockMetadata_DeliverTx) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_store_v1beta1_listening_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BlockMetadata_DeliverTx_messageType fastReflection_BlockMetadata_DeliverTx_messageType
+var _ protoreflect.MessageType = fastReflection_BlockMetadata_DeliverTx_messageType{}
+
+type fastReflection_BlockMetadata_DeliverTx_messageType struct{}
+
+func (x fastReflection_BlockMetadata_DeliverTx_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BlockMetadata_DeliverTx)(nil)
+}
+func (x fastReflection_BlockMetadata_DeliverTx_messageType) New() protoreflect.Message {
+	return new(fastReflection_BlockMetadata_DeliverTx)
+}
+func (x fastReflection_BlockMetadata_DeliverTx_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BlockMetadata_DeliverTx
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BlockMetadata_DeliverTx) Descriptor() protoreflect.MessageDescriptor {
+	return md_BlockMetadata_DeliverTx
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BlockMetadata_DeliverTx) Type() protoreflect.MessageType {
+	return _fastReflection_BlockMetadata_DeliverTx_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BlockMetadata_DeliverTx) New() protoreflect.Message {
+	return new(fastReflection_BlockMetadata_DeliverTx)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BlockMetadata_DeliverTx) Interface() protoreflect.ProtoMessage {
+	return (*BlockMetadata_DeliverTx)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BlockMetadata_DeliverTx) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Request != nil {
+		value := protoreflect.ValueOfMessage(x.Request.ProtoReflect())
+		if !f(fd_BlockMetadata_DeliverTx_request, value) {
+			return
+		}
+	}
+	if x.Response != nil {
+		value := protoreflect.ValueOfMessage(x.Response.ProtoReflect())
+		if !f(fd_BlockMetadata_DeliverTx_response, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BlockMetadata_DeliverTx) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.store.v1beta1.BlockMetadata.DeliverTx.request":
+		return x.Request != nil
+	case "cosmos.store.v1beta1.BlockMetadata.DeliverTx.response":
+		return x.Response != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.v1beta1.BlockMetadata.DeliverTx"))
+		}
+		panic(fmt.Errorf("message cosmos.store.v1beta1.BlockMetadata.DeliverTx does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BlockMetadata_DeliverTx) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.store.v1beta1.BlockMetadata.DeliverTx.request":
+		x.Request = nil
+	case "cosmos.store.v1beta1.BlockMetadata.DeliverTx.response":
+		x.Response = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.v1beta1.BlockMetadata.DeliverTx"))
+		}
+		panic(fmt.Errorf("message cosmos.store.v1beta1.BlockMetadata.DeliverTx does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BlockMetadata_DeliverTx) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.store.v1beta1.BlockMetadata.DeliverTx.request":
+		value := x.Request
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.store.v1beta1.BlockMetadata.DeliverTx.response":
+		value := x.Response
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.v1beta1.BlockMetadata.DeliverTx"))
+		}
+		panic(fmt.Errorf("message cosmos.store.v1beta1.BlockMetadata.DeliverTx does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BlockMetadata_DeliverTx) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.store.v1beta1.BlockMetadata.DeliverTx.request":
+		x.Request = value.Message().Interface().(*abci.RequestDeliverTx)
+	case "cosmos.store.v1beta1.BlockMetadata.DeliverTx.response":
+		x.Response = value.Message().Interface().(*abci.ResponseDeliverTx)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.v1beta1.BlockMetadata.DeliverTx"))
+		}
+		panic(fmt.Errorf("message cosmos.store.v1beta1.BlockMetadata.DeliverTx does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BlockMetadata_DeliverTx) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.store.v1beta1.BlockMetadata.DeliverTx.request":
+		if x.Request == nil {
+			x.Request = new(abci.RequestDeliverTx)
+		}
+		return protoreflect.ValueOfMessage(x.Request.ProtoReflect())
+	case "cosmos.store.v1beta1.BlockMetadata.DeliverTx.response":
+		if x.Response == nil {
+			x.Response = new(abci.ResponseDeliverTx)
+		}
+		return protoreflect.ValueOfMessage(x.Response.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.v1beta1.BlockMetadata.DeliverTx"))
+		}
+		panic(fmt.Errorf("message cosmos.store.v1beta1.BlockMetadata.DeliverTx does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BlockMetadata_DeliverTx) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.store.v1beta1.BlockMetadata.DeliverTx.request":
+		m := new(abci.RequestDeliverTx)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.store.v1beta1.BlockMetadata.DeliverTx.response":
+		m := new(abci.ResponseDeliverTx)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.v1beta1.BlockMetadata.DeliverTx"))
+		}
+		panic(fmt.Errorf("message cosmos.store.v1beta1.BlockMetadata.DeliverTx does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BlockMetadata_DeliverTx) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.store.v1beta1.BlockMetadata.DeliverTx", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BlockMetadata_DeliverTx) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BlockMetadata_DeliverTx) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BlockMetadata_DeliverTx) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BlockMetadata_DeliverTx) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BlockMetadata_DeliverTx)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Request != nil {
+			l = options.Size(x.Request)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Response != nil {
+			l = options.Size(x.Response)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BlockMetadata_DeliverTx)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Response != nil {
+			encoded, err := options.Marshal(x.Response)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Request != nil {
+			encoded, err := options.Marshal(x.Request)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BlockMetadata_DeliverTx)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BlockMetadata_DeliverTx: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BlockMetadata_DeliverTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Request == nil {
+					x.Request = &abci.RequestDeliverTx{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Request); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Response == nil {
+					x.Response = &abci.ResponseDeliverTx{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Response); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_StreamedBlock_3_list)(nil)
+
+type _StreamedBlock_3_list struct {
+	list *[]*StoreKVPair
+}
+
+func (x *_StreamedBlock_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_StreamedBlock_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_StreamedBlock_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StoreKVPair)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_StreamedBlock_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StoreKVPair)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_StreamedBlock_3_list) AppendMutable() protoreflect.Value {
+	v := new(StoreKVPair)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_StreamedBlock_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_StreamedBlock_3_list) NewElement() protoreflect.Value {
+	v := new(StoreKVPair)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_StreamedBlock_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_StreamedBlock              protoreflect.MessageDescriptor
+	fd_StreamedBlock_block_height protoreflect.FieldDescriptor
+	fd_StreamedBlock_metadata     protoreflect.FieldDescriptor
+	fd_StreamedBlock_change_set   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_store_v1beta1_listening_proto_init()
+	md_StreamedBlock = File_cosmos_store_v1beta1_listening_proto.Messages().ByName("StreamedBlock")
+	fd_StreamedBlock_block_height = md_StreamedBlock.Fields().ByName("block_height")
+	fd_StreamedBlock_metadata = md_StreamedBlock.Fields().ByName("metadata")
+	fd_StreamedBlock_change_set = md_StreamedBlock.Fields().ByName("change_set")
+}
+
+var _ protoreflect.Message = (*fastReflection_StreamedBlock)(nil)
+
+type fastReflection_StreamedBlock StreamedBlock
+
+func (x *StreamedBlock) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_StreamedBlock)(x)
+}
+
+func (x *StreamedBlock) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_store_v1beta1_listening_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1541,43 +2108,43 @@ func (x *BlockMetadata_DeliverTx) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_BlockMetadata_DeliverTx_messageType fastReflection_BlockMetadata_DeliverTx_messageType
-var _ protoreflect.MessageType = fastReflection_BlockMetadata_DeliverTx_messageType{}
+var _fastReflection_StreamedBlock_messageType fastReflection_StreamedBlock_messageType
+var _ protoreflect.MessageType = fastReflection_StreamedBlock_messageType{}
 
-type fastReflection_BlockMetadata_DeliverTx_messageType struct{}
+type fastReflection_StreamedBlock_messageType struct{}
 
-func (x fastReflection_BlockMetadata_DeliverTx_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_BlockMetadata_DeliverTx)(nil)
+func (x fastReflection_StreamedBlock_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_StreamedBlock)(nil)
 }
-func (x fastReflection_BlockMetadata_DeliverTx_messageType) New() protoreflect.Message {
-	return new(fastReflection_BlockMetadata_DeliverTx)
+func (x fastReflection_StreamedBlock_messageType) New() protoreflect.Message {
+	return new(fastReflection_StreamedBlock)
 }
-func (x fastReflection_BlockMetadata_DeliverTx_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_BlockMetadata_DeliverTx
+func (x fastReflection_StreamedBlock_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_StreamedBlock
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_BlockMetadata_DeliverTx) Descriptor() protoreflect.MessageDescriptor {
-	return md_BlockMetadata_DeliverTx
+func (x *fastReflection_StreamedBlock) Descriptor() protoreflect.MessageDescriptor {
+	return md_StreamedBlock
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_BlockMetadata_DeliverTx) Type() protoreflect.MessageType {
-	return _fastReflection_BlockMetadata_DeliverTx_messageType
+func (x *fastReflection_StreamedBlock) Type() protoreflect.MessageType {
+	return _fastReflection_StreamedBlock_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_BlockMetadata_DeliverTx) New() protoreflect.Message {
-	return new(fastReflection_BlockMetadata_DeliverTx)
+func (x *fastReflection_StreamedBlock) New() protoreflect.Message {
+	return new(fastReflection_StreamedBlock)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_BlockMetadata_DeliverTx) Interface() protoreflect.ProtoMessage {
-	return (*BlockMetadata_DeliverTx)(x)
+func (x *fastReflection_StreamedBlock) Interface() protoreflect.ProtoMessage {
+	return (*StreamedBlock)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1585,16 +2152,22 @@ func (x *fastReflection_BlockMetadata_DeliverTx) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_BlockMetadata_DeliverTx) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Request != nil {
-		value := protoreflect.ValueOfMessage(x.Request.ProtoReflect())
-		if !f(fd_BlockMetadata_DeliverTx_request, value) {
+func (x *fastReflection_StreamedBlock) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockHeight)
+		if !f(fd_StreamedBlock_block_height, value) {
 			return
 		}
 	}
-	if x.Response != nil {
-		value := protoreflect.ValueOfMessage(x.Response.ProtoReflect())
-		if !f(fd_BlockMetadata_DeliverTx_response, value) {
+	if x.Metadata != nil {
+		value := protoreflect.ValueOfMessage(x.Metadata.ProtoReflect())
+		if !f(fd_StreamedBlock_metadata, value) {
+			return
+		}
+	}
+	if len(x.ChangeSet) != 0 {
+		value := protoreflect.ValueOfList(&_StreamedBlock_3_list{list: &x.ChangeSet})
+		if !f(fd_StreamedBlock_change_set, value) {
 			return
 		}
 	}
@@ -1611,17 +2184,19 @@ func (x *fastReflection_BlockMetadata_DeliverTx) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_BlockMetadata_DeliverTx) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_StreamedBlock) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.store.v1beta1.BlockMetadata.DeliverTx.request":
-		return x.Request != nil
-	case "cosmos.store.v1beta1.BlockMetadata.DeliverTx.response":
-		return x.Response != nil
+	case "cosmos.store.v1beta1.StreamedBlock.block_height":
+		return x.BlockHeight != int64(0)
+	case "cosmos.store.v1beta1.StreamedBlock.metadata":
+		return x.Metadata != nil
+	case "cosmos.store.v1beta1.StreamedBlock.change_set":
+		return len(x.ChangeSet) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.v1beta1.BlockMetadata.DeliverTx"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.v1beta1.StreamedBlock"))
 		}
-		panic(fmt.Errorf("message cosmos.store.v1beta1.BlockMetadata.DeliverTx does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.store.v1beta1.StreamedBlock does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1631,17 +2206,19 @@ func (x *fastReflection_BlockMetadata_DeliverTx) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BlockMetadata_DeliverTx) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_StreamedBlock) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.store.v1beta1.BlockMetadata.DeliverTx.request":
-		x.Request = nil
-	case "cosmos.store.v1beta1.BlockMetadata.DeliverTx.response":
-		x.Response = nil
+	case "cosmos.store.v1beta1.StreamedBlock.block_height":
+		x.BlockHeight = int64(0)
+	case "cosmos.store.v1beta1.StreamedBlock.metadata":
+		x.Metadata = nil
+	case "cosmos.store.v1beta1.StreamedBlock.change_set":
+		x.ChangeSet = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.v1beta1.BlockMetadata.DeliverTx"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.v1beta1.StreamedBlock"))
 		}
-		panic(fmt.Errorf("message cosmos.store.v1beta1.BlockMetadata.DeliverTx does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.store.v1beta1.StreamedBlock does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1651,19 +2228,25 @@ func (x *fastReflection_BlockMetadata_DeliverTx) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_BlockMetadata_DeliverTx) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_StreamedBlock) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.store.v1beta1.BlockMetadata.DeliverTx.request":
-		value := x.Request
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.store.v1beta1.BlockMetadata.DeliverTx.response":
-		value := x.Response
+	case "cosmos.store.v1beta1.StreamedBlock.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.store.v1beta1.StreamedBlock.metadata":
+		value := x.Metadata
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.store.v1beta1.StreamedBlock.change_set":
+		if len(x.ChangeSet) == 0 {
+			return protoreflect.ValueOfList(&_StreamedBlock_3_list{})
+		}
+		listValue := &_StreamedBlock_3_list{list: &x.ChangeSet}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.v1beta1.BlockMetadata.DeliverTx"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.v1beta1.StreamedBlock"))
 		}
-		panic(fmt.Errorf("message cosmos.store.v1beta1.BlockMetadata.DeliverTx does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.store.v1beta1.StreamedBlock does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1677,17 +2260,21 @@ func (x *fastReflection_BlockMetadata_DeliverTx) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BlockMetadata_DeliverTx) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_StreamedBlock) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.store.v1beta1.BlockMetadata.DeliverTx.request":
-		x.Request = value.Message().Interface().(*abci.RequestDeliverTx)
-	case "cosmos.store.v1beta1.BlockMetadata.DeliverTx.response":
-		x.Response = value.Message().Interface().(*abci.ResponseDeliverTx)
+	case "cosmos.store.v1beta1.StreamedBlock.block_height":
+		x.BlockHeight = value.Int()
+	case "cosmos.store.v1beta1.StreamedBlock.metadata":
+		x.Metadata = value.Message().Interface().(*BlockMetadata)
+	case "cosmos.store.v1beta1.StreamedBlock.change_set":
+		lv := value.List()
+		clv := lv.(*_StreamedBlock_3_list)
+		x.ChangeSet = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.v1beta1.BlockMetadata.DeliverTx"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.v1beta1.StreamedBlock"))
 		}
-		panic(fmt.Errorf("message cosmos.store.v1beta1.BlockMetadata.DeliverTx does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.store.v1beta1.StreamedBlock does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1701,52 +2288,57 @@ func (x *fastReflection_BlockMetadata_DeliverTx) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BlockMetadata_DeliverTx) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_StreamedBlock) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.store.v1beta1.BlockMetadata.DeliverTx.request":
-		if x.Request == nil {
-			x.Request = new(abci.RequestDeliverTx)
+	case "cosmos.store.v1beta1.StreamedBlock.metadata":
+		if x.Metadata == nil {
+			x.Metadata = new(BlockMetadata)
 		}
-		return protoreflect.ValueOfMessage(x.Request.ProtoReflect())
-	case "cosmos.store.v1beta1.BlockMetadata.DeliverTx.response":
-		if x.Response == nil {
-			x.Response = new(abci.ResponseDeliverTx)
+		return protoreflect.ValueOfMessage(x.Metadata.ProtoReflect())
+	case "cosmos.store.v1beta1.StreamedBlock.change_set":
+		if x.ChangeSet == nil {
+			x.ChangeSet = []*StoreKVPair{}
 		}
-		return protoreflect.ValueOfMessage(x.Response.ProtoReflect())
+		value := &_StreamedBlock_3_list{list: &x.ChangeSet}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.store.v1beta1.StreamedBlock.block_height":
+		panic(fmt.Errorf("field block_height of message cosmos.store.v1beta1.StreamedBlock is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.v1beta1.BlockMetadata.DeliverTx"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.v1beta1.StreamedBlock"))
 		}
-		panic(fmt.Errorf("message cosmos.store.v1beta1.BlockMetadata.DeliverTx does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.store.v1beta1.StreamedBlock does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_BlockMetadata_DeliverTx) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_StreamedBlock) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.store.v1beta1.BlockMetadata.DeliverTx.request":
-		m := new(abci.RequestDeliverTx)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.store.v1beta1.BlockMetadata.DeliverTx.response":
-		m := new(abci.ResponseDeliverTx)
+	case "cosmos.store.v1beta1.StreamedBlock.block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.store.v1beta1.StreamedBlock.metadata":
+		m := new(BlockMetadata)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.store.v1beta1.StreamedBlock.change_set":
+		list := []*StoreKVPair{}
+		return protoreflect.ValueOfList(&_StreamedBlock_3_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.v1beta1.BlockMetadata.DeliverTx"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.v1beta1.StreamedBlock"))
 		}
-		panic(fmt.Errorf("message cosmos.store.v1beta1.BlockMetadata.DeliverTx does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.store.v1beta1.StreamedBlock does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_BlockMetadata_DeliverTx) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_StreamedBlock) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.store.v1beta1.BlockMetadata.DeliverTx", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.store.v1beta1.StreamedBlock", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1754,7 +2346,7 @@ func (x *fastReflection_BlockMetadata_DeliverTx) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_BlockMetadata_DeliverTx) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_StreamedBlock) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1765,7 +2357,7 @@ func (x *fastReflection_BlockMetadata_DeliverTx) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BlockMetadata_DeliverTx) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_StreamedBlock) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1777,7 +2369,7 @@ func (x *fastReflection_BlockMetadata_DeliverTx) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_BlockMetadata_DeliverTx) IsValid() bool {
+func (x *fastReflection_StreamedBlock) IsValid() bool {
 	return x != nil
 }
 
@@ -1787,9 +2379,9 @@ func (x *fastReflection_BlockMetadata_DeliverTx) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_BlockMetadata_DeliverTx) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_StreamedBlock) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*BlockMetadata_DeliverTx)
+		x := input.Message.Interface().(*StreamedBlock)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1801,14 +2393,19 @@ func (x *fastReflection_BlockMetadata_DeliverTx) ProtoMethods() *protoiface.Meth
 		var n int
 		var l int
 		_ = l
-		if x.Request != nil {
-			l = options.Size(x.Request)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
 		}
-		if x.Response != nil {
-			l = options.Size(x.Response)
+		if x.Metadata != nil {
+			l = options.Size(x.Metadata)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.ChangeSet) > 0 {
+			for _, e := range x.ChangeSet {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1819,7 +2416,7 @@ func (x *fastReflection_BlockMetadata_DeliverTx) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*BlockMetadata_DeliverTx)
+		x := input.Message.Interface().(*StreamedBlock)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1838,8 +2435,24 @@ func (x *fastReflection_BlockMetadata_DeliverTx) ProtoMethods() *protoiface.Meth
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Response != nil {
-			encoded, err := options.Marshal(x.Response)
+		if len(x.ChangeSet) > 0 {
+			for iNdEx := len(x.ChangeSet) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ChangeSet[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.Metadata != nil {
+			encoded, err := options.Marshal(x.Metadata)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1852,19 +2465,10 @@ func (x *fastReflection_BlockMetadata_DeliverTx) ProtoMethods() *protoiface.Meth
 			i--
 			dAtA[i] = 0x12
 		}
-		if x.Request != nil {
-			encoded, err := options.Marshal(x.Request)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1877,7 +2481,7 @@ func (x *fastReflection_BlockMetadata_DeliverTx) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*BlockMetadata_DeliverTx)
+		x := input.Message.Interface().(*StreamedBlock)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1909,15 +2513,34 @@ func (x *fastReflection_BlockMetadata_DeliverTx) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BlockMetadata_DeliverTx: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StreamedBlock: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BlockMetadata_DeliverTx: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StreamedBlock: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1944,16 +2567,16 @@ func (x *fastReflection_BlockMetadata_DeliverTx) ProtoMethods() *protoiface.Meth
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Request == nil {
-					x.Request = &abci.RequestDeliverTx{}
+				if x.Metadata == nil {
+					x.Metadata = &BlockMetadata{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Request); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Metadata); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 2:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChangeSet", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1980,10 +2603,8 @@ func (x *fastReflection_BlockMetadata_DeliverTx) ProtoMethods() *protoiface.Meth
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Response == nil {
-					x.Response = &abci.ResponseDeliverTx{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Response); err != nil {
+				x.ChangeSet = append(x.ChangeSet, &StoreKVPair{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ChangeSet[len(x.ChangeSet)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -2176,6 +2797,59 @@ func (x *BlockMetadata) GetResponseCommit() *abci.ResponseCommit {
 	return nil
 }
 
+// StreamedBlock contains the abci messages and the state changes of a block, it is
+// the record written by the built-in streaming listeners.
+type StreamedBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockHeight int64          `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Metadata    *BlockMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ChangeSet   []*StoreKVPair `protobuf:"bytes,3,rep,name=change_set,json=changeSet,proto3" json:"change_set,omitempty"`
+}
+
+func (x *StreamedBlock) Reset() {
+	*x = StreamedBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_store_v1beta1_listening_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamedBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamedBlock) ProtoMessage() {}
+
+// Deprecated: Use StreamedBlock.ProtoReflect.Descriptor instead.
+func (*StreamedBlock) Descriptor() ([]byte, []int) {
+	return file_cosmos_store_v1beta1_listening_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StreamedBlock) GetBlockHeight() int64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *StreamedBlock) GetMetadata() *BlockMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *StreamedBlock) GetChangeSet() []*StoreKVPair {
+	if x != nil {
+		return x.ChangeSet
+	}
+	return nil
+}
+
 // DeliverTx encapulate deliver tx request and response.
 type BlockMetadata_DeliverTx struct {
 	state         protoimpl.MessageState
@@ -2189,7 +2863,7 @@ type BlockMetadata_DeliverTx struct {
 func (x *BlockMetadata_DeliverTx) Reset() {
 	*x = BlockMetadata_DeliverTx{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_store_v1beta1_listening_proto_msgTypes[2]
+		mi := &file_cosmos_store_v1beta1_listening_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2275,21 +2949,32 @@ var file_cosmos_store_v1beta1_listening_proto_rawDesc = []byte{
 	0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x65, 0x6e, 0x64, 0x65,
 	0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
 	0x6e, 0x73, 0x65, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x54, 0x78, 0x52, 0x08, 0x72, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0xd0, 0x01, 0x0a, 0x18, 0x63, 0x6f, 0x6d, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x42, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x32, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
-	0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x73,
-	0x74, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x73, 0x74, 0x6f,
-	0x72, 0x65, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x53, 0x58, 0xaa,
-	0x02, 0x14, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x56,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x14, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c,
-	0x53, 0x74, 0x6f, 0x72, 0x65, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x20,
-	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x5c, 0x56, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0xea, 0x02, 0x16, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x53, 0x74, 0x6f, 0x72, 0x65,
-	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb5, 0x01, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x3f, 0x0a, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x40, 0x0a, 0x0a,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x56, 0x50,
+	0x61, 0x69, 0x72, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x65, 0x74, 0x42, 0xd0,
+	0x01, 0x0a, 0x18, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0e, 0x4c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x32, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x3b, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0xa2, 0x02, 0x03, 0x43, 0x53, 0x58, 0xaa, 0x02, 0x14, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02,
+	0x14, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x5c, 0x56, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x20, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x3a, 0x3a, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2304,33 +2989,36 @@ func file_cosmos_store_v1beta1_listening_proto_rawDescGZIP() []byte {
 	return file_cosmos_store_v1beta1_listening_proto_rawDescData
 }
 
-var file_cosmos_store_v1beta1_listening_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_cosmos_store_v1beta1_listening_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_cosmos_store_v1beta1_listening_proto_goTypes = []interface{}{
 	(*StoreKVPair)(nil),             // 0: cosmos.store.v1beta1.StoreKVPair
 	(*BlockMetadata)(nil),           // 1: cosmos.store.v1beta1.BlockMetadata
-	(*BlockMetadata_DeliverTx)(nil), // 2: cosmos.store.v1beta1.BlockMetadata.DeliverTx
-	(*abci.RequestBeginBlock)(nil),  // 3: tendermint.abci.RequestBeginBlock
-	(*abci.ResponseBeginBlock)(nil), // 4: tendermint.abci.ResponseBeginBlock
-	(*abci.RequestEndBlock)(nil),    // 5: tendermint.abci.RequestEndBlock
-	(*abci.ResponseEndBlock)(nil),   // 6: tendermint.abci.ResponseEndBlock
-	(*abci.ResponseCommit)(nil),     // 7: tendermint.abci.ResponseCommit
-	(*abci.RequestDeliverTx)(nil),   // 8: tendermint.abci.RequestDeliverTx
-	(*abci.ResponseDeliverTx)(nil),  // 9: tendermint.abci.ResponseDeliverTx
+	(*StreamedBlock)(nil),           // 2: cosmos.store.v1beta1.StreamedBlock
+	(*BlockMetadata_DeliverTx)(nil), // 3: cosmos.store.v1beta1.BlockMetadata.DeliverTx
+	(*abci.RequestBeginBlock)(nil),  // 4: tendermint.abci.RequestBeginBlock
+	(*abci.ResponseBeginBlock)(nil), // 5: tendermint.abci.ResponseBeginBlock
+	(*abci.RequestEndBlock)(nil),    // 6: tendermint.abci.RequestEndBlock
+	(*abci.ResponseEndBlock)(nil),   // 7: tendermint.abci.ResponseEndBlock
+	(*abci.ResponseCommit)(nil),     // 8: tendermint.abci.ResponseCommit
+	(*abci.RequestDeliverTx)(nil),   // 9: tendermint.abci.RequestDeliverTx
+	(*abci.ResponseDeliverTx)(nil),  // 10: tendermint.abci.ResponseDeliverTx
 }
 var file_cosmos_store_v1beta1_listening_proto_depIdxs = []int32{
-	3, // 0: cosmos.store.v1beta1.BlockMetadata.request_begin_block:type_name -> tendermint.abci.RequestBeginBlock
-	4, // 1: cosmos.store.v1beta1.BlockMetadata.response_begin_block:type_name -> tendermint.abci.ResponseBeginBlock
-	2, // 2: cosmos.store.v1beta1.BlockMetadata.deliver_txs:type_name -> cosmos.store.v1beta1.BlockMetadata.DeliverTx
-	5, // 3: cosmos.store.v1beta1.BlockMetadata.request_end_block:type_name -> tendermint.abci.RequestEndBlock
-	6, // 4: cosmos.store.v1beta1.BlockMetadata.response_end_block:type_name -> tendermint.abci.ResponseEndBlock
-	7, // 5: cosmos.store.v1beta1.BlockMetadata.response_commit:type_name -> tendermint.abci.ResponseCommit
-	8, // 6: cosmos.store.v1beta1.BlockMetadata.DeliverTx.request:type_name -> tendermint.abci.RequestDeliverTx
-	9, // 7: cosmos.store.v1beta1.BlockMetadata.DeliverTx.response:type_name -> tendermint.abci.ResponseDeliverTx
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	4,  // 0: cosmos.store.v1beta1.BlockMetadata.request_begin_block:type_name -> tendermint.abci.RequestBeginBlock
+	5,  // 1: cosmos.store.v1beta1.BlockMetadata.response_begin_block:type_name -> tendermint.abci.ResponseBeginBlock
+	3,  // 2: cosmos.store.v1beta1.BlockMetadata.deliver_txs:type_name -> cosmos.store.v1beta1.BlockMetadata.DeliverTx
+	6,  // 3: cosmos.store.v1beta1.BlockMetadata.request_end_block:type_name -> tendermint.abci.RequestEndBlock
+	7,  // 4: cosmos.store.v1beta1.BlockMetadata.response_end_block:type_name -> tendermint.abci.ResponseEndBlock
+	8,  // 5: cosmos.store.v1beta1.BlockMetadata.response_commit:type_name -> tendermint.abci.ResponseCommit
+	1,  // 6: cosmos.store.v1beta1.StreamedBlock.metadata:type_name -> cosmos.store.v1beta1.BlockMetadata
+	0,  // 7: cosmos.store.v1beta1.StreamedBlock.change_set:type_name -> cosmos.store.v1beta1.StoreKVPair
+	9,  // 8: cosmos.store.v1beta1.BlockMetadata.DeliverTx.request:type_name -> tendermint.abci.RequestDeliverTx
+	10, // 9: cosmos.store.v1beta1.BlockMetadata.DeliverTx.response:type_name -> tendermint.abci.ResponseDeliverTx
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_store_v1beta1_listening_proto_init() }
@@ -2364,6 +3052,18 @@ func file_cosmos_store_v1beta1_listening_proto_init() {
 			}
 		}
 		file_cosmos_store_v1beta1_listening_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamedBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_store_v1beta1_listening_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BlockMetadata_DeliverTx); i {
 			case 0:
 				return &v.state
@@ -2382,7 +3082,7 @@ func file_cosmos_store_v1beta1_listening_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_store_v1beta1_listening_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
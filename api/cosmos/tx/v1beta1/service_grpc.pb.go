@@ -20,6 +20,7 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	Service_Simulate_FullMethodName        = "/cosmos.tx.v1beta1.Service/Simulate"
+	Service_SimulateBundle_FullMethodName  = "/cosmos.tx.v1beta1.Service/SimulateBundle"
 	Service_GetTx_FullMethodName           = "/cosmos.tx.v1beta1.Service/GetTx"
 	Service_BroadcastTx_FullMethodName     = "/cosmos.tx.v1beta1.Service/BroadcastTx"
 	Service_GetTxsEvent_FullMethodName     = "/cosmos.tx.v1beta1.Service/GetTxsEvent"
@@ -36,6 +37,10 @@ const (
 type ServiceClient interface {
 	// Simulate simulates executing a transaction for estimating gas usage.
 	Simulate(ctx context.Context, in *SimulateRequest, opts ...grpc.CallOption) (*SimulateResponse, error)
+	// SimulateBundle simulates executing an ordered bundle of transactions on top
+	// of the state of a height, each transaction seeing the writes of the
+	// previous ones. The transactions are not broadcast.
+	SimulateBundle(ctx context.Context, in *SimulateBundleRequest, opts ...grpc.CallOption) (*SimulateBundleResponse, error)
 	// GetTx fetches a tx by hash.
 	GetTx(ctx context.Context, in *GetTxRequest, opts ...grpc.CallOption) (*GetTxResponse, error)
 	// BroadcastTx broadcast transaction.
@@ -81,6 +86,15 @@ func (c *serviceClient) Simulate(ctx context.Context, in *SimulateRequest, opts
 	return out, nil
 }
 
+func (c *serviceClient) SimulateBundle(ctx context.Context, in *SimulateBundleRequest, opts ...grpc.CallOption) (*SimulateBundleResponse, error) {
+	out := new(SimulateBundleResponse)
+	err := c.cc.Invoke(ctx, Service_SimulateBundle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *serviceClient) GetTx(ctx context.Context, in *GetTxRequest, opts ...grpc.CallOption) (*GetTxResponse, error) {
 	out := new(GetTxResponse)
 	err := c.cc.Invoke(ctx, Service_GetTx_FullMethodName, in, out, opts...)
@@ -159,6 +173,10 @@ func (c *serviceClient) TxDecodeAmino(ctx context.Context, in *TxDecodeAminoRequ
 type ServiceServer interface {
 	// Simulate simulates executing a transaction for estimating gas usage.
 	Simulate(context.Context, *SimulateRequest) (*SimulateResponse, error)
+	// SimulateBundle simulates executing an ordered bundle of transactions on top
+	// of the state of a height, each transaction seeing the writes of the
+	// previous ones. The transactions are not broadcast.
+	SimulateBundle(context.Context, *SimulateBundleRequest) (*SimulateBundleResponse, error)
 	// GetTx fetches a tx by hash.
 	GetTx(context.Context, *GetTxRequest) (*GetTxResponse, error)
 	// BroadcastTx broadcast transaction.
@@ -195,6 +213,9 @@ type UnimplementedServiceServer struct {
 func (UnimplementedServiceServer) Simulate(context.Context, *SimulateRequest) (*SimulateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Simulate not implemented")
 }
+func (UnimplementedServiceServer) SimulateBundle(context.Context, *SimulateBundleRequest) (*SimulateBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateBundle not implemented")
+}
 func (UnimplementedServiceServer) GetTx(context.Context, *GetTxRequest) (*GetTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTx not implemented")
 }
@@ -250,6 +271,24 @@ func _Service_Simulate_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_SimulateBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).SimulateBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_SimulateBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SimulateBundle(ctx, req.(*SimulateBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Service_GetTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTxRequest)
 	if err := dec(in); err != nil {
@@ -405,6 +444,10 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Simulate",
 			Handler:    _Service_Simulate_Handler,
 		},
+		{
+			MethodName: "SimulateBundle",
+			Handler:    _Service_SimulateBundle_Handler,
+		},
 		{
 			MethodName: "GetTx",
 			Handler:    _Service_GetTx_Handler,
@@ -216,12 +216,14 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 	// set the signed validators for addition to context in deliverTx
 	app.voteInfos = req.LastCommitInfo.GetVotes()
 
+	app.prepareParallelBlock(req.Hash)
+
 	// call the streaming service hook with the BeginBlock messages
 	for _, abciListener := range app.streamingManager.ABCIListeners {
 		ctx := app.deliverState.ctx
 		blockHeight := ctx.BlockHeight()
 		if err := abciListener.ListenBeginBlock(ctx, req, res); err != nil {
-			app.logger.Error("BeginBlock listening hook failed", "height", blockHeight, "err", err)
+			app.handleListenerError("BeginBlock", blockHeight, err)
 		}
 	}
 
@@ -252,7 +254,7 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 		ctx := app.deliverState.ctx
 		blockHeight := ctx.BlockHeight()
 		if err := abciListener.ListenEndBlock(ctx, req, res); err != nil {
-			app.logger.Error("EndBlock listening hook failed", "height", blockHeight, "err", err)
+			app.handleListenerError("EndBlock", blockHeight, err)
 		}
 	}
 
@@ -306,6 +308,10 @@ func (app *BaseApp) PrepareProposal(req abci.RequestPrepareProposal) (resp abci.
 		}
 	}()
 
+	// the handler enumerates the mempool, which may be rechecked concurrently
+	app.mempoolMtx.Lock()
+	defer app.mempoolMtx.Unlock()
+
 	resp = app.prepareProposal(app.prepareProposalState.ctx, req)
 	return resp
 }
@@ -355,6 +361,10 @@ func (app *BaseApp) ProcessProposal(req abci.RequestProcessProposal) (resp abci.
 	}()
 
 	resp = app.processProposal(app.processProposalState.ctx, req)
+	if app.parallelTxWorkers > 0 && resp.IsAccepted() {
+		app.recordProposal(req)
+	}
+
 	return resp
 }
 
@@ -378,7 +388,7 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 		panic(fmt.Sprintf("unknown RequestCheckTx type: %s", req.Type))
 	}
 
-	gInfo, result, anteEvents, priority, err := app.runTx(mode, req.Tx)
+	gInfo, result, anteEvents, priority, err := app.runTx(mode, req.Tx, nil)
 	if err != nil {
 		return sdkerrors.ResponseCheckTxWithEvents(err, gInfo.GasWanted, gInfo.GasUsed, anteEvents, app.trace)
 	}
@@ -399,20 +409,24 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 // Regardless of tx execution outcome, the ResponseDeliverTx will contain relevant
 // gas execution context.
 func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
-	gInfo := sdk.GasInfo{}
-	resultStr := "successful"
+	res := app.deliverTx(req)
 
-	var res abci.ResponseDeliverTx
-	defer func() {
-		// call the streaming service hook with the EndBlock messages
-		for _, abciListener := range app.streamingManager.ABCIListeners {
-			ctx := app.deliverState.ctx
-			blockHeight := ctx.BlockHeight()
-			if err := abciListener.ListenDeliverTx(ctx, req, res); err != nil {
-				app.logger.Error("DeliverTx listening hook failed", "height", blockHeight, "err", err)
-			}
+	// call the streaming service hook with the DeliverTx messages
+	for _, abciListener := range app.streamingManager.ABCIListeners {
+		ctx := app.deliverState.ctx
+		blockHeight := ctx.BlockHeight()
+		if err := abciListener.ListenDeliverTx(ctx, req, res); err != nil {
+			app.handleListenerError("DeliverTx", blockHeight, err)
 		}
-	}()
+	}
+
+	return res
+}
+
+// deliverTx executes a tx in DeliverTx mode and returns its response.
+func (app *BaseApp) deliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+	gInfo := sdk.GasInfo{}
+	resultStr := "successful"
 
 	defer func() {
 		telemetry.IncrCounter(1, "tx", "count")
@@ -421,12 +435,30 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx
 		telemetry.SetGauge(float32(gInfo.GasWanted), "tx", "gas", "wanted")
 	}()
 
-	gInfo, result, anteEvents, _, err := app.runTx(runTxModeDeliver, req.Tx)
+	var (
+		tx         sdk.Tx
+		result     *sdk.Result
+		anteEvents []abci.Event
+		err        error
+	)
+
+	if app.parallelBlock != nil && !app.parallelBlock.isNext(req.Tx) {
+		app.abortParallelBlock()
+	}
+
+	if app.parallelBlock != nil {
+		tx, gInfo, result, anteEvents, err = app.deliverParallelTx()
+	} else if tx, err = app.txDecoder(req.Tx); err == nil {
+		gInfo, result, anteEvents, _, err = app.runTx(runTxModeDeliver, req.Tx, tx)
+	}
+
 	if err != nil {
 		resultStr = "failed"
 		return sdkerrors.ResponseDeliverTxWithEvents(err, gInfo.GasWanted, gInfo.GasUsed, sdk.MarkEventsToIndex(anteEvents, app.indexEvents), app.trace)
 	}
 
+	app.recordGasPrices(tx)
+
 	return abci.ResponseDeliverTx{
 		GasWanted: int64(gInfo.GasWanted), // TODO: Should type accept unsigned ints?
 		GasUsed:   int64(gInfo.GasUsed),   // TODO: Should type accept unsigned ints?
@@ -450,23 +482,38 @@ func (app *BaseApp) Commit() abci.ResponseCommit {
 	// Write the DeliverTx state into branched storage and commit the MultiStore.
 	// The write to the DeliverTx state writes all state transitions to the root
 	// MultiStore (app.cms) so when Commit() is called is persists those values.
+	// The recheck of the mempool reads the committed state, so it is stopped
+	// first.
+	app.stopMempoolRecheck()
 	app.deliverState.ms.Write()
 	commitID := app.cms.Commit()
 
+	if app.gasPriceHistory != nil {
+		app.gasPriceHistory.commit()
+	}
+
 	res := abci.ResponseCommit{
 		Data:         commitID.Hash,
 		RetainHeight: retainHeight,
 	}
 
-	// call the streaming service hook with the EndBlock messages
+	// save the state diff and call the streaming service hook with the Commit messages
 	abciListeners := app.streamingManager.ABCIListeners
-	if len(abciListeners) > 0 {
+	if len(abciListeners) > 0 || app.stateDiff != nil {
 		ctx := app.deliverState.ctx
 		blockHeight := ctx.BlockHeight()
 		changeSet := app.cms.PopStateCache()
+		if app.stateDiff != nil {
+			var err error
+			// the state diff is subject to the stop-node policy of the
+			// streaming services
+			if changeSet, err = app.saveStateDiff(blockHeight, changeSet); err != nil {
+				app.handleListenerError("StateDiff", blockHeight, err)
+			}
+		}
 		for _, abciListener := range abciListeners {
 			if err := abciListener.ListenCommit(ctx, res, changeSet); err != nil {
-				app.logger.Error("Commit listening hook failed", "height", blockHeight, "err", err)
+				app.handleListenerError("Commit", blockHeight, err)
 			}
 		}
 	}
@@ -479,14 +526,23 @@ func (app *BaseApp) Commit() abci.ResponseCommit {
 	// Commit. Use the header from this latest block.
 	app.setState(runTxModeCheck, header)
 
+	if app.prepareCheckStater != nil {
+		app.prepareCheckStater(app.checkState.ctx)
+	}
+
 	// Reset state to the latest committed but with an empty header to avoid
 	// leaking the header from the last block.
 	emptyHeader := cmtproto.Header{ChainID: app.chainID}
 	app.setState(runTxPrepareProposal, emptyHeader)
 	app.setState(runTxProcessProposal, emptyHeader)
 
+	// Recheck the mempool against the committed state in the background, so that
+	// the transactions it invalidated are not proposed.
+	app.startMempoolRecheck(header)
+
 	// empty/reset the deliver state
 	app.deliverState = nil
+	app.parallelBlock = nil
 
 	var halt bool
 
@@ -597,6 +653,12 @@ func (app *BaseApp) ListSnapshots(req abci.RequestListSnapshots) abci.ResponseLi
 	}
 
 	for _, snapshot := range snapshots {
+		// an incremental snapshot is restored on top of its base snapshot, which
+		// the peers do not have, hence it is only restored locally
+		if snapshot.Format == snapshottypes.IncrementalFormat {
+			continue
+		}
+
 		abciSnapshot, err := snapshot.ToABCI()
 		if err != nil {
 			app.logger.Error("failed to list snapshots", "err", err)
@@ -645,6 +707,10 @@ func (app *BaseApp) OfferSnapshot(req abci.RequestOfferSnapshot) abci.ResponseOf
 		return abci.ResponseOfferSnapshot{Result: abci.ResponseOfferSnapshot_REJECT}
 	}
 
+	if snapshot.Format == snapshottypes.IncrementalFormat {
+		return abci.ResponseOfferSnapshot{Result: abci.ResponseOfferSnapshot_REJECT_FORMAT}
+	}
+
 	err = app.snapshotManager.Restore(snapshot)
 	switch {
 	case err == nil:
@@ -2,10 +2,14 @@ package baseapp_test
 
 import (
 	"bytes"
+	"context"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	dbm "github.com/cosmos/cosmos-db"
 
@@ -16,6 +20,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	"cosmossdk.io/store/snapshots"
 	snapshottypes "cosmossdk.io/store/snapshots/types"
@@ -28,6 +33,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante/unorderedtx"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 )
 
@@ -256,6 +263,10 @@ func TestABCI_ListSnapshots(t *testing.T) {
 
 	suite := NewBaseAppSuiteWithSnapshots(t, ssCfg)
 
+	// the incremental snapshots are not offered to the peers
+	_, err := suite.baseApp.SnapshotManager().CreateIncremental(5, 4)
+	require.NoError(t, err)
+
 	resp := suite.baseApp.ListSnapshots(abci.RequestListSnapshots{})
 	for _, s := range resp.Snapshots {
 		require.NotEmpty(t, s.Hash)
@@ -463,6 +474,9 @@ func TestABCI_OfferSnapshot_Errors(t *testing.T) {
 		"invalid format": {&abci.Snapshot{
 			Height: 1, Format: 9, Chunks: 3, Hash: hash, Metadata: metadata,
 		}, abci.ResponseOfferSnapshot_REJECT_FORMAT},
+		"incremental format": {&abci.Snapshot{
+			Height: 1, Format: snapshottypes.IncrementalFormat, Chunks: 3, Hash: hash, Metadata: metadata,
+		}, abci.ResponseOfferSnapshot_REJECT_FORMAT},
 		"incorrect chunk count": {&abci.Snapshot{
 			Height: 1, Format: snapshottypes.CurrentFormat, Chunks: 2, Hash: hash, Metadata: metadata,
 		}, abci.ResponseOfferSnapshot_REJECT},
@@ -815,6 +829,136 @@ func TestABCI_Query_SimulateTx(t *testing.T) {
 	}
 }
 
+func TestABCI_SimulateBundle(t *testing.T) {
+	anteKey := []byte("ante-key")
+	deliverKey := []byte("deliver-key")
+
+	// the ante handler records the header the txs are simulated with
+	var simulatedHeader cmtproto.Header
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		anteHandler := anteHandlerTxTest(t, capKey1, anteKey)
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			if simulate {
+				simulatedHeader = ctx.BlockHeader()
+			}
+			return anteHandler(ctx, tx, simulate)
+		})
+	}
+	suite := NewBaseAppSuite(t, anteOpt, baseapp.SetChainID("test-chain"), baseapp.SetBundleLimits(3, 0))
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ChainId:         "test-chain",
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+
+	encodeTx := func(tx sdk.Tx) []byte {
+		txBytes, err := suite.txConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+		return txBytes
+	}
+
+	// the bundles cannot be simulated before the first block
+	_, _, err := suite.baseApp.SimulateBundle([][]byte{encodeTx(newTxCounter(t, suite.txConfig, 0, 0))}, cmtproto.Header{Height: 1})
+	require.Error(t, err)
+
+	headers := make([]cmtproto.Header, 3)
+	for height := int64(1); height <= 2; height++ {
+		headers[height] = cmtproto.Header{
+			Height:          height,
+			ChainID:         "test-chain",
+			Time:            time.Unix(height*10, 0).UTC(),
+			ProposerAddress: []byte{byte(height)},
+		}
+		suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: headers[height]})
+		res := suite.baseApp.DeliverTx(abci.RequestDeliverTx{Tx: encodeTx(newTxCounter(t, suite.txConfig, height-1, height-1))})
+		require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+		suite.baseApp.EndBlock(abci.RequestEndBlock{})
+		suite.baseApp.Commit()
+	}
+
+	// each tx sees the writes of the previous ones, the last one fails
+	bundle := [][]byte{
+		encodeTx(newTxCounter(t, suite.txConfig, 2, 2)),
+		encodeTx(newTxCounter(t, suite.txConfig, 3, 3)),
+		encodeTx(setFailOnAnte(t, suite.txConfig, newTxCounter(t, suite.txConfig, 4, 4), true)),
+	}
+	for i := 0; i < 2; i++ {
+		results, changes, err := suite.baseApp.SimulateBundle(bundle, headers[2])
+		require.NoError(t, err)
+		require.Equal(t, headers[2], simulatedHeader)
+		require.Len(t, results, 3)
+		require.NoError(t, results[0].Err)
+		require.NoError(t, results[1].Err)
+		require.NotNil(t, results[1].Result)
+		require.NotEmpty(t, results[1].Result.Events)
+		require.ErrorIs(t, results[2].Err, sdkerrors.ErrUnauthorized)
+		require.Nil(t, results[2].Result)
+
+		// the counters are set to 4, and nothing is persisted
+		require.Len(t, changes, 2)
+		require.Equal(t, capKey1.Name(), changes[0].StoreKey)
+		require.Equal(t, anteKey, changes[0].Key)
+		require.Equal(t, deliverKey, changes[1].Key)
+		for _, change := range changes {
+			counter, _ := binary.Varint(change.Value)
+			require.Equal(t, int64(4), counter)
+		}
+	}
+
+	// a bundle is simulated on the state and with the header of a past height
+	results, _, err := suite.baseApp.SimulateBundle([][]byte{encodeTx(newTxCounter(t, suite.txConfig, 1, 1))}, headers[1])
+	require.NoError(t, err)
+	require.Equal(t, headers[1], simulatedHeader)
+	require.NoError(t, results[0].Err)
+
+	_, _, err = suite.baseApp.SimulateBundle(bundle, cmtproto.Header{Height: 3})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidHeight)
+
+	_, _, err = suite.baseApp.SimulateBundle(bundle, cmtproto.Header{})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidHeight)
+
+	// the bundles are bounded in size
+	_, _, err = suite.baseApp.SimulateBundle(append(bundle, bundle[0]), headers[2])
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestABCI_SimulateBundleMaxGas(t *testing.T) {
+	anteKey := []byte("ante-key")
+	deliverKey := []byte("deliver-key")
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey))
+	}
+	suite := NewBaseAppSuite(t, anteOpt)
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+
+	txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, 0, 0))
+	require.NoError(t, err)
+
+	header := cmtproto.Header{Height: 1}
+	suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	suite.baseApp.EndBlock(abci.RequestEndBlock{})
+	suite.baseApp.Commit()
+
+	results, _, err := suite.baseApp.SimulateBundle([][]byte{txBytes}, header)
+	require.NoError(t, err)
+	gasUsed := results[0].GasInfo.GasUsed
+	require.NotZero(t, gasUsed)
+
+	// the gas used by the bundle is bounded
+	baseapp.SetBundleLimits(0, gasUsed)(suite.baseApp)
+	_, _, err = suite.baseApp.SimulateBundle([][]byte{txBytes}, header)
+	require.NoError(t, err)
+
+	baseapp.SetBundleLimits(0, gasUsed-1)(suite.baseApp)
+	_, _, err = suite.baseApp.SimulateBundle([][]byte{txBytes}, header)
+	require.ErrorIs(t, err, sdkerrors.ErrOutOfGas)
+}
+
 func TestABCI_InvalidTransaction(t *testing.T) {
 	anteOpt := func(bapp *baseapp.BaseApp) {
 		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
@@ -1384,6 +1528,47 @@ func TestABCI_Proposal_HappyPath(t *testing.T) {
 	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
 }
 
+func TestABCI_Proposal_UnorderedTx(t *testing.T) {
+	txm := unorderedtx.NewManager(t.TempDir())
+	txm.Start()
+	defer func() {
+		require.NoError(t, txm.Close())
+	}()
+
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(100, txm)))
+	}
+	suite := NewBaseAppSuite(t, anteOpt, baseapp.SetMempool(mempool.NewSenderNonceMempool()))
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), NoopCounterServerImpl{})
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+
+	builder, err := suite.txConfig.WrapTxBuilder(newTxCounter(t, suite.txConfig, 0, 1))
+	require.NoError(t, err)
+	builder.SetUnordered(true)
+	builder.SetTimeoutHeight(10)
+	txBytes, err := suite.txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+
+	// the default ProcessProposal handler runs the AnteHandler on the txs of the
+	// proposal, which must not record the unordered tx
+	resProcessProposal := suite.baseApp.ProcessProposal(abci.RequestProcessProposal{Txs: [][]byte{txBytes}, Height: 1})
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, resProcessProposal.Status)
+	require.Zero(t, txm.Size())
+
+	suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: cmtproto.Header{Height: 1}})
+	res := suite.baseApp.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	require.Equal(t, 1, txm.Size())
+
+	// the delivered tx is a duplicate
+	res = suite.baseApp.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.False(t, res.IsOK())
+	require.Contains(t, res.Log, "is duplicated")
+}
+
 func TestABCI_Proposal_Read_State_PrepareProposal(t *testing.T) {
 	someKey := []byte("some-key")
 
@@ -1455,6 +1640,238 @@ func TestABCI_PrepareProposal_ReachedMaxBytes(t *testing.T) {
 	require.Equal(t, 11, len(resPrepareProposal.Txs))
 }
 
+func TestABCI_PrepareProposal_LaneQuotas(t *testing.T) {
+	isEven := func(_ context.Context, tx sdk.Tx) bool {
+		counter, _ := parseTxMemo(t, tx)
+		return counter%2 == 0
+	}
+	pool := mempool.NewLaneMempool(mempool.LaneMempoolConfig{
+		Lanes: []mempool.Lane{
+			{Name: "even", Match: isEven, MaxBlockSpace: sdkmath.LegacyNewDecWithPrec(25, 2), Mempool: mempool.NewSenderNonceMempool()},
+			{Name: "default", Mempool: mempool.NewSenderNonceMempool()},
+		},
+		TxGasPrice: mempool.FeeDenomTxGasPrice(sdk.DefaultBondDenom),
+	})
+	suite := NewBaseAppSuite(t, baseapp.SetMempool(pool))
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+
+	for i := 10; i < 30; i++ {
+		tx := newTxCounter(t, suite.txConfig, int64(i), int64(i))
+		require.NoError(t, pool.Insert(sdk.Context{}, tx))
+	}
+
+	reqPrepareProposal := abci.RequestPrepareProposal{
+		MaxTxBytes: 1500,
+		Height:     1,
+	}
+	resPrepareProposal := suite.baseApp.PrepareProposal(reqPrepareProposal)
+
+	// the even lane is limited to a quarter of the block, the default lane
+	// fills the rest of it
+	var counters []int64
+	for _, txBytes := range resPrepareProposal.Txs {
+		tx, err := suite.txConfig.TxDecoder()(txBytes)
+		require.NoError(t, err)
+		counter, _ := parseTxMemo(t, tx)
+		counters = append(counters, counter)
+	}
+	require.Equal(t, []int64{10, 12, 11, 13, 15, 17, 19, 21, 23, 25, 27}, counters)
+}
+
+func TestABCI_Commit_PrepareCheckStater(t *testing.T) {
+	var committedHeights []int64
+	prepareCheckStaterOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetPrepareCheckStater(func(ctx sdk.Context) {
+			require.True(t, ctx.IsCheckTx())
+			committedHeights = append(committedHeights, bapp.LastBlockHeight())
+		})
+	}
+	suite := NewBaseAppSuite(t, prepareCheckStaterOpt)
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+
+	for height := int64(1); height <= 2; height++ {
+		suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: cmtproto.Header{Height: height}})
+		suite.baseApp.EndBlock(abci.RequestEndBlock{})
+		require.Len(t, committedHeights, int(height-1))
+		suite.baseApp.Commit()
+	}
+
+	require.Equal(t, []int64{1, 2}, committedHeights)
+}
+
+func TestABCI_Commit_MempoolRecheck(t *testing.T) {
+	anteKey := []byte("ante-key")
+	deliverKey := []byte("deliver-key")
+	pool := mempool.NewSenderNonceMempool()
+
+	// the ante handler rejects the txs whose counter was consumed by a
+	// delivered tx, like an account sequence
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			store := ctx.KVStore(capKey1)
+			counter, _ := parseTxMemo(t, tx)
+			if counter < getIntFromStore(t, store, anteKey) {
+				return ctx, errorsmod.Wrap(sdkerrors.ErrWrongSequence, "counter consumed")
+			}
+			if !ctx.IsCheckTx() {
+				setIntOnStore(store, anteKey, counter+1)
+			}
+			return ctx, nil
+		})
+	}
+	// the proposals count the txs of the mempool
+	var mempoolTxs int
+	proposalOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetPrepareProposal(func(ctx sdk.Context, req abci.RequestPrepareProposal) abci.ResponsePrepareProposal {
+			mempoolTxs = pool.CountTx()
+			return abci.ResponsePrepareProposal{}
+		})
+	}
+	suite := NewBaseAppSuite(t, anteOpt, proposalOpt, baseapp.SetMempool(pool), baseapp.SetMempoolRecheck(100, time.Minute))
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+
+	for i := int64(0); i < 10; i++ {
+		require.NoError(t, pool.Insert(sdk.Context{}, newTxCounter(t, suite.txConfig, i, 0)))
+	}
+
+	// deliver the tx of counter 5, which is removed from the mempool and
+	// invalidates the txs of lower counters
+	txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, 5, 0))
+	require.NoError(t, err)
+
+	suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: cmtproto.Header{Height: 1}})
+	res := suite.baseApp.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	suite.baseApp.EndBlock(abci.RequestEndBlock{})
+	suite.baseApp.Commit()
+
+	// the invalid txs are removed in the background
+	require.Eventually(t, func() bool {
+		suite.baseApp.PrepareProposal(abci.RequestPrepareProposal{Height: 2})
+		return mempoolTxs == 4
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestABCI_Close_StopsMempoolRecheck(t *testing.T) {
+	pool := mempool.NewSenderNonceMempool()
+
+	// the recheck is slow, and is stopped after its first tx
+	started := make(chan struct{}, 1)
+	var rechecked atomic.Int32
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			if ctx.IsReCheckTx() {
+				select {
+				case started <- struct{}{}:
+				default:
+				}
+				time.Sleep(10 * time.Millisecond)
+				rechecked.Add(1)
+			}
+			return ctx, nil
+		})
+	}
+	suite := NewBaseAppSuite(t, anteOpt, baseapp.SetMempool(pool), baseapp.SetMempoolRecheck(100, time.Minute))
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+
+	for i := int64(0); i < 10; i++ {
+		require.NoError(t, pool.Insert(sdk.Context{}, newTxCounter(t, suite.txConfig, i, 0)))
+	}
+
+	suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: cmtproto.Header{Height: 1}})
+	suite.baseApp.EndBlock(abci.RequestEndBlock{})
+	suite.baseApp.Commit()
+
+	<-started
+	require.NoError(t, suite.baseApp.Close())
+
+	// the recheck has returned once the app is closed
+	count := rechecked.Load()
+	require.Less(t, count, int32(10))
+	time.Sleep(50 * time.Millisecond)
+	require.Equal(t, count, rechecked.Load())
+}
+
+func TestABCI_GasPriceHistory(t *testing.T) {
+	anteKey := []byte("ante-key")
+	deliverKey := []byte("deliver-key")
+	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+	suite := NewBaseAppSuite(t, anteOpt, baseapp.SetGasPriceHistory(2))
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+
+	deliverTx := func(counter int64, fees sdk.Coins, failOnAnte bool) {
+		tx := setFailOnAnte(t, suite.txConfig, newTxCounter(t, suite.txConfig, counter, counter), failOnAnte)
+		builder, err := suite.txConfig.WrapTxBuilder(tx)
+		require.NoError(t, err)
+		builder.SetFeeAmount(fees)
+		builder.SetGasLimit(100)
+
+		txBytes, err := suite.txConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+
+		res := suite.baseApp.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+		require.Equal(t, !failOnAnte, res.IsOK(), fmt.Sprintf("%v", res))
+	}
+	commitBlock := func(height int64, deliverTxs func()) {
+		suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: cmtproto.Header{Height: height}})
+		deliverTxs()
+		suite.baseApp.EndBlock(abci.RequestEndBlock{})
+		suite.baseApp.Commit()
+	}
+
+	history := suite.baseApp.GasPriceHistory()
+	require.NotNil(t, history)
+
+	prices, blocks := history.GasPrices(0)
+	require.Empty(t, prices)
+	require.Zero(t, blocks)
+
+	commitBlock(1, func() {
+		deliverTx(0, sdk.NewCoins(sdk.NewInt64Coin("stake", 300), sdk.NewInt64Coin("atom", 50)), false)
+		deliverTx(1, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), false)
+	})
+	commitBlock(2, func() {
+		deliverTx(2, sdk.NewCoins(sdk.NewInt64Coin("stake", 200)), false)
+		// the gas prices of the failed txs are not recorded
+		deliverTx(3, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), true)
+	})
+
+	prices, blocks = history.GasPrices(0)
+	require.Equal(t, uint64(2), blocks)
+	require.Equal(t, map[string][]sdkmath.LegacyDec{
+		"stake": {sdkmath.LegacyNewDec(1), sdkmath.LegacyNewDec(2), sdkmath.LegacyNewDec(3)},
+		"atom":  {sdkmath.LegacyNewDecWithPrec(5, 1)},
+	}, prices)
+
+	prices, blocks = history.GasPrices(1)
+	require.Equal(t, uint64(1), blocks)
+	require.Equal(t, map[string][]sdkmath.LegacyDec{"stake": {sdkmath.LegacyNewDec(2)}}, prices)
+
+	// only the last 2 blocks are kept
+	commitBlock(3, func() {})
+
+	prices, blocks = history.GasPrices(0)
+	require.Equal(t, uint64(2), blocks)
+	require.Equal(t, map[string][]sdkmath.LegacyDec{"stake": {sdkmath.LegacyNewDec(2)}}, prices)
+}
+
 func TestABCI_PrepareProposal_BadEncoding(t *testing.T) {
 	anteKey := []byte("ante-key")
 	pool := mempool.NewSenderNonceMempool()
@@ -2,14 +2,18 @@ package baseapp
 
 import (
 	"fmt"
+	"io"
 	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	"cosmossdk.io/store"
 	storemetrics "cosmossdk.io/store/metrics"
 	"cosmossdk.io/store/snapshots"
+	"cosmossdk.io/store/statediff"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cockroachdb/errors"
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -45,6 +49,24 @@ const (
 	runTxProcessProposal                  // Process a TM block proposal
 )
 
+// execMode returns the execution mode of the transactions run in the mode.
+func (mode runTxMode) execMode() sdk.ExecMode {
+	switch mode {
+	case runTxModeReCheck:
+		return sdk.ExecModeReCheck
+	case runTxModeSimulate:
+		return sdk.ExecModeSimulate
+	case runTxModeDeliver:
+		return sdk.ExecModeDeliver
+	case runTxPrepareProposal:
+		return sdk.ExecModePrepareProposal
+	case runTxProcessProposal:
+		return sdk.ExecModeProcessProposal
+	default:
+		return sdk.ExecModeCheck
+	}
+}
+
 var _ abci.Application = (*BaseApp)(nil)
 
 // BaseApp reflects the ABCI application implementation.
@@ -74,6 +96,9 @@ type BaseApp struct { //nolint: maligned
 	idPeerFilter    sdk.PeerFilter             // filter peers by node ID
 	fauxMerkleMode  bool                       // if true, IAVL MountStores uses MountStoresDB for simulation speed.
 
+	// prepareCheckStater runs during Commit, once the block is committed
+	prepareCheckStater sdk.PrepareCheckStater
+
 	// manages snapshots, i.e. dumps of app state at certain intervals
 	snapshotManager *snapshots.Manager
 
@@ -144,6 +169,68 @@ type BaseApp struct { //nolint: maligned
 	// streamingManager for managing instances and configuration of ABCIListener services
 	streamingManager storetypes.StreamingManager
 
+	// stateDiff persists the change sets of the committed blocks, when set.
+	stateDiff *statediff.Store
+
+	// kvStoreKeys are the keys of the mounted stores which are neither transient
+	// nor in-memory, whose changes are saved to the state diff.
+	kvStoreKeys []storetypes.StoreKey
+
+	// stateDiffOnlyKeys are the keys of the stores listened to for the state
+	// diff only, whose changes are not streamed to the ABCI listeners.
+	stateDiffOnlyKeys map[string]struct{}
+
+	// parallelTxWorkers is the number of workers executing the transactions of
+	// a block concurrently, parallel execution is disabled if it is 0.
+	parallelTxWorkers int
+
+	// storeGasConfigs holds the gas configurations of the stores which do not
+	// use the default KVStore or transient KVStore gas configuration.
+	storeGasConfigs map[storetypes.StoreKey]storetypes.GasConfig
+
+	// iterBytesLimit is the maximum number of bytes a transaction can read
+	// through store iterators, it is not limited if it is 0.
+	iterBytesLimit uint64
+
+	// storeGasMetrics enables the telemetry of the gas consumed by the store
+	// operations, by store key and operation.
+	storeGasMetrics bool
+
+	// proposals holds the transactions of the proposals accepted in
+	// ProcessProposal by header hash, so that the transactions of a block
+	// are known upfront on BeginBlock.
+	proposals map[string][][]byte
+
+	// parallelBlock is the block being executed in parallel, it is set on
+	// BeginBlock and reset on Commit.
+	parallelBlock *parallelBlock
+
+	// mempoolMtx guards the application side mempool, which is accessed
+	// concurrently by the background recheck of the mempool and by the
+	// mempool inspector.
+	mempoolMtx sync.Mutex
+
+	// recheckMaxTxs is the maximum number of mempool transactions rechecked
+	// in the background after Commit, the recheck is disabled if it is 0.
+	recheckMaxTxs int
+
+	// recheckTimeout bounds the duration of the mempool recheck, it is not
+	// bounded if it is 0.
+	recheckTimeout time.Duration
+
+	// mempoolRecheck is the running recheck of the mempool, it is started on
+	// Commit and stopped on the next Commit.
+	mempoolRecheck *mempoolRecheck
+
+	// gasPriceHistory keeps the gas prices paid in the last committed blocks,
+	// they are not recorded if it is nil.
+	gasPriceHistory *GasPriceHistory
+
+	// bundleMaxTxs and bundleMaxGas bound the number of transactions of a
+	// simulated bundle and the gas they use, they are not bounded if 0.
+	bundleMaxTxs uint64
+	bundleMaxGas uint64
+
 	chainID string
 }
 
@@ -290,6 +377,10 @@ func (app *BaseApp) MountMemoryStores(keys map[string]*storetypes.MemoryStoreKey
 // using the default DB.
 func (app *BaseApp) MountStore(key storetypes.StoreKey, typ storetypes.StoreType) {
 	app.cms.MountStoreWithDB(key, typ, nil)
+
+	if typ != storetypes.StoreTypeTransient && typ != storetypes.StoreTypeMemory {
+		app.kvStoreKeys = append(app.kvStoreKeys, key)
+	}
 }
 
 // LoadLatestVersion loads the latest application version. It will panic if
@@ -366,6 +457,8 @@ func (app *BaseApp) Init() error {
 		return errors.New("commit multi-store must not be nil")
 	}
 
+	app.listenStateDiff()
+
 	return app.cms.GetPruning().Validate()
 }
 
@@ -393,6 +486,10 @@ func (app *BaseApp) setTrace(trace bool) {
 	app.trace = trace
 }
 
+func (app *BaseApp) setParallelTxWorkers(workers int) {
+	app.parallelTxWorkers = workers
+}
+
 func (app *BaseApp) setIndexEvents(ie []string) {
 	app.indexEvents = make(map[string]struct{})
 
@@ -407,14 +504,43 @@ func (app *BaseApp) Seal() { app.sealed = true }
 // IsSealed returns true if the BaseApp is sealed and false otherwise.
 func (app *BaseApp) IsSealed() bool { return app.sealed }
 
+// Close releases the resources of the BaseApp when the node gracefully shuts
+// down: the running recheck of the mempool is stopped, and the streaming
+// listeners implementing io.Closer, such as streaming.Listener, are closed,
+// writing the blocks they buffered. It returns the first error, the others are
+// logged.
+func (app *BaseApp) Close() error {
+	app.stopMempoolRecheck()
+
+	var err error
+	for _, abciListener := range app.streamingManager.ABCIListeners {
+		closer, ok := abciListener.(io.Closer)
+		if !ok {
+			continue
+		}
+
+		if closeErr := closer.Close(); closeErr != nil {
+			app.logger.Error("failed to close streaming listener", "err", closeErr)
+			if err == nil {
+				err = closeErr
+			}
+		}
+	}
+
+	return err
+}
+
 // setState sets the BaseApp's state for the corresponding mode with a branched
 // multi-store (i.e. a CacheMultiStore) and a new Context with the same
 // multi-store branch, and provided header.
 func (app *BaseApp) setState(mode runTxMode, header cmtproto.Header) {
 	ms := app.cms.CacheMultiStore()
 	baseState := &state{
-		ms:  ms,
-		ctx: sdk.NewContext(ms, header, false, app.logger).WithStreamingManager(app.streamingManager),
+		ms: ms,
+		ctx: sdk.NewContext(ms, header, false, app.logger).
+			WithStreamingManager(app.streamingManager).
+			WithStoreGasConfigs(app.storeGasConfigs).
+			WithStoreGasMetrics(app.storeGasMetrics),
 	}
 
 	switch mode {
@@ -609,19 +735,33 @@ func (app *BaseApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (sdk.Context
 }
 
 // runTx processes a transaction within a given execution mode, encoded transaction
-// bytes, and the decoded transaction itself. All state transitions occur through
-// a cached Context depending on the mode provided. State only gets persisted
-// if all messages get executed successfully and the execution mode is DeliverTx.
-// Note, gas execution info is always returned. A reference to a Result is
-// returned if the tx does not run out of gas and if all the messages are valid
-// and execute successfully. An error is returned otherwise.
-func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, priority int64, err error) {
+// bytes, and the decoded transaction itself, which is decoded from the bytes if
+// it is nil. All state transitions occur through a cached Context depending on
+// the mode provided. State only gets persisted if all messages get executed
+// successfully and the execution mode is DeliverTx. Note, gas execution info is
+// always returned. A reference to a Result is returned if the tx does not run
+// out of gas and if all the messages are valid and execute successfully. An
+// error is returned otherwise.
+func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, priority int64, err error) {
+	return app.runTxWithContext(app.getContextForTx(mode, txBytes), mode, txBytes, tx, app.mempool)
+}
+
+// runTxWithContext processes a transaction like runTx, over the provided
+// context. The transaction is inserted into or removed from the provided
+// mempool, depending on the mode.
+func (app *BaseApp) runTxWithContext(ctx sdk.Context, mode runTxMode, txBytes []byte, tx sdk.Tx, mp mempool.Mempool) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, priority int64, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter, so we initialize upfront.
 	var gasWanted uint64
 
-	ctx := app.getContextForTx(mode, txBytes)
+	ctx = ctx.WithExecMode(mode.execMode())
+
+	// the bytes read through store iterators are limited per transaction
+	if app.iterBytesLimit > 0 {
+		ctx = ctx.WithIterBytesMeter(storetypes.NewIterBytesMeter(app.iterBytesLimit))
+	}
+
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
@@ -631,7 +771,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 
 	defer func() {
 		if r := recover(); r != nil {
-			recoveryMW := newOutOfGasRecoveryMiddleware(gasWanted, ctx, app.runTxRecoveryMiddleware)
+			recoveryMW := newOutOfGasRecoveryMiddleware(gasWanted, ctx, newIterBytesLimitRecoveryMiddleware(app.runTxRecoveryMiddleware))
 			err, result = processRecovery(r, recoveryMW), nil
 		}
 
@@ -662,9 +802,10 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		defer consumeBlockGas()
 	}
 
-	tx, err := app.txDecoder(txBytes)
-	if err != nil {
-		return sdk.GasInfo{}, nil, nil, 0, err
+	if tx == nil {
+		if tx, err = app.txDecoder(txBytes); err != nil {
+			return sdk.GasInfo{}, nil, nil, 0, err
+		}
 	}
 
 	msgs := tx.GetMsgs()
@@ -714,12 +855,16 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 	}
 
 	if mode == runTxModeCheck {
-		err = app.mempool.Insert(ctx, tx)
+		app.mempoolMtx.Lock()
+		err = mp.Insert(ctx, tx)
+		app.mempoolMtx.Unlock()
 		if err != nil {
 			return gInfo, nil, anteEvents, priority, err
 		}
 	} else if mode == runTxModeDeliver {
-		err = app.mempool.Remove(tx)
+		app.mempoolMtx.Lock()
+		err = mp.Remove(tx)
+		app.mempoolMtx.Unlock()
 		if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
 			return gInfo, nil, anteEvents, priority,
 				fmt.Errorf("failed to remove tx from mempool: %w", err)
@@ -753,11 +898,17 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 			result.Events = append(result.Events, newCtx.EventManager().ABCIEvents()...)
 		}
 
-		if mode == runTxModeDeliver {
+		switch mode {
+		case runTxModeDeliver:
 			// When block gas exceeds, it'll panic and won't commit the cached store.
 			consumeBlockGas()
 
 			msCache.Write()
+
+		case runTxModeSimulate:
+			// The simulation context is a branch, its writes are only seen by the
+			// transactions simulated after it in a bundle.
+			msCache.Write()
 		}
 
 		if len(anteEvents) > 0 && (mode == runTxModeDeliver || mode == runTxModeSimulate) {
@@ -869,7 +1020,7 @@ func (app *BaseApp) PrepareProposalVerifyTx(tx sdk.Tx) ([]byte, error) {
 		return nil, err
 	}
 
-	_, _, _, _, err = app.runTx(runTxPrepareProposal, bz) //nolint:dogsled
+	_, _, _, _, err = app.runTx(runTxPrepareProposal, bz, nil) //nolint:dogsled
 	if err != nil {
 		return nil, err
 	}
@@ -888,7 +1039,7 @@ func (app *BaseApp) ProcessProposalVerifyTx(txBz []byte) (sdk.Tx, error) {
 		return nil, err
 	}
 
-	_, _, _, _, err = app.runTx(runTxProcessProposal, txBz) //nolint:dogsled
+	_, _, _, _, err = app.runTx(runTxProcessProposal, txBz, tx) //nolint:dogsled
 	if err != nil {
 		return nil, err
 	}
@@ -930,6 +1081,10 @@ func NewDefaultProposalHandler(mp mempool.Mempool, txVerifier ProposalTxVerifier
 // Enumeration is halted once RequestPrepareProposal.MaxBytes of transactions is
 // reached or the mempool is exhausted.
 //
+// If the mempool is a LaneMempool, its lanes are enumerated in order, and each
+// lane is halted once its MaxBlockSpace share of RequestPrepareProposal.MaxBytes
+// is reached.
+//
 // Note:
 //
 // - Step (2) is identical to the validation step performed in
@@ -949,44 +1104,74 @@ func (h DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHand
 			return abci.ResponsePrepareProposal{Txs: req.Txs}
 		}
 
+		laneMempool, ok := h.mempool.(*mempool.LaneMempool)
+		if !ok {
+			selectedTxs, _ := h.selectTxs(h.mempool.Select(ctx, req.Txs), req.MaxTxBytes)
+			return abci.ResponsePrepareProposal{Txs: selectedTxs}
+		}
+
 		var (
 			selectedTxs  [][]byte
 			totalTxBytes int64
 		)
 
-		iterator := h.mempool.Select(ctx, req.Txs)
-
-		for iterator != nil {
-			memTx := iterator.Tx()
-
-			// NOTE: Since transaction verification was already executed in CheckTx,
-			// which calls mempool.Insert, in theory everything in the pool should be
-			// valid. But some mempool implementations may insert invalid txs, so we
-			// check again.
-			bz, err := h.txVerifier.PrepareProposalVerifyTx(memTx)
-			if err != nil {
-				err := h.mempool.Remove(memTx)
-				if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
-					panic(err)
-				}
-			} else {
-				txSize := int64(len(bz))
-				if totalTxBytes += txSize; totalTxBytes <= req.MaxTxBytes {
-					selectedTxs = append(selectedTxs, bz)
-				} else {
-					// We've reached capacity per req.MaxTxBytes so we cannot select any
-					// more transactions.
-					break
+		for _, lane := range laneMempool.Lanes() {
+			maxTxBytes := req.MaxTxBytes - totalTxBytes
+			if lane.MaxBlockSpace.IsPositive() {
+				laneMaxTxBytes := lane.MaxBlockSpace.MulInt64(req.MaxTxBytes).TruncateInt64()
+				if laneMaxTxBytes < maxTxBytes {
+					maxTxBytes = laneMaxTxBytes
 				}
 			}
 
-			iterator = iterator.Next()
+			laneTxs, laneTxBytes := h.selectTxs(lane.Mempool.Select(ctx, req.Txs), maxTxBytes)
+			selectedTxs = append(selectedTxs, laneTxs...)
+			totalTxBytes += laneTxBytes
 		}
 
 		return abci.ResponsePrepareProposal{Txs: selectedTxs}
 	}
 }
 
+// selectTxs returns the valid transactions of the iterator, in order, until
+// maxTxBytes of transactions is reached, and their total size. The invalid
+// transactions are removed from the mempool.
+func (h DefaultProposalHandler) selectTxs(iterator mempool.Iterator, maxTxBytes int64) ([][]byte, int64) {
+	var (
+		selectedTxs  [][]byte
+		totalTxBytes int64
+	)
+
+	for iterator != nil {
+		memTx := iterator.Tx()
+
+		// NOTE: Since transaction verification was already executed in CheckTx,
+		// which calls mempool.Insert, in theory everything in the pool should be
+		// valid. But some mempool implementations may insert invalid txs, so we
+		// check again.
+		bz, err := h.txVerifier.PrepareProposalVerifyTx(memTx)
+		if err != nil {
+			err := h.mempool.Remove(memTx)
+			if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+				panic(err)
+			}
+		} else {
+			txSize := int64(len(bz))
+			if totalTxBytes+txSize > maxTxBytes {
+				// We've reached capacity per maxTxBytes so we cannot select any
+				// more transactions.
+				break
+			}
+			totalTxBytes += txSize
+			selectedTxs = append(selectedTxs, bz)
+		}
+
+		iterator = iterator.Next()
+	}
+
+	return selectedTxs, totalTxBytes
+}
+
 // ProcessProposalHandler returns the default implementation for processing an
 // ABCI proposal. Every transaction in the proposal must pass 2 conditions:
 //
@@ -26,6 +26,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 )
 
@@ -533,6 +534,48 @@ func TestBaseAppAnteHandler(t *testing.T) {
 	suite.baseApp.Commit()
 }
 
+func TestBaseAppStoreGasConfigAndIterBytesLimit(t *testing.T) {
+	gasConfig := storetypes.GasConfig{IterSeekCostFlat: 7}
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetStoreGasConfig(capKey1, gasConfig)
+		bapp.SetIterBytesLimit(15)
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			store := ctx.KVStore(capKey1)
+			store.Set([]byte("key1"), []byte("value1"))
+			store.Set([]byte("key2"), []byte("value2"))
+
+			// the gas config of the store is used
+			gasConsumed := ctx.GasMeter().GasConsumed()
+			itr := store.Iterator(nil, nil)
+			defer itr.Close()
+			require.Equal(t, gasConfig.IterSeekCostFlat, ctx.GasMeter().GasConsumed()-gasConsumed)
+
+			// the second pair exceeds the limit
+			itr.Next()
+			return ctx, nil
+		})
+	}
+	suite := NewBaseAppSuite(t, anteOpt)
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+
+	header := cmtproto.Header{Height: suite.baseApp.LastBlockHeight() + 1}
+	suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	gasConfig, ok := getDeliverStateCtx(suite.baseApp).StoreGasConfig(capKey1)
+	require.True(t, ok)
+	require.Equal(t, storetypes.GasConfig{IterSeekCostFlat: 7}, gasConfig)
+
+	txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, 0, 0))
+	require.NoError(t, err)
+
+	res := suite.baseApp.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.Equal(t, sdkerrors.ErrIterBytesLimit.ABCICode(), res.Code, res.Log)
+	require.Equal(t, sdkerrors.ErrIterBytesLimit.Codespace(), res.Codespace)
+}
+
 // Test and ensure that invalid block heights always cause errors.
 // See issues:
 // - https://github.com/cosmos/cosmos-sdk/issues/11220
@@ -0,0 +1,99 @@
+package baseapp
+
+import (
+	"fmt"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// SimulatedTx is the result of the simulation of a transaction of a bundle.
+type SimulatedTx struct {
+	GasInfo sdk.GasInfo
+	Result  *sdk.Result
+	Err     error
+}
+
+// listeningMultiStore is a multi-store whose branches report their writes to a
+// listener, such as cachemulti.Store.
+type listeningMultiStore interface {
+	CacheMultiStoreWithListener(listener *storetypes.MemoryListener) storetypes.CacheMultiStore
+}
+
+// SimulateBundle simulates the execution of the transactions in order, on top of
+// the state committed at the height of the header, with the header of that block
+// (block time, chain-id, proposer...). Each transaction sees the writes of the
+// previous ones, except the writes of the messages of a failed transaction. It
+// returns the result of each transaction and the changes of the persistent
+// stores resulting from the bundle, grouped by store. Nothing is persisted.
+//
+// The simulation fails if the bundle has more transactions or uses more gas
+// than the limits set with SetBundleLimits.
+func (app *BaseApp) SimulateBundle(txsBytes [][]byte, header cmtproto.Header) ([]SimulatedTx, []*storetypes.StoreKVPair, error) {
+	if header.Height <= 0 {
+		return nil, nil, errorsmod.Wrapf(sdkerrors.ErrInvalidHeight, "cannot simulate a bundle at height %d", header.Height)
+	}
+
+	if app.bundleMaxTxs > 0 && uint64(len(txsBytes)) > app.bundleMaxTxs {
+		return nil, nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "bundle has %d transactions, the maximum is %d", len(txsBytes), app.bundleMaxTxs,
+		)
+	}
+
+	ctx, err := app.CreateQueryContext(header.Height, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ms, ok := ctx.MultiStore().(listeningMultiStore)
+	if !ok {
+		return nil, nil, fmt.Errorf("cannot simulate a bundle on a %T multi-store", ctx.MultiStore())
+	}
+
+	listener := storetypes.NewMemoryListener()
+	bundleMS := ms.CacheMultiStoreWithListener(listener)
+	ctx = ctx.
+		WithBlockHeader(header).
+		WithMultiStore(bundleMS).
+		WithStoreGasConfigs(app.storeGasConfigs)
+	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
+
+	var gasUsed uint64
+	results := make([]SimulatedTx, len(txsBytes))
+	for i, txBytes := range txsBytes {
+		gasInfo, result, _, _, err := app.runTxWithContext(ctx.WithTxBytes(txBytes), runTxModeSimulate, txBytes, nil, mempool.NoOpMempool{})
+		results[i] = SimulatedTx{GasInfo: gasInfo, Result: result, Err: err}
+
+		gasUsed += gasInfo.GasUsed
+		if app.bundleMaxGas > 0 && gasUsed > app.bundleMaxGas {
+			return nil, nil, errorsmod.Wrapf(
+				sdkerrors.ErrOutOfGas, "bundle used more than the maximum of %d gas at transaction %d", app.bundleMaxGas, i,
+			)
+		}
+	}
+
+	// the writes of the bundle are reported to the listener, the state they are
+	// written to is discarded
+	bundleMS.Write()
+
+	persistent := make(map[string]struct{}, len(app.kvStoreKeys))
+	for _, key := range app.kvStoreKeys {
+		persistent[key.Name()] = struct{}{}
+	}
+
+	var changes []*storetypes.StoreKVPair
+	for _, pair := range listener.PopStateCache() {
+		if _, ok := persistent[pair.StoreKey]; ok {
+			changes = append(changes, pair)
+		}
+	}
+	sort.SliceStable(changes, func(i, j int) bool { return changes[i].StoreKey < changes[j].StoreKey })
+
+	return results, changes, nil
+}
@@ -0,0 +1,111 @@
+package baseapp
+
+import (
+	"sort"
+	"sync"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GasPriceHistory keeps the gas prices paid by the successful transactions of
+// the last committed blocks, by denom. The gas price paid by a transaction in a
+// denom is its fee in that denom divided by its gas limit.
+type GasPriceHistory struct {
+	maxBlocks int
+
+	mtx    sync.RWMutex
+	blocks []map[string][]math.LegacyDec // oldest first
+
+	// pending holds the gas prices of the block being executed, it is only
+	// accessed by the ABCI methods.
+	pending map[string][]math.LegacyDec
+}
+
+// NewGasPriceHistory returns a GasPriceHistory keeping the gas prices of the
+// last maxBlocks committed blocks.
+func NewGasPriceHistory(maxBlocks uint64) *GasPriceHistory {
+	return &GasPriceHistory{
+		maxBlocks: int(maxBlocks),
+		blocks:    make([]map[string][]math.LegacyDec, 0, maxBlocks),
+		pending:   make(map[string][]math.LegacyDec),
+	}
+}
+
+// GasPrices returns the gas prices paid in the last blocks committed, at most
+// blocks if it is not 0, by denom and in ascending order, and the number of
+// blocks they were paid in. It is safe to call on a nil GasPriceHistory, which
+// has no gas prices.
+func (h *GasPriceHistory) GasPrices(blocks uint64) (map[string][]math.LegacyDec, uint64) {
+	if h == nil {
+		return nil, 0
+	}
+
+	h.mtx.RLock()
+	defer h.mtx.RUnlock()
+
+	recent := h.blocks
+	if blocks > 0 && blocks < uint64(len(recent)) {
+		recent = recent[uint64(len(recent))-blocks:]
+	}
+
+	prices := make(map[string][]math.LegacyDec)
+	for _, block := range recent {
+		for denom, blockPrices := range block {
+			prices[denom] = append(prices[denom], blockPrices...)
+		}
+	}
+	for _, denomPrices := range prices {
+		sort.Slice(denomPrices, func(i, j int) bool { return denomPrices[i].LT(denomPrices[j]) })
+	}
+
+	return prices, uint64(len(recent))
+}
+
+// record records the gas prices paid by a transaction of the block being
+// executed.
+func (h *GasPriceHistory) record(tx sdk.FeeTx) {
+	gas := tx.GetGas()
+	if gas == 0 {
+		return
+	}
+
+	gasLimit := math.LegacyNewDecFromInt(math.NewIntFromUint64(gas))
+	for _, fee := range tx.GetFee() {
+		h.pending[fee.Denom] = append(h.pending[fee.Denom], math.LegacyNewDecFromInt(fee.Amount).Quo(gasLimit))
+	}
+}
+
+// commit adds the gas prices of the block being executed to the history,
+// dropping the oldest block if the history is full.
+func (h *GasPriceHistory) commit() {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	if h.maxBlocks > 0 {
+		if len(h.blocks) == h.maxBlocks {
+			h.blocks = append(h.blocks[:0], h.blocks[1:]...)
+		}
+		h.blocks = append(h.blocks, h.pending)
+	}
+	h.pending = make(map[string][]math.LegacyDec)
+}
+
+// GasPriceHistory returns the gas prices paid in the last committed blocks, nil
+// if they are not recorded.
+func (app *BaseApp) GasPriceHistory() *GasPriceHistory {
+	return app.gasPriceHistory
+}
+
+// recordGasPrices records the gas prices paid by a successful transaction of the
+// block being executed, if the gas price history is enabled.
+func (app *BaseApp) recordGasPrices(tx sdk.Tx) {
+	if app.gasPriceHistory == nil {
+		return
+	}
+
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		app.gasPriceHistory.record(feeTx)
+	}
+}
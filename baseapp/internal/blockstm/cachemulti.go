@@ -0,0 +1,100 @@
+package blockstm
+
+import (
+	"fmt"
+	"io"
+
+	"cosmossdk.io/store/cachekv"
+	storetypes "cosmossdk.io/store/types"
+)
+
+var _ storetypes.CacheMultiStore = (*cacheMultiStore)(nil)
+
+// cacheMultiStore is a branch of a multi-store, its stores are branched
+// lazily since the store keys of the parent are not known.
+type cacheMultiStore struct {
+	parent storetypes.MultiStore
+	stores map[storetypes.StoreKey]storetypes.CacheKVStore
+	// keys preserves the order in which stores were branched, so that writes
+	// are deterministic.
+	keys []storetypes.StoreKey
+}
+
+func newCacheMultiStore(parent storetypes.MultiStore) *cacheMultiStore {
+	return &cacheMultiStore{
+		parent: parent,
+		stores: make(map[storetypes.StoreKey]storetypes.CacheKVStore),
+	}
+}
+
+// GetStoreType implements storetypes.Store.
+func (cms *cacheMultiStore) GetStoreType() storetypes.StoreType {
+	return storetypes.StoreTypeMulti
+}
+
+// CacheWrap implements storetypes.CacheWrapper.
+func (cms *cacheMultiStore) CacheWrap() storetypes.CacheWrap {
+	return cms.CacheMultiStore()
+}
+
+// CacheWrapWithTrace implements storetypes.CacheWrapper, tracing is not
+// supported.
+func (cms *cacheMultiStore) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return cms.CacheWrap()
+}
+
+// CacheMultiStore implements storetypes.MultiStore.
+func (cms *cacheMultiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	return newCacheMultiStore(cms)
+}
+
+// CacheMultiStoreWithVersion implements storetypes.MultiStore, it is not
+// supported.
+func (cms *cacheMultiStore) CacheMultiStoreWithVersion(_ int64) (storetypes.CacheMultiStore, error) {
+	return nil, fmt.Errorf("cannot branch a transaction multi-store at a version")
+}
+
+// GetStore implements storetypes.MultiStore.
+func (cms *cacheMultiStore) GetStore(key storetypes.StoreKey) storetypes.Store {
+	return cms.GetKVStore(key)
+}
+
+// GetKVStore implements storetypes.MultiStore.
+func (cms *cacheMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	store, ok := cms.stores[key]
+	if !ok {
+		store = cachekv.NewStore(cms.parent.GetKVStore(key))
+		cms.stores[key] = store
+		cms.keys = append(cms.keys, key)
+	}
+
+	return store
+}
+
+// Write implements storetypes.CacheMultiStore.
+func (cms *cacheMultiStore) Write() {
+	for _, key := range cms.keys {
+		cms.stores[key].Write()
+	}
+}
+
+// TracingEnabled implements storetypes.MultiStore.
+func (cms *cacheMultiStore) TracingEnabled() bool {
+	return false
+}
+
+// SetTracer implements storetypes.MultiStore, tracing is not supported.
+func (cms *cacheMultiStore) SetTracer(_ io.Writer) storetypes.MultiStore {
+	return cms
+}
+
+// SetTracingContext implements storetypes.MultiStore, tracing is not
+// supported.
+func (cms *cacheMultiStore) SetTracingContext(_ storetypes.TraceContext) storetypes.MultiStore {
+	return cms
+}
+
+// LatestVersion implements storetypes.MultiStore.
+func (cms *cacheMultiStore) LatestVersion() int64 {
+	return cms.parent.LatestVersion()
+}
@@ -0,0 +1,143 @@
+// Package blockstm implements the optimistic parallel execution of the
+// transactions of a block, in the spirit of Block-STM.
+//
+// Transactions are executed speculatively by a pool of workers, each over a
+// view of the state which reads the values written by the preceding
+// transactions from a multi-version memory. Transactions are then committed
+// in order: the reads of a speculative execution are validated against the
+// final values written by the preceding transactions, and the transaction is
+// executed again if they changed. The committed results are therefore the
+// ones of a sequential execution.
+package blockstm
+
+import (
+	"sync"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+// Block defines the transactions of a block and how they are executed.
+type Block[R any] struct {
+	// Store is the state the block is executed over, it is only read from.
+	Store storetypes.MultiStore
+
+	// NumTxs is the number of transactions of the block.
+	NumTxs int
+
+	// Execute executes the transaction at txIndex over ms. final is true if
+	// all the preceding transactions were committed: ms then holds the state
+	// a sequential execution would see, and the result is always committed.
+	Execute func(txIndex int, ms storetypes.MultiStore, final bool) R
+
+	// Speculative returns true if the transaction at txIndex can be executed
+	// before the preceding transactions were committed. Transactions whose
+	// execution has side effects outside of the multi-store must not be. A
+	// nil Speculative allows every transaction.
+	Speculative func(txIndex int) bool
+
+	// Commit is called in order with the result of each transaction, once the
+	// reads of its speculative execution were validated or after its final
+	// execution. It returns false to reject a speculative result, which makes
+	// the transaction execute again with final set.
+	Commit func(txIndex int, result R, final bool) bool
+}
+
+// Output is the committed outcome of a transaction.
+type Output[R any] struct {
+	Result R
+	Writes WriteSet
+}
+
+// txState is the state of the speculative execution of a transaction.
+type txState[R any] struct {
+	// done is closed once the speculative execution completed, it is nil if
+	// the transaction is not executed speculatively.
+	done chan struct{}
+
+	result  R
+	readSet readSet
+	writes  WriteSet
+	// aborted is true if the speculative execution panicked.
+	aborted bool
+}
+
+// Run executes the transactions of the block with the provided number of
+// workers, and returns their committed outputs in order.
+func Run[R any](b Block[R], workers int) []Output[R] {
+	if workers < 1 {
+		workers = 1
+	}
+
+	var (
+		mv      = NewMVMemory(b.NumTxs)
+		baseMtx = &sync.Mutex{}
+		states  = make([]*txState[R], b.NumTxs)
+		tasks   = make(chan int, b.NumTxs)
+	)
+
+	for i := range states {
+		states[i] = &txState[R]{}
+		if b.Speculative == nil || b.Speculative(i) {
+			states[i].done = make(chan struct{})
+			tasks <- i
+		}
+	}
+	close(tasks)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range tasks {
+				executeSpeculative(b, mv, baseMtx, i, states[i])
+				close(states[i].done)
+			}
+		}()
+	}
+
+	// The transactions are committed in order while the workers execute the
+	// following ones. A transaction whose speculative execution cannot be
+	// committed is executed again, over the committed state.
+	outputs := make([]Output[R], b.NumTxs)
+	for i, st := range states {
+		if st.done != nil {
+			<-st.done
+			if !st.aborted && mv.validate(i, &st.readSet) && b.Commit(i, st.result, false) {
+				outputs[i] = Output[R]{Result: st.result, Writes: st.writes}
+				continue
+			}
+		}
+
+		version := Version{TxIndex: i, Incarnation: 1}
+		ms := newMultiStore(mv, b.Store, baseMtx, version)
+		result := b.Execute(i, ms, true)
+		writes := ms.writeSet()
+		mv.Record(version, writes)
+		b.Commit(i, result, true)
+
+		outputs[i] = Output[R]{Result: result, Writes: writes}
+	}
+
+	wg.Wait()
+
+	return outputs
+}
+
+// executeSpeculative executes the first incarnation of a transaction and
+// records its writes in the multi-version memory. Panics are expected since
+// the state seen may be inconsistent, the execution is then aborted.
+func executeSpeculative[R any](b Block[R], mv *MVMemory, baseMtx *sync.Mutex, txIndex int, st *txState[R]) {
+	defer func() {
+		if r := recover(); r != nil {
+			st.aborted = true
+		}
+	}()
+
+	version := Version{TxIndex: txIndex, Incarnation: 0}
+	ms := newMultiStore(mv, b.Store, baseMtx, version)
+	st.result = b.Execute(txIndex, ms, false)
+	st.readSet = ms.readSet
+	st.writes = ms.writeSet()
+	mv.Record(version, st.writes)
+}
@@ -0,0 +1,231 @@
+package blockstm
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/store/cachemulti"
+	"cosmossdk.io/store/dbadapter"
+	storetypes "cosmossdk.io/store/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testKey1 = storetypes.NewKVStoreKey("store1")
+	testKey2 = storetypes.NewKVStoreKey("store2")
+)
+
+type opKind int
+
+const (
+	opGet opKind = iota
+	opSet
+	opDelete
+	opIterate
+	opReverseIterate
+)
+
+type op struct {
+	kind  opKind
+	store storetypes.StoreKey
+	key   []byte
+	end   []byte
+}
+
+// newTestStore returns a multi-store holding a few keys in each store.
+func newTestStore(t *testing.T) storetypes.CacheMultiStore {
+	t.Helper()
+
+	stores := map[storetypes.StoreKey]storetypes.CacheWrapper{
+		testKey1: dbadapter.Store{DB: dbm.NewMemDB()},
+		testKey2: dbadapter.Store{DB: dbm.NewMemDB()},
+	}
+	ms := cachemulti.NewStore(dbm.NewMemDB(), stores, nil, nil, nil)
+
+	for _, key := range []storetypes.StoreKey{testKey1, testKey2} {
+		for i := 0; i < 10; i += 2 {
+			ms.GetKVStore(key).Set(testKeyAt(i), []byte("base"))
+		}
+	}
+
+	return ms
+}
+
+func testKeyAt(i int) []byte {
+	return []byte(fmt.Sprintf("key%02d", i))
+}
+
+// randomOps returns the operations of a transaction, over a small key space so
+// that transactions conflict.
+func randomOps(r *rand.Rand) []op {
+	ops := make([]op, 1+r.Intn(6))
+	for i := range ops {
+		store := testKey1
+		if r.Intn(2) == 0 {
+			store = testKey2
+		}
+
+		start := r.Intn(12)
+		ops[i] = op{
+			kind:  opKind(r.Intn(5)),
+			store: store,
+			key:   testKeyAt(start),
+			end:   testKeyAt(start + 1 + r.Intn(5)),
+		}
+	}
+
+	return ops
+}
+
+// executeOps executes the operations of a transaction, the values written
+// depend on the values read, and the result records everything read.
+func executeOps(txIndex int, ops []op, ms storetypes.MultiStore) string {
+	var log strings.Builder
+	for _, o := range ops {
+		store := ms.GetKVStore(o.store)
+
+		switch o.kind {
+		case opGet:
+			fmt.Fprintf(&log, "get %s=%s;", o.key, store.Get(o.key))
+
+		case opSet:
+			store.Set(o.key, []byte(fmt.Sprintf("%d:%d", txIndex, len(log.String()))))
+
+		case opDelete:
+			store.Delete(o.key)
+
+		case opIterate, opReverseIterate:
+			var it storetypes.Iterator
+			if o.kind == opIterate {
+				it = store.Iterator(o.key, o.end)
+			} else {
+				it = store.ReverseIterator(o.key, o.end)
+			}
+
+			for ; it.Valid(); it.Next() {
+				fmt.Fprintf(&log, "it %s=%s;", it.Key(), it.Value())
+			}
+			it.Close()
+		}
+	}
+
+	// exercise a branch of the transaction state, like the ante handler and
+	// the messages do
+	branch := ms.CacheMultiStore()
+	branch.GetKVStore(testKey1).Set([]byte(fmt.Sprintf("tx%03d", txIndex)), []byte(log.String()))
+	branch.Write()
+
+	return log.String()
+}
+
+// storeContents returns the contents of all the stores.
+func storeContents(ms storetypes.MultiStore) []string {
+	var contents []string
+	for _, key := range []storetypes.StoreKey{testKey1, testKey2} {
+		it := ms.GetKVStore(key).Iterator(nil, nil)
+		for ; it.Valid(); it.Next() {
+			contents = append(contents, fmt.Sprintf("%s/%s=%s", key.Name(), it.Key(), it.Value()))
+		}
+		it.Close()
+	}
+
+	return contents
+}
+
+func TestRun(t *testing.T) {
+	for seed := int64(0); seed < 50; seed++ {
+		r := rand.New(rand.NewSource(seed))
+
+		numTxs := 1 + r.Intn(40)
+		txs := make([][]op, numTxs)
+		for i := range txs {
+			txs[i] = randomOps(r)
+		}
+
+		// the transactions executed sequentially
+		expected := newTestStore(t)
+		expectedResults := make([]string, numTxs)
+		for i, ops := range txs {
+			expectedResults[i] = executeOps(i, ops, expected)
+		}
+
+		base := newTestStore(t)
+		baseContents := storeContents(base)
+
+		commits := 0
+		outputs := Run(Block[string]{
+			Store:  base,
+			NumTxs: numTxs,
+			Execute: func(i int, ms storetypes.MultiStore, _ bool) string {
+				return executeOps(i, txs[i], ms)
+			},
+			Speculative: func(i int) bool {
+				return i%7 != 3
+			},
+			Commit: func(i int, _ string, _ bool) bool {
+				require.Equal(t, commits, i)
+				commits++
+				return true
+			},
+		}, 1+r.Intn(8))
+
+		require.Equal(t, numTxs, commits)
+		require.Equal(t, baseContents, storeContents(base), "base store must not be written to")
+
+		for i, output := range outputs {
+			require.Equal(t, expectedResults[i], output.Result, "seed %d, tx %d", seed, i)
+			output.Writes.Apply(base)
+		}
+		require.Equal(t, storeContents(expected), storeContents(base), "seed %d", seed)
+	}
+}
+
+func TestRunRejectedCommit(t *testing.T) {
+	base := newTestStore(t)
+
+	var finals []int
+	outputs := Run(Block[int]{
+		Store:  base,
+		NumTxs: 3,
+		Execute: func(i int, ms storetypes.MultiStore, final bool) int {
+			if final {
+				finals = append(finals, i)
+			}
+			ms.GetKVStore(testKey1).Set(testKeyAt(i), []byte("tx"))
+			return i
+		},
+		Commit: func(i int, _ int, final bool) bool {
+			// the speculative execution of the second transaction is rejected
+			return final || i != 1
+		},
+	}, 2)
+
+	require.Equal(t, []int{1}, finals)
+	for i, output := range outputs {
+		require.Equal(t, i, output.Result)
+		require.Equal(t, WriteSet{testKey1: {{Key: testKeyAt(i), Value: []byte("tx")}}}, output.Writes)
+	}
+}
+
+func TestRunAbortedExecution(t *testing.T) {
+	base := newTestStore(t)
+
+	outputs := Run(Block[string]{
+		Store:  base,
+		NumTxs: 2,
+		Execute: func(i int, ms storetypes.MultiStore, final bool) string {
+			if !final {
+				panic("inconsistent state")
+			}
+
+			return string(ms.GetKVStore(testKey1).Get(testKeyAt(0)))
+		},
+		Commit: func(int, string, bool) bool { return true },
+	}, 2)
+
+	require.Equal(t, "base", outputs[0].Result)
+	require.Equal(t, "base", outputs[1].Result)
+}
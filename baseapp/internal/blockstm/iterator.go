@@ -0,0 +1,174 @@
+package blockstm
+
+import (
+	"bytes"
+	"sync"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+// lockedIterator serializes the calls to an iterator of the base store.
+type lockedIterator struct {
+	storetypes.Iterator
+	mtx *sync.Mutex
+}
+
+func (it *lockedIterator) Valid() bool {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+	return it.Iterator.Valid()
+}
+
+func (it *lockedIterator) Next() {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+	it.Iterator.Next()
+}
+
+func (it *lockedIterator) Key() []byte {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+	return it.Iterator.Key()
+}
+
+func (it *lockedIterator) Value() []byte {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+	return it.Iterator.Value()
+}
+
+func (it *lockedIterator) Error() error {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+	return it.Iterator.Error()
+}
+
+func (it *lockedIterator) Close() error {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+	return it.Iterator.Close()
+}
+
+var _ storetypes.Iterator = (*mergeIterator)(nil)
+
+// mergeIterator merges an iterator of the base store with the writes which
+// shadow it, sorted in iteration order. Deletions hide the keys of the base
+// store.
+type mergeIterator struct {
+	parent     storetypes.Iterator
+	overlay    []KVPair
+	start, end []byte
+	ascending  bool
+
+	// overlayCurrent is true when the current item is overlay[0].
+	overlayCurrent bool
+}
+
+func newMergeIterator(parent storetypes.Iterator, overlay []KVPair, start, end []byte, ascending bool) *mergeIterator {
+	it := &mergeIterator{
+		parent:    parent,
+		overlay:   overlay,
+		start:     start,
+		end:       end,
+		ascending: ascending,
+	}
+	it.skip()
+
+	return it
+}
+
+// compare compares two keys in iteration order.
+func (it *mergeIterator) compare(a, b []byte) int {
+	c := bytes.Compare(a, b)
+	if !it.ascending {
+		return -c
+	}
+	return c
+}
+
+// skip moves to the next item which is not a deletion.
+func (it *mergeIterator) skip() {
+	for len(it.overlay) > 0 {
+		o := it.overlay[0]
+
+		if it.parent.Valid() {
+			c := it.compare(it.parent.Key(), o.Key)
+			if c < 0 {
+				it.overlayCurrent = false
+				return
+			}
+			if c == 0 {
+				// the overlay shadows the base store
+				it.parent.Next()
+			}
+		}
+
+		if o.Value != nil {
+			it.overlayCurrent = true
+			return
+		}
+
+		it.overlay = it.overlay[1:]
+	}
+
+	it.overlayCurrent = false
+}
+
+// Domain implements storetypes.Iterator.
+func (it *mergeIterator) Domain() (start, end []byte) {
+	return it.start, it.end
+}
+
+// Valid implements storetypes.Iterator.
+func (it *mergeIterator) Valid() bool {
+	return it.overlayCurrent || it.parent.Valid()
+}
+
+// Next implements storetypes.Iterator.
+func (it *mergeIterator) Next() {
+	if !it.Valid() {
+		panic("iterator is invalid")
+	}
+
+	if it.overlayCurrent {
+		it.overlay = it.overlay[1:]
+	} else {
+		it.parent.Next()
+	}
+
+	it.skip()
+}
+
+// Key implements storetypes.Iterator.
+func (it *mergeIterator) Key() []byte {
+	if !it.Valid() {
+		panic("iterator is invalid")
+	}
+
+	if it.overlayCurrent {
+		return it.overlay[0].Key
+	}
+	return it.parent.Key()
+}
+
+// Value implements storetypes.Iterator.
+func (it *mergeIterator) Value() []byte {
+	if !it.Valid() {
+		panic("iterator is invalid")
+	}
+
+	if it.overlayCurrent {
+		return it.overlay[0].Value
+	}
+	return it.parent.Value()
+}
+
+// Error implements storetypes.Iterator.
+func (it *mergeIterator) Error() error {
+	return it.parent.Error()
+}
+
+// Close implements storetypes.Iterator.
+func (it *mergeIterator) Close() error {
+	return it.parent.Close()
+}
@@ -0,0 +1,210 @@
+package blockstm
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/tidwall/btree"
+)
+
+// Version identifies the incarnation of a transaction which wrote a value.
+// Values read from the base store have the base version.
+type Version struct {
+	TxIndex     int
+	Incarnation int
+}
+
+// baseVersion is the version of the values read from the base store.
+var baseVersion = Version{TxIndex: -1}
+
+// write is a value written by an incarnation of a transaction, a nil value is
+// a deletion.
+type write struct {
+	version Version
+	value   []byte
+}
+
+// keyWrites are the writes to a key, sorted by transaction index.
+type keyWrites []write
+
+// search returns the position of the write of the given transaction, or the
+// position it would be inserted at.
+func (kw keyWrites) search(txIndex int) int {
+	return sort.Search(len(kw), func(i int) bool { return kw[i].version.TxIndex >= txIndex })
+}
+
+// latest returns the write of the highest transaction below txIndex.
+func (kw keyWrites) latest(txIndex int) (write, bool) {
+	i := kw.search(txIndex)
+	if i == 0 {
+		return write{}, false
+	}
+
+	return kw[i-1], true
+}
+
+// storeWrites are the writes to a single store.
+type storeWrites struct {
+	keys   *btree.BTreeG[string] // keys are the keys ever written, sorted
+	writes map[string]keyWrites
+}
+
+// keyRef references a key of a store.
+type keyRef struct {
+	store storetypes.StoreKey
+	key   string
+}
+
+// entry is a value visible to a transaction along with its version.
+type entry struct {
+	key     string
+	value   []byte
+	version Version
+}
+
+// MVMemory is a multi-version memory which holds the values written by the
+// transactions of a block. A transaction reads the value written by the
+// highest transaction preceding it, or falls back to the base store.
+type MVMemory struct {
+	mtx    sync.RWMutex
+	stores map[storetypes.StoreKey]*storeWrites
+	// txKeys are the keys written by the last recorded incarnation of each
+	// transaction.
+	txKeys [][]keyRef
+}
+
+// NewMVMemory returns a new MVMemory for a block of numTxs transactions.
+func NewMVMemory(numTxs int) *MVMemory {
+	return &MVMemory{
+		stores: make(map[storetypes.StoreKey]*storeWrites),
+		txKeys: make([][]keyRef, numTxs),
+	}
+}
+
+// Record replaces the writes of the previous incarnation of a transaction
+// with the provided ones.
+func (mv *MVMemory) Record(version Version, ws WriteSet) {
+	mv.mtx.Lock()
+	defer mv.mtx.Unlock()
+
+	for _, ref := range mv.txKeys[version.TxIndex] {
+		sw := mv.stores[ref.store]
+		kw := sw.writes[ref.key]
+		if i := kw.search(version.TxIndex); i < len(kw) && kw[i].version.TxIndex == version.TxIndex {
+			sw.writes[ref.key] = append(kw[:i], kw[i+1:]...)
+		}
+	}
+
+	keys := make([]keyRef, 0, ws.Len())
+	for storeKey, pairs := range ws {
+		sw, ok := mv.stores[storeKey]
+		if !ok {
+			sw = &storeWrites{
+				keys:   btree.NewBTreeGOptions(func(a, b string) bool { return a < b }, btree.Options{NoLocks: true}),
+				writes: make(map[string]keyWrites),
+			}
+			mv.stores[storeKey] = sw
+		}
+
+		for _, pair := range pairs {
+			key := string(pair.Key)
+			kw, ok := sw.writes[key]
+			if !ok {
+				sw.keys.Set(key)
+			}
+
+			i := kw.search(version.TxIndex)
+			kw = append(kw, write{})
+			copy(kw[i+1:], kw[i:])
+			kw[i] = write{version: version, value: pair.Value}
+			sw.writes[key] = kw
+
+			keys = append(keys, keyRef{store: storeKey, key: key})
+		}
+	}
+
+	mv.txKeys[version.TxIndex] = keys
+}
+
+// read returns the value of a key visible to the given transaction, found is
+// false if no preceding transaction wrote it.
+func (mv *MVMemory) read(storeKey storetypes.StoreKey, key []byte, txIndex int) (w write, found bool) {
+	mv.mtx.RLock()
+	defer mv.mtx.RUnlock()
+
+	return mv.readLocked(storeKey, string(key), txIndex)
+}
+
+func (mv *MVMemory) readLocked(storeKey storetypes.StoreKey, key string, txIndex int) (write, bool) {
+	sw, ok := mv.stores[storeKey]
+	if !ok {
+		return write{}, false
+	}
+
+	return sw.writes[key].latest(txIndex)
+}
+
+// rangeEntries returns the values, including deletions, visible to the given
+// transaction within [start, end), sorted by key.
+func (mv *MVMemory) rangeEntries(storeKey storetypes.StoreKey, start, end []byte, txIndex int) []entry {
+	mv.mtx.RLock()
+	defer mv.mtx.RUnlock()
+
+	return mv.rangeEntriesLocked(storeKey, start, end, txIndex)
+}
+
+func (mv *MVMemory) rangeEntriesLocked(storeKey storetypes.StoreKey, start, end []byte, txIndex int) []entry {
+	sw, ok := mv.stores[storeKey]
+	if !ok {
+		return nil
+	}
+
+	var entries []entry
+	sw.keys.Ascend(string(start), func(key string) bool {
+		if end != nil && bytes.Compare([]byte(key), end) >= 0 {
+			return false
+		}
+
+		if w, ok := sw.writes[key].latest(txIndex); ok {
+			entries = append(entries, entry{key: key, value: w.value, version: w.version})
+		}
+
+		return true
+	})
+
+	return entries
+}
+
+// validate returns true if the values read by a transaction are still the
+// ones visible to it.
+func (mv *MVMemory) validate(txIndex int, rs *readSet) bool {
+	mv.mtx.RLock()
+	defer mv.mtx.RUnlock()
+
+	for _, r := range rs.reads {
+		w, ok := mv.readLocked(r.store, r.key, txIndex)
+		switch {
+		case !ok && r.version != baseVersion:
+			return false
+		case ok && w.version != r.version:
+			return false
+		}
+	}
+
+	for _, it := range rs.iterations {
+		entries := mv.rangeEntriesLocked(it.store, it.start, it.end, txIndex)
+		if len(entries) != len(it.entries) {
+			return false
+		}
+
+		for i, e := range entries {
+			if e.key != it.entries[i].key || e.version != it.entries[i].version {
+				return false
+			}
+		}
+	}
+
+	return true
+}
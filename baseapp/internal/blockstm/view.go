@@ -0,0 +1,284 @@
+package blockstm
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	"cosmossdk.io/store/cachekv"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// read is a value read by a transaction along with its version.
+type read struct {
+	store   storetypes.StoreKey
+	key     string
+	version Version
+}
+
+// iteration is a range iterated by a transaction, along with the entries of
+// the multi-version memory which were visible within it.
+type iteration struct {
+	store      storetypes.StoreKey
+	start, end []byte
+	entries    []entry
+}
+
+// readSet holds what a transaction read from the multi-version memory and
+// the base store.
+type readSet struct {
+	reads      []read
+	iterations []iteration
+}
+
+var _ storetypes.MultiStore = (*multiStore)(nil)
+
+// multiStore is the state seen by an incarnation of a transaction. Writes
+// are buffered, reads are served from the buffered writes, then from the
+// multi-version memory, then from the base store, and recorded so that they
+// can be validated.
+//
+// A multiStore is used by a single goroutine, accesses to the base store are
+// serialized across transactions through baseMtx.
+type multiStore struct {
+	mv      *MVMemory
+	base    storetypes.MultiStore
+	baseMtx *sync.Mutex
+	version Version
+
+	stores  map[storetypes.StoreKey]*kvStore
+	readSet readSet
+}
+
+func newMultiStore(mv *MVMemory, base storetypes.MultiStore, baseMtx *sync.Mutex, version Version) *multiStore {
+	return &multiStore{
+		mv:      mv,
+		base:    base,
+		baseMtx: baseMtx,
+		version: version,
+		stores:  make(map[storetypes.StoreKey]*kvStore),
+	}
+}
+
+// GetStoreType implements storetypes.Store.
+func (ms *multiStore) GetStoreType() storetypes.StoreType {
+	return storetypes.StoreTypeMulti
+}
+
+// CacheWrap implements storetypes.CacheWrapper.
+func (ms *multiStore) CacheWrap() storetypes.CacheWrap {
+	return ms.CacheMultiStore()
+}
+
+// CacheWrapWithTrace implements storetypes.CacheWrapper, tracing is not
+// supported.
+func (ms *multiStore) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return ms.CacheWrap()
+}
+
+// CacheMultiStore implements storetypes.MultiStore.
+func (ms *multiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	return newCacheMultiStore(ms)
+}
+
+// CacheMultiStoreWithVersion implements storetypes.MultiStore, it is not
+// supported.
+func (ms *multiStore) CacheMultiStoreWithVersion(_ int64) (storetypes.CacheMultiStore, error) {
+	return nil, fmt.Errorf("cannot branch a transaction multi-store at a version")
+}
+
+// GetStore implements storetypes.MultiStore.
+func (ms *multiStore) GetStore(key storetypes.StoreKey) storetypes.Store {
+	return ms.GetKVStore(key)
+}
+
+// GetKVStore implements storetypes.MultiStore.
+func (ms *multiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	store, ok := ms.stores[key]
+	if !ok {
+		ms.baseMtx.Lock()
+		base := ms.base.GetKVStore(key)
+		ms.baseMtx.Unlock()
+
+		store = &kvStore{
+			ms:     ms,
+			key:    key,
+			base:   base,
+			writes: make(map[string][]byte),
+		}
+		ms.stores[key] = store
+	}
+
+	return store
+}
+
+// TracingEnabled implements storetypes.MultiStore.
+func (ms *multiStore) TracingEnabled() bool {
+	return false
+}
+
+// SetTracer implements storetypes.MultiStore, tracing is not supported.
+func (ms *multiStore) SetTracer(_ io.Writer) storetypes.MultiStore {
+	return ms
+}
+
+// SetTracingContext implements storetypes.MultiStore, tracing is not
+// supported.
+func (ms *multiStore) SetTracingContext(_ storetypes.TraceContext) storetypes.MultiStore {
+	return ms
+}
+
+// LatestVersion implements storetypes.MultiStore.
+func (ms *multiStore) LatestVersion() int64 {
+	return ms.base.LatestVersion()
+}
+
+// writeSet returns the writes of the transaction.
+func (ms *multiStore) writeSet() WriteSet {
+	ws := make(WriteSet, len(ms.stores))
+	for key, store := range ms.stores {
+		if len(store.writes) == 0 {
+			continue
+		}
+
+		pairs := make([]KVPair, 0, len(store.writes))
+		for k, v := range store.writes {
+			pairs = append(pairs, KVPair{Key: []byte(k), Value: v})
+		}
+		sort.Slice(pairs, func(i, j int) bool { return bytes.Compare(pairs[i].Key, pairs[j].Key) < 0 })
+		ws[key] = pairs
+	}
+
+	return ws
+}
+
+var _ storetypes.KVStore = (*kvStore)(nil)
+
+// kvStore is a single store of a multiStore.
+type kvStore struct {
+	ms   *multiStore
+	key  storetypes.StoreKey
+	base storetypes.KVStore
+	// writes are the buffered writes, a nil value is a deletion.
+	writes map[string][]byte
+}
+
+// GetStoreType implements storetypes.Store.
+func (s *kvStore) GetStoreType() storetypes.StoreType {
+	return s.base.GetStoreType()
+}
+
+// CacheWrap implements storetypes.CacheWrapper.
+func (s *kvStore) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements storetypes.CacheWrapper, tracing is not
+// supported.
+func (s *kvStore) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return s.CacheWrap()
+}
+
+// Get implements storetypes.KVStore.
+func (s *kvStore) Get(key []byte) []byte {
+	storetypes.AssertValidKey(key)
+
+	if value, ok := s.writes[string(key)]; ok {
+		return value
+	}
+
+	if w, ok := s.ms.mv.read(s.key, key, s.ms.version.TxIndex); ok {
+		s.ms.readSet.reads = append(s.ms.readSet.reads, read{store: s.key, key: string(key), version: w.version})
+		return w.value
+	}
+
+	s.ms.readSet.reads = append(s.ms.readSet.reads, read{store: s.key, key: string(key), version: baseVersion})
+
+	s.ms.baseMtx.Lock()
+	defer s.ms.baseMtx.Unlock()
+
+	return s.base.Get(key)
+}
+
+// Has implements storetypes.KVStore.
+func (s *kvStore) Has(key []byte) bool {
+	return s.Get(key) != nil
+}
+
+// Set implements storetypes.KVStore.
+func (s *kvStore) Set(key, value []byte) {
+	storetypes.AssertValidKey(key)
+	storetypes.AssertValidValue(value)
+
+	s.writes[string(key)] = value
+}
+
+// Delete implements storetypes.KVStore.
+func (s *kvStore) Delete(key []byte) {
+	storetypes.AssertValidKey(key)
+
+	s.writes[string(key)] = nil
+}
+
+// Iterator implements storetypes.KVStore.
+func (s *kvStore) Iterator(start, end []byte) storetypes.Iterator {
+	return s.iterator(start, end, true)
+}
+
+// ReverseIterator implements storetypes.KVStore.
+func (s *kvStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	return s.iterator(start, end, false)
+}
+
+func (s *kvStore) iterator(start, end []byte, ascending bool) storetypes.Iterator {
+	entries := s.ms.mv.rangeEntries(s.key, start, end, s.ms.version.TxIndex)
+	s.ms.readSet.iterations = append(s.ms.readSet.iterations, iteration{
+		store:   s.key,
+		start:   bytes.Clone(start),
+		end:     bytes.Clone(end),
+		entries: entries,
+	})
+
+	// the buffered writes shadow the multi-version memory, which shadows the
+	// base store.
+	overlay := make(map[string][]byte, len(entries))
+	for _, e := range entries {
+		overlay[e.key] = e.value
+	}
+	for k, v := range s.writes {
+		if inRange([]byte(k), start, end) {
+			overlay[k] = v
+		}
+	}
+
+	pairs := make([]KVPair, 0, len(overlay))
+	for k, v := range overlay {
+		pairs = append(pairs, KVPair{Key: []byte(k), Value: v})
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		c := bytes.Compare(pairs[i].Key, pairs[j].Key)
+		if ascending {
+			return c < 0
+		}
+		return c > 0
+	})
+
+	s.ms.baseMtx.Lock()
+	var parent storetypes.Iterator
+	if ascending {
+		parent = s.base.Iterator(start, end)
+	} else {
+		parent = s.base.ReverseIterator(start, end)
+	}
+	s.ms.baseMtx.Unlock()
+
+	return newMergeIterator(&lockedIterator{Iterator: parent, mtx: s.ms.baseMtx}, pairs, start, end, ascending)
+}
+
+// inRange returns true if key is within [start, end), nil bounds are
+// unbounded.
+func inRange(key, start, end []byte) bool {
+	return (start == nil || bytes.Compare(key, start) >= 0) && (end == nil || bytes.Compare(key, end) < 0)
+}
@@ -0,0 +1,39 @@
+package blockstm
+
+import (
+	storetypes "cosmossdk.io/store/types"
+)
+
+// KVPair is a write to a key, a nil Value is a deletion.
+type KVPair struct {
+	Key   []byte
+	Value []byte
+}
+
+// WriteSet holds the writes of a transaction by store, the writes to each
+// store are sorted by key.
+type WriteSet map[storetypes.StoreKey][]KVPair
+
+// Len returns the number of writes.
+func (ws WriteSet) Len() int {
+	n := 0
+	for _, pairs := range ws {
+		n += len(pairs)
+	}
+
+	return n
+}
+
+// Apply applies the writes to the provided multi-store.
+func (ws WriteSet) Apply(ms storetypes.MultiStore) {
+	for storeKey, pairs := range ws {
+		store := ms.GetKVStore(storeKey)
+		for _, pair := range pairs {
+			if pair.Value == nil {
+				store.Delete(pair.Key)
+			} else {
+				store.Set(pair.Key, pair.Value)
+			}
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package baseapp
+
+import (
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// MempoolInspector returns the app-side mempool as a mempool.Inspector, safe for
+// use concurrently with the ABCI methods, and false if the mempool does not
+// implement mempool.Inspector.
+func (app *BaseApp) MempoolInspector() (mempool.Inspector, bool) {
+	inspector, ok := app.mempool.(mempool.Inspector)
+	if !ok {
+		return nil, false
+	}
+
+	return lockedInspector{mtx: &app.mempoolMtx, inspector: inspector}, true
+}
+
+var _ mempool.Inspector = lockedInspector{}
+
+// lockedInspector guards a mempool.Inspector with the mempool lock of the
+// BaseApp.
+type lockedInspector struct {
+	mtx       *sync.Mutex
+	inspector mempool.Inspector
+}
+
+func (i lockedInspector) PendingTxs(sender string) []mempool.PendingTx {
+	i.mtx.Lock()
+	defer i.mtx.Unlock()
+
+	return i.inspector.PendingTxs(sender)
+}
+
+func (i lockedInspector) SenderCounts() map[string]int {
+	i.mtx.Lock()
+	defer i.mtx.Unlock()
+
+	return i.inspector.SenderCounts()
+}
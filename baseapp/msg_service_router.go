@@ -22,10 +22,17 @@ type MessageRouter interface {
 	HandlerByTypeURL(typeURL string) MsgServiceHandler
 }
 
+// CircuitBreaker is the interface the MsgServiceRouter uses to check whether a
+// Msg type URL is allowed to be executed, such as the keeper of x/circuit.
+type CircuitBreaker interface {
+	IsAllowed(ctx sdk.Context, msgTypeURL string) (bool, error)
+}
+
 // MsgServiceRouter routes fully-qualified Msg service methods to their handler.
 type MsgServiceRouter struct {
 	interfaceRegistry codectypes.InterfaceRegistry
 	routes            map[string]MsgServiceHandler
+	circuitBreaker    CircuitBreaker
 }
 
 var _ gogogrpc.Server = &MsgServiceRouter{}
@@ -122,6 +129,19 @@ func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler inter
 				return handler(goCtx, req)
 			}
 
+			// the circuit breaker is checked by the router so that it also
+			// applies to the Msgs executed by other Msgs, e.g. authz MsgExec
+			if msr.circuitBreaker != nil {
+				allowed, err := msr.circuitBreaker.IsAllowed(ctx, requestTypeName)
+				if err != nil {
+					return nil, err
+				}
+
+				if !allowed {
+					return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "circuit breaker tripped for %s", requestTypeName)
+				}
+			}
+
 			if err := req.ValidateBasic(); err != nil {
 				return nil, err
 			}
@@ -142,6 +162,12 @@ func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler inter
 	}
 }
 
+// SetCircuit sets the circuit breaker checked before routing a Msg to its
+// handler, all Msgs are routed if it is not set.
+func (msr *MsgServiceRouter) SetCircuit(cb CircuitBreaker) {
+	msr.circuitBreaker = cb
+}
+
 // SetInterfaceRegistry sets the interface registry for the router.
 func (msr *MsgServiceRouter) SetInterfaceRegistry(interfaceRegistry codectypes.InterfaceRegistry) {
 	msr.interfaceRegistry = interfaceRegistry
@@ -10,11 +10,14 @@ import (
 
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
@@ -77,6 +80,45 @@ func TestRegisterMsgServiceTwice(t *testing.T) {
 	})
 }
 
+var _ baseapp.CircuitBreaker = testCircuitBreaker{}
+
+// testCircuitBreaker disallows the Msg type URLs set to true.
+type testCircuitBreaker map[string]bool
+
+func (cb testCircuitBreaker) IsAllowed(_ sdk.Context, msgTypeURL string) (bool, error) {
+	return !cb[msgTypeURL], nil
+}
+
+func TestMsgServiceCircuitBreaker(t *testing.T) {
+	var (
+		appBuilder *runtime.AppBuilder
+		registry   codectypes.InterfaceRegistry
+	)
+	err := depinject.Inject(makeMinimalConfig(), &appBuilder, &registry)
+	require.NoError(t, err)
+	app := appBuilder.Build(log.NewNopLogger(), dbm.NewMemDB(), nil)
+
+	testdata.RegisterInterfaces(registry)
+	testdata.RegisterMsgServer(
+		app.MsgServiceRouter(),
+		testdata.MsgServerImpl{},
+	)
+
+	msg := &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}}
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	handler := app.MsgServiceRouter().Handler(msg)
+
+	// the handlers of the router check the circuit breaker, which is not
+	// bypassed by a Msg routing another Msg
+	app.MsgServiceRouter().SetCircuit(testCircuitBreaker{})
+	_, err = handler(ctx, msg)
+	require.NoError(t, err)
+
+	app.MsgServiceRouter().SetCircuit(testCircuitBreaker{sdk.MsgTypeURL(msg): true})
+	_, err = handler(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
 func TestMsgService(t *testing.T) {
 	priv, _, _ := testdata.KeyTestPubAddr()
 
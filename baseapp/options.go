@@ -3,11 +3,13 @@ package baseapp
 import (
 	"fmt"
 	"io"
+	"time"
 
 	"cosmossdk.io/store/metrics"
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	"cosmossdk.io/store/snapshots"
 	snapshottypes "cosmossdk.io/store/snapshots/types"
+	"cosmossdk.io/store/statediff"
 	storetypes "cosmossdk.io/store/types"
 	dbm "github.com/cosmos/cosmos-db"
 
@@ -24,6 +26,18 @@ func SetPruning(opts pruningtypes.PruningOptions) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.cms.SetPruning(opts) }
 }
 
+// SetPruningConcurrency sets the number of stores of the multistore pruned
+// concurrently in the background, they are pruned during Commit if it is 0.
+func SetPruningConcurrency(concurrency int) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.cms.SetPruningConcurrency(concurrency) }
+}
+
+// SetWriteAheadLog enables the write-ahead log of the commits of the
+// multistore, an incomplete commit being completed or rolled back on load.
+func SetWriteAheadLog(enabled bool) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.cms.SetWriteAheadLog(enabled) }
+}
+
 // SetMinGasPrices returns an option that sets the minimum gas prices on the app.
 func SetMinGasPrices(gasPricesStr string) func(*BaseApp) {
 	gasPrices, err := sdk.ParseDecCoins(gasPricesStr)
@@ -56,6 +70,53 @@ func SetTrace(trace bool) func(*BaseApp) {
 	return func(app *BaseApp) { app.setTrace(trace) }
 }
 
+// SetParallelTxWorkers returns a BaseApp option function that sets the number
+// of workers executing the transactions of a block concurrently. Parallel
+// execution is disabled if it is 0.
+func SetParallelTxWorkers(workers int) func(*BaseApp) {
+	return func(app *BaseApp) { app.setParallelTxWorkers(workers) }
+}
+
+// SetMempoolRecheck returns a BaseApp option function that enables the recheck
+// of the application side mempool in the background after Commit. At most
+// maxTxs transactions are run through the AnteHandler against the committed
+// state, within timeout if it is not 0, and the failing ones are removed from
+// the mempool. The recheck is disabled if maxTxs is 0.
+func SetMempoolRecheck(maxTxs int, timeout time.Duration) func(*BaseApp) {
+	return func(app *BaseApp) {
+		app.recheckMaxTxs = maxTxs
+		app.recheckTimeout = timeout
+	}
+}
+
+// SetGasPriceHistory returns a BaseApp option function that records the gas
+// prices paid by the successful transactions of the last blocks committed, at
+// most blocks. The gas prices are not recorded if blocks is 0.
+func SetGasPriceHistory(blocks uint64) func(*BaseApp) {
+	return func(app *BaseApp) {
+		if blocks > 0 {
+			app.gasPriceHistory = NewGasPriceHistory(blocks)
+		}
+	}
+}
+
+// SetBundleLimits returns a BaseApp option function that bounds the number of
+// transactions of a simulated bundle and the total gas they use. A limit is not
+// enforced if it is 0.
+func SetBundleLimits(maxTxs, maxGas uint64) func(*BaseApp) {
+	return func(app *BaseApp) {
+		app.bundleMaxTxs = maxTxs
+		app.bundleMaxGas = maxGas
+	}
+}
+
+// SetStoreGasMetrics returns a BaseApp option function that enables the
+// telemetry of the gas consumed by the store operations, attributed to the store
+// key and the operation.
+func SetStoreGasMetrics(enabled bool) func(*BaseApp) {
+	return func(app *BaseApp) { app.storeGasMetrics = enabled }
+}
+
 // SetIndexEvents provides a BaseApp option function that sets the events to index.
 func SetIndexEvents(ie []string) func(*BaseApp) {
 	return func(app *BaseApp) { app.setIndexEvents(ie) }
@@ -76,6 +137,26 @@ func SetIAVLLazyLoading(lazyLoading bool) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.cms.SetLazyLoading(lazyLoading) }
 }
 
+// SetStateDiff provides a BaseApp option function that sets the store of the
+// change sets of the committed blocks. The changes of all the stores mounted
+// with the BaseApp, except the transient and in-memory ones, are saved to it.
+func SetStateDiff(store *statediff.Store) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.stateDiff = store }
+}
+
+// SetStateStorage provides a BaseApp option function that sets the state
+// storage, which serves the queries at heights pruned from the IAVL stores.
+func SetStateStorage(ss storetypes.StateStorage) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.cms.SetStateStorage(ss) }
+}
+
+// SetCommitmentBackend provides a BaseApp option function that registers the
+// commitment backend of the stores of a StoreType, so that they can be mounted
+// alongside the IAVL stores.
+func SetCommitmentBackend(typ storetypes.StoreType, backend storetypes.CommitmentBackend) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.cms.RegisterCommitmentBackend(typ, backend) }
+}
+
 // SetInterBlockCache provides a BaseApp option function that sets the
 // inter-block cache.
 func SetInterBlockCache(cache storetypes.MultiStorePersistentCache) func(*BaseApp) {
@@ -167,6 +248,14 @@ func (app *BaseApp) SetEndBlocker(endBlocker sdk.EndBlocker) {
 	app.endBlocker = endBlocker
 }
 
+func (app *BaseApp) SetPrepareCheckStater(prepareCheckStater sdk.PrepareCheckStater) {
+	if app.sealed {
+		panic("SetPrepareCheckStater() on sealed BaseApp")
+	}
+
+	app.prepareCheckStater = prepareCheckStater
+}
+
 func (app *BaseApp) SetAnteHandler(ah sdk.AnteHandler) {
 	if app.sealed {
 		panic("SetAnteHandler() on sealed BaseApp")
@@ -232,6 +321,9 @@ func (app *BaseApp) SetSnapshot(snapshotStore *snapshots.Store, opts snapshottyp
 		return
 	}
 	app.cms.SetSnapshotInterval(opts.Interval)
+	if cms, ok := app.cms.(interface{ SetSnapshotSpoolDir(string) }); ok {
+		cms.SetSnapshotSpoolDir(snapshotStore.SpoolDir())
+	}
 	app.snapshotManager = snapshots.NewManager(snapshotStore, opts, app.cms, nil, app.logger)
 }
 
@@ -293,6 +385,31 @@ func (app *BaseApp) SetStoreMetrics(gatherer metrics.StoreMetrics) {
 	app.cms.SetMetrics(gatherer)
 }
 
+// SetStoreGasConfig sets the gas configuration of the store of the given key,
+// overriding the default KVStore or transient KVStore gas configuration.
+func (app *BaseApp) SetStoreGasConfig(key storetypes.StoreKey, gasConfig storetypes.GasConfig) {
+	if app.sealed {
+		panic("SetStoreGasConfig() on sealed BaseApp")
+	}
+
+	if app.storeGasConfigs == nil {
+		app.storeGasConfigs = make(map[storetypes.StoreKey]storetypes.GasConfig)
+	}
+	app.storeGasConfigs[key] = gasConfig
+}
+
+// SetIterBytesLimit sets the maximum number of bytes a transaction can read
+// through store iterators, the transaction fails with ErrIterBytesLimit once
+// it is exceeded. Since it affects the execution of the transactions, it must
+// be the same on all the nodes of the network. It is not limited if it is 0.
+func (app *BaseApp) SetIterBytesLimit(limit uint64) {
+	if app.sealed {
+		panic("SetIterBytesLimit() on sealed BaseApp")
+	}
+
+	app.iterBytesLimit = limit
+}
+
 // SetStreamingManager sets the streaming manager for the BaseApp.
 func (app *BaseApp) SetStreamingManager(manager storetypes.StreamingManager) {
 	app.streamingManager = manager
@@ -0,0 +1,356 @@
+package baseapp
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cockroachdb/errors"
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp/internal/blockstm"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// parallelBlock is a block whose transactions are executed concurrently. The
+// whole block is executed on the first DeliverTx, each DeliverTx then applies
+// the writes of its transaction to the deliver state and returns its result.
+//
+// The results are identical to the ones of a sequential execution: a
+// transaction is executed speculatively over the writes of the preceding
+// transactions, its result is only kept if the values it read, the gas meters
+// shared by the transactions of the block and the mempool are unchanged once
+// the preceding transactions are committed, otherwise it is executed again.
+//
+// Modules must keep all of their state in stores, since in-memory state is not
+// tracked. Transactions which write to the same keys, such as the fee
+// collector balance, are effectively executed sequentially.
+//
+// If the delivered transactions do not match the accepted proposal, the rest
+// of the block is executed sequentially.
+type parallelBlock struct {
+	txs     [][]byte
+	outputs []blockstm.Output[parallelTxResult]
+	// blockGasConsumed and gasConsumed are the consumption of the gas meters
+	// shared by the block before the execution, at index 0, and once each
+	// transaction is committed.
+	blockGasConsumed []storetypes.Gas
+	gasConsumed      []storetypes.Gas
+	// next is the index of the next transaction to deliver.
+	next int
+}
+
+// parallelTxResult is the result of the execution of a transaction of a
+// parallelBlock.
+type parallelTxResult struct {
+	tx         sdk.Tx
+	gInfo      sdk.GasInfo
+	result     *sdk.Result
+	anteEvents []abci.Event
+	err        error
+
+	// blockGasMeter, gasMeter and mempool stand in for the ones shared by the
+	// transactions of the block during a speculative execution.
+	blockGasMeter *deferredGasMeter
+	gasMeter      *deferredGasMeter
+	mempool       *deferredMempool
+}
+
+// prepareParallelBlock sets up the parallel execution of the block with the
+// provided header hash, if its transactions were accepted in ProcessProposal.
+func (app *BaseApp) prepareParallelBlock(hash []byte) {
+	txs, ok := app.proposals[string(hash)]
+	app.proposals = nil
+	app.parallelBlock = nil
+
+	// Without an AnteHandler every transaction consumes gas from the gas meter
+	// shared by the block, hence depends on the preceding ones.
+	if app.parallelTxWorkers <= 0 || !ok || len(txs) == 0 || app.anteHandler == nil || app.cms.TracingEnabled() {
+		return
+	}
+
+	// the shared gas meter is expected to be infinite, so that a speculative
+	// execution can consume from it without knowing its actual state.
+	if app.deliverState.ctx.GasMeter().Limit() != math.MaxUint64 {
+		return
+	}
+
+	app.parallelBlock = &parallelBlock{txs: txs}
+}
+
+// recordProposal records the transactions of an accepted proposal for a
+// parallel execution.
+func (app *BaseApp) recordProposal(req abci.RequestProcessProposal) {
+	if app.proposals == nil {
+		app.proposals = make(map[string][][]byte)
+	}
+
+	app.proposals[string(req.Hash)] = req.Txs
+}
+
+// isNext returns true if the provided transaction is the next one of the
+// accepted proposal.
+func (pb *parallelBlock) isNext(txBytes []byte) bool {
+	return pb.next < len(pb.txs) && bytes.Equal(pb.txs[pb.next], txBytes)
+}
+
+// abortParallelBlock stops the parallel execution of the block when the
+// delivered transactions do not match the accepted proposal, the rest of the
+// block is then executed sequentially. The consumption of the gas meters
+// shared by the block is reset to the one of the delivered transactions, the
+// writes of the other transactions were not applied. The mempool removals of
+// the other transactions are not reverted.
+func (app *BaseApp) abortParallelBlock() {
+	pb := app.parallelBlock
+	app.parallelBlock = nil
+	app.logger.Error("delivered tx does not match the accepted proposal, executing the rest of the block sequentially", "tx", pb.next)
+
+	if pb.outputs == nil {
+		return
+	}
+
+	ctx := app.deliverState.ctx
+	resetGasConsumed(ctx.BlockGasMeter(), pb.blockGasConsumed[pb.next])
+	resetGasConsumed(ctx.GasMeter(), pb.gasConsumed[pb.next])
+}
+
+// resetGasConsumed refunds the gas consumed by the meter above the provided
+// amount.
+func resetGasConsumed(meter storetypes.GasMeter, consumed storetypes.Gas) {
+	if current := meter.GasConsumed(); current > consumed {
+		meter.RefundGas(current-consumed, "parallel block aborted")
+	}
+}
+
+// deliverParallelTx returns the next transaction of the parallel block, decoded,
+// and its result, executing the block if needed. The transaction must be the
+// next one of the accepted proposal, see isNext.
+func (app *BaseApp) deliverParallelTx() (sdk.Tx, sdk.GasInfo, *sdk.Result, []abci.Event, error) {
+	pb := app.parallelBlock
+	if pb.outputs == nil {
+		app.executeParallelBlock(pb)
+	}
+
+	output := pb.outputs[pb.next]
+	pb.next++
+
+	output.Writes.Apply(app.deliverState.ms)
+
+	res := output.Result
+	return res.tx, res.gInfo, res.result, res.anteEvents, res.err
+}
+
+// executeParallelBlock executes the transactions of the block concurrently
+// over the deliver state, which is left untouched, and records their outputs.
+func (app *BaseApp) executeParallelBlock(pb *parallelBlock) {
+	ctx := app.deliverState.ctx
+	txs := pb.txs
+	blockGasLimit := ctx.BlockGasMeter().Limit()
+
+	pb.blockGasConsumed = make([]storetypes.Gas, len(txs)+1)
+	pb.gasConsumed = make([]storetypes.Gas, len(txs)+1)
+	pb.blockGasConsumed[0], pb.gasConsumed[0] = ctx.BlockGasMeter().GasConsumed(), ctx.GasMeter().GasConsumed()
+
+	// the txs are decoded once for all their executions, a tx failing to be
+	// decoded is left nil and fails to be executed
+	decoded := make([]sdk.Tx, len(txs))
+	for i, txBytes := range txs {
+		decoded[i], _ = app.txDecoder(txBytes)
+	}
+
+	pb.outputs = blockstm.Run(blockstm.Block[parallelTxResult]{
+		Store:  app.deliverState.ms,
+		NumTxs: len(txs),
+		Execute: func(i int, ms storetypes.MultiStore, final bool) parallelTxResult {
+			if final {
+				return app.executeTx(ctx.WithMultiStore(ms), txs[i], decoded[i], app.mempool)
+			}
+
+			blockGasMeter, gasMeter, mp := newDeferredGasMeter(blockGasLimit), newDeferredGasMeter(math.MaxUint64), &deferredMempool{}
+			res := app.executeTx(
+				ctx.WithMultiStore(ms).
+					WithBlockGasMeter(blockGasMeter).
+					WithGasMeter(gasMeter).
+					WithEventManager(sdk.NewEventManager()),
+				txs[i],
+				decoded[i],
+				mp,
+			)
+			res.blockGasMeter, res.gasMeter, res.mempool = blockGasMeter, gasMeter, mp
+
+			return res
+		},
+		Speculative: func(i int) bool {
+			// the replay protection of unordered transactions is kept in memory
+			unorderedTx, ok := decoded[i].(sdk.TxWithUnordered)
+			return !ok || !unorderedTx.GetUnordered()
+		},
+		Commit: func(i int, res parallelTxResult, final bool) bool {
+			if !final && !app.commitSpeculativeTx(ctx, res) {
+				return false
+			}
+
+			pb.blockGasConsumed[i+1], pb.gasConsumed[i+1] = ctx.BlockGasMeter().GasConsumed(), ctx.GasMeter().GasConsumed()
+			return true
+		},
+	}, app.parallelTxWorkers)
+}
+
+// executeTx executes a transaction in DeliverTx mode over the provided
+// context. The transaction is decoded from txBytes if tx is nil.
+func (app *BaseApp) executeTx(ctx sdk.Context, txBytes []byte, tx sdk.Tx, mp mempool.Mempool) parallelTxResult {
+	ctx = ctx.
+		WithTxBytes(txBytes).
+		WithVoteInfos(app.voteInfos)
+
+	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
+
+	gInfo, result, anteEvents, _, err := app.runTxWithContext(ctx, runTxModeDeliver, txBytes, tx, mp)
+
+	return parallelTxResult{
+		tx:         tx,
+		gInfo:      gInfo,
+		result:     result,
+		anteEvents: anteEvents,
+		err:        err,
+	}
+}
+
+// commitSpeculativeTx applies the effects a speculative execution had on the
+// gas meters and the mempool shared by the block. It returns false if the
+// execution depended on their state, nothing is applied then.
+func (app *BaseApp) commitSpeculativeTx(ctx sdk.Context, res parallelTxResult) bool {
+	if !res.blockGasMeter.canApply(ctx.BlockGasMeter()) || !res.gasMeter.canApply(ctx.GasMeter()) {
+		return false
+	}
+
+	if res.mempool.removed != nil {
+		app.mempoolMtx.Lock()
+		err := app.mempool.Remove(res.mempool.removed)
+		app.mempoolMtx.Unlock()
+		if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+			return false
+		}
+	}
+
+	res.blockGasMeter.apply(ctx.BlockGasMeter())
+	res.gasMeter.apply(ctx.GasMeter())
+
+	return true
+}
+
+var _ storetypes.GasMeter = (*deferredGasMeter)(nil)
+
+// deferredGasMeter stands in for a gas meter shared by the transactions of a
+// block during a speculative execution. Its consumption is applied to the
+// actual meter once the transaction is committed. The meter assumes it is not
+// out of gas, and records whether its consumption was read, which depends on
+// the preceding transactions.
+type deferredGasMeter struct {
+	limit    storetypes.Gas
+	consumed storetypes.Gas
+
+	// checked is true if the meter was assumed not to be out of gas.
+	checked bool
+	// observed is true if the consumption was read.
+	observed bool
+}
+
+func newDeferredGasMeter(limit storetypes.Gas) *deferredGasMeter {
+	return &deferredGasMeter{limit: limit}
+}
+
+// GasConsumed implements storetypes.GasMeter.
+func (g *deferredGasMeter) GasConsumed() storetypes.Gas {
+	g.observed = true
+	return g.consumed
+}
+
+// GasConsumedToLimit implements storetypes.GasMeter.
+func (g *deferredGasMeter) GasConsumedToLimit() storetypes.Gas {
+	g.observed = true
+	return g.consumed
+}
+
+// GasRemaining implements storetypes.GasMeter.
+func (g *deferredGasMeter) GasRemaining() storetypes.Gas {
+	g.observed = true
+	return g.limit
+}
+
+// Limit implements storetypes.GasMeter.
+func (g *deferredGasMeter) Limit() storetypes.Gas {
+	return g.limit
+}
+
+// ConsumeGas implements storetypes.GasMeter.
+func (g *deferredGasMeter) ConsumeGas(amount storetypes.Gas, _ string) {
+	if amount > math.MaxUint64-g.consumed {
+		g.observed = true
+		g.consumed = math.MaxUint64
+		return
+	}
+
+	g.consumed += amount
+}
+
+// RefundGas implements storetypes.GasMeter.
+func (g *deferredGasMeter) RefundGas(amount storetypes.Gas, _ string) {
+	if amount > g.consumed {
+		g.observed = true
+		g.consumed = 0
+		return
+	}
+
+	g.consumed -= amount
+}
+
+// IsPastLimit implements storetypes.GasMeter.
+func (g *deferredGasMeter) IsPastLimit() bool {
+	g.checked = true
+	return false
+}
+
+// IsOutOfGas implements storetypes.GasMeter.
+func (g *deferredGasMeter) IsOutOfGas() bool {
+	g.checked = true
+	return false
+}
+
+// String implements storetypes.GasMeter.
+func (g *deferredGasMeter) String() string {
+	g.observed = true
+	return fmt.Sprintf("DeferredGasMeter:\n  limit: %d\n  consumed: %d", g.limit, g.consumed)
+}
+
+// canApply returns true if the speculative execution holds against the
+// actual meter, and its consumption can be applied without running out of
+// gas.
+func (g *deferredGasMeter) canApply(actual storetypes.GasMeter) bool {
+	if g.observed || (g.checked && actual.IsOutOfGas()) {
+		return false
+	}
+
+	return g.consumed <= actual.GasRemaining() && g.consumed <= math.MaxUint64-actual.GasConsumed()
+}
+
+// apply applies the consumption to the actual meter.
+func (g *deferredGasMeter) apply(actual storetypes.GasMeter) {
+	actual.ConsumeGas(g.consumed, "deferred gas")
+}
+
+// deferredMempool stands in for the application mempool during a speculative
+// execution, the removal of the transaction is applied once it is committed.
+type deferredMempool struct {
+	mempool.NoOpMempool
+
+	removed sdk.Tx
+}
+
+// Remove implements mempool.Mempool.
+func (mp *deferredMempool) Remove(tx sdk.Tx) error {
+	mp.removed = tx
+	return nil
+}
@@ -0,0 +1,212 @@
+package baseapp_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	parallelCounterKey = []byte("counter")
+	parallelSumKey     = []byte("sum")
+)
+
+// parallelAnteHandler sets a gas meter for the tx, and makes the txs with a
+// memo conflict: "counter" increments a shared counter, "sum" iterates over
+// the keys written by the messages and "fail" fails after incrementing the
+// counter.
+func parallelAnteHandler(ctx sdk.Context, tx sdk.Tx, _ bool) (sdk.Context, error) {
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(1_000_000))
+
+	memo := tx.(sdk.TxWithMemo).GetMemo()
+	store := ctx.KVStore(capKey1)
+
+	switch memo {
+	case "counter", "fail":
+		counter := uint64(0)
+		if bz := store.Get(parallelCounterKey); bz != nil {
+			counter = binary.BigEndian.Uint64(bz)
+		}
+		store.Set(parallelCounterKey, binary.BigEndian.AppendUint64(nil, counter+1))
+		ctx.EventManager().EmitEvent(sdk.NewEvent("counter", sdk.NewAttribute("value", fmt.Sprint(counter+1))))
+
+		if memo == "fail" {
+			return ctx, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "failing on ante")
+		}
+
+	case "sum":
+		it := ctx.KVStore(capKey2).Iterator(nil, nil)
+		defer it.Close()
+
+		sum := 0
+		for ; it.Valid(); it.Next() {
+			sum += len(it.Value())
+		}
+		store.Set(parallelSumKey, []byte(fmt.Sprint(sum)))
+	}
+
+	return ctx, nil
+}
+
+// newParallelTestApp returns a test app whose blocks can be executed in
+// parallel with the SetParallelTxWorkers option.
+func newParallelTestApp(t *testing.T, opts ...func(*baseapp.BaseApp)) *BaseAppSuite {
+	t.Helper()
+
+	suite := NewBaseAppSuite(t, append(opts, func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(parallelAnteHandler)
+		// accept the failing txs too
+		bapp.SetProcessProposal(baseapp.NoOpProcessProposal())
+	})...)
+	baseapptestutil.RegisterKeyValueServer(suite.baseApp.MsgServiceRouter(), MsgKeyValueImpl{})
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{
+			Block: &cmtproto.BlockParams{MaxGas: 100_000},
+		},
+	})
+
+	return suite
+}
+
+// newParallelTestTx returns the bytes of a tx with the provided memo, which
+// sets the behavior of the parallelAnteHandler, and msgs.
+func newParallelTestTx(t *testing.T, suite *BaseAppSuite, memo string, unordered bool, msgs ...sdk.Msg) []byte {
+	t.Helper()
+
+	builder := suite.txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(msgs...))
+	builder.SetMemo(memo)
+	builder.SetUnordered(unordered)
+	setTxSignature(t, builder, 0)
+
+	bz, err := suite.txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+
+	return bz
+}
+
+func keyValueMsg(key, value string) sdk.Msg {
+	return &baseapptestutil.MsgKeyValue{Key: []byte(key), Value: []byte(value)}
+}
+
+func TestParallelDeliverTx(t *testing.T) {
+	sequential := newParallelTestApp(t)
+	parallel := newParallelTestApp(t, baseapp.SetParallelTxWorkers(4))
+
+	newTx := func(memo string, unordered bool, msgs ...sdk.Msg) []byte {
+		return newParallelTestTx(t, sequential, memo, unordered, msgs...)
+	}
+	keyValue := keyValueMsg
+
+	blocks := [][][]byte{
+		{
+			newTx("", false, keyValue("a", "1")),
+			newTx("", false, keyValue("b", "1")),
+			newTx("counter", false, keyValue("c", "1")),
+			newTx("sum", false, keyValue("sum", "1")),
+			newTx("counter", false, keyValue("a", "2")),
+			newTx("", false, keyValue("a", "3"), keyValue("d", "1")),
+			newTx("fail", false, keyValue("e", "1")),
+			newTx("sum", false, keyValue("sum", "1")),
+			newTx("", false, &baseapptestutil.MsgKeyValue{Key: []byte("f")}),
+			newTx("counter", true, keyValue("g", "1")),
+			newTx("", false, keyValue("h", "1")),
+		},
+	}
+
+	// the last txs of a block run out of block gas
+	var txs [][]byte
+	for i := 0; i < 40; i++ {
+		memo := ""
+		if i%3 == 0 {
+			memo = "counter"
+		}
+		txs = append(txs, newTx(memo, false, keyValue(fmt.Sprintf("key%d", i), fmt.Sprint(i))))
+	}
+	blocks = append(blocks, txs)
+
+	for i, txs := range blocks {
+		height := int64(i + 1)
+		hash := []byte(fmt.Sprintf("block %d", height))
+
+		var commits [][]byte
+		var responses [][]abci.ResponseDeliverTx
+		for _, suite := range []*BaseAppSuite{sequential, parallel} {
+			app := suite.baseApp
+
+			res := app.ProcessProposal(abci.RequestProcessProposal{Txs: txs, Hash: hash, Height: height})
+			require.True(t, res.IsAccepted())
+
+			app.BeginBlock(abci.RequestBeginBlock{Header: cmtproto.Header{Height: height}, Hash: hash})
+
+			var blockResponses []abci.ResponseDeliverTx
+			for _, tx := range txs {
+				blockResponses = append(blockResponses, app.DeliverTx(abci.RequestDeliverTx{Tx: tx}))
+			}
+			app.EndBlock(abci.RequestEndBlock{Height: height})
+
+			responses = append(responses, blockResponses)
+			commits = append(commits, app.Commit().Data)
+		}
+
+		require.Equal(t, responses[0], responses[1])
+		require.Equal(t, commits[0], commits[1])
+	}
+
+	// the last txs of the second block must have run out of block gas
+	res := sequential.baseApp.Query(abci.RequestQuery{Path: fmt.Sprintf("/store/%s/key", capKey2.Name()), Data: []byte("key39")})
+	require.Nil(t, res.Value)
+}
+
+func TestParallelDeliverTx_ProposalMismatch(t *testing.T) {
+	sequential := newParallelTestApp(t)
+	parallel := newParallelTestApp(t, baseapp.SetParallelTxWorkers(4))
+
+	// the last txs of the block run out of block gas
+	var proposal [][]byte
+	for i := 0; i < 40; i++ {
+		proposal = append(proposal, newParallelTestTx(t, sequential, "", false, keyValueMsg(fmt.Sprintf("key%d", i), fmt.Sprint(i))))
+	}
+
+	// the delivered txs differ from the accepted proposal from the 21st one
+	delivered := append([][]byte{}, proposal[:20]...)
+	delivered = append(delivered, newParallelTestTx(t, sequential, "sum", false, keyValueMsg("sum", "1")))
+	delivered = append(delivered, proposal[21:]...)
+
+	hash := []byte("block 1")
+	var commits [][]byte
+	var responses [][]abci.ResponseDeliverTx
+	for _, suite := range []*BaseAppSuite{sequential, parallel} {
+		app := suite.baseApp
+
+		res := app.ProcessProposal(abci.RequestProcessProposal{Txs: proposal, Hash: hash, Height: 1})
+		require.True(t, res.IsAccepted())
+
+		app.BeginBlock(abci.RequestBeginBlock{Header: cmtproto.Header{Height: 1}, Hash: hash})
+
+		var blockResponses []abci.ResponseDeliverTx
+		for _, tx := range delivered {
+			blockResponses = append(blockResponses, app.DeliverTx(abci.RequestDeliverTx{Tx: tx}))
+		}
+		app.EndBlock(abci.RequestEndBlock{Height: 1})
+
+		responses = append(responses, blockResponses)
+		commits = append(commits, app.Commit().Data)
+	}
+
+	// the rest of the block is executed sequentially
+	require.Equal(t, responses[0], responses[1])
+	require.Equal(t, commits[0], commits[1])
+}
@@ -0,0 +1,135 @@
+package baseapp
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// mempoolRecheck is a recheck of the application side mempool running in the
+// background against the state of the last committed block.
+type mempoolRecheck struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// startMempoolRecheck starts rechecking the transactions of the mempool against
+// the state of the last committed block, with the given header. It must be
+// called once the block is committed.
+func (app *BaseApp) startMempoolRecheck(header cmtproto.Header) {
+	if app.recheckMaxTxs <= 0 {
+		return
+	}
+
+	if _, isNoOp := app.mempool.(mempool.NoOpMempool); app.mempool == nil || isNoOp {
+		return
+	}
+
+	if app.txEncoder == nil {
+		app.logger.Error("mempool recheck requires a tx encoder, skipping it")
+		return
+	}
+
+	// the recheck runs over its own branch of the committed state, so that it
+	// neither affects nor is affected by the check state
+	ms := app.cms.CacheMultiStore()
+	ctx := sdk.NewContext(ms, header, true, app.logger).
+		WithStoreGasConfigs(app.storeGasConfigs).
+		WithStoreGasMetrics(app.storeGasMetrics).
+		WithMinGasPrices(app.minGasPrices).
+		WithIsReCheckTx(true)
+	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
+
+	var (
+		goCtx  context.Context
+		cancel context.CancelFunc
+	)
+	if app.recheckTimeout > 0 {
+		goCtx, cancel = context.WithTimeout(context.Background(), app.recheckTimeout)
+	} else {
+		goCtx, cancel = context.WithCancel(context.Background())
+	}
+
+	recheck := &mempoolRecheck{cancel: cancel, done: make(chan struct{})}
+	app.mempoolRecheck = recheck
+
+	go func() {
+		defer close(recheck.done)
+		defer cancel()
+
+		app.recheckMempool(goCtx, ctx)
+	}()
+}
+
+// stopMempoolRecheck cancels the running recheck of the mempool, if any, and
+// waits for it to return. It must be called before the committed state is
+// modified.
+func (app *BaseApp) stopMempoolRecheck() {
+	if app.mempoolRecheck == nil {
+		return
+	}
+
+	app.mempoolRecheck.cancel()
+	<-app.mempoolRecheck.done
+	app.mempoolRecheck = nil
+}
+
+// recheckMempool runs the AnteHandler over the transactions of the mempool, in
+// the order they are selected for a proposal, and removes the ones failing.
+// At most recheckMaxTxs transactions are rechecked, until goCtx is done.
+func (app *BaseApp) recheckMempool(goCtx context.Context, ctx sdk.Context) {
+	defer telemetry.MeasureSince(time.Now(), "mempool", "recheck")
+
+	// snapshot the transactions, so that the mempool is not locked for the
+	// duration of the recheck
+	txs := make([]sdk.Tx, 0, app.recheckMaxTxs)
+	app.mempoolMtx.Lock()
+	for it := app.mempool.Select(goCtx, nil); it != nil && len(txs) < app.recheckMaxTxs; it = it.Next() {
+		txs = append(txs, it.Tx())
+	}
+	app.mempoolMtx.Unlock()
+
+	var checked, evicted int
+	defer func() {
+		telemetry.IncrCounter(float32(checked), "mempool", "recheck", "checked")
+		telemetry.IncrCounter(float32(evicted), "mempool", "recheck", "evicted")
+	}()
+
+	for _, tx := range txs {
+		if goCtx.Err() != nil {
+			app.logger.Debug("mempool recheck interrupted", "checked", checked, "evicted", evicted)
+			return
+		}
+
+		txBytes, err := app.txEncoder(tx)
+		if err != nil {
+			app.logger.Error("failed to encode mempool tx for recheck", "err", err)
+			continue
+		}
+
+		_, _, _, _, err = app.runTxWithContext(ctx.WithTxBytes(txBytes), runTxModeReCheck, txBytes, tx, mempool.NoOpMempool{})
+		checked++
+		if err == nil {
+			continue
+		}
+
+		app.mempoolMtx.Lock()
+		err = app.mempool.Remove(tx)
+		app.mempoolMtx.Unlock()
+
+		switch {
+		case err == nil:
+			evicted++
+		case !errors.Is(err, mempool.ErrTxNotFound):
+			app.logger.Error("failed to remove invalid tx from mempool", "err", err)
+		}
+	}
+
+	app.logger.Debug("mempool rechecked", "checked", checked, "evicted", evicted)
+}
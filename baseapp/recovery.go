@@ -66,6 +66,22 @@ func newOutOfGasRecoveryMiddleware(gasWanted uint64, ctx sdk.Context, next recov
 	return newRecoveryMiddleware(handler, next)
 }
 
+// newIterBytesLimitRecoveryMiddleware creates a recovery middleware for app.runTx
+// method, recovering from a transaction reading too many bytes through store
+// iterators.
+func newIterBytesLimitRecoveryMiddleware(next recoveryMiddleware) recoveryMiddleware {
+	handler := func(recoveryObj interface{}) error {
+		err, ok := recoveryObj.(storetypes.ErrorIterBytesLimit)
+		if !ok {
+			return nil
+		}
+
+		return errorsmod.Wrapf(sdkerrors.ErrIterBytesLimit, "read more than %d bytes through store iterators", err.Limit)
+	}
+
+	return newRecoveryMiddleware(handler, next)
+}
+
 // newDefaultRecoveryMiddleware creates a default (last in chain) recovery middleware for app.runTx method.
 func newDefaultRecoveryMiddleware() recoveryMiddleware {
 	handler := func(recoveryObj interface{}) error {
@@ -0,0 +1,56 @@
+package baseapp
+
+import (
+	"fmt"
+
+	"cosmossdk.io/store/statediff"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// StateDiff returns the store of the change sets of the committed blocks, nil
+// if the state diff is not enabled.
+func (app *BaseApp) StateDiff() *statediff.Store {
+	return app.stateDiff
+}
+
+// listenStateDiff listens to the changes of all the stores whose changes are
+// saved to the state diff. The stores which are not streamed are recorded, so
+// that their changes are not sent to the ABCI listeners.
+func (app *BaseApp) listenStateDiff() {
+	if app.stateDiff == nil {
+		return
+	}
+
+	app.stateDiffOnlyKeys = make(map[string]struct{})
+	var keys []storetypes.StoreKey
+	for _, key := range app.kvStoreKeys {
+		if !app.cms.ListeningEnabled(key) {
+			app.stateDiffOnlyKeys[key.Name()] = struct{}{}
+			keys = append(keys, key)
+		}
+	}
+	app.cms.AddListeners(keys)
+}
+
+// saveStateDiff saves the change set of the block to the state diff, and returns
+// the changes which are streamed to the ABCI listeners, even if the change set
+// failed to be saved.
+func (app *BaseApp) saveStateDiff(height int64, changeSet []*storetypes.StoreKVPair) ([]*storetypes.StoreKVPair, error) {
+	var err error
+	if saveErr := app.stateDiff.Save(height, changeSet); saveErr != nil {
+		err = fmt.Errorf("failed to save the state diff at height %d: %w", height, saveErr)
+	}
+
+	if len(app.stateDiffOnlyKeys) == 0 {
+		return changeSet, err
+	}
+
+	streamed := make([]*storetypes.StoreKVPair, 0, len(changeSet))
+	for _, pair := range changeSet {
+		if _, ok := app.stateDiffOnlyKeys[pair.StoreKey]; !ok {
+			streamed = append(streamed, pair)
+		}
+	}
+
+	return streamed, err
+}
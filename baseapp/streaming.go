@@ -2,10 +2,13 @@ package baseapp
 
 import (
 	"fmt"
+	"path/filepath"
 	"sort"
 	"strings"
+	"time"
 
 	"cosmossdk.io/store/streaming"
+	"cosmossdk.io/store/streaming/file"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/spf13/cast"
 
@@ -19,6 +22,14 @@ const (
 	StreamingABCIPluginTomlKey        = "plugin"
 	StreamingABCIKeysTomlKey          = "keys"
 	StreamingABCIStopNodeOnErrTomlKey = "stop-node-on-err"
+
+	StreamingFileTomlKey            = "file"
+	StreamingFileDirTomlKey         = "dir"
+	StreamingFileMaxFileSizeTomlKey = "max-file-size"
+	StreamingFileFsyncTomlKey       = "fsync"
+	StreamingFileBufferSizeTomlKey  = "buffer-size"
+	StreamingFileAckTomlKey         = "ack"
+	StreamingFileAckTimeoutTomlKey  = "ack-timeout"
 )
 
 // RegisterStreamingServices registers streaming services with the BaseApp.
@@ -40,9 +51,46 @@ func (app *BaseApp) RegisterStreamingServices(appOpts servertypes.AppOptions, ke
 		}
 	}
 
+	// register the file streaming service
+	dirKey := fmt.Sprintf("%s.%s.%s", StreamingTomlKey, StreamingFileTomlKey, StreamingFileDirTomlKey)
+	if dir := strings.TrimSpace(cast.ToString(appOpts.Get(dirKey))); len(dir) > 0 {
+		listener, err := newFileListener(appOpts, dir)
+		if err != nil {
+			return fmt.Errorf("failed to create file streaming service: %w", err)
+		}
+		app.RegisterStreamingListener(appOpts, keys, listener)
+	}
+
 	return nil
 }
 
+// newFileListener creates the listener of the file streaming service, a relative
+// directory is relative to the home directory of the node.
+func newFileListener(appOpts servertypes.AppOptions, dir string) (*streaming.Listener, error) {
+	fileKey := func(key string) string {
+		return fmt.Sprintf("%s.%s.%s", StreamingTomlKey, StreamingFileTomlKey, key)
+	}
+
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), dir)
+	}
+
+	maxFileSize := cast.ToInt64(appOpts.Get(fileKey(StreamingFileMaxFileSizeTomlKey)))
+	fsync := cast.ToBool(appOpts.Get(fileKey(StreamingFileFsyncTomlKey)))
+	sink, err := file.NewSink(dir, maxFileSize, fsync)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := streaming.ListenerOptions{
+		BufferSize: cast.ToInt(appOpts.Get(fileKey(StreamingFileBufferSizeTomlKey))),
+		Ack:        cast.ToBool(appOpts.Get(fileKey(StreamingFileAckTomlKey))),
+		AckTimeout: time.Duration(cast.ToUint(appOpts.Get(fileKey(StreamingFileAckTimeoutTomlKey)))) * time.Second,
+	}
+
+	return streaming.NewListener(sink, opts), nil
+}
+
 // registerStreamingPlugin registers streaming plugins with the BaseApp.
 func (app *BaseApp) registerStreamingPlugin(
 	appOpts servertypes.AppOptions,
@@ -54,12 +102,17 @@ func (app *BaseApp) registerStreamingPlugin(
 		return fmt.Errorf("unexpected plugin type %T", v)
 	}
 
-	app.registerABCIListenerPlugin(appOpts, keys, v)
+	app.RegisterStreamingListener(appOpts, keys, v)
 	return nil
 }
 
-// registerABCIListenerPlugin registers plugins that implement the ABCIListener interface.
-func (app *BaseApp) registerABCIListenerPlugin(
+// RegisterStreamingListener registers an ABCIListener with the BaseApp, in
+// addition to the listeners already registered. The state changes of the stores
+// set in the streaming.abci.keys configuration are streamed to the listeners.
+//
+// It is used to register the listeners which cannot be created from the
+// configuration, like a streaming.Listener writing to a kafka.Sink.
+func (app *BaseApp) RegisterStreamingListener(
 	appOpts servertypes.AppOptions,
 	keys map[string]*storetypes.KVStoreKey,
 	abciListener storetypes.ABCIListener,
@@ -72,12 +125,24 @@ func (app *BaseApp) registerABCIListenerPlugin(
 	app.cms.AddListeners(exposedKeys)
 	app.SetStreamingManager(
 		storetypes.StreamingManager{
-			ABCIListeners: []storetypes.ABCIListener{abciListener},
+			ABCIListeners: append(app.streamingManager.ABCIListeners, abciListener),
 			StopNodeOnErr: stopNodeOnErr,
 		},
 	)
 }
 
+// handleListenerError logs the error of a streaming hook. The block goes on
+// unless the streaming services are configured to stop the node on errors: the
+// node is then halted before the block is committed, so that no block is
+// missing from the streamed data. The ABCI methods cannot return errors, hence
+// the node is halted with a panic.
+func (app *BaseApp) handleListenerError(hook string, height int64, err error) {
+	app.logger.Error(hook+" listening hook failed", "height", height, "err", err)
+	if app.streamingManager.StopNodeOnErr {
+		panic(fmt.Errorf("%s listening hook failed at height %d: %w", hook, height, err))
+	}
+}
+
 func exposeAll(list []string) bool {
 	for _, ele := range list {
 		if ele == "*" {
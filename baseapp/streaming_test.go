@@ -2,16 +2,24 @@ package baseapp_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
 	"testing"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/store/statediff"
+	"cosmossdk.io/store/streaming/file"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -145,3 +153,223 @@ func Test_Ctx_with_StreamingManager(t *testing.T) {
 		suite.baseApp.Commit()
 	}
 }
+
+func TestABCI_FileStreaming(t *testing.T) {
+	dir := t.TempDir()
+	appOpts := simtestutil.AppOptionsMap{
+		"streaming.abci.keys":             []string{distKey1.Name()},
+		"streaming.abci.stop-node-on-err": true,
+		"streaming.file.dir":              dir,
+		"streaming.file.ack":              true,
+	}
+	streamingOpt := func(bapp *baseapp.BaseApp) {
+		bapp.MountStores(distKey1)
+		keys := map[string]*storetypes.KVStoreKey{distKey1.Name(): distKey1}
+		require.NoError(t, bapp.RegisterStreamingServices(appOpts, keys))
+	}
+	suite := NewBaseAppSuite(t, streamingOpt)
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &tmproto.ConsensusParams{},
+	})
+
+	for height := int64(1); height <= 2; height++ {
+		suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+		getDeliverStateCtx(suite.baseApp).KVStore(distKey1).Set([]byte("key"), []byte{byte(height)})
+		suite.baseApp.EndBlock(abci.RequestEndBlock{Height: height})
+		suite.baseApp.Commit()
+	}
+
+	// the blocks are written when the Commit returns in ack mode
+	f, err := os.Open(filepath.Join(dir, file.FileName(1)))
+	require.NoError(t, err)
+	defer f.Close()
+
+	r := file.NewReader(f)
+	for height := int64(1); height <= 2; height++ {
+		block, err := r.Next()
+		require.NoError(t, err)
+		require.Equal(t, height, block.BlockHeight)
+		require.Equal(t, height, block.Metadata.RequestEndBlock.Height)
+		require.Equal(t, []*storetypes.StoreKVPair{{
+			StoreKey: distKey1.Name(),
+			Key:      []byte("key"),
+			Value:    []byte{byte(height)},
+		}}, block.ChangeSet)
+	}
+	_, err = r.Next()
+	require.Equal(t, io.EOF, err)
+}
+
+type failingABCIListener struct {
+	MockABCIListener
+}
+
+func (failingABCIListener) ListenCommit(ctx context.Context, res abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	return errors.New("sink is behind")
+}
+
+func TestABCI_StopNodeOnErr(t *testing.T) {
+	for _, stopNodeOnErr := range []bool{false, true} {
+		streamingManager := storetypes.StreamingManager{
+			ABCIListeners: []storetypes.ABCIListener{failingABCIListener{}},
+			StopNodeOnErr: stopNodeOnErr,
+		}
+		streamingManagerOpt := func(bapp *baseapp.BaseApp) { bapp.SetStreamingManager(streamingManager) }
+		suite := NewBaseAppSuite(t, streamingManagerOpt)
+
+		suite.baseApp.InitChain(abci.RequestInitChain{
+			ConsensusParams: &tmproto.ConsensusParams{},
+		})
+		suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+		suite.baseApp.EndBlock(abci.RequestEndBlock{})
+
+		if stopNodeOnErr {
+			require.PanicsWithError(t, "Commit listening hook failed at height 1: sink is behind", func() { suite.baseApp.Commit() })
+		} else {
+			require.NotPanics(t, func() { suite.baseApp.Commit() })
+		}
+	}
+}
+
+type deliverTxABCIListener struct {
+	MockABCIListener
+	responses []abci.ResponseDeliverTx
+}
+
+func (l *deliverTxABCIListener) ListenDeliverTx(ctx context.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	l.responses = append(l.responses, res)
+	return nil
+}
+
+func TestABCI_ListenDeliverTx(t *testing.T) {
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+	listener := &deliverTxABCIListener{}
+	streamingManager := storetypes.StreamingManager{ABCIListeners: []storetypes.ABCIListener{listener}}
+	streamingManagerOpt := func(bapp *baseapp.BaseApp) { bapp.SetStreamingManager(streamingManager) }
+	suite := NewBaseAppSuite(t, anteOpt, streamingManagerOpt)
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &tmproto.ConsensusParams{},
+	})
+
+	deliverKey := []byte("deliver-key")
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+
+	suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+
+	tx := newTxCounter(t, suite.txConfig, 0, 0)
+	txBytes, err := suite.txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	res := suite.baseApp.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+
+	// the listener receives the response of the tx
+	require.Equal(t, []abci.ResponseDeliverTx{res}, listener.responses)
+	require.NotEmpty(t, listener.responses[0].Events)
+}
+
+type closingABCIListener struct {
+	MockABCIListener
+	closed   bool
+	closeErr error
+}
+
+func (l *closingABCIListener) Close() error {
+	l.closed = true
+	return l.closeErr
+}
+
+func TestBaseApp_CloseStreamingListeners(t *testing.T) {
+	listener1 := &closingABCIListener{closeErr: errors.New("sink is closed")}
+	listener2 := &closingABCIListener{}
+	streamingManager := storetypes.StreamingManager{
+		ABCIListeners: []storetypes.ABCIListener{listener1, failingABCIListener{}, listener2},
+	}
+	streamingManagerOpt := func(bapp *baseapp.BaseApp) { bapp.SetStreamingManager(streamingManager) }
+	suite := NewBaseAppSuite(t, streamingManagerOpt)
+
+	// every listener is closed even if one of them fails
+	require.EqualError(t, suite.baseApp.Close(), "sink is closed")
+	require.True(t, listener1.closed)
+	require.True(t, listener2.closed)
+}
+
+func TestABCI_StateDiff(t *testing.T) {
+	stateDiff := statediff.NewStore(dbm.NewMemDB(), 0)
+	mockListener := NewMockABCIListener("lis_1")
+	streamingManager := storetypes.StreamingManager{ABCIListeners: []storetypes.ABCIListener{&mockListener}}
+	opts := func(bapp *baseapp.BaseApp) {
+		bapp.MountStores(distKey1)
+		bapp.SetStreamingManager(streamingManager)
+		bapp.CommitMultiStore().AddListeners([]storetypes.StoreKey{distKey1})
+	}
+	suite := NewBaseAppSuite(t, baseapp.SetStateDiff(stateDiff), opts)
+	require.Equal(t, stateDiff, suite.baseApp.StateDiff())
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &tmproto.ConsensusParams{},
+	})
+	suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	ctx := getDeliverStateCtx(suite.baseApp)
+	ctx.KVStore(distKey1).Set([]byte("dist"), []byte("1"))
+	ctx.KVStore(capKey1).Set([]byte("cap"), []byte("2"))
+	suite.baseApp.EndBlock(abci.RequestEndBlock{})
+	suite.baseApp.Commit()
+
+	// the changes of all the stores are saved to the state diff
+	changeSet, err := stateDiff.GetChangeSet(1, "")
+	require.NoError(t, err)
+	require.Equal(t, []*storetypes.StoreKVPair{
+		{StoreKey: capKey1.Name(), Key: []byte("cap"), Value: []byte("2")},
+		{StoreKey: distKey1.Name(), Key: []byte("dist"), Value: []byte("1")},
+	}, changeSet)
+
+	// only the changes of the streamed stores are sent to the listeners
+	require.Equal(t, []*storetypes.StoreKVPair{
+		{StoreKey: distKey1.Name(), Key: []byte("dist"), Value: []byte("1")},
+	}, mockListener.ChangeSet)
+}
+
+// failingDB is a database failing to read.
+type failingDB struct {
+	dbm.DB
+}
+
+func (failingDB) Get([]byte) ([]byte, error) {
+	return nil, errors.New("read failed")
+}
+
+func TestABCI_StateDiffSaveError(t *testing.T) {
+	for _, stopNodeOnErr := range []bool{false, true} {
+		mockListener := NewMockABCIListener("lis_1")
+		streamingManager := storetypes.StreamingManager{
+			ABCIListeners: []storetypes.ABCIListener{&mockListener},
+			StopNodeOnErr: stopNodeOnErr,
+		}
+		opts := func(bapp *baseapp.BaseApp) {
+			bapp.SetStreamingManager(streamingManager)
+			bapp.CommitMultiStore().AddListeners([]storetypes.StoreKey{capKey1})
+		}
+		suite := NewBaseAppSuite(t, baseapp.SetStateDiff(statediff.NewStore(failingDB{dbm.NewMemDB()}, 0)), opts)
+
+		suite.baseApp.InitChain(abci.RequestInitChain{
+			ConsensusParams: &tmproto.ConsensusParams{},
+		})
+		suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+		getDeliverStateCtx(suite.baseApp).KVStore(capKey1).Set([]byte("cap"), []byte("1"))
+		suite.baseApp.EndBlock(abci.RequestEndBlock{})
+
+		// the failure follows the stop-node policy of the streaming services
+		if stopNodeOnErr {
+			require.PanicsWithError(t, "StateDiff listening hook failed at height 1: failed to save the state diff at height 1: read failed", func() { suite.baseApp.Commit() })
+		} else {
+			require.NotPanics(t, func() { suite.baseApp.Commit() })
+			require.Equal(t, []*storetypes.StoreKVPair{
+				{StoreKey: capKey1.Name(), Key: []byte("cap"), Value: []byte("1")},
+			}, mockListener.ChangeSet)
+		}
+	}
+}
@@ -0,0 +1,232 @@
+package debug
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+const (
+	flagHeight       = "height"
+	flagAppDBBackend = "app-db-backend"
+)
+
+// StateCmd returns the command to inspect the collections state of the modules.
+// The schemas are indexed by module name, see module.Manager.CollectionsSchemas, the
+// store of a module being expected to be named after it.
+func StateCmd(schemas map[string]collections.Schema) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state",
+		Short: "Inspect the collections state of the modules",
+		RunE:  client.ValidateCmd,
+	}
+
+	cmd.AddCommand(StateSchemaCmd(schemas))
+	cmd.AddCommand(StateDumpCmd(schemas))
+
+	return cmd
+}
+
+// collectionInfo is the JSON description of a collection.
+type collectionInfo struct {
+	Name      string `json:"name"`
+	Prefix    string `json:"prefix"`
+	KeyType   string `json:"key_type"`
+	ValueType string `json:"value_type"`
+}
+
+// StateSchemaCmd returns the command to list the collections of a store.
+func StateSchemaCmd(schemas map[string]collections.Schema) *cobra.Command {
+	return &cobra.Command{
+		Use:   "schema [store]",
+		Short: "List the collections of a store, or of all the stores",
+		Long: `List the collections of a store, or of all the stores, with their name, hex prefix, key and value types.
+
+Example:
+$ simd debug state schema bank
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			stores := make(map[string][]collectionInfo)
+			for name, schema := range schemas {
+				if len(args) == 1 && args[0] != name {
+					continue
+				}
+				infos := []collectionInfo{}
+				for _, coll := range schema.ListCollections() {
+					infos = append(infos, collectionInfo{
+						Name:      coll.GetName(),
+						Prefix:    hex.EncodeToString(coll.GetPrefix()),
+						KeyType:   coll.KeyType(),
+						ValueType: coll.ValueType(),
+					})
+				}
+				stores[name] = infos
+			}
+
+			if len(args) == 1 {
+				infos, ok := stores[args[0]]
+				if !ok {
+					return fmt.Errorf("no collections schema for store %s", args[0])
+				}
+				return printJSON(cmd, infos)
+			}
+			return printJSON(cmd, stores)
+		},
+	}
+}
+
+// stateEntry is the JSON description of a store entry. The key and value of the entries
+// which do not belong to a collection, or which cannot be decoded, are hex encoded.
+type stateEntry struct {
+	Collection string          `json:"collection,omitempty"`
+	Key        json.RawMessage `json:"key,omitempty"`
+	Value      json.RawMessage `json:"value,omitempty"`
+	RawKey     string          `json:"raw_key,omitempty"`
+	RawValue   string          `json:"raw_value,omitempty"`
+	Error      string          `json:"error,omitempty"`
+}
+
+// StateDumpCmd returns the command to dump the decoded entries of a store.
+func StateDumpCmd(schemas map[string]collections.Schema) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dump [store] [collection]",
+		Short: "Dump the decoded entries of a store, or of one of its collections",
+		Long: `Dump the decoded entries of a store, or of one of its collections, as JSON lines.
+The application database is read from the home directory, at the latest height or at the given height.
+The node must be stopped, unless the database backend supports concurrent readers.
+
+Example:
+$ simd debug state dump bank Balances --height 100
+`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			storeName := args[0]
+			schema, ok := schemas[storeName]
+			if !ok {
+				return fmt.Errorf("no collections schema for store %s", storeName)
+			}
+
+			var start, end []byte
+			if len(args) == 2 {
+				coll, err := schema.CollectionByName(args[1])
+				if err != nil {
+					return err
+				}
+				start = coll.GetPrefix()
+				end = storetypes.PrefixEndBytes(start)
+			}
+
+			height, err := cmd.Flags().GetInt64(flagHeight)
+			if err != nil {
+				return err
+			}
+			backend, err := cmd.Flags().GetString(flagAppDBBackend)
+			if err != nil {
+				return err
+			}
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			db, err := openReadOnlyDB(clientCtx.HomeDir, dbm.BackendType(backend))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			store, err := loadStore(db, storeName, height)
+			if err != nil {
+				return err
+			}
+
+			iter := store.Iterator(start, end)
+			defer iter.Close()
+
+			enc := json.NewEncoder(cmd.OutOrStdout())
+			for ; iter.Valid(); iter.Next() {
+				if err := enc.Encode(decodeEntry(schema, iter.Key(), iter.Value())); err != nil {
+					return err
+				}
+			}
+			return iter.Error()
+		},
+	}
+
+	cmd.Flags().Int64(flagHeight, 0, "The height of the state, defaults to the latest height")
+	cmd.Flags().String(flagAppDBBackend, string(dbm.GoLevelDBBackend), "The type of database of the application")
+
+	return cmd
+}
+
+// decodeEntry decodes a store entry with the collection owning its key.
+func decodeEntry(schema collections.Schema, key, value []byte) stateEntry {
+	coll, err := schema.CollectionByKey(key)
+	if err != nil {
+		return stateEntry{RawKey: hex.EncodeToString(key), RawValue: hex.EncodeToString(value), Error: err.Error()}
+	}
+
+	keyJSON, valueJSON, err := coll.DecodeRawJSON(key[len(coll.GetPrefix()):], value)
+	if err != nil {
+		return stateEntry{
+			Collection: coll.GetName(),
+			RawKey:     hex.EncodeToString(key),
+			RawValue:   hex.EncodeToString(value),
+			Error:      err.Error(),
+		}
+	}
+
+	return stateEntry{Collection: coll.GetName(), Key: keyJSON, Value: valueJSON}
+}
+
+// openReadOnlyDB opens the application database of the home directory. Only goleveldb
+// supports a read-only mode, the other backends are opened as is but never written.
+func openReadOnlyDB(home string, backend dbm.BackendType) (dbm.DB, error) {
+	dataDir := filepath.Join(home, "data")
+	if backend == dbm.GoLevelDBBackend {
+		return dbm.NewGoLevelDBWithOpts("application", dataDir, &opt.Options{ReadOnly: true})
+	}
+	return dbm.NewDB("application", backend, dataDir)
+}
+
+// loadStore loads the store with the given name at the given height, the latest height
+// being used when height is 0. Only this store is mounted so the others are not loaded.
+func loadStore(db dbm.DB, storeName string, height int64) (storetypes.KVStore, error) {
+	if height == 0 {
+		height = rootmulti.GetLatestVersion(db)
+	}
+	if height == 0 {
+		return nil, fmt.Errorf("no state committed in the application database")
+	}
+
+	key := storetypes.NewKVStoreKey(storeName)
+	rs := rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	rs.SetLazyLoading(true)
+	rs.SetIAVLDisableFastNode(true)
+	rs.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	if err := rs.LoadVersion(height); err != nil {
+		return nil, fmt.Errorf("failed to load store %s at height %d: %w", storeName, height, err)
+	}
+
+	return rs.GetKVStore(key), nil
+}
+
+func printJSON(cmd *cobra.Command, v any) error {
+	bz, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+	return err
+}
@@ -0,0 +1,93 @@
+package debug_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/debug"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestStateCmd(t *testing.T) {
+	home := t.TempDir()
+	key := storetypes.NewKVStoreKey("test")
+
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(key))
+	params := collections.NewItem(sb, collections.NewPrefix(0), "params", collections.Uint64Value)
+	balances := collections.NewMap(sb, collections.NewPrefix(1), "balances", collections.StringKey, collections.Uint64Value)
+	schema, err := sb.Build()
+	require.NoError(t, err)
+	schemas := map[string]collections.Schema{"test": schema}
+
+	// commit two heights of state
+	db, err := dbm.NewGoLevelDB("application", filepath.Join(home, "data"), nil)
+	require.NoError(t, err)
+	rs := rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	rs.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, rs.LoadLatestVersion())
+
+	ctx := sdk.NewContext(rs, cmtproto.Header{}, false, log.NewNopLogger())
+	require.NoError(t, params.Set(ctx, 1))
+	require.NoError(t, balances.Set(ctx, "alice", 10))
+	rs.Commit()
+	require.NoError(t, balances.Set(ctx, "bob", 20))
+	ctx.KVStore(key).Set([]byte{0xff}, []byte{0x01})
+	rs.Commit()
+	require.NoError(t, db.Close())
+
+	run := func(args ...string) (string, error) {
+		cmd := debug.StateCmd(schemas)
+		out := new(bytes.Buffer)
+		cmd.SetOut(out)
+		cmd.SetErr(new(bytes.Buffer))
+		cmd.SetArgs(args)
+		err := cmd.ExecuteContext(context.WithValue(context.Background(), client.ClientContextKey, &client.Context{HomeDir: home}))
+		return out.String(), err
+	}
+
+	out, err := run("schema", "test")
+	require.NoError(t, err)
+	var infos []map[string]string
+	require.NoError(t, json.Unmarshal([]byte(out), &infos))
+	require.Len(t, infos, 2)
+	require.Equal(t, map[string]string{"name": "balances", "prefix": "01", "key_type": "string", "value_type": "uint64"}, infos[0])
+
+	_, err = run("schema", "unknown")
+	require.Error(t, err)
+
+	// latest height
+	out, err = run("dump", "test")
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		`{"collection":"params","key":"item","value":"1"}`,
+		`{"collection":"balances","key":"alice","value":"10"}`,
+		`{"collection":"balances","key":"bob","value":"20"}`,
+		`{"raw_key":"ff","raw_value":"01","error":"collections: not found: no collection for key 0xff"}`,
+	}, strings.Split(strings.TrimSpace(out), "\n"))
+
+	// single collection at a previous height
+	out, err = run("dump", "test", "balances", "--height", "1")
+	require.NoError(t, err)
+	require.Equal(t, `{"collection":"balances","key":"alice","value":"10"}`, strings.TrimSpace(out))
+
+	_, err = run("dump", "test", "unknown")
+	require.Error(t, err)
+	_, err = run("dump", "test", "--height", "3")
+	require.Error(t, err)
+}
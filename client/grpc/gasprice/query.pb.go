@@ -0,0 +1,1289 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/base/gasprice/v1beta1/query.proto
+
+package gasprice
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GasPricesRequest is the request type for the Query/GasPrices RPC method.
+type GasPricesRequest struct {
+	// blocks restricts the gas prices to the last blocks, the gas prices of all the blocks recorded by
+	// the node are used if it is 0.
+	Blocks uint64 `protobuf:"varint,1,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// percentiles are the percentiles of the gas prices to compute, between 0 and 100. The 25th, 50th
+	// and 75th percentiles are computed if it is empty.
+	Percentiles []uint32 `protobuf:"varint,2,rep,packed,name=percentiles,proto3" json:"percentiles,omitempty"`
+}
+
+func (m *GasPricesRequest) Reset()         { *m = GasPricesRequest{} }
+func (m *GasPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*GasPricesRequest) ProtoMessage()    {}
+func (*GasPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68398c13e5e5bb54, []int{0}
+}
+func (m *GasPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPricesRequest.Merge(m, src)
+}
+func (m *GasPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPricesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasPricesRequest proto.InternalMessageInfo
+
+func (m *GasPricesRequest) GetBlocks() uint64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+func (m *GasPricesRequest) GetPercentiles() []uint32 {
+	if m != nil {
+		return m.Percentiles
+	}
+	return nil
+}
+
+// GasPricesResponse is the response type for the Query/GasPrices RPC method.
+type GasPricesResponse struct {
+	// minimum_gas_prices are the minimum gas prices accepted by the node.
+	MinimumGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=minimum_gas_prices,json=minimumGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"minimum_gas_prices"`
+	// gas_prices are the gas prices paid in the recent blocks, by denom, ordered by denom.
+	GasPrices []*DenomGasPrices `protobuf:"bytes,2,rep,name=gas_prices,json=gasPrices,proto3" json:"gas_prices,omitempty"`
+	// blocks is the number of blocks the gas prices were paid in.
+	Blocks uint64 `protobuf:"varint,3,opt,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (m *GasPricesResponse) Reset()         { *m = GasPricesResponse{} }
+func (m *GasPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*GasPricesResponse) ProtoMessage()    {}
+func (*GasPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68398c13e5e5bb54, []int{1}
+}
+func (m *GasPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPricesResponse.Merge(m, src)
+}
+func (m *GasPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPricesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasPricesResponse proto.InternalMessageInfo
+
+func (m *GasPricesResponse) GetMinimumGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.MinimumGasPrices
+	}
+	return nil
+}
+
+func (m *GasPricesResponse) GetGasPrices() []*DenomGasPrices {
+	if m != nil {
+		return m.GasPrices
+	}
+	return nil
+}
+
+func (m *GasPricesResponse) GetBlocks() uint64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+// DenomGasPrices are the gas prices paid in a denom in the recent blocks. The gas price paid by a
+// transaction is its fee divided by its gas limit.
+type DenomGasPrices struct {
+	// denom is the denom of the gas prices.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// tx_count is the number of successful transactions which paid a fee in the denom.
+	TxCount uint64 `protobuf:"varint,2,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	// percentiles are the requested percentiles of the gas prices.
+	Percentiles []*GasPricePercentile `protobuf:"bytes,3,rep,name=percentiles,proto3" json:"percentiles,omitempty"`
+}
+
+func (m *DenomGasPrices) Reset()         { *m = DenomGasPrices{} }
+func (m *DenomGasPrices) String() string { return proto.CompactTextString(m) }
+func (*DenomGasPrices) ProtoMessage()    {}
+func (*DenomGasPrices) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68398c13e5e5bb54, []int{2}
+}
+func (m *DenomGasPrices) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomGasPrices) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomGasPrices.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomGasPrices) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomGasPrices.Merge(m, src)
+}
+func (m *DenomGasPrices) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomGasPrices) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomGasPrices.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomGasPrices proto.InternalMessageInfo
+
+func (m *DenomGasPrices) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomGasPrices) GetTxCount() uint64 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *DenomGasPrices) GetPercentiles() []*GasPricePercentile {
+	if m != nil {
+		return m.Percentiles
+	}
+	return nil
+}
+
+// GasPricePercentile is a percentile of gas prices.
+type GasPricePercentile struct {
+	// percentile is the percentile, between 0 and 100.
+	Percentile uint32 `protobuf:"varint,1,opt,name=percentile,proto3" json:"percentile,omitempty"`
+	// gas_price is the gas price of the percentile.
+	GasPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=gas_price,json=gasPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"gas_price"`
+}
+
+func (m *GasPricePercentile) Reset()         { *m = GasPricePercentile{} }
+func (m *GasPricePercentile) String() string { return proto.CompactTextString(m) }
+func (*GasPricePercentile) ProtoMessage()    {}
+func (*GasPricePercentile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68398c13e5e5bb54, []int{3}
+}
+func (m *GasPricePercentile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPricePercentile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPricePercentile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasPricePercentile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPricePercentile.Merge(m, src)
+}
+func (m *GasPricePercentile) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPricePercentile) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPricePercentile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasPricePercentile proto.InternalMessageInfo
+
+func (m *GasPricePercentile) GetPercentile() uint32 {
+	if m != nil {
+		return m.Percentile
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GasPricesRequest)(nil), "cosmos.base.gasprice.v1beta1.GasPricesRequest")
+	proto.RegisterType((*GasPricesResponse)(nil), "cosmos.base.gasprice.v1beta1.GasPricesResponse")
+	proto.RegisterType((*DenomGasPrices)(nil), "cosmos.base.gasprice.v1beta1.DenomGasPrices")
+	proto.RegisterType((*GasPricePercentile)(nil), "cosmos.base.gasprice.v1beta1.GasPricePercentile")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/base/gasprice/v1beta1/query.proto", fileDescriptor_68398c13e5e5bb54)
+}
+
+var fileDescriptor_68398c13e5e5bb54 = []byte{
+	// 520 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4f, 0x6f, 0xd3, 0x30,
+	0x1c, 0xad, 0x5b, 0xd8, 0x16, 0x4f, 0x43, 0xc3, 0x9a, 0x50, 0x57, 0x55, 0x69, 0xd5, 0x03, 0x8a,
+	0x80, 0xd9, 0xeb, 0x76, 0xe5, 0xd4, 0x55, 0xe2, 0x30, 0x0e, 0x53, 0x38, 0xc1, 0xa5, 0x4a, 0x3c,
+	0x2b, 0x58, 0x6b, 0xec, 0x2c, 0x76, 0xa6, 0xed, 0x84, 0xc4, 0x17, 0x00, 0x09, 0x71, 0xe5, 0x03,
+	0xec, 0xcc, 0x87, 0xd8, 0x71, 0x82, 0x0b, 0xe2, 0x30, 0x50, 0xcb, 0x97, 0xe0, 0x86, 0x92, 0x38,
+	0x7f, 0x2a, 0xa4, 0xaa, 0xa7, 0xf6, 0xe7, 0xdf, 0xfb, 0xbd, 0xf7, 0xf2, 0x7e, 0x36, 0x74, 0xa8,
+	0x54, 0xa1, 0x54, 0xc4, 0xf7, 0x14, 0x23, 0x81, 0xa7, 0xa2, 0x98, 0x53, 0x46, 0x2e, 0x86, 0x3e,
+	0xd3, 0xde, 0x90, 0x9c, 0x27, 0x2c, 0xbe, 0xc2, 0x51, 0x2c, 0xb5, 0x44, 0xdd, 0x1c, 0x89, 0x53,
+	0x24, 0x2e, 0x90, 0xd8, 0x20, 0x3b, 0x3b, 0x81, 0x0c, 0x64, 0x06, 0x24, 0xe9, 0xbf, 0x7c, 0xa6,
+	0xd3, 0x0d, 0xa4, 0x0c, 0xa6, 0x8c, 0x78, 0x11, 0x27, 0x9e, 0x10, 0x52, 0x7b, 0x9a, 0x4b, 0xa1,
+	0x4c, 0xd7, 0xae, 0x6b, 0x17, 0x92, 0x54, 0x72, 0x61, 0xfa, 0xbb, 0x79, 0x7f, 0x92, 0xd3, 0x1a,
+	0xf9, 0xac, 0x18, 0xbc, 0x84, 0xdb, 0x2f, 0x3c, 0x75, 0x92, 0x5a, 0x50, 0x2e, 0x3b, 0x4f, 0x98,
+	0xd2, 0xe8, 0x11, 0x5c, 0xf3, 0xa7, 0x92, 0x9e, 0xa9, 0x36, 0xe8, 0x03, 0xe7, 0x9e, 0x6b, 0x2a,
+	0xd4, 0x87, 0x9b, 0x11, 0x8b, 0x29, 0x13, 0x9a, 0x4f, 0x99, 0x6a, 0x37, 0xfb, 0x2d, 0x67, 0xcb,
+	0xad, 0x1f, 0x0d, 0xfe, 0x02, 0xf8, 0xb0, 0x46, 0xa7, 0x22, 0x29, 0x14, 0x43, 0xef, 0x20, 0x0a,
+	0xb9, 0xe0, 0x61, 0x12, 0x4e, 0x02, 0x2f, 0x75, 0x91, 0x76, 0xdb, 0xa0, 0xdf, 0x72, 0x36, 0x0f,
+	0xba, 0xb8, 0x9e, 0x86, 0xf1, 0x8e, 0xc7, 0x8c, 0x1e, 0x49, 0x2e, 0x46, 0x87, 0x37, 0x77, 0xbd,
+	0xc6, 0xf5, 0xaf, 0xde, 0xd3, 0x80, 0xeb, 0xb7, 0x89, 0x8f, 0xa9, 0x0c, 0x8d, 0x7d, 0xf3, 0xb3,
+	0xa7, 0x4e, 0xcf, 0x88, 0xbe, 0x8a, 0x98, 0x2a, 0x66, 0x94, 0xbb, 0x6d, 0xc4, 0x4a, 0x23, 0xe8,
+	0x18, 0xc2, 0x9a, 0x70, 0x33, 0x13, 0x7e, 0x86, 0x97, 0xad, 0x01, 0x8f, 0x99, 0x90, 0x15, 0x83,
+	0x6b, 0x05, 0x25, 0x59, 0x95, 0x4e, 0xab, 0x9e, 0xce, 0xe0, 0x33, 0x80, 0x0f, 0x16, 0xa7, 0xd0,
+	0x0e, 0xbc, 0x7f, 0x9a, 0x9e, 0x64, 0x39, 0x5a, 0x6e, 0x5e, 0xa0, 0x5d, 0xb8, 0xa1, 0x2f, 0x27,
+	0x54, 0x26, 0x42, 0xb7, 0x9b, 0x19, 0xc5, 0xba, 0xbe, 0x3c, 0x4a, 0x4b, 0xe4, 0x2e, 0x26, 0xdc,
+	0xca, 0x9c, 0xee, 0x2f, 0x77, 0x5a, 0xc8, 0x9d, 0x94, 0x83, 0x8b, 0x3b, 0xf9, 0x00, 0x20, 0xfa,
+	0x1f, 0x83, 0x6c, 0x08, 0x2b, 0x54, 0x66, 0x70, 0xcb, 0xad, 0x9d, 0xa0, 0xd7, 0xd0, 0x2a, 0x33,
+	0xcb, 0x6c, 0x5a, 0xa3, 0xe7, 0xe9, 0x36, 0x7e, 0xde, 0xf5, 0x1e, 0xaf, 0xb6, 0x8d, 0x6f, 0x5f,
+	0xf7, 0xa0, 0x71, 0x3e, 0x66, 0xd4, 0xdd, 0x28, 0x22, 0x3c, 0xb8, 0x06, 0x70, 0xfd, 0x15, 0x8b,
+	0x2f, 0x38, 0x65, 0xe8, 0x0b, 0x80, 0x56, 0x15, 0x18, 0x5e, 0xed, 0x53, 0x8b, 0x9b, 0xda, 0x21,
+	0x2b, 0xe3, 0xf3, 0xab, 0x38, 0xd8, 0x7f, 0xff, 0xfd, 0xcf, 0xa7, 0xe6, 0x13, 0xe4, 0x90, 0xa5,
+	0xcf, 0xb5, 0xba, 0x2d, 0xa3, 0xe3, 0x9b, 0x99, 0x0d, 0x6e, 0x67, 0x36, 0xf8, 0x3d, 0xb3, 0xc1,
+	0xc7, 0xb9, 0xdd, 0xb8, 0x9d, 0xdb, 0x8d, 0x1f, 0x73, 0xbb, 0xf1, 0x66, 0xb8, 0x34, 0x06, 0x3a,
+	0xe5, 0x4c, 0x68, 0x12, 0xc4, 0x11, 0x2d, 0xf9, 0xfd, 0xb5, 0xec, 0xd1, 0x1d, 0xfe, 0x1b, 0x00,
+	0xeb, 0x60, 0x40, 0xf7, 0x2d, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ServiceClient is the client API for Service service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ServiceClient interface {
+	// GasPrices queries the minimum gas prices of the node and the percentiles of the gas prices paid
+	// in the recent blocks, by denom.
+	GasPrices(ctx context.Context, in *GasPricesRequest, opts ...grpc.CallOption) (*GasPricesResponse, error)
+}
+
+type serviceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewServiceClient(cc grpc1.ClientConn) ServiceClient {
+	return &serviceClient{cc}
+}
+
+func (c *serviceClient) GasPrices(ctx context.Context, in *GasPricesRequest, opts ...grpc.CallOption) (*GasPricesResponse, error) {
+	out := new(GasPricesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.gasprice.v1beta1.Service/GasPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServiceServer is the server API for Service service.
+type ServiceServer interface {
+	// GasPrices queries the minimum gas prices of the node and the percentiles of the gas prices paid
+	// in the recent blocks, by denom.
+	GasPrices(context.Context, *GasPricesRequest) (*GasPricesResponse, error)
+}
+
+// UnimplementedServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedServiceServer struct {
+}
+
+func (*UnimplementedServiceServer) GasPrices(ctx context.Context, req *GasPricesRequest) (*GasPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasPrices not implemented")
+}
+
+func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
+	s.RegisterService(&_Service_serviceDesc, srv)
+}
+
+func _Service_GasPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GasPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GasPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.gasprice.v1beta1.Service/GasPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GasPrices(ctx, req.(*GasPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Service_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.gasprice.v1beta1.Service",
+	HandlerType: (*ServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GasPrices",
+			Handler:    _Service_GasPrices_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/base/gasprice/v1beta1/query.proto",
+}
+
+func (m *GasPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Percentiles) > 0 {
+		dAtA2 := make([]byte, len(m.Percentiles)*10)
+		var j1 int
+		for _, num := range m.Percentiles {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintQuery(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Blocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.GasPrices) > 0 {
+		for iNdEx := len(m.GasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MinimumGasPrices) > 0 {
+		for iNdEx := len(m.MinimumGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinimumGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomGasPrices) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomGasPrices) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomGasPrices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Percentiles) > 0 {
+		for iNdEx := len(m.Percentiles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Percentiles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.TxCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TxCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasPricePercentile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPricePercentile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPricePercentile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.GasPrice.Size()
+		i -= size
+		if _, err := m.GasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Percentile != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Percentile))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GasPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		n += 1 + sovQuery(uint64(m.Blocks))
+	}
+	if len(m.Percentiles) > 0 {
+		l = 0
+		for _, e := range m.Percentiles {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *GasPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinimumGasPrices) > 0 {
+		for _, e := range m.MinimumGasPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.GasPrices) > 0 {
+		for _, e := range m.GasPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Blocks != 0 {
+		n += 1 + sovQuery(uint64(m.Blocks))
+	}
+	return n
+}
+
+func (m *DenomGasPrices) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TxCount != 0 {
+		n += 1 + sovQuery(uint64(m.TxCount))
+	}
+	if len(m.Percentiles) > 0 {
+		for _, e := range m.Percentiles {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GasPricePercentile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Percentile != 0 {
+		n += 1 + sovQuery(uint64(m.Percentile))
+	}
+	l = m.GasPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GasPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Percentiles = append(m.Percentiles, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Percentiles) == 0 {
+					m.Percentiles = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Percentiles = append(m.Percentiles, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentiles", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinimumGasPrices = append(m.MinimumGasPrices, types.DecCoin{})
+			if err := m.MinimumGasPrices[len(m.MinimumGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasPrices = append(m.GasPrices, &DenomGasPrices{})
+			if err := m.GasPrices[len(m.GasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomGasPrices) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomGasPrices: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomGasPrices: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
+			}
+			m.TxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentiles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Percentiles = append(m.Percentiles, &GasPricePercentile{})
+			if err := m.Percentiles[len(m.Percentiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasPricePercentile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPricePercentile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPricePercentile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentile", wireType)
+			}
+			m.Percentile = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Percentile |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,107 @@
+package gasprice
+
+import (
+	"context"
+	"sort"
+
+	"cosmossdk.io/math"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultPercentiles are the percentiles of the gas prices computed when none
+// are requested.
+var DefaultPercentiles = []uint32{25, 50, 75}
+
+// History is the source of the gas prices paid in the recent blocks, such as
+// baseapp.GasPriceHistory.
+type History interface {
+	// GasPrices returns the gas prices paid in the last blocks, at most blocks
+	// if it is not 0, by denom and in ascending order, and the number of blocks
+	// they were paid in.
+	GasPrices(blocks uint64) (map[string][]math.LegacyDec, uint64)
+}
+
+// RegisterGasPriceService registers the gas price gRPC service on the provided
+// gRPC router.
+func RegisterGasPriceService(server gogogrpc.Server, history History) {
+	RegisterServiceServer(server, NewQueryServer(history))
+}
+
+// RegisterGRPCGatewayRoutes mounts the gas price gRPC service's GRPC-gateway
+// routes on the given mux object.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	_ = RegisterServiceHandlerClient(context.Background(), mux, NewServiceClient(clientConn))
+}
+
+var _ ServiceServer = queryServer{}
+
+type queryServer struct {
+	history History
+}
+
+func NewQueryServer(history History) ServiceServer {
+	return queryServer{
+		history: history,
+	}
+}
+
+func (s queryServer) GasPrices(ctx context.Context, req *GasPricesRequest) (*GasPricesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	percentiles := req.Percentiles
+	if len(percentiles) == 0 {
+		percentiles = DefaultPercentiles
+	}
+	for _, p := range percentiles {
+		if p > 100 {
+			return nil, status.Errorf(codes.InvalidArgument, "percentile must be between 0 and 100, got %d", p)
+		}
+	}
+
+	prices, blocks := s.history.GasPrices(req.Blocks)
+
+	denoms := make([]string, 0, len(prices))
+	for denom := range prices {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+
+	res := &GasPricesResponse{
+		MinimumGasPrices: sdk.UnwrapSDKContext(ctx).MinGasPrices(),
+		GasPrices:        make([]*DenomGasPrices, 0, len(denoms)),
+		Blocks:           blocks,
+	}
+	for _, denom := range denoms {
+		denomPrices := &DenomGasPrices{
+			Denom:   denom,
+			TxCount: uint64(len(prices[denom])),
+		}
+		for _, p := range percentiles {
+			denomPrices.Percentiles = append(denomPrices.Percentiles, &GasPricePercentile{
+				Percentile: p,
+				GasPrice:   percentile(prices[denom], p),
+			})
+		}
+		res.GasPrices = append(res.GasPrices, denomPrices)
+	}
+
+	return res, nil
+}
+
+// percentile returns the p-th percentile of the sorted, non empty, gas prices
+// with the nearest-rank method.
+func percentile(prices []math.LegacyDec, p uint32) math.LegacyDec {
+	rank := (uint64(p)*uint64(len(prices)) + 99) / 100
+	if rank == 0 {
+		return prices[0]
+	}
+
+	return prices[rank-1]
+}
@@ -0,0 +1,104 @@
+package gasprice_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/gasprice"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type testHistory []map[string][]math.LegacyDec
+
+func (h testHistory) GasPrices(blocks uint64) (map[string][]math.LegacyDec, uint64) {
+	if blocks == 0 || blocks > uint64(len(h)) {
+		blocks = uint64(len(h))
+	}
+
+	prices := make(map[string][]math.LegacyDec)
+	for _, block := range h[uint64(len(h))-blocks:] {
+		for denom, blockPrices := range block {
+			prices[denom] = append(prices[denom], blockPrices...)
+		}
+	}
+
+	return prices, blocks
+}
+
+func decs(amounts ...int64) []math.LegacyDec {
+	res := make([]math.LegacyDec, len(amounts))
+	for i, amount := range amounts {
+		res[i] = math.LegacyNewDec(amount)
+	}
+	return res
+}
+
+func TestServiceServer_GasPrices(t *testing.T) {
+	history := testHistory{
+		{"stake": decs(1, 2, 3, 4, 5, 6, 7, 8), "atom": decs(10)},
+		{"stake": decs(9, 10)},
+	}
+	server := gasprice.NewQueryServer(history)
+	minGasPrices := sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 2))
+	ctx := sdk.Context{}.WithMinGasPrices(minGasPrices)
+
+	res, err := server.GasPrices(ctx, &gasprice.GasPricesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, minGasPrices, res.MinimumGasPrices)
+	require.Equal(t, uint64(2), res.Blocks)
+	require.Equal(t, []*gasprice.DenomGasPrices{
+		{
+			Denom:   "atom",
+			TxCount: 1,
+			Percentiles: []*gasprice.GasPricePercentile{
+				{Percentile: 25, GasPrice: math.LegacyNewDec(10)},
+				{Percentile: 50, GasPrice: math.LegacyNewDec(10)},
+				{Percentile: 75, GasPrice: math.LegacyNewDec(10)},
+			},
+		},
+		{
+			Denom:   "stake",
+			TxCount: 10,
+			Percentiles: []*gasprice.GasPricePercentile{
+				{Percentile: 25, GasPrice: math.LegacyNewDec(3)},
+				{Percentile: 50, GasPrice: math.LegacyNewDec(5)},
+				{Percentile: 75, GasPrice: math.LegacyNewDec(8)},
+			},
+		},
+	}, res.GasPrices)
+
+	res, err = server.GasPrices(ctx, &gasprice.GasPricesRequest{Blocks: 1, Percentiles: []uint32{0, 100}})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Blocks)
+	require.Equal(t, []*gasprice.DenomGasPrices{
+		{
+			Denom:   "stake",
+			TxCount: 2,
+			Percentiles: []*gasprice.GasPricePercentile{
+				{Percentile: 0, GasPrice: math.LegacyNewDec(9)},
+				{Percentile: 100, GasPrice: math.LegacyNewDec(10)},
+			},
+		},
+	}, res.GasPrices)
+
+	_, err = server.GasPrices(ctx, &gasprice.GasPricesRequest{Percentiles: []uint32{101}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = server.GasPrices(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServiceServer_GasPricesNoHistory(t *testing.T) {
+	server := gasprice.NewQueryServer(testHistory{})
+	ctx := sdk.Context{}.WithMinGasPrices(sdk.DecCoins{})
+
+	res, err := server.GasPrices(ctx, &gasprice.GasPricesRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.MinimumGasPrices)
+	require.Empty(t, res.GasPrices)
+	require.Zero(t, res.Blocks)
+}
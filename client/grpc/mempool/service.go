@@ -0,0 +1,127 @@
+package mempool
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// RegisterMempoolService registers the mempool gRPC service on the provided gRPC
+// router. The transactions are encoded with txEncoder to compute their hash and
+// size.
+func RegisterMempoolService(server gogogrpc.Server, inspector sdkmempool.Inspector, txEncoder sdk.TxEncoder) {
+	RegisterServiceServer(server, NewQueryServer(inspector, txEncoder))
+}
+
+// RegisterGRPCGatewayRoutes mounts the mempool gRPC service's GRPC-gateway routes
+// on the given mux object.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	_ = RegisterServiceHandlerClient(context.Background(), mux, NewServiceClient(clientConn))
+}
+
+var _ ServiceServer = queryServer{}
+
+type queryServer struct {
+	inspector sdkmempool.Inspector
+	txEncoder sdk.TxEncoder
+}
+
+func NewQueryServer(inspector sdkmempool.Inspector, txEncoder sdk.TxEncoder) ServiceServer {
+	return queryServer{
+		inspector: inspector,
+		txEncoder: txEncoder,
+	}
+}
+
+func (s queryServer) PendingTxs(_ context.Context, req *PendingTxsRequest) (*PendingTxsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Sender != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Sender); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid sender address: %s", err)
+		}
+	}
+
+	var offset, limit uint64 = 0, query.DefaultLimit
+	if req.Pagination != nil {
+		if len(req.Pagination.Key) > 0 {
+			return nil, status.Error(codes.InvalidArgument, "key pagination is not supported, use offset")
+		}
+		offset = req.Pagination.Offset
+		if req.Pagination.Limit > 0 {
+			limit = req.Pagination.Limit
+		}
+	}
+
+	pending := s.inspector.PendingTxs(req.Sender)
+	pageRes := &query.PageResponse{}
+	if req.Pagination != nil && req.Pagination.CountTotal {
+		pageRes.Total = uint64(len(pending))
+	}
+
+	if offset > uint64(len(pending)) {
+		offset = uint64(len(pending))
+	}
+	end := uint64(len(pending))
+	if limit < end-offset {
+		end = offset + limit
+	}
+
+	txs := make([]*PendingTx, 0, end-offset)
+	for _, tx := range pending[offset:end] {
+		pendingTx, err := s.pendingTx(tx)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		txs = append(txs, pendingTx)
+	}
+
+	return &PendingTxsResponse{Txs: txs, Pagination: pageRes}, nil
+}
+
+func (s queryServer) SenderCounts(_ context.Context, req *SenderCountsRequest) (*SenderCountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	res := &SenderCountsResponse{}
+	for sender, count := range s.inspector.SenderCounts() {
+		res.Counts = append(res.Counts, &SenderCount{Sender: sender, Count: uint64(count)})
+		res.Total += uint64(count)
+	}
+	sort.Slice(res.Counts, func(i, j int) bool { return res.Counts[i].Sender < res.Counts[j].Sender })
+
+	return res, nil
+}
+
+// pendingTx describes a transaction of the mempool.
+func (s queryServer) pendingTx(tx sdkmempool.PendingTx) (*PendingTx, error) {
+	txBytes, err := s.txEncoder(tx.Tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode tx: %w", err)
+	}
+
+	pendingTx := &PendingTx{
+		Hash:     fmt.Sprintf("%X", tmhash.Sum(txBytes)),
+		Sender:   tx.Sender,
+		Nonce:    tx.Nonce,
+		Priority: tx.Priority,
+		Size_:    uint64(len(txBytes)),
+	}
+	if feeTx, ok := tx.Tx.(sdk.FeeTx); ok {
+		pendingTx.GasWanted = feeTx.GetGas()
+	}
+
+	return pendingTx, nil
+}
@@ -0,0 +1,107 @@
+package mempool_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/mempool"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+type testTx struct {
+	sdk.FeeTx
+	bz  []byte
+	gas uint64
+}
+
+func (tx testTx) GetGas() uint64 { return tx.gas }
+
+func testTxEncoder(tx sdk.Tx) ([]byte, error) { return tx.(testTx).bz, nil }
+
+type testInspector []sdkmempool.PendingTx
+
+func (i testInspector) PendingTxs(sender string) []sdkmempool.PendingTx {
+	var txs []sdkmempool.PendingTx
+	for _, tx := range i {
+		if sender == "" || tx.Sender == sender {
+			txs = append(txs, tx)
+		}
+	}
+	return txs
+}
+
+func (i testInspector) SenderCounts() map[string]int {
+	counts := make(map[string]int)
+	for _, tx := range i {
+		counts[tx.Sender]++
+	}
+	return counts
+}
+
+func TestServiceServer_PendingTxs(t *testing.T) {
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	inspector := testInspector{
+		{Tx: testTx{bz: []byte("tx0"), gas: 100}, Sender: addr1.String(), Nonce: 0, Priority: 5},
+		{Tx: testTx{bz: []byte("tx1"), gas: 200}, Sender: addr1.String(), Nonce: 1, Priority: 5},
+		{Tx: testTx{bz: []byte("tx22")}, Sender: addr2.String(), Nonce: 7},
+	}
+	server := mempool.NewQueryServer(inspector, testTxEncoder)
+	ctx := context.Background()
+
+	res, err := server.PendingTxs(ctx, &mempool.PendingTxsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 3)
+	require.Equal(t, &mempool.PendingTx{
+		Hash:      fmt.Sprintf("%X", tmhash.Sum([]byte("tx1"))),
+		Sender:    addr1.String(),
+		Nonce:     1,
+		Priority:  5,
+		GasWanted: 200,
+		Size_:     3,
+	}, res.Txs[1])
+	require.Equal(t, uint64(4), res.Txs[2].Size_)
+
+	res, err = server.PendingTxs(ctx, &mempool.PendingTxsRequest{Sender: addr2.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 1)
+	require.Equal(t, uint64(7), res.Txs[0].Nonce)
+
+	res, err = server.PendingTxs(ctx, &mempool.PendingTxsRequest{
+		Pagination: &query.PageRequest{Offset: 1, Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 1)
+	require.Equal(t, uint64(1), res.Txs[0].Nonce)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+
+	res, err = server.PendingTxs(ctx, &mempool.PendingTxsRequest{Pagination: &query.PageRequest{Offset: 5}})
+	require.NoError(t, err)
+	require.Empty(t, res.Txs)
+
+	_, err = server.PendingTxs(ctx, &mempool.PendingTxsRequest{Sender: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServiceServer_SenderCounts(t *testing.T) {
+	inspector := testInspector{
+		{Tx: testTx{}, Sender: "b"},
+		{Tx: testTx{}, Sender: "a"},
+		{Tx: testTx{}, Sender: "b"},
+	}
+	server := mempool.NewQueryServer(inspector, testTxEncoder)
+
+	res, err := server.SenderCounts(context.Background(), &mempool.SenderCountsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []*mempool.SenderCount{{Sender: "a", Count: 1}, {Sender: "b", Count: 2}}, res.Counts)
+	require.Equal(t, uint64(3), res.Total)
+}
@@ -0,0 +1,113 @@
+package statediff
+
+import (
+	"context"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/store/statediff"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// RegisterStateDiffService registers the state diff gRPC service on the provided
+// gRPC router. The state changes of the stores are decoded with the collections
+// schemas, indexed by store name.
+func RegisterStateDiffService(server gogogrpc.Server, store *statediff.Store, schemas map[string]collections.Schema) {
+	RegisterServiceServer(server, NewQueryServer(store, schemas))
+}
+
+// RegisterGRPCGatewayRoutes mounts the state diff gRPC service's GRPC-gateway
+// routes on the given mux object.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	_ = RegisterServiceHandlerClient(context.Background(), mux, NewServiceClient(clientConn))
+}
+
+var _ ServiceServer = queryServer{}
+
+type queryServer struct {
+	store   *statediff.Store
+	schemas map[string]collections.Schema
+}
+
+func NewQueryServer(store *statediff.Store, schemas map[string]collections.Schema) ServiceServer {
+	return queryServer{
+		store:   store,
+		schemas: schemas,
+	}
+}
+
+func (s queryServer) StateChanges(_ context.Context, req *StateChangesRequest) (*StateChangesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Height <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "height must be greater than 0")
+	}
+
+	has, err := s.store.HasHeight(req.Height)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !has {
+		return nil, status.Errorf(codes.NotFound, "state changes of height %d are not retained", req.Height)
+	}
+
+	var changes []*StateChange
+	pageRes, err := query.Paginate(s.store.ChangeSetStore(req.Height, req.StoreKey), req.Pagination, func(_, value []byte) error {
+		var pair storetypes.StoreKVPair
+		if err := pair.Unmarshal(value); err != nil {
+			return err
+		}
+		changes = append(changes, s.decodeChange(&pair))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &StateChangesResponse{Changes: changes, Pagination: pageRes}, nil
+}
+
+// decodeChange decodes a change with the collection owning its key, if the store
+// has a collections schema.
+func (s queryServer) decodeChange(pair *storetypes.StoreKVPair) *StateChange {
+	change := &StateChange{
+		StoreKey: pair.StoreKey,
+		Delete:   pair.Delete,
+		Key:      pair.Key,
+		Value:    pair.Value,
+	}
+
+	schema, ok := s.schemas[pair.StoreKey]
+	if !ok {
+		return change
+	}
+
+	coll, err := schema.CollectionByKey(pair.Key)
+	if err != nil {
+		change.DecodeError = err.Error()
+		return change
+	}
+	change.Collection = coll.GetName()
+
+	// the value of a deleted key is nil, only its key is decoded
+	value := pair.Value
+	if !pair.Delete && value == nil {
+		value = []byte{}
+	}
+	keyJSON, valueJSON, err := coll.DecodeRawJSON(pair.Key[len(coll.GetPrefix()):], value)
+	if err != nil {
+		change.DecodeError = err.Error()
+		return change
+	}
+	change.KeyJson = string(keyJSON)
+	change.ValueJson = string(valueJSON)
+
+	return change
+}
@@ -0,0 +1,92 @@
+package statediff_test
+
+import (
+	"context"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	storestatediff "cosmossdk.io/store/statediff"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/statediff"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func rawKey[K any](t *testing.T, prefix byte, kc collcodec.KeyCodec[K], key K) []byte {
+	t.Helper()
+
+	bz := make([]byte, kc.Size(key))
+	_, err := kc.Encode(bz, key)
+	require.NoError(t, err)
+
+	return append([]byte{prefix}, bz...)
+}
+
+func TestServiceServer_StateChanges(t *testing.T) {
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(storetypes.NewKVStoreKey("bank")))
+	collections.NewMap(sb, collections.NewPrefix(1), "balances", collections.StringKey, collections.Uint64Value)
+	collections.NewKeySet(sb, collections.NewPrefix(2), "allowed", collections.Uint64Key)
+	schema, err := sb.Build()
+	require.NoError(t, err)
+
+	value, err := collections.Uint64Value.Encode(100)
+	require.NoError(t, err)
+
+	store := storestatediff.NewStore(dbm.NewMemDB(), 0)
+	require.NoError(t, store.Save(1, []*storetypes.StoreKVPair{
+		{StoreKey: "bank", Key: rawKey(t, 1, collections.StringKey, "atom"), Value: value},
+		{StoreKey: "bank", Key: rawKey(t, 1, collections.StringKey, "osmo"), Delete: true},
+		{StoreKey: "bank", Key: rawKey(t, 2, collections.Uint64Key, 7), Value: []byte{}},
+		{StoreKey: "bank", Key: []byte{9}, Value: []byte{1}},
+		{StoreKey: "acc", Key: []byte("key"), Value: []byte("value")},
+	}))
+
+	svr := statediff.NewQueryServer(store, map[string]collections.Schema{"bank": schema})
+	ctx := context.Background()
+
+	res, err := svr.StateChanges(ctx, &statediff.StateChangesRequest{Height: 1, StoreKey: "bank"})
+	require.NoError(t, err)
+	require.Len(t, res.Changes, 4)
+
+	require.Equal(t, "balances", res.Changes[0].Collection)
+	require.Equal(t, `"atom"`, res.Changes[0].KeyJson)
+	require.Equal(t, `"100"`, res.Changes[0].ValueJson)
+
+	// the deleted keys are decoded without value
+	require.True(t, res.Changes[1].Delete)
+	require.Equal(t, `"osmo"`, res.Changes[1].KeyJson)
+	require.Empty(t, res.Changes[1].ValueJson)
+	require.Empty(t, res.Changes[1].DecodeError)
+
+	require.Equal(t, "allowed", res.Changes[2].Collection)
+	require.Equal(t, `"7"`, res.Changes[2].KeyJson)
+	require.Empty(t, res.Changes[2].DecodeError)
+
+	// the keys of no collection are returned raw
+	require.Empty(t, res.Changes[3].Collection)
+	require.Equal(t, []byte{9}, res.Changes[3].Key)
+	require.NotEmpty(t, res.Changes[3].DecodeError)
+
+	// the stores without schema are not decoded
+	res, err = svr.StateChanges(ctx, &statediff.StateChangesRequest{Height: 1, Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, res.Changes, 1)
+	require.Equal(t, "acc", res.Changes[0].StoreKey)
+	require.Empty(t, res.Changes[0].Collection)
+	require.Empty(t, res.Changes[0].DecodeError)
+	require.Equal(t, uint64(5), res.Pagination.Total)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	_, err = svr.StateChanges(ctx, &statediff.StateChangesRequest{Height: 2})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = svr.StateChanges(ctx, &statediff.StateChangesRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -17,7 +17,11 @@ import (
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 )
 
-const FlagAppDBBackend = "app-db-backend"
+const (
+	FlagAppDBBackend = "app-db-backend"
+	FlagBatchSize    = "batch-size"
+	FlagCompact      = "compact"
+)
 
 // Cmd prunes the sdk root multi store history versions based on the pruning options
 // specified by command flags.
@@ -37,8 +41,12 @@ func Cmd(appCreator servertypes.AppCreator) *cobra.Command {
 		besides pruning options, database home directory and database backend type should also be specified via flags
 		'--home' and '--app-db-backend'.
 		valid app-db-backend type includes 'goleveldb', 'rocksdb', 'pebbledb'.
+
+		The heights are pruned incrementally, '--batch-size' heights at a time, reporting the progress
+		after each batch. With '--compact', the database is compacted once the heights are pruned to
+		reclaim the disk space, which is only supported by the 'goleveldb' backend.
 		`,
-		Example: "prune --home './' --app-db-backend 'goleveldb' --pruning 'custom' --pruning-keep-recent 100",
+		Example: "prune --home './' --app-db-backend 'goleveldb' --pruning 'custom' --pruning-keep-recent 100 --compact",
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			vp := viper.New()
 
@@ -91,11 +99,29 @@ func Cmd(appCreator servertypes.AppCreator) *cobra.Command {
 				pruningHeights[len(pruningHeights)-1],
 			)
 
-			err = rootMultiStore.PruneStores(false, pruningHeights)
-			if err != nil {
-				return err
+			batchSize := vp.GetInt(FlagBatchSize)
+			if batchSize <= 0 {
+				batchSize = len(pruningHeights)
+			}
+			for start := 0; start < len(pruningHeights); start += batchSize {
+				end := start + batchSize
+				if end > len(pruningHeights) {
+					end = len(pruningHeights)
+				}
+				if err := rootMultiStore.PruneStores(false, pruningHeights[start:end]); err != nil {
+					return err
+				}
+				fmt.Printf("pruned heights up to %v (%d/%d)\n", pruningHeights[end-1], end, len(pruningHeights))
 			}
 			fmt.Printf("successfully pruned the application root multi stores\n")
+
+			if vp.GetBool(FlagCompact) {
+				fmt.Printf("compacting the application database\n")
+				if err := compactDB(db); err != nil {
+					return err
+				}
+				fmt.Printf("successfully compacted the application database\n")
+			}
 			return nil
 		},
 	}
@@ -107,6 +133,8 @@ func Cmd(appCreator servertypes.AppCreator) *cobra.Command {
 	cmd.Flags().Uint64(server.FlagPruningInterval, 10,
 		`Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom'), 
 		this is not used by this command but kept for compatibility with the complete pruning options`)
+	cmd.Flags().Int(FlagBatchSize, 100, "Number of heights pruned at a time (0 prunes all the heights at once)")
+	cmd.Flags().Bool(FlagCompact, false, "Compact the database once the heights are pruned")
 
 	return cmd
 }
@@ -115,3 +143,15 @@ func openDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
 	dataDir := filepath.Join(rootDir, "data")
 	return dbm.NewDB("application", backendType, dataDir)
 }
+
+// compactDB compacts the whole database, so that the disk space of the pruned
+// heights is reclaimed.
+func compactDB(db dbm.DB) error {
+	compactor, ok := db.(interface {
+		ForceCompact(start, limit []byte) error
+	})
+	if !ok {
+		return fmt.Errorf("the compaction of a %T database is not supported", db)
+	}
+	return compactor.ForceCompact(nil, nil)
+}
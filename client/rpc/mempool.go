@@ -0,0 +1,108 @@
+package rpc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/grpc/mempool"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// MempoolCommand returns the command to query the app-side mempool of a node.
+func MempoolCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "mempool",
+		Short:                      "Query the app-side mempool of a node",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		MempoolPendingTxsCommand(),
+		MempoolSenderCountsCommand(),
+	)
+
+	return cmd
+}
+
+// MempoolPendingTxsCommand returns the command to list the transactions of the
+// app-side mempool of a node, optionally restricted to a sender.
+func MempoolPendingTxsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-txs [sender]",
+		Short: "List the transactions of the mempool, optionally restricted to a sender",
+		Long: `List the transactions of the app-side mempool ordered by sender and nonce, optionally restricted to a sender.
+The hash, sender, nonce, priority, gas wanted and size of each transaction are returned.`,
+		Example: strings.TrimSpace(fmt.Sprintf(`
+$ %s query mempool pending-txs
+$ %s query mempool pending-txs cosmos1...
+`, version.AppName, version.AppName)),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var sender string
+			if len(args) == 1 {
+				sender = args[0]
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := mempool.NewServiceClient(clientCtx)
+			res, err := queryClient.PendingTxs(cmd.Context(), &mempool.PendingTxsRequest{
+				Sender:     sender,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending txs")
+
+	return cmd
+}
+
+// MempoolSenderCountsCommand returns the command to query the number of
+// transactions of each sender in the app-side mempool of a node.
+func MempoolSenderCountsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "sender-counts",
+		Short:   "Query the number of transactions of each sender in the mempool",
+		Example: fmt.Sprintf("$ %s query mempool sender-counts", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := mempool.NewServiceClient(clientCtx)
+			res, err := queryClient.SenderCounts(cmd.Context(), &mempool.SenderCountsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
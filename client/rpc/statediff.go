@@ -0,0 +1,69 @@
+package rpc
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/grpc/statediff"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// StateChangesCommand returns the command to query the state changes committed
+// at a height, from a node with the state diff enabled.
+func StateChangesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state-changes [height] [store]",
+		Short: "Query the state changes committed at a height, optionally restricted to a store",
+		Long: `Query the state changes committed at a height, optionally restricted to a store.
+The keys and values are decoded with the collections schema of the store, when it is available.
+The node must have the state diff enabled and retain the height.`,
+		Example: strings.TrimSpace(fmt.Sprintf(`
+$ %s query state-changes 100
+$ %s query state-changes 100 bank
+`, version.AppName, version.AppName)),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %s: %w", args[0], err)
+			}
+
+			var storeKey string
+			if len(args) == 2 {
+				storeKey = args[1]
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := statediff.NewServiceClient(clientCtx)
+			res, err := queryClient.StateChanges(cmd.Context(), &statediff.StateChangesRequest{
+				Height:     height,
+				StoreKey:   storeKey,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "state changes")
+
+	return cmd
+}
@@ -0,0 +1,33 @@
+package snapshot
+
+import (
+	"path/filepath"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// Cmd returns the snapshots group command
+func Cmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshots",
+		Short: "Manage local snapshots",
+		Long: `Manage the local state sync snapshots of the node.
+Snapshots can be exported to a single archive file, and imported in the snapshot store of another node,
+to restore its application state and bootstrap its CometBFT state without state syncing from peers.`,
+	}
+	cmd.AddCommand(
+		ListSnapshotsCmd(),
+		ExportSnapshotCmd(appCreator),
+		ImportSnapshotCmd(),
+		RestoreSnapshotCmd(appCreator),
+	)
+	return cmd
+}
+
+func openDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	return dbm.NewDB("application", backendType, dataDir)
+}
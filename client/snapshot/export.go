@@ -0,0 +1,117 @@
+package snapshot
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+const (
+	flagOutput     = "output"
+	flagBaseHeight = "base-height"
+)
+
+// ExportSnapshotCmd returns the command to export a snapshot of the application state to an archive
+func ExportSnapshotCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export [height]",
+		Short: "Export a snapshot of the application state to an archive file",
+		Long: `Export a snapshot of the application state at the given height, the latest height by default,
+to a single archive file containing the snapshot metadata and chunks. The snapshot is taken first
+if it is not in the local snapshot store. The node must be stopped.
+
+With --base-height, an incremental snapshot is exported instead, only recording the changes since
+the snapshot of the base height, which must be in the local snapshot store. Restoring it requires
+the base snapshot to be imported first.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := server.GetServerContextFromCmd(cmd)
+			db, err := openDB(ctx.Config.RootDir, server.GetAppDBBackend(ctx.Viper))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			app := appCreator(ctx.Logger, db, nil, ctx.Viper)
+			if closer, ok := app.(io.Closer); ok {
+				defer closer.Close()
+			}
+			sm := app.SnapshotManager()
+
+			height := uint64(app.CommitMultiStore().LastCommitID().Version)
+			if len(args) == 1 {
+				height, err = strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid height %s: %w", args[0], err)
+				}
+			}
+			baseHeight, err := cmd.Flags().GetUint64(flagBaseHeight)
+			if err != nil {
+				return err
+			}
+			format := snapshottypes.CurrentFormat
+			if baseHeight != 0 {
+				format = snapshottypes.IncrementalFormat
+			}
+
+			snapshots, err := sm.List()
+			if err != nil {
+				return err
+			}
+			exists := false
+			for _, snapshot := range snapshots {
+				if snapshot.Height == height && snapshot.Format == format {
+					exists = true
+					break
+				}
+			}
+			if !exists {
+				ctx.Logger.Info("taking snapshot", "height", height, "base_height", baseHeight)
+				if baseHeight != 0 {
+					_, err = sm.CreateIncremental(height, baseHeight)
+				} else {
+					_, err = sm.Create(height)
+				}
+				if err != nil {
+					return err
+				}
+			}
+
+			output, err := cmd.Flags().GetString(flagOutput)
+			if err != nil {
+				return err
+			}
+			if output == "" {
+				output = fmt.Sprintf("%d-%d.tar.gz", height, format)
+			}
+			file, err := os.Create(output)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			if err := sm.ExportArchive(height, format, file); err != nil {
+				return err
+			}
+			if err := file.Close(); err != nil {
+				return err
+			}
+
+			cmd.Println("Snapshot exported to", output)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP(flagOutput, "o", "", "Output file, <height>-<format>.tar.gz by default")
+	cmd.Flags().Uint64(flagBaseHeight, 0, "Height of the base snapshot of an incremental snapshot")
+
+	return cmd
+}
@@ -0,0 +1,42 @@
+package snapshot
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+// ImportSnapshotCmd returns the command to import a snapshot archive in the local snapshot store
+func ImportSnapshotCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "import <archive-file>",
+		Short: "Import a snapshot archive in the local snapshot store",
+		Long: `Import a snapshot archive, written by the export command, in the local snapshot store.
+The hashes of the snapshot chunks are verified against the archive metadata. The imported snapshot
+can then be restored with the restore command.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := server.GetServerContextFromCmd(cmd)
+			snapshotStore, err := server.GetSnapshotStore(ctx.Viper)
+			if err != nil {
+				return err
+			}
+
+			file, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			snapshot, err := snapshotStore.ImportArchive(file)
+			if err != nil {
+				return err
+			}
+
+			cmd.Println("Snapshot imported, height:", snapshot.Height, "format:", snapshot.Format, "chunks:", snapshot.Chunks)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,81 @@
+package snapshot
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+const flagAppOnly = "app-only"
+
+// RestoreSnapshotCmd returns the command to restore the application and CometBFT states from a local snapshot
+func RestoreSnapshotCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore <height> [format]",
+		Short: "Restore the application and CometBFT states from a local snapshot",
+		Long: `Restore the application state of a fresh node from a snapshot of the local snapshot store,
+for example one imported from an archive, then bootstrap its CometBFT state at the snapshot height.
+
+The CometBFT state is fetched from the rpc_servers of the [statesync] section of config.toml, and
+verified by a light client with its trust_height, trust_hash and trust_period. Its app hash must match
+the restored one. The node can then be started without state syncing from peers.`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %s: %w", args[0], err)
+			}
+			format := snapshottypes.CurrentFormat
+			if len(args) == 2 {
+				f, err := strconv.ParseUint(args[1], 10, 32)
+				if err != nil {
+					return fmt.Errorf("invalid format %s: %w", args[1], err)
+				}
+				format = uint32(f)
+			}
+
+			ctx := server.GetServerContextFromCmd(cmd)
+			db, err := openDB(ctx.Config.RootDir, server.GetAppDBBackend(ctx.Viper))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			app := appCreator(ctx.Logger, db, nil, ctx.Viper)
+			if closer, ok := app.(io.Closer); ok {
+				defer closer.Close()
+			}
+
+			if err := app.SnapshotManager().RestoreLocalSnapshot(height, format); err != nil {
+				return fmt.Errorf("failed to restore the snapshot: %w", err)
+			}
+			commitID := app.CommitMultiStore().LastCommitID()
+			cmd.Printf("Application state restored at height %d, app hash %X\n", commitID.Version, commitID.Hash)
+
+			appOnly, err := cmd.Flags().GetBool(flagAppOnly)
+			if err != nil {
+				return err
+			}
+			if appOnly {
+				return nil
+			}
+
+			if err := server.BootstrapCometBFTState(cmd.Context(), ctx.Config, ctx.Logger, commitID.Version, commitID.Hash); err != nil {
+				return fmt.Errorf("failed to bootstrap the CometBFT state: %w", err)
+			}
+			cmd.Printf("CometBFT state bootstrapped at height %d\n", commitID.Version)
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool(flagAppOnly, false, "Only restore the application state, without bootstrapping the CometBFT state")
+
+	return cmd
+}
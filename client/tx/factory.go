@@ -31,12 +31,14 @@ type Factory struct {
 	sequence           uint64
 	gas                uint64
 	timeoutHeight      uint64
+	unordered          bool
 	gasAdjustment      float64
 	chainID            string
 	offline            bool
 	generateOnly       bool
 	memo               string
 	fees               sdk.Coins
+	autoFees           bool
 	tip                *tx.Tip
 	feeGranter         sdk.AccAddress
 	feePayer           sdk.AccAddress
@@ -77,6 +79,7 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) (Factory, e
 	gasAdj, _ := flagSet.GetFloat64(flags.FlagGasAdjustment)
 	memo, _ := flagSet.GetString(flags.FlagNote)
 	timeoutHeight, _ := flagSet.GetUint64(flags.FlagTimeoutHeight)
+	unordered, _ := flagSet.GetBool(flags.FlagUnordered)
 
 	gasStr, _ := flagSet.GetString(flags.FlagGas)
 	gasSetting, _ := flags.ParseGasSetting(gasStr)
@@ -93,6 +96,7 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) (Factory, e
 		accountNumber:      accNum,
 		sequence:           accSeq,
 		timeoutHeight:      timeoutHeight,
+		unordered:          unordered,
 		gasAdjustment:      gasAdj,
 		memo:               memo,
 		signMode:           signMode,
@@ -127,11 +131,16 @@ func (f Factory) Fees() sdk.Coins                           { return f.fees }
 func (f Factory) GasPrices() sdk.DecCoins                   { return f.gasPrices }
 func (f Factory) AccountRetriever() client.AccountRetriever { return f.accountRetriever }
 func (f Factory) TimeoutHeight() uint64                     { return f.timeoutHeight }
+func (f Factory) Unordered() bool                           { return f.unordered }
 
 // SimulateAndExecute returns the option to simulate and then execute the transaction
 // using the gas from the simulation results
 func (f Factory) SimulateAndExecute() bool { return f.simulateAndExecute }
 
+// AutoFees returns the option to derive the fees of the transaction from the gas
+// prices of the node.
+func (f Factory) AutoFees() bool { return f.autoFees }
+
 // WithTxConfig returns a copy of the Factory with an updated TxConfig.
 func (f Factory) WithTxConfig(g client.TxConfig) Factory {
 	f.txConfig = g
@@ -156,14 +165,24 @@ func (f Factory) WithGas(gas uint64) Factory {
 	return f
 }
 
-// WithFees returns a copy of the Factory with an updated fee.
+// WithFees returns a copy of the Factory with an updated fee. The fees are
+// derived from the gas prices of the node if fees is "auto", and the gas limit
+// they are paid for is then estimated by simulating the transaction.
 func (f Factory) WithFees(fees string) Factory {
+	if fees == flags.FeesFlagAuto {
+		f.fees = nil
+		f.autoFees = true
+		f.simulateAndExecute = true
+		return f
+	}
+
 	parsedFees, err := sdk.ParseCoinsNormalized(fees)
 	if err != nil {
 		panic(err)
 	}
 
 	f.fees = parsedFees
+	f.autoFees = false
 	return f
 }
 
@@ -246,6 +265,12 @@ func (f Factory) WithTimeoutHeight(height uint64) Factory {
 	return f
 }
 
+// WithUnordered returns a copy of the Factory with an updated unordered field.
+func (f Factory) WithUnordered(v bool) Factory {
+	f.unordered = v
+	return f
+}
+
 // WithFeeGranter returns a copy of the Factory with an updated fee granter.
 func (f Factory) WithFeeGranter(fg sdk.AccAddress) Factory {
 	f.feeGranter = fg
@@ -292,6 +317,10 @@ func (f Factory) BuildUnsignedTx(msgs ...sdk.Msg) (client.TxBuilder, error) {
 		return nil, fmt.Errorf("chain ID required but not specified")
 	}
 
+	if f.autoFees {
+		return nil, errors.New("fees set to auto must be derived from the gas prices of the node before building the transaction")
+	}
+
 	fees := f.fees
 
 	if !f.gasPrices.IsZero() {
@@ -328,6 +357,7 @@ func (f Factory) BuildUnsignedTx(msgs ...sdk.Msg) (client.TxBuilder, error) {
 	tx.SetFeeGranter(f.feeGranter)
 	tx.SetFeePayer(f.feePayer)
 	tx.SetTimeoutHeight(f.TimeoutHeight())
+	tx.SetUnordered(f.Unordered())
 
 	return tx, nil
 }
@@ -337,6 +367,11 @@ func (f Factory) BuildUnsignedTx(msgs ...sdk.Msg) (client.TxBuilder, error) {
 // simulated and also printed to the same writer before the transaction is
 // printed.
 func (f Factory) PrintUnsignedTx(clientCtx client.Context, msgs ...sdk.Msg) error {
+	f, err := f.prepareAutoFees(clientCtx)
+	if err != nil {
+		return err
+	}
+
 	if f.SimulateAndExecute() {
 		if clientCtx.Offline {
 			return errors.New("cannot estimate gas in offline mode")
@@ -455,3 +490,31 @@ func (f Factory) Prepare(clientCtx client.Context) (Factory, error) {
 
 	return fc, nil
 }
+
+// prepareAutoFees sets the gas prices of the Factory to the gas price returned
+// by CalculateGasPrices if its fees are set to auto, so that the fees are
+// derived from the gas limit of the transaction. A new Factory with the updated
+// fields will be returned.
+func (f Factory) prepareAutoFees(clientCtx client.Context) (Factory, error) {
+	if !f.autoFees {
+		return f, nil
+	}
+
+	if !f.gasPrices.IsZero() {
+		return f, errors.New("cannot provide both fees and gas prices")
+	}
+
+	if clientCtx.Offline {
+		return f, errors.New("cannot query gas prices in offline mode")
+	}
+
+	gasPrices, err := CalculateGasPrices(clientCtx)
+	if err != nil {
+		return f, err
+	}
+
+	f.autoFees = false
+	f.gasPrices = gasPrices
+
+	return f, nil
+}
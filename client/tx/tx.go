@@ -12,6 +12,7 @@ import (
 	"github.com/spf13/pflag"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/grpc/gasprice"
 	"github.com/cosmos/cosmos-sdk/client/input"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -71,6 +72,11 @@ func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 		return err
 	}
 
+	txf, err = txf.prepareAutoFees(clientCtx)
+	if err != nil {
+		return err
+	}
+
 	if txf.SimulateAndExecute() || clientCtx.Simulate {
 		if clientCtx.Offline {
 			return errors.New("cannot estimate gas in offline mode")
@@ -156,6 +162,71 @@ func CalculateGas(
 	return simRes, uint64(txf.GasAdjustment() * float64(simRes.GasInfo.GasUsed)), nil
 }
 
+// autoFeesPercentile is the percentile of the gas prices paid in the recent
+// blocks used to derive the fees set to auto.
+const autoFeesPercentile = 50
+
+// CalculateGasPrices queries the gas prices of the node and returns the gas price
+// to derive the fees of a transaction from. The gas price is in the denom of the
+// minimum gas prices of the node, or of the gas prices paid in the recent blocks
+// if there are none, which is the most used in the recent blocks. It is the
+// median of the gas prices paid in that denom, but not less than the minimum gas
+// price. No gas price is returned if the node requires no fees and none were
+// paid in the recent blocks.
+func CalculateGasPrices(clientCtx gogogrpc.ClientConn) (sdk.DecCoins, error) {
+	res, err := gasprice.NewServiceClient(clientCtx).GasPrices(context.Background(), &gasprice.GasPricesRequest{
+		Percentiles: []uint32{autoFeesPercentile},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	recent := make(map[string]*gasprice.DenomGasPrices, len(res.GasPrices))
+	for _, prices := range res.GasPrices {
+		recent[prices.Denom] = prices
+	}
+
+	denoms := make([]string, 0, len(res.MinimumGasPrices))
+	for _, minGasPrice := range res.MinimumGasPrices {
+		denoms = append(denoms, minGasPrice.Denom)
+	}
+	if len(denoms) == 0 {
+		for _, prices := range res.GasPrices {
+			denoms = append(denoms, prices.Denom)
+		}
+	}
+
+	var (
+		denom   string
+		txCount uint64
+	)
+	for _, d := range denoms {
+		if denom == "" || recent[d] != nil && recent[d].TxCount > txCount {
+			denom = d
+			if recent[d] != nil {
+				txCount = recent[d].TxCount
+			}
+		}
+	}
+	if denom == "" {
+		return sdk.DecCoins{}, nil
+	}
+
+	gasPrice := res.MinimumGasPrices.AmountOf(denom)
+	if prices := recent[denom]; prices != nil {
+		for _, p := range prices.Percentiles {
+			if p.Percentile == autoFeesPercentile && p.GasPrice.GT(gasPrice) {
+				gasPrice = p.GasPrice
+			}
+		}
+	}
+	if !gasPrice.IsPositive() {
+		return sdk.DecCoins{}, nil
+	}
+
+	return sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom, gasPrice)), nil
+}
+
 // SignWithPrivKey signs a given tx with the given private key, and returns the
 // corresponding SignatureV2 if the signing is successful.
 func SignWithPrivKey(
@@ -12,6 +12,7 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/grpc/gasprice"
 	clienttestutil "github.com/cosmos/cosmos-sdk/client/testutil"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -108,6 +109,127 @@ func TestCalculateGas(t *testing.T) {
 	}
 }
 
+// mockGasPricesContext is a mock client.Context to return arbitrary gas prices,
+// used to unit test CalculateGasPrices.
+type mockGasPricesContext struct {
+	res     gasprice.GasPricesResponse
+	wantErr bool
+}
+
+func (m mockGasPricesContext) Invoke(grpcCtx gocontext.Context, method string, req, reply interface{}, opts ...grpc.CallOption) (err error) {
+	if m.wantErr {
+		return fmt.Errorf("mock err")
+	}
+
+	*(reply.(*gasprice.GasPricesResponse)) = m.res
+
+	return nil
+}
+
+func (mockGasPricesContext) NewStream(gocontext.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	panic("not implemented")
+}
+
+func TestCalculateGasPrices(t *testing.T) {
+	median := func(denom string, txCount uint64, gasPrice sdkmath.LegacyDec) *gasprice.DenomGasPrices {
+		return &gasprice.DenomGasPrices{
+			Denom:       denom,
+			TxCount:     txCount,
+			Percentiles: []*gasprice.GasPricePercentile{{Percentile: 50, GasPrice: gasPrice}},
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		res     gasprice.GasPricesResponse
+		wantErr bool
+		expPass bool
+		expGP   sdk.DecCoins
+	}{
+		{"error", gasprice.GasPricesResponse{}, true, false, nil},
+		{"no gas prices", gasprice.GasPricesResponse{}, false, true, sdk.DecCoins{}},
+		{
+			"minimum gas price",
+			gasprice.GasPricesResponse{MinimumGasPrices: sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 2))},
+			false, true, sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 2)),
+		},
+		{
+			"recent gas price above the minimum",
+			gasprice.GasPricesResponse{
+				MinimumGasPrices: sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 2)),
+				GasPrices:        []*gasprice.DenomGasPrices{median("stake", 3, sdkmath.LegacyNewDec(5))},
+			},
+			false, true, sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 5)),
+		},
+		{
+			"recent gas price below the minimum",
+			gasprice.GasPricesResponse{
+				MinimumGasPrices: sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 10)),
+				GasPrices:        []*gasprice.DenomGasPrices{median("stake", 3, sdkmath.LegacyNewDec(5))},
+			},
+			false, true, sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 10)),
+		},
+		{
+			"most used denom of the minimum gas prices",
+			gasprice.GasPricesResponse{
+				MinimumGasPrices: sdk.NewDecCoins(sdk.NewInt64DecCoin("atom", 1), sdk.NewInt64DecCoin("stake", 2)),
+				GasPrices: []*gasprice.DenomGasPrices{
+					median("atom", 1, sdkmath.LegacyNewDec(1)),
+					median("photon", 10, sdkmath.LegacyNewDec(1)),
+					median("stake", 3, sdkmath.LegacyNewDecWithPrec(5, 1)),
+				},
+			},
+			false, true, sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 2)),
+		},
+		{
+			"most used denom of the recent gas prices",
+			gasprice.GasPricesResponse{
+				GasPrices: []*gasprice.DenomGasPrices{
+					median("atom", 1, sdkmath.LegacyNewDec(3)),
+					median("stake", 5, sdkmath.LegacyNewDecWithPrec(1, 1)),
+				},
+			},
+			false, true, sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdkmath.LegacyNewDecWithPrec(1, 1))),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			gasPrices, err := tx.CalculateGasPrices(mockGasPricesContext{res: tc.res, wantErr: tc.wantErr})
+			if tc.expPass {
+				require.NoError(t, err)
+				require.Equal(t, tc.expGP, gasPrices)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestAutoFees(t *testing.T) {
+	txConfig, _ := newTestTxConfig(t)
+
+	txf := tx.Factory{}.
+		WithTxConfig(txConfig).
+		WithChainID("test-chain").
+		WithFees("auto")
+	require.True(t, txf.AutoFees())
+	require.Nil(t, txf.Fees())
+
+	// the gas limit the fees are paid for is simulated
+	require.True(t, txf.SimulateAndExecute())
+
+	// the fees must be derived from the gas prices of the node first
+	msg := banktypes.NewMsgSend(sdk.AccAddress("from"), sdk.AccAddress("to"), nil)
+	_, err := txf.BuildUnsignedTx(msg)
+	require.Error(t, err)
+
+	txf = txf.WithFees("50stake")
+	require.False(t, txf.AutoFees())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), txf.Fees())
+}
+
 func TestBuildSimTx(t *testing.T) {
 	txCfg, cdc := newTestTxConfig(t)
 
@@ -45,6 +45,7 @@ type (
 		SetGasLimit(limit uint64)
 		SetTip(tip *tx.Tip)
 		SetTimeoutHeight(height uint64)
+		SetUnordered(v bool)
 		SetFeeGranter(feeGranter sdk.AccAddress)
 		AddAuxSignerData(tx.AuxSignerData) error
 	}
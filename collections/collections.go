@@ -14,6 +14,8 @@ var (
 	ErrEncoding = codec.ErrEncoding
 	// ErrConflict is returned when there are conflicts, for example in UniqueIndex.
 	ErrConflict = errors.New("collections: conflict")
+	// ErrOutOfBounds is returned when an index is out of the bounds of a Vec, or when a Queue is full.
+	ErrOutOfBounds = errors.New("collections: out of bounds")
 )
 
 // KEYS
@@ -76,14 +78,34 @@ var (
 // include methods for importing/exporting genesis data and schema
 // reflection for clients.
 type collection interface {
-	// getName is the unique name of the collection within a schema. It must
+	Collection
+
+	genesisHandler
+}
+
+// Collection exposes the schema information of a collection, and allows to decode
+// its raw entries, for example to inspect the state of a module without knowing its
+// concrete types.
+// Unstable: API and methods are currently unstable.
+type Collection interface {
+	// GetName is the unique name of the collection within a schema. It must
 	// match format specified by NameRegex.
-	getName() string
+	GetName() string
 
-	// getPrefix is the unique prefix of the collection within a schema.
-	getPrefix() []byte
+	// GetPrefix is the unique prefix of the collection within a schema.
+	GetPrefix() []byte
 
-	genesisHandler
+	// KeyType returns the type identifier of the collection keys, as defined by the KeyCodec.
+	KeyType() string
+
+	// ValueType returns the type identifier of the collection values, as defined by the ValueCodec.
+	ValueType() string
+
+	// DecodeRawJSON decodes a raw entry of the collection into the JSON representation
+	// of its key and value. The raw key must not contain the collection prefix.
+	// The value JSON is empty for the collections which hold no values, like KeySet, and
+	// when the raw value is nil, which only decodes the key of a deleted entry.
+	DecodeRawJSON(key, value []byte) (keyJSON, valueJSON []byte, err error)
 }
 
 // Prefix defines a segregation bytes namespace for specific collections objects.
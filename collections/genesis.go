@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+
+	"cosmossdk.io/collections/codec"
 )
 
 type genesisHandler interface {
@@ -151,3 +153,85 @@ func (m Map[K, V]) defaultGenesis(writer io.Writer) error {
 	_, err := writer.Write([]byte(`[]`))
 	return err
 }
+
+// encodeJSONValues writes the values of the elements of a Vec or a Queue
+// as a JSON array, in order.
+func encodeJSONValues[V any](ctx context.Context, elements Map[uint64, V], writer io.Writer) error {
+	_, err := writer.Write([]byte("["))
+	if err != nil {
+		return err
+	}
+
+	first := true
+	var walkErr error
+	err = walkElements(ctx, elements, func(_ uint64, value V) bool {
+		// add a comma before encoding the value
+		// for all values besides the first one.
+		if !first {
+			if _, walkErr = writer.Write([]byte(",")); walkErr != nil {
+				return true
+			}
+		}
+		first = false
+
+		var bz []byte
+		bz, walkErr = elements.vc.EncodeJSON(value)
+		if walkErr != nil {
+			return true
+		}
+		_, walkErr = writer.Write(bz)
+		return walkErr != nil
+	})
+	if err != nil {
+		return err
+	}
+	if walkErr != nil {
+		return walkErr
+	}
+
+	_, err = writer.Write([]byte("]"))
+	return err
+}
+
+// decodeJSONValues decodes a JSON array of values, as written by encodeJSONValues,
+// calling onValue for each of them in order.
+func decodeJSONValues[V any](reader io.Reader, vc codec.ValueCodec[V], onValue func(value V) error) error {
+	decoder := json.NewDecoder(reader)
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+
+	if token != json.Delim('[') {
+		return fmt.Errorf("expected [ got %s", token)
+	}
+
+	for decoder.More() {
+		var rawJSON json.RawMessage
+		err := decoder.Decode(&rawJSON)
+		if err != nil {
+			return err
+		}
+
+		value, err := vc.DecodeJSON(rawJSON)
+		if err != nil {
+			return err
+		}
+
+		err = onValue(value)
+		if err != nil {
+			return err
+		}
+	}
+
+	token, err = decoder.Token()
+	if err != nil {
+		return err
+	}
+
+	if token != json.Delim(']') {
+		return fmt.Errorf("expected ] got %s", token)
+	}
+
+	return nil
+}
@@ -111,3 +111,71 @@ func ScanValues[K, V any, I iterator[K], Idx collections.Indexes[K, V]](
 
 	return nil
 }
+
+// KeyValueIterator wraps an index iterator and yields the records of the indexed map
+// referenced by the index, the value is fetched lazily from the indexed map.
+type KeyValueIterator[K, V any, I iterator[K], Idx collections.Indexes[K, V]] struct {
+	ctx        context.Context
+	indexedMap *collections.IndexedMap[K, V, Idx]
+	iter       I
+}
+
+// NewKeyValueIterator returns a KeyValueIterator over the records of the indexed map
+// referenced by the provided index iterator. Closing the KeyValueIterator closes the index iterator.
+func NewKeyValueIterator[K, V any, I iterator[K], Idx collections.Indexes[K, V]](
+	ctx context.Context,
+	indexedMap *collections.IndexedMap[K, V, Idx],
+	iter I,
+) KeyValueIterator[K, V, I, Idx] {
+	return KeyValueIterator[K, V, I, Idx]{
+		ctx:        ctx,
+		indexedMap: indexedMap,
+		iter:       iter,
+	}
+}
+
+// PrimaryKey returns the iterator's current primary key.
+func (i KeyValueIterator[K, V, I, Idx]) PrimaryKey() (K, error) {
+	return i.iter.PrimaryKey()
+}
+
+// Value returns the value of the indexed map referenced by the iterator's current primary key.
+func (i KeyValueIterator[K, V, I, Idx]) Value() (v V, err error) {
+	pk, err := i.iter.PrimaryKey()
+	if err != nil {
+		return v, err
+	}
+	return i.indexedMap.Get(i.ctx, pk)
+}
+
+// KeyValue returns the iterator's current primary key and the value it references.
+func (i KeyValueIterator[K, V, I, Idx]) KeyValue() (kv collections.KeyValue[K, V], err error) {
+	pk, err := i.iter.PrimaryKey()
+	if err != nil {
+		return kv, err
+	}
+	value, err := i.indexedMap.Get(i.ctx, pk)
+	if err != nil {
+		return kv, err
+	}
+	return collections.KeyValue[K, V]{Key: pk, Value: value}, nil
+}
+
+// KeyValues fully consumes the iterator and returns all the primary keys and values it references.
+func (i KeyValueIterator[K, V, I, Idx]) KeyValues() ([]collections.KeyValue[K, V], error) {
+	return CollectKeyValues(i.ctx, i.indexedMap, i.iter)
+}
+
+// Values fully consumes the iterator and returns all the values it references.
+func (i KeyValueIterator[K, V, I, Idx]) Values() ([]V, error) {
+	return CollectValues(i.ctx, i.indexedMap, i.iter)
+}
+
+// Next advances the iterator.
+func (i KeyValueIterator[K, V, I, Idx]) Next() { i.iter.Next() }
+
+// Valid asserts if the iterator is still valid or not.
+func (i KeyValueIterator[K, V, I, Idx]) Valid() bool { return i.iter.Valid() }
+
+// Close closes the iterator.
+func (i KeyValueIterator[K, V, I, Idx]) Close() error { return i.iter.Close() }
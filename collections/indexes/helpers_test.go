@@ -85,4 +85,27 @@ func TestHelpers(t *testing.T) {
 		return true // says to stop
 	})
 	require.NoError(t, err)
+
+	// test key value iterator
+	iter, err = indexedMap.Indexes.Denom.MatchExact(ctx, "osmo")
+	require.NoError(t, err)
+	kvIter := NewKeyValueIterator(ctx, indexedMap, iter)
+	defer kvIter.Close()
+
+	pk, err := kvIter.PrimaryKey()
+	require.NoError(t, err)
+	require.Equal(t, collections.Join("address1", "osmo"), pk)
+
+	value, err := kvIter.Value()
+	require.NoError(t, err)
+	require.Equal(t, Amount(200), value)
+
+	kvIter.Next()
+	require.True(t, kvIter.Valid())
+	kv, err := kvIter.KeyValue()
+	require.NoError(t, err)
+	require.Equal(t, collections.KeyValue[collections.Pair[Address, Denom], Amount]{Key: collections.Join("address2", "osmo"), Value: 300}, kv)
+
+	kvIter.Next()
+	require.False(t, kvIter.Valid())
 }
@@ -66,8 +66,21 @@ func (m *Multi[ReferenceKey, PrimaryKey, Value]) MatchExact(ctx context.Context,
 	return m.Iterate(ctx, collections.NewPrefixedPairRange[ReferenceKey, PrimaryKey](refKey))
 }
 
-func (i *MultiPair[K1, K2, Value]) KeyCodec() codec.KeyCodec[collections.Pair[K2, K1]] {
-	return (*collections.GenericMultiIndex[K2, K1, collections.Pair[K1, K2], Value])(i).KeyCodec()
+// MatchRange returns a MultiIterator containing all the primary keys referenced by the reference keys
+// within the provided range. A nil range yields all the primary keys of the index.
+func (m *Multi[ReferenceKey, PrimaryKey, Value]) MatchRange(ctx context.Context, ranger collections.Ranger[ReferenceKey]) (MultiIterator[ReferenceKey, PrimaryKey], error) {
+	return m.Iterate(ctx, collections.NewPairK1Range[ReferenceKey, PrimaryKey](ranger))
+}
+
+// IterateRaw iterates the index using raw bytes keys. Follows the same semantics as collections.Map.IterateRaw.
+func (m *Multi[ReferenceKey, PrimaryKey, Value]) IterateRaw(ctx context.Context, start, end []byte, order collections.Order) (MultiIterator[ReferenceKey, PrimaryKey], error) {
+	iter, err := (*collections.GenericMultiIndex[ReferenceKey, PrimaryKey, PrimaryKey, Value])(m).IterateRaw(ctx, start, end, order)
+	return (MultiIterator[ReferenceKey, PrimaryKey])(iter), err
+}
+
+// KeyCodec returns the codec of the index keys, which are Pair[ReferenceKey, PrimaryKey].
+func (m *Multi[ReferenceKey, PrimaryKey, Value]) KeyCodec() codec.KeyCodec[collections.Pair[ReferenceKey, PrimaryKey]] {
+	return (*collections.GenericMultiIndex[ReferenceKey, PrimaryKey, PrimaryKey, Value])(m).KeyCodec()
 }
 
 // MultiIterator is just a KeySetIterator with key as Pair[ReferenceKey, PrimaryKey].
@@ -92,6 +105,11 @@ func (i MultiIterator[ReferenceKey, PrimaryKey]) PrimaryKeys() ([]PrimaryKey, er
 	return pks, nil
 }
 
+// Key returns the current key of the index, which is the full reference key.
+func (i MultiIterator[ReferenceKey, PrimaryKey]) Key() (collections.Pair[ReferenceKey, PrimaryKey], error) {
+	return i.FullKey()
+}
+
 // FullKey returns the current full reference key as Pair[ReferenceKey, PrimaryKey].
 func (i MultiIterator[ReferenceKey, PrimaryKey]) FullKey() (collections.Pair[ReferenceKey, PrimaryKey], error) {
 	return (collections.KeySetIterator[collections.Pair[ReferenceKey, PrimaryKey]])(i).Key()
@@ -61,6 +61,12 @@ func (i *MultiPair[K1, K2, Value]) MatchExact(ctx context.Context, key K2) (Mult
 	return i.Iterate(ctx, collections.NewPrefixedPairRange[K2, K1](key))
 }
 
+// MatchRange will return an iterator containing the primary keys whose second part of the multipart
+// pair key is within the provided range. A nil range yields all the primary keys of the index.
+func (i *MultiPair[K1, K2, Value]) MatchRange(ctx context.Context, ranger collections.Ranger[K2]) (MultiPairIterator[K2, K1], error) {
+	return i.Iterate(ctx, collections.NewPairK1Range[K2, K1](ranger))
+}
+
 // Reference implements collections.Index
 func (i *MultiPair[K1, K2, Value]) Reference(ctx context.Context, pk collections.Pair[K1, K2], value Value, oldValue *Value) error {
 	return (*collections.GenericMultiIndex[K2, K1, collections.Pair[K1, K2], Value])(i).Reference(ctx, pk, value, oldValue)
@@ -79,12 +85,15 @@ func (i *MultiPair[K1, K2, Value]) Walk(
 	return (*collections.GenericMultiIndex[K2, K1, collections.Pair[K1, K2], Value])(i).Walk(ctx, ranger, walkFunc)
 }
 
-func (i *MultiPair[K1, K2, Value]) IterateRaw(
-	ctx context.Context, start, end []byte, order collections.Order,
-) (
-	iter collections.Iterator[collections.Pair[K2, K1], collections.NoValue], err error,
-) {
-	return (*collections.GenericMultiIndex[K2, K1, collections.Pair[K1, K2], Value])(i).IterateRaw(ctx, start, end, order)
+// IterateRaw iterates the index using raw bytes keys. Follows the same semantics as collections.Map.IterateRaw.
+func (i *MultiPair[K1, K2, Value]) IterateRaw(ctx context.Context, start, end []byte, order collections.Order) (MultiPairIterator[K2, K1], error) {
+	iter, err := (*collections.GenericMultiIndex[K2, K1, collections.Pair[K1, K2], Value])(i).IterateRaw(ctx, start, end, order)
+	return (MultiPairIterator[K2, K1])(iter), err
+}
+
+// KeyCodec returns the codec of the index keys, which are the reversed pair keys Pair[K2, K1].
+func (i *MultiPair[K1, K2, Value]) KeyCodec() codec.KeyCodec[collections.Pair[K2, K1]] {
+	return (*collections.GenericMultiIndex[K2, K1, collections.Pair[K1, K2], Value])(i).KeyCodec()
 }
 
 // MultiPairIterator is a helper type around a collections.KeySetIterator when used to work
@@ -115,6 +124,11 @@ func (m MultiPairIterator[K2, K1]) PrimaryKeys() (pairs []collections.Pair[K1, K
 	return pairs, err
 }
 
+// Key returns the current key of the index, which is the full reference key.
+func (m MultiPairIterator[K2, K1]) Key() (collections.Pair[K2, K1], error) {
+	return m.FullKey()
+}
+
 func (m MultiPairIterator[K2, K1]) FullKey() (p collections.Pair[K2, K1], err error) {
 	return (collections.KeySetIterator[collections.Pair[K2, K1]])(m).Key()
 }
@@ -60,3 +60,53 @@ func TestMultiPair(t *testing.T) {
 	require.Equal(t, "address1", pks[0].K1())
 	require.Equal(t, "address2", pks[1].K1())
 }
+
+func TestMultiPair_MatchRange(t *testing.T) {
+	sk, ctx := deps()
+	sb := collections.NewSchemaBuilder(sk)
+	keyCodec := collections.PairKeyCodec(collections.StringKey, collections.StringKey)
+
+	indexedMap := collections.NewIndexedMap(
+		sb,
+		collections.NewPrefix("balances"), "balances",
+		keyCodec,
+		collections.Uint64Value,
+		balanceIndex{
+			Denom: NewMultiPair[Amount](sb, collections.NewPrefix("denom_index"), "denom_index", keyCodec),
+		},
+	)
+
+	require.NoError(t, indexedMap.Set(ctx, collections.Join("address1", "atom"), 100))
+	require.NoError(t, indexedMap.Set(ctx, collections.Join("address1", "osmo"), 200))
+	require.NoError(t, indexedMap.Set(ctx, collections.Join("address2", "juno"), 300))
+	require.NoError(t, indexedMap.Set(ctx, collections.Join("address2", "osmo"), 400))
+
+	// denoms between atom and juno, inclusive
+	iter, err := indexedMap.Indexes.Denom.MatchRange(ctx, new(collections.Range[Denom]).StartInclusive("atom").EndInclusive("juno"))
+	require.NoError(t, err)
+	pks, err := iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []collections.Pair[Address, Denom]{
+		collections.Join("address1", "atom"),
+		collections.Join("address2", "juno"),
+	}, pks)
+
+	// denoms after atom, in descending order
+	iter, err = indexedMap.Indexes.Denom.MatchRange(ctx, new(collections.Range[Denom]).StartExclusive("atom").Descending())
+	require.NoError(t, err)
+	kvs, err := NewKeyValueIterator(ctx, indexedMap, iter).KeyValues()
+	require.NoError(t, err)
+	require.Equal(t, []collections.KeyValue[collections.Pair[Address, Denom], Amount]{
+		{Key: collections.Join("address2", "osmo"), Value: 400},
+		{Key: collections.Join("address1", "osmo"), Value: 200},
+		{Key: collections.Join("address2", "juno"), Value: 300},
+	}, kvs)
+
+	// raw iteration yields the reversed pair keys
+	iter, err = indexedMap.Indexes.Denom.IterateRaw(ctx, nil, nil, collections.OrderAscending)
+	require.NoError(t, err)
+	key, err := iter.Key()
+	require.NoError(t, err)
+	require.Equal(t, collections.Join("atom", "address1"), key)
+	require.NoError(t, iter.Close())
+}
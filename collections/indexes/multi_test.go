@@ -60,3 +60,37 @@ func TestMultiIndex(t *testing.T) {
 	require.False(t, iter.Valid())
 	require.NoError(t, iter.Close())
 }
+
+func TestMultiIndex_MatchRange(t *testing.T) {
+	sk, ctx := deps()
+	schema := collections.NewSchemaBuilder(sk)
+
+	mi := NewMulti(schema, collections.NewPrefix(1), "multi_index", collections.StringKey, collections.Uint64Key, func(_ uint64, value company) (string, error) {
+		return value.City, nil
+	})
+
+	require.NoError(t, mi.Reference(ctx, 1, company{City: "milan"}, nil))
+	require.NoError(t, mi.Reference(ctx, 2, company{City: "new york"}, nil))
+	require.NoError(t, mi.Reference(ctx, 3, company{City: "milan"}, nil))
+	require.NoError(t, mi.Reference(ctx, 4, company{City: "rome"}, nil))
+
+	iter, err := mi.MatchRange(ctx, new(collections.Range[string]).StartInclusive("milan").EndExclusive("rome"))
+	require.NoError(t, err)
+	pks, err := iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 3, 2}, pks)
+
+	iter, err = mi.MatchRange(ctx, new(collections.Range[string]).StartExclusive("milan").Descending())
+	require.NoError(t, err)
+	pks, err = iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{4, 2}, pks)
+
+	// raw iteration yields the full reference keys
+	iter, err = mi.IterateRaw(ctx, nil, nil, collections.OrderAscending)
+	require.NoError(t, err)
+	key, err := iter.Key()
+	require.NoError(t, err)
+	require.Equal(t, collections.Join("milan", uint64(1)), key)
+	require.NoError(t, iter.Close())
+}
@@ -67,9 +67,19 @@ func (i *Unique[ReferenceKey, PrimaryKey, Value]) IterateRaw(ctx context.Context
 	return (UniqueIterator[ReferenceKey, PrimaryKey])(iter), nil
 }
 
+// KeyCodec returns the codec of the index keys, which are the reference keys.
+func (i *Unique[ReferenceKey, PrimaryKey, Value]) KeyCodec() codec.KeyCodec[ReferenceKey] {
+	return (*collections.GenericUniqueIndex[ReferenceKey, PrimaryKey, PrimaryKey, Value])(i).KeyCodec()
+}
+
 // UniqueIterator is an Iterator wrapper, that exposes only the functionality needed to work with Unique keys.
 type UniqueIterator[ReferenceKey, PrimaryKey any] collections.Iterator[ReferenceKey, PrimaryKey]
 
+// Key returns the current key of the index, which is the reference key.
+func (i UniqueIterator[ReferenceKey, PrimaryKey]) Key() (ReferenceKey, error) {
+	return (collections.Iterator[ReferenceKey, PrimaryKey])(i).Key()
+}
+
 // PrimaryKey returns the iterator's current primary key.
 func (i UniqueIterator[ReferenceKey, PrimaryKey]) PrimaryKey() (PrimaryKey, error) {
 	return (collections.Iterator[ReferenceKey, PrimaryKey])(i).Value()
@@ -43,4 +43,15 @@ func TestUniqueIndex(t *testing.T) {
 	require.Equal(t, uint64(1), id)
 	_, err = ui.MatchExact(ctx, 1_1) // assert old reference was removed
 	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	// raw iteration yields the reference keys
+	iter, err := ui.IterateRaw(ctx, nil, nil, collections.OrderAscending)
+	require.NoError(t, err)
+	refKey, err := iter.Key()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1_2), refKey)
+	pk, err := iter.PrimaryKey()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), pk)
+	require.NoError(t, iter.Close())
 }
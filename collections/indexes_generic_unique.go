@@ -120,3 +120,11 @@ func (i *GenericUniqueIndex[ReferencingKey, ReferencedKey, PrimaryKey, Value]) W
 ) error {
 	return i.refs.Walk(ctx, ranger, func(k ReferencingKey, v ReferencedKey) bool { return walkFunc(k, v) })
 }
+
+func (i *GenericUniqueIndex[ReferencingKey, ReferencedKey, PrimaryKey, Value]) KeyCodec() codec.KeyCodec[ReferencingKey] {
+	return i.refs.KeyCodec()
+}
+
+func (i *GenericUniqueIndex[ReferencingKey, ReferencedKey, PrimaryKey, Value]) ValueCodec() codec.ValueCodec[ReferencedKey] {
+	return i.refs.ValueCodec()
+}
@@ -124,6 +124,7 @@ func (r *Range[K]) Descending() *Range[K] {
 // test sentinel error
 var (
 	errOrder = errors.New("collections: invalid order")
+	errRange = errors.New("collections: invalid range")
 )
 
 func (r *Range[K]) RangeValues() (start *RangeKey[K], end *RangeKey[K], order Order, err error) {
@@ -32,22 +32,36 @@ func NewMap[K, V any](
 	keyCodec codec.KeyCodec[K],
 	valueCodec codec.ValueCodec[V],
 ) Map[K, V] {
-	m := Map[K, V]{
+	m := newMap(schemaBuilder, prefix.Bytes(), name, keyCodec, valueCodec)
+	schemaBuilder.addCollection(m)
+	return m
+}
+
+// newMap returns a Map which is not added to the schema, it is used by the collections
+// built on top of several Maps sharing a single prefix, like Vec and Queue.
+func newMap[K, V any](
+	schemaBuilder *SchemaBuilder,
+	prefix []byte,
+	name string,
+	keyCodec codec.KeyCodec[K],
+	valueCodec codec.ValueCodec[V],
+) Map[K, V] {
+	return Map[K, V]{
 		kc:     keyCodec,
 		vc:     valueCodec,
 		sa:     schemaBuilder.schema.storeAccessor,
-		prefix: prefix.Bytes(),
+		prefix: prefix,
 		name:   name,
 	}
-	schemaBuilder.addCollection(m)
-	return m
 }
 
-func (m Map[K, V]) getName() string {
+// GetName returns the name of the collection.
+func (m Map[K, V]) GetName() string {
 	return m.name
 }
 
-func (m Map[K, V]) getPrefix() []byte {
+// GetPrefix returns the prefix of the collection.
+func (m Map[K, V]) GetPrefix() []byte {
 	return m.prefix
 }
 
@@ -195,6 +209,41 @@ func (m Map[K, V]) KeyCodec() codec.KeyCodec[K] { return m.kc }
 // ValueCodec returns the Map's ValueCodec.
 func (m Map[K, V]) ValueCodec() codec.ValueCodec[V] { return m.vc }
 
+// KeyType returns the type identifier of the Map's keys.
+func (m Map[K, V]) KeyType() string { return m.kc.KeyType() }
+
+// ValueType returns the type identifier of the Map's values.
+func (m Map[K, V]) ValueType() string { return m.vc.ValueType() }
+
+// DecodeRawJSON decodes a raw key, without the Map's prefix, and a raw value
+// into the JSON representation of the Map's key and value.
+func (m Map[K, V]) DecodeRawJSON(key, value []byte) (keyJSON, valueJSON []byte, err error) {
+	read, k, err := m.kc.Decode(key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: key decode: %s", ErrEncoding, err)
+	}
+	if read != len(key) {
+		return nil, nil, fmt.Errorf("%w: key decode: read %d bytes out of %d", ErrEncoding, read, len(key))
+	}
+	keyJSON, err = m.kc.EncodeJSON(k)
+	if err != nil {
+		return nil, nil, err
+	}
+	if value == nil {
+		return keyJSON, nil, nil
+	}
+
+	v, err := m.vc.Decode(value)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: value decode: %s", ErrEncoding, err)
+	}
+	valueJSON, err = m.vc.EncodeJSON(v)
+	if err != nil {
+		return nil, nil, err
+	}
+	return keyJSON, valueJSON, nil
+}
+
 func encodeKeyWithPrefix[K any](prefix []byte, kc codec.KeyCodec[K], key K) ([]byte, error) {
 	prefixLen := len(prefix)
 	// preallocate buffer
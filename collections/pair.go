@@ -267,3 +267,56 @@ func (p *PairRange[K1, K2]) RangeValues() (start *RangeKey[Pair[K1, K2]], end *R
 	}
 	return p.start, p.end, p.order, nil
 }
+
+// NewPairK1Range creates a Ranger over all the pair keys whose first part is
+// within the provided range of K1 keys, for example:
+// NewPairK1Range[string, uint64](new(Range[string]).StartInclusive("a").EndExclusive("c"))
+// yields all the pairs whose K1 is bigger or equal to "a" and smaller than "c", whatever
+// their K2 is. The order of the provided range is retained.
+// A nil range yields all the pair keys. Prefix ranges are not supported, as K1 is
+// encoded in its non-terminal form, which does not retain the prefix semantics.
+// Unstable: API and methods are currently unstable.
+func NewPairK1Range[K1, K2 any](ranger Ranger[K1]) Ranger[Pair[K1, K2]] {
+	return pairK1Range[K1, K2]{ranger: ranger}
+}
+
+// pairK1Range is the Ranger returned by NewPairK1Range.
+type pairK1Range[K1, K2 any] struct {
+	ranger Ranger[K1]
+}
+
+func (p pairK1Range[K1, K2]) RangeValues() (start, end *RangeKey[Pair[K1, K2]], order Order, err error) {
+	if p.ranger == nil {
+		return nil, nil, OrderAscending, nil
+	}
+	k1Start, k1End, order, err := p.ranger.RangeValues()
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	start, err = pairK1RangeKey[K1, K2](k1Start)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	end, err = pairK1RangeKey[K1, K2](k1End)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	return start, end, order, nil
+}
+
+// pairK1RangeKey converts a range bound of K1 into the range bound of the pairs prefixed
+// by K1. An exact K1 bound matches the first pair prefixed by K1, and the bound following K1
+// matches the first pair after all the pairs prefixed by K1.
+func pairK1RangeKey[K1, K2 any](bound *RangeKey[K1]) (*RangeKey[Pair[K1, K2]], error) {
+	if bound == nil {
+		return nil, nil
+	}
+	switch bound.kind {
+	case rangeKeyExact:
+		return RangeKeyExact(PairPrefix[K1, K2](bound.key)), nil
+	case rangeKeyNext:
+		return RangeKeyPrefixEnd(PairPrefix[K1, K2](bound.key)), nil
+	default:
+		return nil, fmt.Errorf("%w: prefix ranges are not supported on the first part of a pair key", errRange)
+	}
+}
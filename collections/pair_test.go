@@ -67,3 +67,48 @@ func TestPairRange(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, []Pair[string, uint64]{Join("A", uint64(2)), Join("A", uint64(1))}, keys)
 }
+
+func TestPairK1Range(t *testing.T) {
+	sk, ctx := deps()
+	schema := NewSchemaBuilder(sk)
+	pc := PairKeyCodec(StringKey, Uint64Key)
+	m := NewMap(schema, NewPrefix(0), "pair", pc, Uint64Value)
+
+	require.NoError(t, m.Set(ctx, Join("A", uint64(0)), 0))
+	require.NoError(t, m.Set(ctx, Join("A", uint64(1)), 0))
+	require.NoError(t, m.Set(ctx, Join("AB", uint64(0)), 0))
+	require.NoError(t, m.Set(ctx, Join("B", uint64(0)), 0))
+	require.NoError(t, m.Set(ctx, Join("C", uint64(0)), 0))
+
+	// expect all the keys
+	iter, err := m.Iterate(ctx, NewPairK1Range[string, uint64](nil))
+	require.NoError(t, err)
+	keys, err := iter.Keys()
+	require.NoError(t, err)
+	require.Len(t, keys, 5)
+
+	// expect A0, A1, AB0, B0
+	iter, err = m.Iterate(ctx, NewPairK1Range[string, uint64](new(Range[string]).StartInclusive("A").EndInclusive("B")))
+	require.NoError(t, err)
+	keys, err = iter.Keys()
+	require.NoError(t, err)
+	require.Equal(t, []Pair[string, uint64]{Join("A", uint64(0)), Join("A", uint64(1)), Join("AB", uint64(0)), Join("B", uint64(0))}, keys)
+
+	// expect only AB0, as "A" is excluded, but not the keys it prefixes
+	iter, err = m.Iterate(ctx, NewPairK1Range[string, uint64](new(Range[string]).StartExclusive("A").EndExclusive("B")))
+	require.NoError(t, err)
+	keys, err = iter.Keys()
+	require.NoError(t, err)
+	require.Equal(t, []Pair[string, uint64]{Join("AB", uint64(0))}, keys)
+
+	// expect C0, B0
+	iter, err = m.Iterate(ctx, NewPairK1Range[string, uint64](new(Range[string]).StartInclusive("B").Descending()))
+	require.NoError(t, err)
+	keys, err = iter.Keys()
+	require.NoError(t, err)
+	require.Equal(t, []Pair[string, uint64]{Join("C", uint64(0)), Join("B", uint64(0))}, keys)
+
+	// prefix ranges are not supported
+	_, err = m.Iterate(ctx, NewPairK1Range[string, uint64](new(Range[string]).Prefix("A")))
+	require.ErrorIs(t, err, errRange)
+}
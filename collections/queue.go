@@ -0,0 +1,201 @@
+package collections
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"cosmossdk.io/collections/codec"
+)
+
+const (
+	queueHeadPrefix     byte = 0x0
+	queueElementsPrefix byte = 0x1
+	queueTailPrefix     byte = 0x2
+)
+
+// Queue represents a first in, first out queue of values, optionally bounded.
+// Values are pushed at the tail of the Queue and popped from its head, the
+// indexes of the head and the tail are saved under the prefix of the Queue,
+// along with the values.
+type Queue[V any] struct {
+	head     Item[uint64]
+	tail     Item[uint64]
+	elements Map[uint64, V]
+	capacity uint64
+
+	prefix []byte
+	name   string
+}
+
+// NewQueue instantiates a new Queue instance, given the value encoder of the values V,
+// and the maximum number of values of the Queue. A zero capacity means the Queue is unbounded.
+// Name and prefix must be unique within the schema and name must match the format specified by NameRegex, or
+// else this method will panic.
+func NewQueue[V any](
+	schema *SchemaBuilder,
+	prefix Prefix,
+	name string,
+	valueCodec codec.ValueCodec[V],
+	capacity uint64,
+) Queue[V] {
+	q := Queue[V]{
+		head:     (Item[uint64])(newMap[noKey](schema, subPrefix(prefix, queueHeadPrefix), name, noKey{}, Uint64Value)),
+		tail:     (Item[uint64])(newMap[noKey](schema, subPrefix(prefix, queueTailPrefix), name, noKey{}, Uint64Value)),
+		elements: newMap(schema, subPrefix(prefix, queueElementsPrefix), name, Uint64Key, valueCodec),
+		capacity: capacity,
+		prefix:   prefix.Bytes(),
+		name:     name,
+	}
+	schema.addCollection(q)
+	return q
+}
+
+// GetName returns the name of the collection.
+func (q Queue[V]) GetName() string { return q.name }
+
+// GetPrefix returns the prefix of the collection.
+func (q Queue[V]) GetPrefix() []byte { return q.prefix }
+
+// KeyType returns the type identifier of the Queue's indexes.
+func (q Queue[V]) KeyType() string { return Uint64Key.KeyType() }
+
+// ValueType returns the type identifier of the Queue's values.
+func (q Queue[V]) ValueType() string { return q.elements.ValueType() }
+
+// DecodeRawJSON decodes a raw key, without the Queue's prefix, and a raw value into
+// their JSON representation. The keys of the head and tail indexes are decoded as
+// "head" and "tail".
+func (q Queue[V]) DecodeRawJSON(key, value []byte) (keyJSON, valueJSON []byte, err error) {
+	switch {
+	case len(key) == 1 && key[0] == queueHeadPrefix:
+		return decodeRawJSONMeta("head", value)
+	case len(key) == 1 && key[0] == queueTailPrefix:
+		return decodeRawJSONMeta("tail", value)
+	case len(key) > 0 && key[0] == queueElementsPrefix:
+		return q.elements.DecodeRawJSON(key[1:], value)
+	default:
+		return nil, nil, fmt.Errorf("%w: unknown queue key %x", ErrEncoding, key)
+	}
+}
+
+// Capacity returns the maximum number of values of the Queue, zero if it is unbounded.
+func (q Queue[V]) Capacity() uint64 { return q.capacity }
+
+// Len returns the number of values in the Queue.
+// Errors on encoding issues.
+func (q Queue[V]) Len(ctx context.Context) (uint64, error) {
+	head, tail, err := q.bounds(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return tail - head, nil
+}
+
+// Push adds the value at the tail of the Queue.
+// Errors with ErrOutOfBounds if the Queue is full.
+func (q Queue[V]) Push(ctx context.Context, value V) error {
+	head, tail, err := q.bounds(ctx)
+	if err != nil {
+		return err
+	}
+	if q.capacity != 0 && tail-head >= q.capacity {
+		return fmt.Errorf("%w: queue %s is full, capacity is %d", ErrOutOfBounds, q.name, q.capacity)
+	}
+	err = q.elements.Set(ctx, tail, value)
+	if err != nil {
+		return err
+	}
+	return q.tail.Set(ctx, tail+1)
+}
+
+// Peek returns the value at the head of the Queue, without removing it.
+// Errors with ErrNotFound if the Queue is empty.
+func (q Queue[V]) Peek(ctx context.Context) (value V, err error) {
+	head, tail, err := q.bounds(ctx)
+	if err != nil {
+		return value, err
+	}
+	if head == tail {
+		return value, fmt.Errorf("%w: queue %s is empty", ErrNotFound, q.name)
+	}
+	return q.elements.Get(ctx, head)
+}
+
+// Pop removes and returns the value at the head of the Queue.
+// Errors with ErrNotFound if the Queue is empty.
+func (q Queue[V]) Pop(ctx context.Context) (value V, err error) {
+	head, tail, err := q.bounds(ctx)
+	if err != nil {
+		return value, err
+	}
+	if head == tail {
+		return value, fmt.Errorf("%w: queue %s is empty", ErrNotFound, q.name)
+	}
+	value, err = q.elements.Get(ctx, head)
+	if err != nil {
+		return value, err
+	}
+	err = q.elements.Remove(ctx, head)
+	if err != nil {
+		return value, err
+	}
+
+	// the indexes are reset once the queue is empty
+	if head+1 == tail {
+		err = q.head.Remove(ctx)
+		if err != nil {
+			return value, err
+		}
+		return value, q.tail.Remove(ctx)
+	}
+	return value, q.head.Set(ctx, head+1)
+}
+
+// Walk iterates over the values of the Queue from its head to its tail, calling
+// the provided walk function with each value. If the callback function returns
+// true then the walking is stopped.
+func (q Queue[V]) Walk(ctx context.Context, walkFunc func(value V) bool) error {
+	return walkElements(ctx, q.elements, func(_ uint64, value V) bool { return walkFunc(value) })
+}
+
+// bounds returns the indexes of the head and the tail of the Queue, the values
+// are saved from the head index included to the tail index excluded.
+func (q Queue[V]) bounds(ctx context.Context) (head, tail uint64, err error) {
+	head, err = q.head.Get(ctx)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return 0, 0, err
+	}
+	tail, err = q.tail.Get(ctx)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return 0, 0, err
+	}
+	return head, tail, nil
+}
+
+func (q Queue[V]) validateGenesis(reader io.Reader) error {
+	var length uint64
+	return decodeJSONValues(reader, q.elements.vc, func(V) error {
+		length++
+		if q.capacity != 0 && length > q.capacity {
+			return fmt.Errorf("%w: queue %s has more than %d values", ErrOutOfBounds, q.name, q.capacity)
+		}
+		return nil
+	})
+}
+
+func (q Queue[V]) importGenesis(ctx context.Context, reader io.Reader) error {
+	return decodeJSONValues(reader, q.elements.vc, func(value V) error {
+		return q.Push(ctx, value)
+	})
+}
+
+func (q Queue[V]) exportGenesis(ctx context.Context, writer io.Writer) error {
+	return encodeJSONValues(ctx, q.elements, writer)
+}
+
+func (q Queue[V]) defaultGenesis(writer io.Writer) error {
+	_, err := writer.Write([]byte(`[]`))
+	return err
+}
@@ -0,0 +1,147 @@
+package collections_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/colltest"
+)
+
+type task struct {
+	ID   uint64
+	Name string
+}
+
+func TestQueue(t *testing.T) {
+	sk, ctx := colltest.MockStore()
+	schemaBuilder := collections.NewSchemaBuilder(sk)
+	queue := collections.NewQueue(schemaBuilder, collections.NewPrefix("queue"), "queue", colltest.MockValueCodec[task](), 3)
+	_, err := schemaBuilder.Build()
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), queue.Capacity())
+
+	// empty
+	_, err = queue.Peek(ctx)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	_, err = queue.Pop(ctx)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	require.NoError(t, queue.Walk(ctx, func(task) bool { panic("must not be called") }))
+
+	// push up to the capacity
+	require.NoError(t, queue.Push(ctx, task{ID: 1, Name: "a"}))
+	require.NoError(t, queue.Push(ctx, task{ID: 2, Name: "b"}))
+	require.NoError(t, queue.Push(ctx, task{ID: 3, Name: "c"}))
+	require.ErrorIs(t, queue.Push(ctx, task{ID: 4, Name: "d"}), collections.ErrOutOfBounds)
+	length, err := queue.Len(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), length)
+
+	// peek and pop in order
+	v, err := queue.Peek(ctx)
+	require.NoError(t, err)
+	require.Equal(t, task{ID: 1, Name: "a"}, v)
+	v, err = queue.Pop(ctx)
+	require.NoError(t, err)
+	require.Equal(t, task{ID: 1, Name: "a"}, v)
+
+	// a value can be pushed again
+	require.NoError(t, queue.Push(ctx, task{ID: 4, Name: "d"}))
+	var ids []uint64
+	require.NoError(t, queue.Walk(ctx, func(value task) bool {
+		ids = append(ids, value.ID)
+		return false
+	}))
+	require.Equal(t, []uint64{2, 3, 4}, ids)
+
+	for _, expected := range []uint64{2, 3, 4} {
+		v, err = queue.Pop(ctx)
+		require.NoError(t, err)
+		require.Equal(t, expected, v.ID)
+	}
+	length, err = queue.Len(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), length)
+
+	// the store is left empty
+	it, err := sk.OpenKVStore(ctx).Iterator(nil, nil)
+	require.NoError(t, err)
+	defer it.Close()
+	require.False(t, it.Valid())
+}
+
+func TestQueueUnbounded(t *testing.T) {
+	sk, ctx := colltest.MockStore()
+	schemaBuilder := collections.NewSchemaBuilder(sk)
+	queue := collections.NewQueue(schemaBuilder, collections.NewPrefix("queue"), "queue", collections.Uint64Value, 0)
+	_, err := schemaBuilder.Build()
+	require.NoError(t, err)
+
+	for i := uint64(0); i < 300; i++ {
+		require.NoError(t, queue.Push(ctx, i))
+	}
+	length, err := queue.Len(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(300), length)
+}
+
+func TestQueueGenesis(t *testing.T) {
+	sk, ctx := colltest.MockStore()
+	schemaBuilder := collections.NewSchemaBuilder(sk)
+	queue := collections.NewQueue(schemaBuilder, collections.NewPrefix("queue"), "queue", collections.StringValue, 2)
+	schema, err := schemaBuilder.Build()
+	require.NoError(t, err)
+
+	target := newGenesisTarget()
+	require.NoError(t, schema.DefaultGenesis(target.target))
+	require.Equal(t, `[]`, target.fields["queue"].String())
+
+	require.ErrorIs(t, schema.ValidateGenesis(genesisSource(map[string]string{"queue": `["a","b","c"]`})), collections.ErrOutOfBounds)
+	require.NoError(t, schema.ValidateGenesis(genesisSource(map[string]string{"queue": `["a","b"]`})))
+	require.NoError(t, schema.InitGenesis(ctx, genesisSource(map[string]string{"queue": `["a","b"]`})))
+
+	// the exported queue starts at its head
+	_, err = queue.Pop(ctx)
+	require.NoError(t, err)
+	require.NoError(t, queue.Push(ctx, "c"))
+	target = newGenesisTarget()
+	require.NoError(t, schema.ExportGenesis(ctx, target.target))
+	require.Equal(t, `["b","c"]`, target.fields["queue"].String())
+}
+
+// genesisSource returns a genesis source reading the JSON of the fields.
+func genesisSource(fields map[string]string) func(field string) (io.ReadCloser, error) {
+	return func(field string) (io.ReadCloser, error) {
+		fieldJSON, ok := fields[field]
+		if !ok {
+			return nil, fmt.Errorf("unknown genesis field %s", field)
+		}
+		return io.NopCloser(strings.NewReader(fieldJSON)), nil
+	}
+}
+
+// genesisTarget records the JSON written to the fields of a genesis target.
+type genesisTarget struct {
+	fields map[string]*bytes.Buffer
+}
+
+func newGenesisTarget() *genesisTarget {
+	return &genesisTarget{fields: make(map[string]*bytes.Buffer)}
+}
+
+func (g *genesisTarget) target(field string) (io.WriteCloser, error) {
+	buf := new(bytes.Buffer)
+	g.fields[field] = buf
+	return nopWriteCloser{buf}, nil
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
@@ -1,6 +1,7 @@
 package collections
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"regexp"
@@ -85,8 +86,8 @@ func (s *SchemaBuilder) Build() (Schema, error) {
 }
 
 func (s *SchemaBuilder) addCollection(collection collection) {
-	prefix := collection.getPrefix()
-	name := collection.getName()
+	prefix := collection.GetPrefix()
+	name := collection.GetName()
 
 	if _, ok := s.schema.collectionsByPrefix[string(prefix)]; ok {
 		s.appendError(fmt.Errorf("prefix %v already taken within schema", prefix))
@@ -122,9 +123,8 @@ var nameRegex = regexp.MustCompile("^" + NameRegex + "$")
 
 // Schema specifies a group of collections stored within the storage specified
 // by a single store key. All the collections within the schema must have a
-// unique binary prefix and human-readable name. Schema will eventually include
-// methods for importing/exporting genesis data and for schema reflection for
-// clients.
+// unique binary prefix and human-readable name. Schema implements the genesis
+// import/export of its collections, and exposes them for schema reflection.
 type Schema struct {
 	storeAccessor       func(context.Context) store.KVStore
 	collectionsOrdered  []string
@@ -279,6 +279,31 @@ func (s Schema) exportGenesis(ctx context.Context, target appmodule.GenesisTarge
 	return coll.exportGenesis(ctx, wc)
 }
 
+// ListCollections returns all the collections of the schema, ordered by name.
+func (s Schema) ListCollections() []Collection {
+	colls := make([]Collection, len(s.collectionsOrdered))
+	for i, name := range s.collectionsOrdered {
+		colls[i] = s.collectionsByName[name]
+	}
+	return colls
+}
+
+// CollectionByName returns the collection of the schema with the provided name.
+func (s Schema) CollectionByName(name string) (Collection, error) {
+	return s.getCollection(name)
+}
+
+// CollectionByKey returns the collection of the schema which contains the provided raw store key,
+// meaning the collection whose prefix is a prefix of the key.
+func (s Schema) CollectionByKey(key []byte) (Collection, error) {
+	for prefix, coll := range s.collectionsByPrefix {
+		if bytes.HasPrefix(key, []byte(prefix)) {
+			return coll, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: no collection for key 0x%x", ErrNotFound, key)
+}
+
 func (s Schema) getCollection(name string) (collection, error) {
 	coll, ok := s.collectionsByName[name]
 	if !ok {
@@ -70,3 +70,63 @@ func TestSchemaBuilderCantBeUsedAfterBuild(t *testing.T) {
 		NewMap(schemaBuilder, NewPrefix(2), "def", Uint64Key, Uint64Value)
 	})
 }
+
+func TestSchemaReflection(t *testing.T) {
+	sk, ctx := deps()
+	schemaBuilder := NewSchemaBuilder(sk)
+	m := NewMap(schemaBuilder, NewPrefix(1), "balances", PairKeyCodec(StringKey, StringKey), Uint64Value)
+	item := NewItem(schemaBuilder, NewPrefix(2), "params", StringValue)
+	ks := NewKeySet(schemaBuilder, NewPrefix(3), "allowed", Uint64Key)
+	schema, err := schemaBuilder.Build()
+	require.NoError(t, err)
+
+	colls := schema.ListCollections()
+	require.Len(t, colls, 3)
+	require.Equal(t, "allowed", colls[0].GetName())
+	require.Equal(t, "balances", colls[1].GetName())
+	require.Equal(t, []byte{1}, colls[1].GetPrefix())
+	require.Equal(t, "Pair[string, string]", colls[1].KeyType())
+	require.Equal(t, "uint64", colls[1].ValueType())
+	require.Equal(t, "params", colls[2].GetName())
+
+	coll, err := schema.CollectionByName("params")
+	require.NoError(t, err)
+	require.Equal(t, "params", coll.GetName())
+	_, err = schema.CollectionByName("unknown")
+	require.Error(t, err)
+
+	// decode the raw entries of the store
+	require.NoError(t, m.Set(ctx, Join("address", "atom"), 100))
+	require.NoError(t, item.Set(ctx, "value"))
+	require.NoError(t, ks.Set(ctx, 7))
+
+	expected := map[string][2]string{
+		"balances": {`["address","atom"]`, `"100"`},
+		"params":   {`"item"`, `"value"`},
+		"allowed":  {`"7"`, ``},
+	}
+	iter, err := sk.OpenKVStore(ctx).Iterator(nil, nil)
+	require.NoError(t, err)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		coll, err := schema.CollectionByKey(iter.Key())
+		require.NoError(t, err)
+		keyJSON, valueJSON, err := coll.DecodeRawJSON(iter.Key()[len(coll.GetPrefix()):], iter.Value())
+		require.NoError(t, err)
+		require.Equal(t, expected[coll.GetName()][0], string(keyJSON))
+		require.Equal(t, expected[coll.GetName()][1], string(valueJSON))
+	}
+
+	_, err = schema.CollectionByKey([]byte{4})
+	require.ErrorIs(t, err, ErrNotFound)
+
+	// a nil value, as the value of a deleted entry, only decodes the key
+	keyJSON, valueJSON, err := colls[2].DecodeRawJSON(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, `"item"`, string(keyJSON))
+	require.Nil(t, valueJSON)
+
+	// trailing bytes after the key are rejected
+	_, _, err = colls[0].DecodeRawJSON([]byte{0, 0, 0, 0, 0, 0, 0, 1, 0}, nil)
+	require.ErrorIs(t, err, ErrEncoding)
+}
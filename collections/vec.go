@@ -0,0 +1,246 @@
+package collections
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"cosmossdk.io/collections/codec"
+)
+
+const (
+	vecLengthPrefix   byte = 0x0
+	vecElementsPrefix byte = 0x1
+)
+
+// Vec represents a list of values indexed from 0 to its length excluded,
+// like a slice. Values can be appended, accessed by index, and removed
+// from the end of the Vec or by swapping them with the last value.
+// The length and the values are saved under the prefix of the Vec.
+type Vec[V any] struct {
+	length   Item[uint64]
+	elements Map[uint64, V]
+
+	prefix []byte
+	name   string
+}
+
+// NewVec instantiates a new Vec instance, given the value encoder of the values V.
+// Name and prefix must be unique within the schema and name must match the format specified by NameRegex, or
+// else this method will panic.
+func NewVec[V any](
+	schema *SchemaBuilder,
+	prefix Prefix,
+	name string,
+	valueCodec codec.ValueCodec[V],
+) Vec[V] {
+	v := Vec[V]{
+		length:   (Item[uint64])(newMap[noKey](schema, subPrefix(prefix, vecLengthPrefix), name, noKey{}, Uint64Value)),
+		elements: newMap(schema, subPrefix(prefix, vecElementsPrefix), name, Uint64Key, valueCodec),
+		prefix:   prefix.Bytes(),
+		name:     name,
+	}
+	schema.addCollection(v)
+	return v
+}
+
+// GetName returns the name of the collection.
+func (v Vec[V]) GetName() string { return v.name }
+
+// GetPrefix returns the prefix of the collection.
+func (v Vec[V]) GetPrefix() []byte { return v.prefix }
+
+// KeyType returns the type identifier of the Vec's indexes.
+func (v Vec[V]) KeyType() string { return Uint64Key.KeyType() }
+
+// ValueType returns the type identifier of the Vec's values.
+func (v Vec[V]) ValueType() string { return v.elements.ValueType() }
+
+// DecodeRawJSON decodes a raw key, without the Vec's prefix, and a raw value into
+// their JSON representation. The key of the length of the Vec is decoded as "length".
+func (v Vec[V]) DecodeRawJSON(key, value []byte) (keyJSON, valueJSON []byte, err error) {
+	switch {
+	case len(key) == 1 && key[0] == vecLengthPrefix:
+		return decodeRawJSONMeta("length", value)
+	case len(key) > 0 && key[0] == vecElementsPrefix:
+		return v.elements.DecodeRawJSON(key[1:], value)
+	default:
+		return nil, nil, fmt.Errorf("%w: unknown vec key %x", ErrEncoding, key)
+	}
+}
+
+// Len returns the length of the Vec.
+// Errors on encoding issues.
+func (v Vec[V]) Len(ctx context.Context) (uint64, error) {
+	length, err := v.length.Get(ctx)
+	switch {
+	case err == nil:
+		return length, nil
+	case errors.Is(err, ErrNotFound):
+		return 0, nil
+	default:
+		return 0, err
+	}
+}
+
+// Push appends the value at the end of the Vec.
+// Errors on encoding issues.
+func (v Vec[V]) Push(ctx context.Context, value V) error {
+	length, err := v.Len(ctx)
+	if err != nil {
+		return err
+	}
+	err = v.elements.Set(ctx, length, value)
+	if err != nil {
+		return err
+	}
+	return v.length.Set(ctx, length+1)
+}
+
+// Pop removes and returns the last value of the Vec.
+// Errors with ErrNotFound if the Vec is empty.
+func (v Vec[V]) Pop(ctx context.Context) (value V, err error) {
+	length, err := v.Len(ctx)
+	if err != nil {
+		return value, err
+	}
+	if length == 0 {
+		return value, fmt.Errorf("%w: vec %s is empty", ErrNotFound, v.name)
+	}
+	value, err = v.elements.Get(ctx, length-1)
+	if err != nil {
+		return value, err
+	}
+	return value, v.truncate(ctx, length-1)
+}
+
+// Get returns the value at the provided index.
+// Errors with ErrOutOfBounds if the index is not lower than the length of the Vec.
+func (v Vec[V]) Get(ctx context.Context, index uint64) (value V, err error) {
+	if _, err = v.checkIndex(ctx, index); err != nil {
+		return value, err
+	}
+	return v.elements.Get(ctx, index)
+}
+
+// Set replaces the value at the provided index.
+// Errors with ErrOutOfBounds if the index is not lower than the length of the Vec.
+func (v Vec[V]) Set(ctx context.Context, index uint64, value V) error {
+	if _, err := v.checkIndex(ctx, index); err != nil {
+		return err
+	}
+	return v.elements.Set(ctx, index, value)
+}
+
+// SwapRemove removes and returns the value at the provided index, the last value
+// of the Vec takes its place. It does not preserve the order of the values, but
+// does not shift them either.
+// Errors with ErrOutOfBounds if the index is not lower than the length of the Vec.
+func (v Vec[V]) SwapRemove(ctx context.Context, index uint64) (value V, err error) {
+	length, err := v.checkIndex(ctx, index)
+	if err != nil {
+		return value, err
+	}
+	value, err = v.elements.Get(ctx, index)
+	if err != nil {
+		return value, err
+	}
+	last := length - 1
+	if index != last {
+		lastValue, err := v.elements.Get(ctx, last)
+		if err != nil {
+			return value, err
+		}
+		err = v.elements.Set(ctx, index, lastValue)
+		if err != nil {
+			return value, err
+		}
+	}
+	return value, v.truncate(ctx, last)
+}
+
+// Walk iterates over the values of the Vec in order, calling the provided walk
+// function with the index and the value. If the callback function returns true
+// then the walking is stopped.
+func (v Vec[V]) Walk(ctx context.Context, walkFunc func(index uint64, value V) bool) error {
+	return walkElements(ctx, v.elements, walkFunc)
+}
+
+// checkIndex returns the length of the Vec, or an error if the index is out of bounds.
+func (v Vec[V]) checkIndex(ctx context.Context, index uint64) (uint64, error) {
+	length, err := v.Len(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if index >= length {
+		return 0, fmt.Errorf("%w: index %d, length of vec %s is %d", ErrOutOfBounds, index, v.name, length)
+	}
+	return length, nil
+}
+
+// truncate removes the last value of the Vec, the provided length being the new length.
+func (v Vec[V]) truncate(ctx context.Context, length uint64) error {
+	err := v.elements.Remove(ctx, length)
+	if err != nil {
+		return err
+	}
+	if length == 0 {
+		return v.length.Remove(ctx)
+	}
+	return v.length.Set(ctx, length)
+}
+
+func (v Vec[V]) validateGenesis(reader io.Reader) error {
+	return decodeJSONValues(reader, v.elements.vc, func(V) error { return nil })
+}
+
+func (v Vec[V]) importGenesis(ctx context.Context, reader io.Reader) error {
+	return decodeJSONValues(reader, v.elements.vc, func(value V) error {
+		return v.Push(ctx, value)
+	})
+}
+
+func (v Vec[V]) exportGenesis(ctx context.Context, writer io.Writer) error {
+	return encodeJSONValues(ctx, v.elements, writer)
+}
+
+func (v Vec[V]) defaultGenesis(writer io.Writer) error {
+	_, err := writer.Write([]byte(`[]`))
+	return err
+}
+
+// subPrefix returns the prefix extended with the provided byte, without modifying it.
+func subPrefix(prefix Prefix, b byte) []byte {
+	bz := make([]byte, 0, len(prefix)+1)
+	bz = append(bz, prefix...)
+	return append(bz, b)
+}
+
+// decodeRawJSONMeta decodes the raw uint64 value of a metadata key, like the length of a Vec.
+func decodeRawJSONMeta(name string, value []byte) (keyJSON, valueJSON []byte, err error) {
+	keyJSON = []byte(`"` + name + `"`)
+	if value == nil {
+		return keyJSON, nil, nil
+	}
+
+	n, err := Uint64Value.Decode(value)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: value decode: %s", ErrEncoding, err)
+	}
+	valueJSON, err = Uint64Value.EncodeJSON(n)
+	if err != nil {
+		return nil, nil, err
+	}
+	return keyJSON, valueJSON, nil
+}
+
+// walkElements walks over the elements of a Vec or a Queue, an empty collection
+// is not an error.
+func walkElements[V any](ctx context.Context, elements Map[uint64, V], walkFunc func(index uint64, value V) bool) error {
+	err := elements.Walk(ctx, nil, walkFunc)
+	if errors.Is(err, ErrInvalidIterator) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,136 @@
+package collections_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/colltest"
+)
+
+func TestVec(t *testing.T) {
+	sk, ctx := colltest.MockStore()
+	schemaBuilder := collections.NewSchemaBuilder(sk)
+	vec := collections.NewVec(schemaBuilder, collections.NewPrefix("vec"), "vec", colltest.MockValueCodec[string]())
+	_, err := schemaBuilder.Build()
+	require.NoError(t, err)
+
+	// empty
+	length, err := vec.Len(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), length)
+	_, err = vec.Pop(ctx)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	_, err = vec.Get(ctx, 0)
+	require.ErrorIs(t, err, collections.ErrOutOfBounds)
+	require.NoError(t, vec.Walk(ctx, func(uint64, string) bool { panic("must not be called") }))
+
+	// push
+	for _, v := range []string{"a", "b", "c", "d"} {
+		require.NoError(t, vec.Push(ctx, v))
+	}
+	length, err = vec.Len(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), length)
+
+	// get and set
+	v, err := vec.Get(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, "b", v)
+	require.NoError(t, vec.Set(ctx, 1, "B"))
+	require.ErrorIs(t, vec.Set(ctx, 4, "e"), collections.ErrOutOfBounds)
+
+	// swap remove
+	v, err = vec.SwapRemove(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, "a", v)
+	require.Equal(t, []string{"d", "B", "c"}, collectVec(t, ctx, vec))
+	v, err = vec.SwapRemove(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, "c", v)
+	require.Equal(t, []string{"d", "B"}, collectVec(t, ctx, vec))
+	_, err = vec.SwapRemove(ctx, 2)
+	require.ErrorIs(t, err, collections.ErrOutOfBounds)
+
+	// pop
+	v, err = vec.Pop(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "B", v)
+	v, err = vec.Pop(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "d", v)
+	length, err = vec.Len(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), length)
+
+	// the store is left empty
+	it, err := sk.OpenKVStore(ctx).Iterator(nil, nil)
+	require.NoError(t, err)
+	defer it.Close()
+	require.False(t, it.Valid())
+}
+
+func TestVecGenesis(t *testing.T) {
+	sk, ctx := colltest.MockStore()
+	schemaBuilder := collections.NewSchemaBuilder(sk)
+	vec := collections.NewVec(schemaBuilder, collections.NewPrefix("vec"), "vec", collections.Uint64Value)
+	schema, err := schemaBuilder.Build()
+	require.NoError(t, err)
+
+	target := newGenesisTarget()
+	require.NoError(t, schema.DefaultGenesis(target.target))
+	require.Equal(t, `[]`, target.fields["vec"].String())
+
+	require.Error(t, schema.ValidateGenesis(genesisSource(map[string]string{"vec": `[{"key":"1"}]`})))
+	require.NoError(t, schema.ValidateGenesis(genesisSource(map[string]string{"vec": `["3","1","2"]`})))
+	require.NoError(t, schema.InitGenesis(ctx, genesisSource(map[string]string{"vec": `["3","1","2"]`})))
+	require.Equal(t, []uint64{3, 1, 2}, collectVec(t, ctx, vec))
+
+	target = newGenesisTarget()
+	require.NoError(t, schema.ExportGenesis(ctx, target.target))
+	require.Equal(t, `["3","1","2"]`, target.fields["vec"].String())
+}
+
+func TestVecDecodeRawJSON(t *testing.T) {
+	sk, ctx := colltest.MockStore()
+	schemaBuilder := collections.NewSchemaBuilder(sk)
+	vec := collections.NewVec(schemaBuilder, collections.NewPrefix("vec"), "vec", collections.Uint64Value)
+	schema, err := schemaBuilder.Build()
+	require.NoError(t, err)
+	require.NoError(t, vec.Push(ctx, 7))
+
+	coll, err := schema.CollectionByName("vec")
+	require.NoError(t, err)
+	require.Equal(t, "uint64", coll.KeyType())
+
+	var entries []string
+	it, err := sk.OpenKVStore(ctx).Iterator(nil, nil)
+	require.NoError(t, err)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		keyJSON, valueJSON, err := coll.DecodeRawJSON(it.Key()[len(coll.GetPrefix()):], it.Value())
+		require.NoError(t, err)
+		entries = append(entries, string(keyJSON)+":"+string(valueJSON))
+	}
+	require.Equal(t, []string{`"length":"1"`, `"0":"7"`}, entries)
+
+	keyJSON, valueJSON, err := coll.DecodeRawJSON([]byte{0x0}, nil)
+	require.NoError(t, err)
+	require.Equal(t, `"length"`, string(keyJSON))
+	require.Nil(t, valueJSON)
+
+	_, _, err = coll.DecodeRawJSON([]byte{0x5}, nil)
+	require.ErrorIs(t, err, collections.ErrEncoding)
+}
+
+func collectVec[V any](t *testing.T, ctx context.Context, vec collections.Vec[V]) []V {
+	t.Helper()
+	var values []V
+	require.NoError(t, vec.Walk(ctx, func(_ uint64, value V) bool {
+		values = append(values, value)
+		return false
+	}))
+	return values
+}
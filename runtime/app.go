@@ -15,7 +15,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
+	gaspriceservice "github.com/cosmos/cosmos-sdk/client/grpc/gasprice"
+	mempoolservice "github.com/cosmos/cosmos-sdk/client/grpc/mempool"
 	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
+	statediffservice "github.com/cosmos/cosmos-sdk/client/grpc/statediff"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/server/api"
@@ -147,13 +150,22 @@ func (a *App) RegisterAPIRoutes(apiSvr *api.Server, _ config.APIConfig) {
 	// Register node gRPC service for grpc-gateway.
 	nodeservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
+	// Register state diff gRPC service for grpc-gateway.
+	statediffservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+
+	// Register mempool gRPC service for grpc-gateway.
+	mempoolservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+
+	// Register gas price gRPC service for grpc-gateway.
+	gaspriceservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+
 	// Register grpc-gateway routes for all modules.
 	a.basicManager.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 }
 
 // RegisterTxService implements the Application.RegisterTxService method.
 func (a *App) RegisterTxService(clientCtx client.Context) {
-	authtx.RegisterTxService(a.GRPCQueryRouter(), clientCtx, a.Simulate, a.interfaceRegistry)
+	authtx.RegisterTxService(a.GRPCQueryRouter(), clientCtx, a.Simulate, a.SimulateBundle, a.interfaceRegistry)
 }
 
 // RegisterTendermintService implements the Application.RegisterTendermintService method.
@@ -166,9 +178,20 @@ func (a *App) RegisterTendermintService(clientCtx client.Context) {
 	)
 }
 
-// RegisterNodeService registers the node gRPC service on the app gRPC router.
+// RegisterNodeService registers the node and gas price gRPC services on the app
+// gRPC router, the state diff gRPC service when the state diff is enabled, and
+// the mempool gRPC service when the mempool can be inspected.
 func (a *App) RegisterNodeService(clientCtx client.Context) {
 	nodeservice.RegisterNodeService(clientCtx, a.GRPCQueryRouter())
+	gaspriceservice.RegisterGasPriceService(a.GRPCQueryRouter(), a.GasPriceHistory())
+
+	if stateDiff := a.StateDiff(); stateDiff != nil {
+		statediffservice.RegisterStateDiffService(a.GRPCQueryRouter(), stateDiff, a.ModuleManager.CollectionsSchemas())
+	}
+
+	if inspector, ok := a.MempoolInspector(); ok && clientCtx.TxConfig != nil {
+		mempoolservice.RegisterMempoolService(a.GRPCQueryRouter(), inspector, clientCtx.TxConfig.TxEncoder())
+	}
 }
 
 // Configurator returns the app's configurator.
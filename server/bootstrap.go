@@ -0,0 +1,137 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/log"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/light"
+	"github.com/cometbft/cometbft/node"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/statesync"
+	"github.com/cometbft/cometbft/store"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	servercmtlog "github.com/cosmos/cosmos-sdk/server/log"
+)
+
+// BootstrapCometBFTState initializes the empty CometBFT state and block stores of a node at
+// the given height, so that it starts from an application state restored at this height,
+// for example from a local snapshot, without state syncing from peers.
+//
+// The state is built from light blocks fetched from the RPC servers of the [statesync]
+// configuration, and verified by a light client with its trust options. The app hash of
+// the chain at the height must be the provided app hash, the one of the restored state.
+// The block at the height is saved as well, CometBFT requiring the heights of its stores
+// to match at startup.
+func BootstrapCometBFTState(ctx context.Context, cfg *cmtcfg.Config, logger log.Logger, height int64, appHash []byte) error {
+	if height <= 0 {
+		return fmt.Errorf("invalid height %d", height)
+	}
+	if cfg.StateSync.TrustHeight <= 0 {
+		return errors.New("statesync trust_height is required to bootstrap the CometBFT state")
+	}
+	trustHash, err := hex.DecodeString(cfg.StateSync.TrustHash)
+	if err != nil || len(trustHash) == 0 {
+		return fmt.Errorf("invalid statesync trust_hash %q", cfg.StateSync.TrustHash)
+	}
+
+	blockStoreDB, err := node.DefaultDBProvider(&node.DBContext{ID: "blockstore", Config: cfg})
+	if err != nil {
+		return err
+	}
+	defer blockStoreDB.Close()
+	blockStore := store.NewBlockStore(blockStoreDB)
+	if blockStore.Height() != 0 {
+		return fmt.Errorf("the block store is not empty, its height is %d", blockStore.Height())
+	}
+
+	stateDB, err := node.DefaultDBProvider(&node.DBContext{ID: "state", Config: cfg})
+	if err != nil {
+		return err
+	}
+	defer stateDB.Close()
+	genState, _, err := node.LoadStateFromDBOrGenesisDocProvider(stateDB, getGenDocProvider(cfg))
+	if err != nil {
+		return err
+	}
+	if genState.LastBlockHeight != 0 {
+		return fmt.Errorf("the state store is not empty, its height is %d", genState.LastBlockHeight)
+	}
+
+	stateProvider, err := statesync.NewLightClientStateProvider(
+		ctx,
+		genState.ChainID,
+		genState.Version,
+		genState.InitialHeight,
+		cfg.StateSync.RPCServers,
+		light.TrustOptions{
+			Period: cfg.StateSync.TrustPeriod,
+			Height: cfg.StateSync.TrustHeight,
+			Hash:   trustHash,
+		},
+		servercmtlog.CometZeroLogWrapper{Logger: logger.With("module", "light")},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to set up the light client: %w", err)
+	}
+
+	state, err := stateProvider.State(ctx, uint64(height))
+	if err != nil {
+		return fmt.Errorf("failed to get the state at height %d: %w", height, err)
+	}
+	if !bytes.Equal(appHash, state.AppHash) {
+		return fmt.Errorf("the app hash %X of the chain at height %d does not match the restored app hash %X",
+			state.AppHash, height, appHash)
+	}
+	commit, err := stateProvider.Commit(ctx, uint64(height))
+	if err != nil {
+		return fmt.Errorf("failed to get the commit at height %d: %w", height, err)
+	}
+
+	// the block is verified against the block ID of the state verified by the light client
+	block, err := fetchBlock(ctx, cfg.StateSync.RPCServers[0], height)
+	if err != nil {
+		return err
+	}
+	parts, err := block.MakePartSet(cmttypes.BlockPartSizeBytes)
+	if err != nil {
+		return err
+	}
+	blockID := cmttypes.BlockID{Hash: block.Hash(), PartSetHeader: parts.Header()}
+	if !blockID.Equals(state.LastBlockID) {
+		return fmt.Errorf("the block at height %d does not match the verified block ID %v", height, state.LastBlockID)
+	}
+
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+		DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
+	})
+	if err := stateStore.Bootstrap(state); err != nil {
+		return fmt.Errorf("failed to bootstrap the state: %w", err)
+	}
+	blockStore.SaveBlock(block, parts, commit)
+
+	return nil
+}
+
+// fetchBlock fetches the block at the given height from a CometBFT RPC server.
+func fetchBlock(ctx context.Context, server string, height int64) (*cmttypes.Block, error) {
+	if !strings.Contains(server, "://") {
+		server = "http://" + server
+	}
+	client, err := rpchttp.New(server, "/websocket")
+	if err != nil {
+		return nil, fmt.Errorf("failed to set up the RPC client: %w", err)
+	}
+	res, err := client.Block(ctx, &height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch the block at height %d: %w", height, err)
+	}
+	return res.Block, nil
+}
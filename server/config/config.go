@@ -7,6 +7,7 @@ import (
 
 	"github.com/spf13/viper"
 
+	storecache "cosmossdk.io/store/cache"
 	pruningtypes "cosmossdk.io/store/pruning/types"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -39,10 +40,31 @@ type BaseConfig struct {
 	// specified in this config (e.g. 0.25token1;0.0001token2).
 	MinGasPrices string `mapstructure:"minimum-gas-prices"`
 
+	// GasPriceBlocks defines the number of recent blocks whose gas prices are
+	// served by the gas price gRPC service. The gas prices paid in the blocks are
+	// not recorded if it is 0.
+	GasPriceBlocks uint64 `mapstructure:"gas-price-blocks"`
+
+	// BundleMaxTxs defines the maximum number of transactions of a bundle
+	// simulated by the tx gRPC service. It is not bounded if it is 0.
+	BundleMaxTxs uint64 `mapstructure:"bundle-max-txs"`
+
+	// BundleMaxGas defines the maximum gas used by the transactions of a bundle
+	// simulated by the tx gRPC service. It is not bounded if it is 0.
+	BundleMaxGas uint64 `mapstructure:"bundle-max-gas"`
+
 	Pruning           string `mapstructure:"pruning"`
 	PruningKeepRecent string `mapstructure:"pruning-keep-recent"`
 	PruningInterval   string `mapstructure:"pruning-interval"`
 
+	// PruningConcurrency defines the number of stores pruned concurrently in the
+	// background. The stores are pruned during Commit if it is 0.
+	PruningConcurrency int `mapstructure:"pruning-concurrency"`
+
+	// WriteAheadLog enables the write-ahead log of the commits of the
+	// multi-store, used to complete a commit interrupted by a crash on restart.
+	WriteAheadLog bool `mapstructure:"write-ahead-log"`
+
 	// HaltHeight contains a non-zero block height at which a node will gracefully
 	// halt and shutdown that can be used to assist upgrades and testing.
 	//
@@ -88,6 +110,10 @@ type BaseConfig struct {
 	// IAVLLazyLoading enable/disable the lazy loading of iavl store.
 	IAVLLazyLoading bool `mapstructure:"iavl-lazy-loading"`
 
+	// ParallelTxWorkers defines the number of workers executing the transactions
+	// of a block concurrently. Parallel execution is disabled if it is 0.
+	ParallelTxWorkers int `mapstructure:"parallel-tx-workers"`
+
 	// AppDBBackend defines the type of Database to use for the application and snapshots databases.
 	// An empty string indicates that the CometBFT config's DBBackend value should be used.
 	AppDBBackend string `mapstructure:"app-db-backend"`
@@ -158,6 +184,47 @@ type StateSyncConfig struct {
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
 }
 
+// StoreCacheConfig defines the configuration of the inter-block caches of the
+// stores, which are enabled by inter-block-cache.
+type StoreCacheConfig struct {
+	// MaxBytes sets the maximum size in bytes of the inter-block cache of a store.
+	MaxBytes uint64 `mapstructure:"max-bytes"`
+
+	// Warmup defines if the keys accessed during a block which were evicted are
+	// reloaded at commit.
+	Warmup bool `mapstructure:"warmup"`
+
+	// Stores overrides MaxBytes for the stores, by store name. A store with a
+	// maximum size of 0 is not cached.
+	Stores map[string]uint64 `mapstructure:"stores"`
+}
+
+// StateStorageConfig defines the state storage configuration. The state storage
+// keeps the state at every height, to serve queries at heights pruned from the
+// IAVL stores.
+type StateStorageConfig struct {
+	// Enable defines if the state storage should be enabled.
+	Enable bool `mapstructure:"enable"`
+
+	// KeepRecent sets the number of recent heights to keep in the state storage.
+	// 0 keeps all heights.
+	KeepRecent uint64 `mapstructure:"keep-recent"`
+
+	// PruneInterval sets the interval at which the state storage is pruned.
+	PruneInterval uint64 `mapstructure:"prune-interval"`
+}
+
+// StateDiffConfig defines the state diff configuration. The state diff keeps the
+// change sets of the committed blocks, to serve the state changes of a block.
+type StateDiffConfig struct {
+	// Enable defines if the state diff should be enabled.
+	Enable bool `mapstructure:"enable"`
+
+	// KeepRecent sets the number of recent heights to keep in the state diff.
+	// 0 keeps all heights.
+	KeepRecent uint64 `mapstructure:"keep-recent"`
+}
+
 // MempoolConfig defines the configurations for the SDK built-in app-side mempool
 // implementations.
 type MempoolConfig struct {
@@ -166,6 +233,15 @@ type MempoolConfig struct {
 	// unbounded in how many txs it may contain, and a positive value indicates
 	// the maximum amount of txs it may contain.
 	MaxTxs int
+
+	// RecheckMaxTxs defines the maximum number of mempool transactions
+	// rechecked against the committed state after each block, the invalid ones
+	// being removed from the mempool. The recheck is disabled if it is 0.
+	RecheckMaxTxs int `mapstructure:"recheck-max-txs"`
+
+	// RecheckTimeout defines the maximum duration (in milliseconds) of the
+	// recheck of the mempool, 0 does not bound it.
+	RecheckTimeout uint `mapstructure:"recheck-timeout"`
 }
 
 // State Streaming configuration
@@ -173,6 +249,7 @@ type (
 	// StreamingConfig defines application configuration for external streaming services
 	StreamingConfig struct {
 		ABCI ABCIListenerConfig `mapstructure:"abci"`
+		File FileListenerConfig `mapstructure:"file"`
 	}
 	// ABCIListenerConfig defines application configuration for ABCIListener streaming service
 	ABCIListenerConfig struct {
@@ -180,6 +257,21 @@ type (
 		Plugin        string   `mapstructure:"plugin"`
 		StopNodeOnErr bool     `mapstructure:"stop-node-on-err"`
 	}
+	// FileListenerConfig defines application configuration for the file streaming service
+	FileListenerConfig struct {
+		// Dir is the directory of the streamed files, the file streaming service is enabled if it is set.
+		Dir string `mapstructure:"dir"`
+		// MaxFileSize is the size (in bytes) after which a new file is started.
+		MaxFileSize int64 `mapstructure:"max-file-size"`
+		// Fsync syncs every block to the disk before it is acknowledged.
+		Fsync bool `mapstructure:"fsync"`
+		// BufferSize is the number of blocks which can wait to be written.
+		BufferSize int `mapstructure:"buffer-size"`
+		// Ack makes the Commit wait until the block is written.
+		Ack bool `mapstructure:"ack"`
+		// AckTimeout is the maximum time (in seconds) the Commit waits for the block to be written.
+		AckTimeout uint `mapstructure:"ack-timeout"`
+	}
 )
 
 // Config defines the server's top level configuration
@@ -187,13 +279,16 @@ type Config struct {
 	BaseConfig `mapstructure:",squash"`
 
 	// Telemetry defines the application telemetry configuration
-	Telemetry telemetry.Config `mapstructure:"telemetry"`
-	API       APIConfig        `mapstructure:"api"`
-	GRPC      GRPCConfig       `mapstructure:"grpc"`
-	GRPCWeb   GRPCWebConfig    `mapstructure:"grpc-web"`
-	StateSync StateSyncConfig  `mapstructure:"state-sync"`
-	Streaming StreamingConfig  `mapstructure:"streaming"`
-	Mempool   MempoolConfig    `mapstructure:"mempool"`
+	Telemetry    telemetry.Config   `mapstructure:"telemetry"`
+	API          APIConfig          `mapstructure:"api"`
+	GRPC         GRPCConfig         `mapstructure:"grpc"`
+	GRPCWeb      GRPCWebConfig      `mapstructure:"grpc-web"`
+	StoreCache   StoreCacheConfig   `mapstructure:"store-cache"`
+	StateSync    StateSyncConfig    `mapstructure:"state-sync"`
+	StateStorage StateStorageConfig `mapstructure:"state-storage"`
+	StateDiff    StateDiffConfig    `mapstructure:"state-diff"`
+	Streaming    StreamingConfig    `mapstructure:"streaming"`
+	Mempool      MempoolConfig      `mapstructure:"mempool"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -228,15 +323,21 @@ func DefaultConfig() *Config {
 	return &Config{
 		BaseConfig: BaseConfig{
 			MinGasPrices:        defaultMinGasPrices,
+			GasPriceBlocks:      20,
+			BundleMaxTxs:        16,
+			BundleMaxGas:        50000000,
 			InterBlockCache:     true,
 			Pruning:             pruningtypes.PruningOptionDefault,
 			PruningKeepRecent:   "0",
 			PruningInterval:     "0",
+			PruningConcurrency:  0,
+			WriteAheadLog:       false,
 			MinRetainBlocks:     0,
 			IndexEvents:         make([]string, 0),
 			IAVLCacheSize:       781250,
 			IAVLDisableFastNode: false,
 			IAVLLazyLoading:     false,
+			ParallelTxWorkers:   0,
 			AppDBBackend:        "",
 		},
 		Telemetry: telemetry.Config{
@@ -260,18 +361,41 @@ func DefaultConfig() *Config {
 		GRPCWeb: GRPCWebConfig{
 			Enable: true,
 		},
+		StoreCache: StoreCacheConfig{
+			MaxBytes: storecache.DefaultCommitKVStoreCacheMaxBytes,
+			Warmup:   false,
+			Stores:   map[string]uint64{},
+		},
 		StateSync: StateSyncConfig{
 			SnapshotInterval:   0,
 			SnapshotKeepRecent: 2,
 		},
+		StateStorage: StateStorageConfig{
+			Enable:        false,
+			KeepRecent:    0,
+			PruneInterval: 10,
+		},
+		StateDiff: StateDiffConfig{
+			Enable:     false,
+			KeepRecent: 0,
+		},
 		Streaming: StreamingConfig{
 			ABCI: ABCIListenerConfig{
 				Keys:          []string{},
 				StopNodeOnErr: true,
 			},
+			File: FileListenerConfig{
+				MaxFileSize: 100 << 20,
+				Fsync:       true,
+				BufferSize:  100,
+				Ack:         true,
+				AckTimeout:  30,
+			},
 		},
 		Mempool: MempoolConfig{
-			MaxTxs: 5_000,
+			MaxTxs:         5_000,
+			RecheckMaxTxs:  0,
+			RecheckTimeout: 500,
 		},
 	}
 }
@@ -295,6 +419,19 @@ func (c Config) ValidateBasic() error {
 			"cannot enable state sync snapshots with '%s' pruning setting", pruningtypes.PruningOptionEverything,
 		)
 	}
+	if c.Mempool.RecheckMaxTxs < 0 {
+		return sdkerrors.ErrAppConfig.Wrapf("mempool.recheck-max-txs must not be negative, got %d", c.Mempool.RecheckMaxTxs)
+	}
+
+	if c.StateStorage.KeepRecent > 0 && c.StateStorage.PruneInterval == 0 {
+		return sdkerrors.ErrAppConfig.Wrap("state-storage.prune-interval must not be 0 when state-storage.keep-recent is set")
+	}
+	if c.PruningConcurrency < 0 {
+		return sdkerrors.ErrAppConfig.Wrapf("pruning-concurrency must not be negative, got %d", c.PruningConcurrency)
+	}
+	if c.ParallelTxWorkers < 0 {
+		return sdkerrors.ErrAppConfig.Wrapf("parallel-tx-workers must not be negative, got %d", c.ParallelTxWorkers)
+	}
 
 	return nil
 }
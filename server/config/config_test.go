@@ -24,6 +24,83 @@ func TestSetMinimumFees(t *testing.T) {
 	require.Equal(t, "5.000000000000000000foo", cfg.MinGasPrices)
 }
 
+func TestParallelTxWorkers(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.MinGasPrices = "0stake"
+	require.Equal(t, 0, cfg.ParallelTxWorkers)
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.ParallelTxWorkers = -1
+	require.ErrorContains(t, cfg.ValidateBasic(), "parallel-tx-workers")
+
+	var buffer bytes.Buffer
+	cfg.ParallelTxWorkers = 8
+	require.NoError(t, configTemplate.Execute(&buffer, cfg))
+	require.Contains(t, buffer.String(), "parallel-tx-workers = 8\n")
+}
+
+func TestStateStorageConfig(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.MinGasPrices = "0stake"
+	require.False(t, cfg.StateStorage.Enable)
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.StateStorage.KeepRecent = 100
+	cfg.StateStorage.PruneInterval = 0
+	require.ErrorContains(t, cfg.ValidateBasic(), "state-storage.prune-interval")
+
+	var buffer bytes.Buffer
+	cfg.StateStorage.Enable = true
+	cfg.StateStorage.PruneInterval = 10
+	require.NoError(t, configTemplate.Execute(&buffer, cfg))
+	require.Contains(t, buffer.String(), "[state-storage]\n")
+	require.Contains(t, buffer.String(), "keep-recent = 100\n")
+}
+
+func TestStateDiffConfig(t *testing.T) {
+	cfg := DefaultConfig()
+	require.False(t, cfg.StateDiff.Enable)
+
+	var buffer bytes.Buffer
+	cfg.StateDiff.Enable = true
+	cfg.StateDiff.KeepRecent = 1000
+	require.NoError(t, configTemplate.Execute(&buffer, cfg))
+	require.Contains(t, buffer.String(), "[state-diff]\n\n# enable defines if the state diff should be enabled.\nenable = true\n")
+	require.Contains(t, buffer.String(), "keep-recent = 1000\n")
+}
+
+func TestStoreCacheWriteRead(t *testing.T) {
+	confFile := filepath.Join(t.TempDir(), "app.toml")
+	conf := DefaultConfig()
+	conf.StoreCache.Warmup = true
+	conf.StoreCache.Stores = map[string]uint64{"bank": 64 << 20, "params": 0}
+	WriteConfigFile(confFile, conf)
+
+	vpr := viper.New()
+	vpr.SetConfigFile(confFile)
+	require.NoError(t, vpr.ReadInConfig())
+
+	cfg, err := ParseConfig(vpr)
+	require.NoError(t, err)
+	require.Equal(t, conf.StoreCache, cfg.StoreCache)
+}
+
+func TestMempoolRecheckConfig(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.MinGasPrices = "0stake"
+	require.Equal(t, 0, cfg.Mempool.RecheckMaxTxs)
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.Mempool.RecheckMaxTxs = -1
+	require.ErrorContains(t, cfg.ValidateBasic(), "mempool.recheck-max-txs")
+
+	var buffer bytes.Buffer
+	cfg.Mempool.RecheckMaxTxs = 1000
+	require.NoError(t, configTemplate.Execute(&buffer, cfg))
+	require.Contains(t, buffer.String(), "recheck-max-txs = 1000\n")
+	require.Contains(t, buffer.String(), "recheck-timeout = 500\n")
+}
+
 func TestIndexEventsMarshalling(t *testing.T) {
 	expectedIn := `index-events = ["key1", "key2", ]` + "\n"
 	cfg := DefaultConfig()
@@ -44,6 +121,13 @@ func TestStreamingConfig(t *testing.T) {
 				Plugin:        "plugin-A",
 				StopNodeOnErr: false,
 			},
+			File: FileListenerConfig{
+				Dir:         "streaming",
+				MaxFileSize: 1024,
+				BufferSize:  10,
+				Ack:         true,
+				AckTimeout:  5,
+			},
 		},
 	}
 
@@ -60,6 +144,12 @@ func TestStreamingConfig(t *testing.T) {
 		`keys = ["one", "two", ]`,
 		`plugin = "plugin-A"`,
 		`stop-node-on-err = false`,
+		`dir = "streaming"`,
+		`max-file-size = 1024`,
+		`fsync = false`,
+		`buffer-size = 10`,
+		`ack = true`,
+		`ack-timeout = 5`,
 	}
 
 	for _, line := range expectedLines {
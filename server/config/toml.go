@@ -21,6 +21,16 @@ const DefaultConfigTemplate = `# This is a TOML config file.
 # specified in this config (e.g. 0.25token1;0.0001token2).
 minimum-gas-prices = "{{ .BaseConfig.MinGasPrices }}"
 
+# The number of recent blocks whose gas prices are served by the gas price gRPC
+# service, used by clients to set their fees with '--fees auto'. The gas prices
+# paid in the blocks are not recorded if it is 0.
+gas-price-blocks = {{ .BaseConfig.GasPriceBlocks }}
+
+# The maximum number of transactions of a bundle simulated by the tx gRPC
+# service, and the maximum gas they use. A limit is not enforced if it is 0.
+bundle-max-txs = {{ .BaseConfig.BundleMaxTxs }}
+bundle-max-gas = {{ .BaseConfig.BundleMaxGas }}
+
 # default: the last 362880 states are kept, pruning at 10 block intervals
 # nothing: all historic states will be saved, nothing will be deleted (i.e. archiving node)
 # everything: 2 latest states will be kept; pruning at 10 block intervals.
@@ -31,6 +41,18 @@ pruning = "{{ .BaseConfig.Pruning }}"
 pruning-keep-recent = "{{ .BaseConfig.PruningKeepRecent }}"
 pruning-interval = "{{ .BaseConfig.PruningInterval }}"
 
+# PruningConcurrency defines the number of stores pruned concurrently in a
+# background worker, instead of during Commit, avoiding block commit stalls.
+# Pending heights are pruned after a restart.
+# Default is 0, which prunes the stores during Commit.
+pruning-concurrency = {{ .BaseConfig.PruningConcurrency }}
+
+# WriteAheadLog enables the write-ahead log of the commits of the multi-store.
+# The changeset of a block is written before the stores commit it, so that a
+# commit interrupted by a crash, leaving the stores at different versions, is
+# completed on restart instead of requiring a manual rollback.
+write-ahead-log = {{ .BaseConfig.WriteAheadLog }}
+
 # HaltHeight contains a non-zero block height at which a node will gracefully
 # halt and shutdown that can be used to assist upgrades and testing.
 #
@@ -81,6 +103,12 @@ iavl-disable-fastnode = {{ .BaseConfig.IAVLDisableFastNode }}
 # Default is false.
 iavl-lazy-loading = {{ .BaseConfig.IAVLLazyLoading }}
 
+# ParallelTxWorkers defines the number of workers executing the transactions of
+# a block concurrently, with results identical to a sequential execution.
+# Only blocks accepted in ProcessProposal are executed in parallel.
+# Default is 0, which disables parallel execution.
+parallel-tx-workers = {{ .BaseConfig.ParallelTxWorkers }}
+
 # AppDBBackend defines the database backend type to use for the application and snapshots DBs.
 # An empty string indicates that a fallback will be used.
 # First fallback is the deprecated compile-time types.DBBackend value.
@@ -122,6 +150,11 @@ global-labels = [{{ range $k, $v := .Telemetry.GlobalLabels }}
   ["{{index $v 0 }}", "{{ index $v 1}}"],{{ end }}
 ]
 
+# StoreGasMetrics enables the store_gas_consumed counter, attributing the gas
+# consumed by the store operations to the store key and the operation, to tune
+# the gas costs of the stores. It is only recorded if telemetry is enabled.
+store-gas-metrics = {{ .Telemetry.StoreGasMetrics }}
+
 ###############################################################################
 ###                           API Configuration                             ###
 ###############################################################################
@@ -183,6 +216,29 @@ max-send-msg-size = "{{ .GRPC.MaxSendMsgSize }}"
 # NOTE: gRPC-Web uses the same address as the API server.
 enable = {{ .GRPCWeb.Enable }}
 
+###############################################################################
+###                         Store Cache Configuration                       ###
+###############################################################################
+
+# The inter-block caches keep the recently used keys of the stores across blocks,
+# they are enabled by inter-block-cache.
+[store-cache]
+
+# max-bytes sets the maximum size in bytes of the inter-block cache of a store. The
+# least recently used keys are evicted when the cache exceeds it.
+max-bytes = {{ .StoreCache.MaxBytes }}
+
+# warmup defines if the keys accessed during a block which were evicted from the cache
+# are reloaded at commit, in the space left in the cache.
+warmup = {{ .StoreCache.Warmup }}
+
+# stores overrides max-bytes for the listed stores, by store name. A store with a maximum
+# size of 0 is not cached.
+# Example:
+# bank = 67108864
+[store-cache.stores]
+{{ range $k, $v := .StoreCache.Stores }}{{ $k }} = {{ $v }}
+{{ end }}
 ###############################################################################
 ###                        State Sync Configuration                         ###
 ###############################################################################
@@ -198,6 +254,40 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
 
+###############################################################################
+###                        State Storage Configuration                      ###
+###############################################################################
+
+# The state storage keeps the application state at every height in a separate database,
+# to serve queries at heights which were pruned from the IAVL stores.
+[state-storage]
+
+# enable defines if the state storage should be enabled. When enabled on an existing node,
+# the current state is imported at startup.
+enable = {{ .StateStorage.Enable }}
+
+# keep-recent specifies the number of recent heights to keep in the state storage (0 to keep all).
+keep-recent = {{ .StateStorage.KeepRecent }}
+
+# prune-interval specifies the block interval at which the state storage is pruned.
+prune-interval = {{ .StateStorage.PruneInterval }}
+
+###############################################################################
+###                         State Diff Configuration                        ###
+###############################################################################
+
+# The state diff keeps the change sets of the committed blocks in a separate database,
+# and serves the state changes of a block through the cosmos.base.statediff.v1beta1.Service
+# gRPC service, decoded with the collections schemas of the modules. A change set which
+# fails to be saved halts the node when streaming.abci.stop-node-on-err is set.
+[state-diff]
+
+# enable defines if the state diff should be enabled.
+enable = {{ .StateDiff.Enable }}
+
+# keep-recent specifies the number of recent heights to keep in the state diff (0 to keep all).
+keep-recent = {{ .StateDiff.KeepRecent }}
+
 ###############################################################################
 ###                              State Streaming                            ###
 ###############################################################################
@@ -208,7 +298,7 @@ snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
 # streaming.abci specifies the configuration for the ABCI Listener streaming service.
 [streaming.abci]
 
-# List of kv store keys to stream out via gRPC.
+# List of kv store keys to stream out.
 # The store key names MUST match the module's StoreKey name.
 #
 # Example:
@@ -222,8 +312,36 @@ keys = [{{ range .Streaming.ABCI.Keys }}{{ printf "%q, " . }}{{end}}]
 plugin = "{{ .Streaming.ABCI.Plugin }}"
 
 # stop-node-on-err specifies whether to stop the node on message delivery error.
+# It applies to all the streaming services.
 stop-node-on-err = {{ .Streaming.ABCI.StopNodeOnErr }}
 
+# streaming.file specifies the configuration for the built-in file streaming service,
+# which writes the ABCI messages and the state changes of the blocks to rotating files
+# of length-prefixed protobuf StreamedBlock records. It streams the keys set in streaming.abci.
+[streaming.file]
+
+# The directory of the streamed files.
+# File streaming is only enabled if this is set.
+dir = "{{ .Streaming.File.Dir }}"
+
+# The size (in bytes) after which the next block starts a new file.
+max-file-size = {{ .Streaming.File.MaxFileSize }}
+
+# fsync specifies whether every block is synced to the disk before it is acknowledged.
+fsync = {{ .Streaming.File.Fsync }}
+
+# The number of blocks which can wait to be written to the files.
+buffer-size = {{ .Streaming.File.BufferSize }}
+
+# ack specifies whether the Commit waits until the block is written to the files. Otherwise
+# the blocks are written in the background, and a block which does not fit in the buffer is
+# dropped with an error. With stop-node-on-err the node halts instead of missing a block.
+ack = {{ .Streaming.File.Ack }}
+
+# The maximum time (in seconds) the Commit waits for a block to be written in ack mode,
+# 0 waits indefinitely.
+ack-timeout = {{ .Streaming.File.AckTimeout }}
+
 ###############################################################################
 ###                         Mempool                                         ###
 ###############################################################################
@@ -236,6 +354,15 @@ stop-node-on-err = {{ .Streaming.ABCI.StopNodeOnErr }}
 # Note, this configuration only applies to SDK built-in app-side mempool
 # implementations.
 max-txs = "{{ .Mempool.MaxTxs }}"
+
+# recheck-max-txs defines the maximum number of transactions of the app-side mempool
+# rechecked in the background against the state of each committed block, the
+# invalid ones being removed from the mempool. Setting it to 0 disables the recheck.
+recheck-max-txs = {{ .Mempool.RecheckMaxTxs }}
+
+# recheck-timeout defines the maximum duration (in milliseconds) of the recheck,
+# 0 does not bound it.
+recheck-timeout = {{ .Mempool.RecheckTimeout }}
 `
 
 var configTemplate *template.Template
@@ -146,6 +146,22 @@ func (ms multiStore) SetLazyLoading(bool) {
 	panic("not implemented")
 }
 
+func (ms multiStore) SetPruningConcurrency(int) {
+	panic("not implemented")
+}
+
+func (ms multiStore) SetWriteAheadLog(bool) {
+	panic("not implemented")
+}
+
+func (ms multiStore) SetStateStorage(storetypes.StateStorage) {
+	panic("not implemented")
+}
+
+func (ms multiStore) RegisterCommitmentBackend(storetypes.StoreType, storetypes.CommitmentBackend) {
+	panic("not implemented")
+}
+
 func (ms multiStore) SetInitialVersion(version int64) error {
 	panic("not implemented")
 }
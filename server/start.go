@@ -3,13 +3,16 @@ package server
 import (
 	"context"
 	"fmt"
+	"io"
 	"net"
 	"os"
 	"runtime/pprof"
 
+	storecache "cosmossdk.io/store/cache"
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	"github.com/cometbft/cometbft/abci/server"
 	cmtcmd "github.com/cometbft/cometbft/cmd/cometbft/commands"
+	cmtcfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/node"
 	"github.com/cometbft/cometbft/p2p"
 	pvm "github.com/cometbft/cometbft/privval"
@@ -43,6 +46,9 @@ const (
 	flagTraceStore         = "trace-store"
 	flagCPUProfile         = "cpu-profile"
 	FlagMinGasPrices       = "minimum-gas-prices"
+	FlagGasPriceBlocks     = "gas-price-blocks"
+	FlagBundleMaxTxs       = "bundle-max-txs"
+	FlagBundleMaxGas       = "bundle-max-gas"
 	FlagHaltHeight         = "halt-height"
 	FlagHaltTime           = "halt-time"
 	FlagInterBlockCache    = "inter-block-cache"
@@ -53,16 +59,32 @@ const (
 	FlagPruning             = "pruning"
 	FlagPruningKeepRecent   = "pruning-keep-recent"
 	FlagPruningInterval     = "pruning-interval"
+	FlagPruningConcurrency  = "pruning-concurrency"
+	FlagWriteAheadLog       = "write-ahead-log"
 	FlagIndexEvents         = "index-events"
 	FlagMinRetainBlocks     = "min-retain-blocks"
 	FlagIAVLCacheSize       = "iavl-cache-size"
 	FlagDisableIAVLFastNode = "iavl-disable-fastnode"
 	FlagIAVLLazyLoading     = "iavl-lazy-loading"
+	FlagParallelTxWorkers   = "parallel-tx-workers"
+
+	// store cache-related flags
+	FlagStoreCacheMaxBytes = "store-cache.max-bytes"
+	FlagStoreCacheWarmup   = "store-cache.warmup"
 
 	// state sync-related flags
 	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
 	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
 
+	// state storage-related flags
+	FlagStateStorageEnable        = "state-storage.enable"
+	FlagStateStorageKeepRecent    = "state-storage.keep-recent"
+	FlagStateStoragePruneInterval = "state-storage.prune-interval"
+
+	// state diff-related flags
+	FlagStateDiffEnable     = "state-diff.enable"
+	FlagStateDiffKeepRecent = "state-diff.keep-recent"
+
 	// api-related flags
 	FlagAPIEnable             = "api.enable"
 	FlagAPISwagger            = "api.swagger"
@@ -80,7 +102,9 @@ const (
 	flagGRPCWebEnable = "grpc-web.enable"
 
 	// mempool flags
-	FlagMempoolMaxTxs = "mempool.max-txs"
+	FlagMempoolMaxTxs         = "mempool.max-txs"
+	FlagMempoolRecheckMaxTxs  = "mempool.recheck-max-txs"
+	FlagMempoolRecheckTimeout = "mempool.recheck-timeout"
 )
 
 // StartCmd runs the service passed in, either stand-alone or in-process with
@@ -156,15 +180,22 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().String(flagTransport, "socket", "Transport protocol: socket, grpc")
 	cmd.Flags().String(flagTraceStore, "", "Enable KVStore tracing to an output file")
 	cmd.Flags().String(FlagMinGasPrices, "", "Minimum gas prices to accept for transactions; Any fee in a tx must meet this minimum (e.g. 0.01photino;0.0001stake)")
+	cmd.Flags().Uint64(FlagGasPriceBlocks, 20, "Number of recent blocks whose gas prices are served by the gas price gRPC service (0 disables the recording)")
+	cmd.Flags().Uint64(FlagBundleMaxTxs, 16, "Maximum number of transactions of a bundle simulated by the tx gRPC service (0 for no limit)")
+	cmd.Flags().Uint64(FlagBundleMaxGas, 50000000, "Maximum gas used by the transactions of a bundle simulated by the tx gRPC service (0 for no limit)")
 	cmd.Flags().IntSlice(FlagUnsafeSkipUpgrades, []int{}, "Skip a set of upgrade heights to continue the old binary")
 	cmd.Flags().Uint64(FlagHaltHeight, 0, "Block height at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Uint64(FlagHaltTime, 0, "Minimum block time (in Unix seconds) at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Bool(FlagInterBlockCache, true, "Enable inter-block caching")
+	cmd.Flags().Uint64(FlagStoreCacheMaxBytes, storecache.DefaultCommitKVStoreCacheMaxBytes, "Maximum size in bytes of the inter-block cache of a store")
+	cmd.Flags().Bool(FlagStoreCacheWarmup, false, "Reload at commit the keys accessed during the block which were evicted from the inter-block caches")
 	cmd.Flags().String(flagCPUProfile, "", "Enable CPU profiling and write to the provided file")
 	cmd.Flags().Bool(FlagTrace, false, "Provide full stack traces for errors in ABCI Log")
 	cmd.Flags().String(FlagPruning, pruningtypes.PruningOptionDefault, "Pruning strategy (default|nothing|everything|custom)")
 	cmd.Flags().Uint64(FlagPruningKeepRecent, 0, "Number of recent heights to keep on disk (ignored if pruning is not 'custom')")
 	cmd.Flags().Uint64(FlagPruningInterval, 0, "Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom')")
+	cmd.Flags().Int(FlagPruningConcurrency, 0, "Number of stores pruned concurrently in the background (0 prunes the stores during Commit)")
+	cmd.Flags().Bool(FlagWriteAheadLog, false, "Write the changeset of each block ahead of its commit to recover from interrupted commits")
 	cmd.Flags().Uint(FlagInvCheckPeriod, 0, "Assert registered invariants every N blocks")
 	cmd.Flags().Uint64(FlagMinRetainBlocks, 0, "Minimum block height offset during ABCI commit to prune CometBFT blocks")
 	cmd.Flags().Bool(FlagAPIEnable, false, "Define if the API server should be enabled")
@@ -181,8 +212,16 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().Bool(flagGRPCWebEnable, true, "Define if the gRPC-Web server should be enabled. (Note: gRPC must also be enabled)")
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
+	cmd.Flags().Bool(FlagStateStorageEnable, false, "Enable the state storage serving queries at heights pruned from the IAVL stores")
+	cmd.Flags().Uint64(FlagStateStorageKeepRecent, 0, "Number of recent heights to keep in the state storage (0 keeps all)")
+	cmd.Flags().Uint64(FlagStateStoragePruneInterval, 10, "Block interval at which the state storage is pruned")
+	cmd.Flags().Bool(FlagStateDiffEnable, false, "Enable the state diff keeping the change sets of the committed blocks")
+	cmd.Flags().Uint64(FlagStateDiffKeepRecent, 0, "Number of recent heights to keep in the state diff (0 keeps all)")
 	cmd.Flags().Bool(FlagDisableIAVLFastNode, false, "Disable fast node for IAVL tree")
 	cmd.Flags().Int(FlagMempoolMaxTxs, mempool.DefaultMaxTx, "Sets MaxTx value for the app-side mempool")
+	cmd.Flags().Int(FlagMempoolRecheckMaxTxs, 0, "Maximum number of app-side mempool txs rechecked after each block (0 disables the recheck)")
+	cmd.Flags().Uint(FlagMempoolRecheckTimeout, 500, "Maximum duration (in milliseconds) of the app-side mempool recheck (0 does not bound it)")
+	cmd.Flags().Int(FlagParallelTxWorkers, 0, "Number of workers executing the txs of a block concurrently (0 disables parallel execution)")
 
 	// support old flags name for backwards compatibility
 	cmd.Flags().SetNormalizeFunc(func(f *pflag.FlagSet, name string) pflag.NormalizedName {
@@ -248,7 +287,11 @@ func startStandAlone(svrCtx *Context, appCreator types.AppCreator) error {
 		// so we can gracefully stop the ABCI server.
 		<-ctx.Done()
 		svrCtx.Logger.Info("stopping the ABCI server...")
-		return svr.Stop()
+		if err := svr.Stop(); err != nil {
+			return err
+		}
+
+		return closeApp(svrCtx, app)
 	})
 
 	return g.Wait()
@@ -297,14 +340,7 @@ func startInProcess(svrCtx *Context, clientCtx client.Context, appCreator types.
 		return err
 	}
 
-	genDocProvider := func() (*cmttypes.GenesisDoc, error) {
-		appGenesis, err := genutiltypes.AppGenesisFromFile(cfg.GenesisFile())
-		if err != nil {
-			return nil, err
-		}
-
-		return appGenesis.ToGenesisDoc()
-	}
+	genDocProvider := getGenDocProvider(cfg)
 
 	var (
 		tmNode   *node.Node
@@ -456,12 +492,28 @@ func startInProcess(svrCtx *Context, clientCtx client.Context, appCreator types.
 		if traceWriterCleanup != nil {
 			traceWriterCleanup()
 		}
+
+		if err := closeApp(svrCtx, app); err != nil {
+			svrCtx.Logger.Error("failed to close application", "err", err)
+		}
 	}()
 
 	// wait for signal capture and gracefully return
 	return g.Wait()
 }
 
+// closeApp releases the resources held by the application, if it implements
+// io.Closer, once the node has stopped.
+func closeApp(svrCtx *Context, app types.Application) error {
+	closer, ok := app.(io.Closer)
+	if !ok {
+		return nil
+	}
+
+	svrCtx.Logger.Info("closing application...")
+	return closer.Close()
+}
+
 func startTelemetry(cfg serverconfig.Config) (*telemetry.Metrics, error) {
 	if !cfg.Telemetry.Enabled {
 		return nil, nil
@@ -505,3 +557,16 @@ func wrapCPUProfile(svrCtx *Context, callbackFn func() error) error {
 
 	return <-errCh
 }
+
+// getGenDocProvider returns a function which loads the CometBFT genesis document
+// from the application genesis file.
+func getGenDocProvider(cfg *cmtcfg.Config) func() (*cmttypes.GenesisDoc, error) {
+	return func() (*cmttypes.GenesisDoc, error) {
+		appGenesis, err := genutiltypes.AppGenesisFromFile(cfg.GenesisFile())
+		if err != nil {
+			return nil, err
+		}
+
+		return appGenesis.ToGenesisDoc()
+	}
+}
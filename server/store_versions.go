@@ -0,0 +1,76 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/store/rootmulti"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/types"
+)
+
+const flagRepair = "repair"
+
+// NewStoreVersionsCmd creates a command to inspect and repair the versions of the stores of the
+// multistore.
+func NewStoreVersionsCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store-versions",
+		Short: "inspect and repair version mismatches across the stores of the multistore",
+		Long: `
+If the node stops while the multistore commits a height, some of its stores may have
+committed the height while the others have not, which prevents the node from restarting.
+This command reports the stores ahead of the multistore.
+
+With --repair, the interrupted commit is completed from the write-ahead log if it holds
+the changeset of the height, otherwise the stores ahead are rolled back to the height of
+the multistore, and the block is executed again when the node restarts.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := GetServerContextFromCmd(cmd)
+			db, err := openDB(ctx.Config.RootDir, GetAppDBBackend(ctx.Viper))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			// the interrupted commit is only recovered when requested
+			ctx.Viper.Set(FlagWriteAheadLog, false)
+			app := appCreator(ctx.Logger, db, nil, ctx.Viper)
+
+			cms, ok := app.CommitMultiStore().(*rootmulti.Store)
+			if !ok {
+				return errors.New("store-versions requires the rootmulti store")
+			}
+
+			version := cms.LatestVersion()
+			ahead := cms.StoresAhead()
+			if len(ahead) == 0 {
+				cmd.Printf("All stores are at version %d\n", version)
+			} else {
+				cmd.Printf("Stores ahead of the multistore at version %d: %v\n", version, ahead)
+			}
+
+			repair, _ := cmd.Flags().GetBool(flagRepair)
+			if !repair {
+				return nil
+			}
+
+			version, err = cms.RecoverCommit()
+			if err != nil {
+				return fmt.Errorf("failed to recover commit: %w", err)
+			}
+
+			cmd.Printf("Recovered the multistore at version %d\n", version)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Bool(flagRepair, false, "Complete or roll back the interrupted commit")
+	return cmd
+}
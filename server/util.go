@@ -25,9 +25,12 @@ import (
 	"github.com/spf13/viper"
 
 	"cosmossdk.io/log"
-	"cosmossdk.io/store"
+	storecache "cosmossdk.io/store/cache"
+	pruningtypes "cosmossdk.io/store/pruning/types"
 	"cosmossdk.io/store/snapshots"
 	snapshottypes "cosmossdk.io/store/snapshots/types"
+	"cosmossdk.io/store/statediff"
+	"cosmossdk.io/store/storage"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -331,6 +334,7 @@ func AddCommands(rootCmd *cobra.Command, defaultNodeHome string, appCreator type
 		ExportCmd(appExport, defaultNodeHome),
 		version.NewVersionCommand(),
 		NewRollbackCmd(appCreator, defaultNodeHome),
+		NewStoreVersionsCmd(appCreator, defaultNodeHome),
 	)
 }
 
@@ -443,12 +447,28 @@ func openTraceWriter(traceWriterFile string) (w io.WriteCloser, err error) {
 	)
 }
 
+// GetSnapshotStore returns the snapshot store of the application, in the data directory of its home.
+func GetSnapshotStore(appOpts types.AppOptions) (*snapshots.Store, error) {
+	homeDir := cast.ToString(appOpts.Get(flags.FlagHome))
+	snapshotDir := filepath.Join(homeDir, "data", "snapshots")
+	if err := os.MkdirAll(snapshotDir, os.ModePerm); err != nil {
+		return nil, fmt.Errorf("failed to create snapshots directory: %w", err)
+	}
+
+	snapshotDB, err := dbm.NewDB("metadata", GetAppDBBackend(appOpts), snapshotDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshots.NewStore(snapshotDB, snapshotDir)
+}
+
 // DefaultBaseappOptions returns the default baseapp options provided by the Cosmos SDK
 func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 	var cache storetypes.MultiStorePersistentCache
 
 	if cast.ToBool(appOpts.Get(FlagInterBlockCache)) {
-		cache = store.NewCommitKVStoreCacheManager()
+		cache = storecache.NewCommitKVStoreCacheManager(storeCacheConfig(appOpts))
 	}
 
 	pruningOpts, err := GetPruningOptionsFromFlags(appOpts)
@@ -468,16 +488,7 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		chainID = appGenesis.ChainID
 	}
 
-	snapshotDir := filepath.Join(homeDir, "data", "snapshots")
-	if err = os.MkdirAll(snapshotDir, os.ModePerm); err != nil {
-		panic(fmt.Errorf("failed to create snapshots directory: %w", err))
-	}
-
-	snapshotDB, err := dbm.NewDB("metadata", GetAppDBBackend(appOpts), snapshotDir)
-	if err != nil {
-		panic(err)
-	}
-	snapshotStore, err := snapshots.NewStore(snapshotDB, snapshotDir)
+	snapshotStore, err := GetSnapshotStore(appOpts)
 	if err != nil {
 		panic(err)
 	}
@@ -487,9 +498,14 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		cast.ToUint32(appOpts.Get(FlagStateSyncSnapshotKeepRecent)),
 	)
 
-	return []func(*baseapp.BaseApp){
+	baseappOptions := []func(*baseapp.BaseApp){
 		baseapp.SetPruning(pruningOpts),
+		baseapp.SetPruningConcurrency(cast.ToInt(appOpts.Get(FlagPruningConcurrency))),
+		baseapp.SetWriteAheadLog(cast.ToBool(appOpts.Get(FlagWriteAheadLog))),
+		baseapp.SetStoreGasMetrics(cast.ToBool(appOpts.Get("telemetry.enabled")) && cast.ToBool(appOpts.Get("telemetry.store-gas-metrics"))),
 		baseapp.SetMinGasPrices(cast.ToString(appOpts.Get(FlagMinGasPrices))),
+		baseapp.SetGasPriceHistory(cast.ToUint64(appOpts.Get(FlagGasPriceBlocks))),
+		baseapp.SetBundleLimits(cast.ToUint64(appOpts.Get(FlagBundleMaxTxs)), cast.ToUint64(appOpts.Get(FlagBundleMaxGas))),
 		baseapp.SetHaltHeight(cast.ToUint64(appOpts.Get(FlagHaltHeight))),
 		baseapp.SetHaltTime(cast.ToUint64(appOpts.Get(FlagHaltTime))),
 		baseapp.SetMinRetainBlocks(cast.ToUint64(appOpts.Get(FlagMinRetainBlocks))),
@@ -504,7 +520,70 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 				mempool.SenderNonceMaxTxOpt(cast.ToInt(appOpts.Get(FlagMempoolMaxTxs))),
 			),
 		),
+		baseapp.SetMempoolRecheck(
+			cast.ToInt(appOpts.Get(FlagMempoolRecheckMaxTxs)),
+			time.Duration(cast.ToUint(appOpts.Get(FlagMempoolRecheckTimeout)))*time.Millisecond,
+		),
 		baseapp.SetIAVLLazyLoading(cast.ToBool(appOpts.Get(FlagIAVLLazyLoading))),
+		baseapp.SetParallelTxWorkers(cast.ToInt(appOpts.Get(FlagParallelTxWorkers))),
 		baseapp.SetChainID(chainID),
 	}
+
+	if cast.ToBool(appOpts.Get(FlagStateStorageEnable)) {
+		baseappOptions = append(baseappOptions, baseapp.SetStateStorage(openStateStorage(homeDir, appOpts)))
+	}
+
+	if cast.ToBool(appOpts.Get(FlagStateDiffEnable)) {
+		baseappOptions = append(baseappOptions, baseapp.SetStateDiff(openStateDiff(homeDir, appOpts)))
+	}
+
+	return baseappOptions
+}
+
+// openStateStorage opens the state storage database in the data directory, and
+// returns the state storage pruned according to the provided options.
+func openStateStorage(homeDir string, appOpts types.AppOptions) *storage.Database {
+	db, err := dbm.NewDB("state_storage", GetAppDBBackend(appOpts), filepath.Join(homeDir, "data"))
+	if err != nil {
+		panic(fmt.Errorf("failed to open state storage: %w", err))
+	}
+
+	pruningOpts := pruningtypes.NewPruningOptions(pruningtypes.PruningNothing)
+	if keepRecent := cast.ToUint64(appOpts.Get(FlagStateStorageKeepRecent)); keepRecent > 0 {
+		pruningOpts = pruningtypes.NewCustomPruningOptions(keepRecent, cast.ToUint64(appOpts.Get(FlagStateStoragePruneInterval)))
+	}
+
+	return storage.NewDatabase(db, pruningOpts)
+}
+
+// storeCacheConfig returns the configuration of the inter-block caches, the
+// metrics of the caches are enabled with the telemetry.
+func storeCacheConfig(appOpts types.AppOptions) storecache.Config {
+	cfg := storecache.DefaultConfig()
+	if maxBytes := appOpts.Get(FlagStoreCacheMaxBytes); maxBytes != nil {
+		cfg.MaxBytes = cast.ToUint64(maxBytes)
+	}
+	cfg.Warmup = cast.ToBool(appOpts.Get(FlagStoreCacheWarmup))
+	cfg.Metrics = cast.ToBool(appOpts.Get("telemetry.enabled"))
+
+	stores := cast.ToStringMap(appOpts.Get("store-cache.stores"))
+	if len(stores) > 0 {
+		cfg.StoreMaxBytes = make(map[string]uint64, len(stores))
+		for name, maxBytes := range stores {
+			cfg.StoreMaxBytes[name] = cast.ToUint64(maxBytes)
+		}
+	}
+
+	return cfg
+}
+
+// openStateDiff opens the state diff database in the data directory, and returns
+// the state diff keeping the number of recent heights of the provided options.
+func openStateDiff(homeDir string, appOpts types.AppOptions) *statediff.Store {
+	db, err := dbm.NewDB("state_diff", GetAppDBBackend(appOpts), filepath.Join(homeDir, "data"))
+	if err != nil {
+		panic(fmt.Errorf("failed to open state diff: %w", err))
+	}
+
+	return statediff.NewStore(db, cast.ToUint64(appOpts.Get(FlagStateDiffKeepRecent)))
 }
@@ -4,6 +4,7 @@ package simapp
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -38,7 +39,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
+	gaspriceservice "github.com/cosmos/cosmos-sdk/client/grpc/gasprice"
+	mempoolservice "github.com/cosmos/cosmos-sdk/client/grpc/mempool"
 	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
+	statediffservice "github.com/cosmos/cosmos-sdk/client/grpc/statediff"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
@@ -55,6 +59,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante/unorderedtx"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
 	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
@@ -68,6 +73,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+	circuitkeeper "github.com/cosmos/cosmos-sdk/x/circuit/keeper"
+	circuittypes "github.com/cosmos/cosmos-sdk/x/circuit/types"
 	consensus "github.com/cosmos/cosmos-sdk/x/consensus"
 	consensusparamkeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
 	consensusparamtypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
@@ -133,6 +141,7 @@ var (
 		authzmodule.AppModuleBasic{},
 		groupmodule.AppModuleBasic{},
 		vesting.AppModuleBasic{},
+		circuit.AppModuleBasic{},
 		nftmodule.AppModuleBasic{},
 		consensus.AppModuleBasic{},
 	)
@@ -186,6 +195,10 @@ type SimApp struct {
 	GroupKeeper           groupkeeper.Keeper
 	NFTKeeper             nftkeeper.Keeper
 	ConsensusParamsKeeper consensusparamkeeper.Keeper
+	CircuitKeeper         circuitkeeper.Keeper
+
+	// UnorderedTxManager tracks the unordered transactions for replay protection
+	UnorderedTxManager *unorderedtx.Manager
 
 	// the module manager
 	ModuleManager *module.Manager
@@ -237,6 +250,23 @@ func NewSimApp(
 	// bApp.SetPrepareProposal(abciPropHandler.PrepareProposalHandler())
 	// bApp.SetProcessProposal(abciPropHandler.ProcessProposalHandler())
 	//
+	// A LaneMempool splits the transactions in lanes, each one with its own
+	// ordering and share of the block space, which the default PrepareProposal
+	// handler honours. Once full, it evicts the transactions with the lowest gas
+	// price.
+	//
+	// Example:
+	//
+	// laneMempool := mempool.NewLaneMempool(mempool.LaneMempoolConfig{
+	// 	Lanes: []mempool.Lane{
+	// 		{Name: "oracle", Match: isOracleTx, MaxBlockSpace: math.LegacyNewDecWithPrec(1, 1), Mempool: mempool.DefaultPriorityMempool()},
+	// 		{Name: "default", Mempool: mempool.DefaultPriorityMempool()},
+	// 	},
+	// 	TxGasPrice:      mempool.FeeDenomTxGasPrice("stake"),
+	// 	ReplacementBump: mempool.DefaultReplacementBump,
+	// 	MaxTx:           5000,
+	// })
+	//
 	// Alternatively, you can construct BaseApp options, append those to
 	// baseAppOptions and pass them to NewBaseApp.
 	//
@@ -258,7 +288,7 @@ func NewSimApp(
 		authtypes.StoreKey, banktypes.StoreKey, stakingtypes.StoreKey, crisistypes.StoreKey,
 		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
 		govtypes.StoreKey, paramstypes.StoreKey, consensusparamtypes.StoreKey, upgradetypes.StoreKey, feegrant.StoreKey,
-		evidencetypes.StoreKey, authzkeeper.StoreKey, nftkeeper.StoreKey, group.StoreKey,
+		evidencetypes.StoreKey, authzkeeper.StoreKey, nftkeeper.StoreKey, group.StoreKey, circuittypes.StoreKey,
 	)
 
 	// register streaming services
@@ -315,6 +345,11 @@ func NewSimApp(
 
 	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, keys[feegrant.StoreKey], app.AccountKeeper)
 
+	app.CircuitKeeper = circuitkeeper.NewKeeper(appCodec, keys[circuittypes.StoreKey], authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	// the circuit breaker is checked by the router, so that it also applies to
+	// the Msgs executed by other Msgs, e.g. authz MsgExec
+	app.MsgServiceRouter().SetCircuit(app.CircuitKeeper)
+
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
 	app.StakingKeeper.SetHooks(
@@ -405,6 +440,7 @@ func NewSimApp(
 		groupmodule.NewAppModule(appCodec, app.GroupKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		nftmodule.NewAppModule(appCodec, app.NFTKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		consensus.NewAppModule(appCodec, app.ConsensusParamsKeeper),
+		circuit.NewAppModule(appCodec, app.CircuitKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -437,7 +473,7 @@ func NewSimApp(
 		distrtypes.ModuleName, stakingtypes.ModuleName, slashingtypes.ModuleName, govtypes.ModuleName,
 		minttypes.ModuleName, crisistypes.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName,
 		feegrant.ModuleName, nft.ModuleName, group.ModuleName, paramstypes.ModuleName, upgradetypes.ModuleName,
-		vestingtypes.ModuleName, consensusparamtypes.ModuleName,
+		vestingtypes.ModuleName, consensusparamtypes.ModuleName, circuittypes.ModuleName,
 	}
 	app.ModuleManager.SetOrderInitGenesis(genesisModuleOrder...)
 	app.ModuleManager.SetOrderExportGenesis(genesisModuleOrder...)
@@ -483,10 +519,27 @@ func NewSimApp(
 	app.MountTransientStores(tkeys)
 	app.MountMemoryStores(memKeys)
 
+	// create, start, and load the unordered tx manager
+	app.UnorderedTxManager = unorderedtx.NewManager(filepath.Join(homePath, "data"))
+	app.UnorderedTxManager.Start()
+
+	if err := app.UnorderedTxManager.OnInit(); err != nil {
+		panic(fmt.Errorf("failed to initialize unordered tx manager: %w", err))
+	}
+
+	// register the unordered tx snapshot extension, so that the unexpired
+	// unordered txs are restored by state sync
+	if manager := app.SnapshotManager(); manager != nil {
+		if err := manager.RegisterExtensions(unorderedtx.NewSnapshotter(app.UnorderedTxManager)); err != nil {
+			panic(fmt.Errorf("failed to register snapshot extension: %w", err))
+		}
+	}
+
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
+	app.SetPrepareCheckStater(app.PrepareCheckStater)
 	app.setAnteHandler(encodingConfig.TxConfig)
 
 	// In v0.46, the SDK introduces _postHandlers_. PostHandlers are like
@@ -534,11 +587,12 @@ func NewSimApp(
 func (app *SimApp) setAnteHandler(txConfig client.TxConfig) {
 	anteHandler, err := ante.NewAnteHandler(
 		ante.HandlerOptions{
-			AccountKeeper:   app.AccountKeeper,
-			BankKeeper:      app.BankKeeper,
-			SignModeHandler: txConfig.SignModeHandler(),
-			FeegrantKeeper:  app.FeeGrantKeeper,
-			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+			AccountKeeper:      app.AccountKeeper,
+			BankKeeper:         app.BankKeeper,
+			SignModeHandler:    txConfig.SignModeHandler(),
+			FeegrantKeeper:     app.FeeGrantKeeper,
+			SigGasConsumer:     ante.DefaultSigVerificationGasConsumer,
+			UnorderedTxManager: app.UnorderedTxManager,
 		},
 	)
 	if err != nil {
@@ -569,9 +623,27 @@ func (app *SimApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) (ab
 
 // EndBlocker application updates every end block
 func (app *SimApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) (abci.ResponseEndBlock, error) {
+	// expire the unordered transactions that can no longer be included in a block
+	app.UnorderedTxManager.OnNewBlock(uint64(ctx.BlockHeight()))
+
 	return app.ModuleManager.EndBlock(ctx, req)
 }
 
+// PrepareCheckStater application updates once the block is committed
+func (app *SimApp) PrepareCheckStater(ctx sdk.Context) {
+	// persist the unordered transactions of the committed block, so that their
+	// replay protection survives a crash
+	if err := app.UnorderedTxManager.OnCommit(); err != nil {
+		panic(fmt.Errorf("failed to flush unordered txs: %w", err))
+	}
+}
+
+// Close closes all necessary application resources. It is called by the
+// server when the node gracefully shuts down.
+func (app *SimApp) Close() error {
+	return errors.Join(app.BaseApp.Close(), app.UnorderedTxManager.Close())
+}
+
 func (a *SimApp) Configurator() module.Configurator {
 	return a.configurator
 }
@@ -684,6 +756,15 @@ func (app *SimApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICon
 	// Register node gRPC service for grpc-gateway.
 	nodeservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
+	// Register state diff gRPC service for grpc-gateway.
+	statediffservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+
+	// Register mempool gRPC service for grpc-gateway.
+	mempoolservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+
+	// Register gas price gRPC service for grpc-gateway.
+	gaspriceservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+
 	// Register grpc-gateway routes for all modules.
 	ModuleBasics.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
@@ -695,7 +776,7 @@ func (app *SimApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICon
 
 // RegisterTxService implements the Application.RegisterTxService method.
 func (app *SimApp) RegisterTxService(clientCtx client.Context) {
-	authtx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.BaseApp.Simulate, app.interfaceRegistry)
+	authtx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.BaseApp.Simulate, app.BaseApp.SimulateBundle, app.interfaceRegistry)
 }
 
 // RegisterTendermintService implements the Application.RegisterTendermintService method.
@@ -710,6 +791,15 @@ func (app *SimApp) RegisterTendermintService(clientCtx client.Context) {
 
 func (app *SimApp) RegisterNodeService(clientCtx client.Context) {
 	nodeservice.RegisterNodeService(clientCtx, app.GRPCQueryRouter())
+	gaspriceservice.RegisterGasPriceService(app.GRPCQueryRouter(), app.GasPriceHistory())
+
+	if stateDiff := app.StateDiff(); stateDiff != nil {
+		statediffservice.RegisterStateDiffService(app.GRPCQueryRouter(), stateDiff, app.ModuleManager.CollectionsSchemas())
+	}
+
+	if inspector, ok := app.MempoolInspector(); ok && clientCtx.TxConfig != nil {
+		mempoolservice.RegisterMempoolService(app.GRPCQueryRouter(), inspector, clientCtx.TxConfig.TxEncoder())
+	}
 }
 
 // GetMaccPerms returns a copy of the module account permissions
@@ -21,10 +21,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/mock"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -273,6 +275,21 @@ func TestUpgradeStateOnGenesis(t *testing.T) {
 	require.NotNil(t, app.UpgradeKeeper.GetVersionSetter())
 }
 
+func TestCircuitBreakerNestedMsgs(t *testing.T) {
+	app := Setup(t, false)
+	ctx := app.NewContext(false, cmtproto.Header{})
+
+	addrs := simtestutil.CreateIncrementalAccounts(2)
+	msgSend := banktypes.NewMsgSend(addrs[0], addrs[1], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+	require.NoError(t, app.CircuitKeeper.DisableList.Set(ctx, sdk.MsgTypeURL(msgSend)))
+
+	// the circuit breaker is checked by the router, so that it cannot be
+	// bypassed by executing the Msg with authz MsgExec
+	msgExec := authz.NewMsgExec(addrs[0], []sdk.Msg{msgSend})
+	_, err := app.MsgServiceRouter().Handler(&msgExec)(ctx, &msgExec)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
 // TestMergedRegistry tests that fetching the gogo/protov2 merged registry
 // doesn't fail after loading all file descriptors.
 func TestMergedRegistry(t *testing.T) {
@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	circuittypes "github.com/cosmos/cosmos-sdk/x/circuit/types"
 	consensustypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
 	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
@@ -83,6 +84,7 @@ func (app SimApp) RegisterUpgradeHandlers() {
 			Added: []string{
 				consensustypes.ModuleName,
 				crisistypes.ModuleName,
+				circuittypes.ModuleName,
 			},
 		}
 
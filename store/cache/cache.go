@@ -1,33 +1,62 @@
 package cache
 
 import (
-	"fmt"
+	"sync"
+
+	"github.com/armon/go-metrics"
 
 	"cosmossdk.io/store/cachekv"
 	"cosmossdk.io/store/types"
-
-	lru "github.com/hashicorp/golang-lru"
 )
 
 var (
 	_ types.CommitKVStore             = (*CommitKVStoreCache)(nil)
 	_ types.MultiStorePersistentCache = (*CommitKVStoreCacheManager)(nil)
 
-	// DefaultCommitKVStoreCacheSize defines the persistent ARC cache size for a
-	// CommitKVStoreCache.
-	DefaultCommitKVStoreCacheSize uint = 1000
+	// DefaultCommitKVStoreCacheMaxBytes defines the maximum size in bytes of the
+	// persistent LRU cache of a CommitKVStoreCache.
+	DefaultCommitKVStoreCacheMaxBytes uint64 = 32 << 20
 )
 
 type (
+	// Config defines the configuration of the inter-block caches.
+	Config struct {
+		// MaxBytes is the maximum size in bytes of the cache of a store.
+		MaxBytes uint64
+		// StoreMaxBytes overrides MaxBytes for the stores, by store name. A
+		// store with a maximum size of 0 is not cached.
+		StoreMaxBytes map[string]uint64
+		// Warmup reloads at commit the keys accessed during the block which were
+		// evicted, in the space left in the cache, to serve them from the cache
+		// in the next block.
+		Warmup bool
+		// Metrics enables the telemetry of the hits, misses, evictions and size
+		// of the caches.
+		Metrics bool
+	}
+
 	// CommitKVStoreCache implements an inter-block (persistent) cache that wraps a
-	// CommitKVStore. Reads first hit the internal ARC (Adaptive Replacement Cache).
-	// During a cache miss, the read is delegated to the underlying CommitKVStore
-	// and cached. Deletes and writes always happen to both the cache and the
-	// CommitKVStore in a write-through manner. Caching performed in the
-	// CommitKVStore and below is completely irrelevant to this layer.
+	// CommitKVStore. Reads first hit the internal LRU cache, bounded by the total
+	// size of its entries. During a cache miss, the read is delegated to the
+	// underlying CommitKVStore and cached. Deletes and writes always happen to
+	// both the cache and the CommitKVStore in a write-through manner. Caching
+	// performed in the CommitKVStore and below is completely irrelevant to this
+	// layer.
 	CommitKVStoreCache struct {
 		types.CommitKVStore
-		cache *lru.ARCCache
+
+		mtx   sync.Mutex
+		cache *lruCache
+
+		// accessedKeys holds the keys read or written since the last commit, in
+		// access order, if warm-up is enabled.
+		warmup        bool
+		accessed      map[string]struct{}
+		accessedKeys  []string
+		accessedBytes uint64
+
+		// metricLabels labels the metrics of the cache, nil if they are disabled.
+		metricLabels []metrics.Label
 	}
 
 	// CommitKVStoreCacheManager maintains a mapping from a StoreKey to a
@@ -35,36 +64,65 @@ type (
 	// in an inter-block (persistent) manner and typically provided by a
 	// CommitMultiStore.
 	CommitKVStoreCacheManager struct {
-		cacheSize uint
-		caches    map[string]types.CommitKVStore
+		config Config
+		caches map[string]types.CommitKVStore
 	}
 )
 
-func NewCommitKVStoreCache(store types.CommitKVStore, size uint) *CommitKVStoreCache {
-	cache, err := lru.NewARC(int(size))
-	if err != nil {
-		panic(fmt.Errorf("failed to create KVStore cache: %s", err))
-	}
+// DefaultConfig returns the default configuration of the inter-block caches.
+func DefaultConfig() Config {
+	return Config{MaxBytes: DefaultCommitKVStoreCacheMaxBytes}
+}
 
-	return &CommitKVStoreCache{
+// NewCommitKVStoreCache returns a CommitKVStoreCache wrapping store, with a
+// cache of at most maxBytes. The keys accessed during a block are reloaded at
+// commit if warmup is set.
+func NewCommitKVStoreCache(store types.CommitKVStore, maxBytes uint64, warmup bool) *CommitKVStoreCache {
+	ckv := &CommitKVStoreCache{
 		CommitKVStore: store,
-		cache:         cache,
+		cache:         newLRUCache(maxBytes),
+		warmup:        warmup,
 	}
+	if warmup {
+		ckv.accessed = make(map[string]struct{})
+	}
+
+	return ckv
 }
 
-func NewCommitKVStoreCacheManager(size uint) *CommitKVStoreCacheManager {
+// WithMetrics enables the telemetry of the cache, labeled with the store key.
+// It returns the cache.
+func (ckv *CommitKVStoreCache) WithMetrics(storeKey string) *CommitKVStoreCache {
+	ckv.metricLabels = []metrics.Label{{Name: "store_key", Value: storeKey}}
+	return ckv
+}
+
+func NewCommitKVStoreCacheManager(config Config) *CommitKVStoreCacheManager {
 	return &CommitKVStoreCacheManager{
-		cacheSize: size,
-		caches:    make(map[string]types.CommitKVStore),
+		config: config,
+		caches: make(map[string]types.CommitKVStore),
 	}
 }
 
 // GetStoreCache returns a Cache from the CommitStoreCacheManager for a given
 // StoreKey. If no Cache exists for the StoreKey, then one is created and set.
-// The returned Cache is meant to be used in a persistent manner.
+// The returned Cache is meant to be used in a persistent manner. The store is
+// returned as is if its maximum cache size is 0.
 func (cmgr *CommitKVStoreCacheManager) GetStoreCache(key types.StoreKey, store types.CommitKVStore) types.CommitKVStore {
 	if cmgr.caches[key.Name()] == nil {
-		cmgr.caches[key.Name()] = NewCommitKVStoreCache(store, cmgr.cacheSize)
+		maxBytes, ok := cmgr.config.StoreMaxBytes[key.Name()]
+		if !ok {
+			maxBytes = cmgr.config.MaxBytes
+		}
+		if maxBytes == 0 {
+			return store
+		}
+
+		ckv := NewCommitKVStoreCache(store, maxBytes, cmgr.config.Warmup)
+		if cmgr.config.Metrics {
+			ckv.WithMetrics(key.Name())
+		}
+		cmgr.caches[key.Name()] = ckv
 	}
 
 	return cmgr.caches[key.Name()]
@@ -101,15 +159,25 @@ func (ckv *CommitKVStoreCache) Get(key []byte) []byte {
 	types.AssertValidKey(key)
 
 	keyStr := string(key)
-	valueI, ok := ckv.cache.Get(keyStr)
+
+	ckv.mtx.Lock()
+	ckv.access(keyStr)
+	value, ok := ckv.cache.get(keyStr)
+	ckv.mtx.Unlock()
 	if ok {
 		// cache hit
-		return valueI.([]byte)
+		ckv.incrCounter(1, "hit")
+		return value
 	}
 
-	// cache miss; write to cache
-	value := ckv.CommitKVStore.Get(key)
-	ckv.cache.Add(keyStr, value)
+	// cache miss; write to cache, the underlying CommitKVStore is read without
+	// holding the lock to not serialize the concurrent reads
+	ckv.incrCounter(1, "miss")
+	value = ckv.CommitKVStore.Get(key)
+
+	ckv.mtx.Lock()
+	ckv.add(keyStr, value)
+	ckv.mtx.Unlock()
 
 	return value
 }
@@ -120,13 +188,108 @@ func (ckv *CommitKVStoreCache) Set(key, value []byte) {
 	types.AssertValidKey(key)
 	types.AssertValidValue(value)
 
-	ckv.cache.Add(string(key), value)
+	keyStr := string(key)
+
+	ckv.mtx.Lock()
+	defer ckv.mtx.Unlock()
+
+	ckv.access(keyStr)
+	ckv.add(keyStr, value)
 	ckv.CommitKVStore.Set(key, value)
 }
 
 // Delete removes a key/value pair from both the write-through cache and the
 // underlying CommitKVStore.
 func (ckv *CommitKVStoreCache) Delete(key []byte) {
-	ckv.cache.Remove(string(key))
+	ckv.mtx.Lock()
+	defer ckv.mtx.Unlock()
+
+	ckv.cache.remove(string(key))
 	ckv.CommitKVStore.Delete(key)
 }
+
+// Commit commits the underlying CommitKVStore, and warms the cache up with the
+// keys accessed during the block if enabled.
+func (ckv *CommitKVStoreCache) Commit() types.CommitID {
+	id := ckv.CommitKVStore.Commit()
+
+	ckv.mtx.Lock()
+	defer ckv.mtx.Unlock()
+
+	if ckv.warmup {
+		ckv.warmUp()
+	}
+	if ckv.metricLabels != nil {
+		metrics.SetGaugeWithLabels([]string{"store", "cache", "size_bytes"}, float32(ckv.cache.size), ckv.metricLabels)
+		metrics.SetGaugeWithLabels([]string{"store", "cache", "entries"}, float32(ckv.cache.len()), ckv.metricLabels)
+	}
+
+	return id
+}
+
+// warmUp reloads the keys accessed during the block which were evicted from the
+// cache, and resets the access set. The reloaded keys only use the bytes left by
+// the accessed keys still cached, so they only evict the keys which were not
+// accessed during the block.
+func (ckv *CommitKVStoreCache) warmUp() {
+	var (
+		cachedBytes uint64
+		missing     []string
+	)
+	for _, key := range ckv.accessedKeys {
+		if size, ok := ckv.cache.sizeOf(key); ok {
+			cachedBytes += size
+		} else {
+			missing = append(missing, key)
+		}
+	}
+
+	if cachedBytes < ckv.cache.maxBytes {
+		budget := ckv.cache.maxBytes - cachedBytes
+		warmed := 0
+		for _, key := range missing {
+			value := ckv.CommitKVStore.Get([]byte(key))
+			size := entrySize(key, value)
+			if size > budget {
+				continue
+			}
+			budget -= size
+			ckv.add(key, value)
+			warmed++
+		}
+		ckv.incrCounter(float32(warmed), "warmup")
+	}
+
+	ckv.accessed = make(map[string]struct{}, len(ckv.accessed))
+	ckv.accessedKeys = ckv.accessedKeys[:0]
+	ckv.accessedBytes = 0
+}
+
+// access records the access to a key for the warm-up, as long as the accessed
+// keys could fit in the cache.
+func (ckv *CommitKVStoreCache) access(key string) {
+	if !ckv.warmup || ckv.accessedBytes >= ckv.cache.maxBytes {
+		return
+	}
+	if _, ok := ckv.accessed[key]; ok {
+		return
+	}
+
+	ckv.accessed[key] = struct{}{}
+	ckv.accessedKeys = append(ckv.accessedKeys, key)
+	ckv.accessedBytes += entrySize(key, nil)
+}
+
+// add adds an entry to the cache and records the evictions.
+func (ckv *CommitKVStoreCache) add(key string, value []byte) {
+	if evicted := ckv.cache.add(key, value); evicted > 0 {
+		ckv.incrCounter(float32(evicted), "eviction")
+	}
+}
+
+// incrCounter increments a counter of the cache, if the metrics are enabled.
+func (ckv *CommitKVStoreCache) incrCounter(val float32, name string) {
+	if ckv.metricLabels != nil && val > 0 {
+		metrics.IncrCounterWithLabels([]string{"store", "cache", name}, val, ckv.metricLabels)
+	}
+}
@@ -16,7 +16,7 @@ import (
 
 func TestGetOrSetStoreCache(t *testing.T) {
 	db := dbm.NewMemDB()
-	mngr := cache.NewCommitKVStoreCacheManager(cache.DefaultCommitKVStoreCacheSize)
+	mngr := cache.NewCommitKVStoreCacheManager(cache.DefaultConfig())
 
 	sKey := types.NewKVStoreKey("test")
 	tree, err := iavl.NewMutableTree(db, 100, false)
@@ -30,7 +30,7 @@ func TestGetOrSetStoreCache(t *testing.T) {
 
 func TestUnwrap(t *testing.T) {
 	db := dbm.NewMemDB()
-	mngr := cache.NewCommitKVStoreCacheManager(cache.DefaultCommitKVStoreCacheSize)
+	mngr := cache.NewCommitKVStoreCacheManager(cache.DefaultConfig())
 
 	sKey := types.NewKVStoreKey("test")
 	tree, err := iavl.NewMutableTree(db, 100, false)
@@ -44,7 +44,7 @@ func TestUnwrap(t *testing.T) {
 
 func TestStoreCache(t *testing.T) {
 	db := dbm.NewMemDB()
-	mngr := cache.NewCommitKVStoreCacheManager(cache.DefaultCommitKVStoreCacheSize)
+	mngr := cache.NewCommitKVStoreCacheManager(cache.DefaultConfig())
 
 	sKey := types.NewKVStoreKey("test")
 	tree, err := iavl.NewMutableTree(db, 100, false)
@@ -52,7 +52,7 @@ func TestStoreCache(t *testing.T) {
 	store := iavlstore.UnsafeNewStore(tree)
 	kvStore := mngr.GetStoreCache(sKey, store)
 
-	for i := uint(0); i < cache.DefaultCommitKVStoreCacheSize*2; i++ {
+	for i := 0; i < 2000; i++ {
 		key := []byte(fmt.Sprintf("key_%d", i))
 		value := []byte(fmt.Sprintf("value_%d", i))
 
@@ -71,7 +71,7 @@ func TestStoreCache(t *testing.T) {
 
 func TestReset(t *testing.T) {
 	db := dbm.NewMemDB()
-	mngr := cache.NewCommitKVStoreCacheManager(cache.DefaultCommitKVStoreCacheSize)
+	mngr := cache.NewCommitKVStoreCacheManager(cache.DefaultConfig())
 
 	sKey := types.NewKVStoreKey("test")
 	tree, err := iavl.NewMutableTree(db, 100, false)
@@ -92,7 +92,7 @@ func TestReset(t *testing.T) {
 
 func TestCacheWrap(t *testing.T) {
 	db := dbm.NewMemDB()
-	mngr := cache.NewCommitKVStoreCacheManager(cache.DefaultCommitKVStoreCacheSize)
+	mngr := cache.NewCommitKVStoreCacheManager(cache.DefaultConfig())
 
 	sKey := types.NewKVStoreKey("test")
 	tree, err := iavl.NewMutableTree(db, 100, false)
@@ -102,3 +102,75 @@ func TestCacheWrap(t *testing.T) {
 	cacheWrapper := mngr.GetStoreCache(sKey, store).CacheWrap()
 	require.IsType(t, &cachekv.Store{}, cacheWrapper)
 }
+
+func TestStoreCacheMaxBytes(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree, err := iavl.NewMutableTree(db, 100, false)
+	require.NoError(t, err)
+	store := iavlstore.UnsafeNewStore(tree)
+
+	// each entry uses 128 bytes of overhead and 10 bytes of key and value
+	kvStore := cache.NewCommitKVStoreCache(store, 3*138, false)
+	for i := 0; i < 4; i++ {
+		kvStore.Set([]byte(fmt.Sprintf("key_%d", i)), []byte("val_0"))
+	}
+
+	// the least recently used entry is evicted, but still read from the store
+	store.Set([]byte("key_0"), []byte("val_1"))
+	store.Set([]byte("key_1"), []byte("val_1"))
+	require.Equal(t, []byte("val_1"), kvStore.Get([]byte("key_0")))
+
+	// key_1 was evicted when key_0 was read back
+	require.Equal(t, []byte("val_1"), kvStore.Get([]byte("key_1")))
+	require.Equal(t, []byte("val_0"), kvStore.Get([]byte("key_3")))
+
+	// the entries larger than the cache are not cached
+	large := make([]byte, 3*138)
+	kvStore.Set([]byte("large"), large)
+	require.Equal(t, []byte("val_0"), kvStore.Get([]byte("key_3")))
+	require.Equal(t, large, kvStore.Get([]byte("large")))
+}
+
+func TestStoreMaxBytes(t *testing.T) {
+	db := dbm.NewMemDB()
+	mngr := cache.NewCommitKVStoreCacheManager(cache.Config{
+		MaxBytes:      cache.DefaultCommitKVStoreCacheMaxBytes,
+		StoreMaxBytes: map[string]uint64{"disabled": 0},
+	})
+
+	tree, err := iavl.NewMutableTree(db, 100, false)
+	require.NoError(t, err)
+	store := iavlstore.UnsafeNewStore(tree)
+
+	// a store with a maximum size of 0 is not cached
+	sKey := types.NewKVStoreKey("disabled")
+	require.Equal(t, store, mngr.GetStoreCache(sKey, store))
+	require.Nil(t, mngr.Unwrap(sKey))
+
+	require.IsType(t, &cache.CommitKVStoreCache{}, mngr.GetStoreCache(types.NewKVStoreKey("test"), store))
+}
+
+func TestStoreCacheWarmup(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree, err := iavl.NewMutableTree(db, 100, false)
+	require.NoError(t, err)
+	store := iavlstore.UnsafeNewStore(tree)
+
+	for i := 0; i < 4; i++ {
+		store.Set([]byte(fmt.Sprintf("key_%d", i)), []byte("val_0"))
+	}
+	store.Commit()
+
+	kvStore := cache.NewCommitKVStoreCache(store, 3*138, true)
+
+	// key_3 evicts key_0, then its deletion frees space in the cache
+	for i := 0; i < 4; i++ {
+		kvStore.Get([]byte(fmt.Sprintf("key_%d", i)))
+	}
+	kvStore.Delete([]byte("key_3"))
+
+	// the warm-up reloads key_0, accessed during the block
+	kvStore.Commit()
+	store.Set([]byte("key_0"), []byte("val_1"))
+	require.Equal(t, []byte("val_0"), kvStore.Get([]byte("key_0")))
+}
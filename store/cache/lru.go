@@ -0,0 +1,98 @@
+package cache
+
+import "container/list"
+
+// entryOverhead approximates the memory used by an entry of the LRU cache in
+// addition to its key and value: the list element, the map entry and the slice
+// and string headers.
+const entryOverhead = 128
+
+// entrySize returns the number of bytes accounted for an entry.
+func entrySize(key string, value []byte) uint64 {
+	return uint64(len(key)+len(value)) + entryOverhead
+}
+
+type lruEntry struct {
+	key   string
+	value []byte
+}
+
+// lruCache is a least recently used cache bounded by the total size of its
+// entries, in bytes. It is not safe for concurrent use.
+type lruCache struct {
+	maxBytes uint64
+	size     uint64
+	ll       *list.List
+	items    map[string]*list.Element
+}
+
+func newLRUCache(maxBytes uint64) *lruCache {
+	return &lruCache{
+		maxBytes: maxBytes,
+		ll:       list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+// get returns the value of a key and marks it as the most recently used.
+func (c *lruCache) get(key string) ([]byte, bool) {
+	elem, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	c.ll.MoveToFront(elem)
+
+	return elem.Value.(*lruEntry).value, true
+}
+
+// sizeOf returns the size of the entry of a key, without marking it as used.
+func (c *lruCache) sizeOf(key string) (uint64, bool) {
+	elem, ok := c.items[key]
+	if !ok {
+		return 0, false
+	}
+	entry := elem.Value.(*lruEntry)
+
+	return entrySize(entry.key, entry.value), true
+}
+
+// add sets the value of a key as the most recently used entry, and evicts the
+// least recently used entries until the cache fits in its maximum size. It
+// returns the number of evicted entries. An entry larger than the maximum size
+// is not cached.
+func (c *lruCache) add(key string, value []byte) (evicted int) {
+	c.remove(key)
+
+	size := entrySize(key, value)
+	if size > c.maxBytes {
+		return 0
+	}
+
+	c.items[key] = c.ll.PushFront(&lruEntry{key: key, value: value})
+	c.size += size
+
+	for c.size > c.maxBytes {
+		c.removeElement(c.ll.Back())
+		evicted++
+	}
+
+	return evicted
+}
+
+// remove removes the entry of a key, if any.
+func (c *lruCache) remove(key string) {
+	if elem, ok := c.items[key]; ok {
+		c.removeElement(elem)
+	}
+}
+
+func (c *lruCache) removeElement(elem *list.Element) {
+	entry := c.ll.Remove(elem).(*lruEntry)
+	delete(c.items, entry.key)
+	c.size -= entrySize(entry.key, entry.value)
+}
+
+// len returns the number of entries of the cache.
+func (c *lruCache) len() int {
+	return c.ll.Len()
+}
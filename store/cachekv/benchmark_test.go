@@ -45,6 +45,26 @@ func DoBenchmarkDeepCacheStack(b *testing.B, depth int) {
 	}
 }
 
+// BenchmarkIterateAfterWrites measures the creation of an iterator after each
+// write, over a store holding many writes.
+func BenchmarkIterateAfterWrites(b *testing.B) {
+	store := cachekv.NewStore(dbadapter.Store{DB: dbm.NewMemDB()})
+	for i := 0; i < 10000; i++ {
+		store.Set([]byte(fmt.Sprintf("key%08d", i)), []byte{0})
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		store.Set([]byte(fmt.Sprintf("new%08d", i)), []byte{0})
+		it := store.Iterator([]byte("key00005000"), []byte("key00005010"))
+		for ; it.Valid(); it.Next() {
+			it.Value()
+		}
+		it.Close()
+	}
+}
+
 func BenchmarkDeepCacheStack1(b *testing.B) {
 	DoBenchmarkDeepCacheStack(b, 1)
 }
@@ -65,6 +65,14 @@ func (bt BTree) ReverseIterator(start, end []byte) (types.Iterator, error) {
 	return newMemIterator(start, end, bt, false), nil
 }
 
+// Scan calls the iterator for each key/value pair in ascending order of the
+// keys, until it returns false.
+func (bt BTree) Scan(iter func(key, value []byte) bool) {
+	bt.tree.Scan(func(i item) bool {
+		return iter(i.key, i.value)
+	})
+}
+
 // Copy the tree. This is a copy-on-write operation and is very fast because
 // it only performs a shadowed copy.
 func (bt BTree) Copy() BTree {
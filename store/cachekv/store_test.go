@@ -101,6 +101,60 @@ func TestCacheKVStoreNested(t *testing.T) {
 	require.Equal(t, valFmt(3), mem.Get(keyFmt(1)))
 }
 
+func TestCacheKVStoreNestedReads(t *testing.T) {
+	mem := dbadapter.Store{DB: dbm.NewMemDB()}
+	mem.Set(keyFmt(1), valFmt(1))
+	st := cachekv.NewStore(mem)
+	st2 := cachekv.NewStore(st)
+
+	// the read is cached by st, not by st2 which reads it from st
+	require.Equal(t, valFmt(1), st2.Get(keyFmt(1)))
+	mem.Set(keyFmt(1), valFmt(2))
+	require.Equal(t, valFmt(1), st.Get(keyFmt(1)))
+	st.Set(keyFmt(1), valFmt(3))
+	require.Equal(t, valFmt(3), st2.Get(keyFmt(1)))
+}
+
+func TestCacheKVIteratorSnapshot(t *testing.T) {
+	st := newCacheKVStore()
+	st.Set(keyFmt(1), valFmt(1))
+	st.Set(keyFmt(3), valFmt(3))
+
+	// the iterator is unaffected by the writes done while iterating
+	itr := st.Iterator(nil, nil)
+	st.Set(keyFmt(2), valFmt(2))
+	st.Delete(keyFmt(3))
+	var keys [][]byte
+	for ; itr.Valid(); itr.Next() {
+		keys = append(keys, itr.Key())
+	}
+	require.NoError(t, itr.Close())
+	require.Equal(t, [][]byte{keyFmt(1), keyFmt(3)}, keys)
+
+	itr = st.ReverseIterator(nil, nil)
+	keys = nil
+	for ; itr.Valid(); itr.Next() {
+		keys = append(keys, itr.Key())
+	}
+	require.NoError(t, itr.Close())
+	require.Equal(t, [][]byte{keyFmt(2), keyFmt(1)}, keys)
+}
+
+func TestCacheKVStoreWriteOwnsKeys(t *testing.T) {
+	mem := dbadapter.Store{DB: dbm.NewMemDB()}
+	st := cachekv.NewStore(mem)
+
+	// the caller may reuse the key once set
+	key := keyFmt(1)
+	st.Set(key, valFmt(1))
+	copy(key, keyFmt(2))
+	require.Nil(t, st.Get(keyFmt(2)))
+
+	st.Write()
+	require.Equal(t, valFmt(1), mem.Get(keyFmt(1)))
+	require.Nil(t, mem.Get(keyFmt(2)))
+}
+
 func TestCacheKVIteratorBounds(t *testing.T) {
 	st := newCacheKVStore()
 
@@ -8,6 +8,7 @@ import (
 
 	"cosmossdk.io/store/cachekv"
 	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/listenkv"
 	"cosmossdk.io/store/tracekv"
 	"cosmossdk.io/store/types"
 )
@@ -142,6 +143,18 @@ func (cms Store) CacheMultiStore() types.CacheMultiStore {
 	return newCacheMultiStoreFromCMS(cms)
 }
 
+// CacheMultiStoreWithListener branches the multi-store like CacheMultiStore, the
+// writes of the branch to the stores of the multi-store, on Write, being
+// reported to the listener.
+func (cms Store) CacheMultiStoreWithListener(listener *types.MemoryListener) types.CacheMultiStore {
+	stores := make(map[types.StoreKey]types.CacheWrapper, len(cms.stores))
+	for key, store := range cms.stores {
+		stores[key] = listenkv.NewStore(store.(types.KVStore), key, listener)
+	}
+
+	return NewFromKVStore(cms.db, stores, nil, cms.traceWriter, cms.traceContext)
+}
+
 // CacheMultiStoreWithVersion implements the MultiStore interface. It will panic
 // as an already cached multi-store cannot load previous versions.
 //
@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"testing"
 
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/store/dbadapter"
 	"cosmossdk.io/store/types"
 )
 
@@ -22,3 +24,25 @@ func TestStoreGetKVStore(t *testing.T) {
 	require.PanicsWithValue(errMsg,
 		func() { s.GetKVStore(key) })
 }
+
+func TestStoreCacheMultiStoreWithListener(t *testing.T) {
+	key := types.NewKVStoreKey("abc")
+	parent := dbadapter.Store{DB: dbm.NewMemDB()}
+	parent.Set([]byte("deleted"), []byte("value"))
+	cms := NewStore(dbm.NewMemDB(), map[types.StoreKey]types.CacheWrapper{key: parent}, nil, nil, nil)
+
+	listener := types.NewMemoryListener()
+	branch := cms.CacheMultiStoreWithListener(listener)
+	branch.GetKVStore(key).Set([]byte("key"), []byte("value"))
+	branch.GetKVStore(key).Delete([]byte("deleted"))
+	require.Empty(t, listener.PopStateCache())
+
+	// the writes are reported once written to the multi-store
+	branch.Write()
+	require.Equal(t, []*types.StoreKVPair{
+		{StoreKey: "abc", Delete: true, Key: []byte("deleted")},
+		{StoreKey: "abc", Key: []byte("key"), Value: []byte("value")},
+	}, listener.PopStateCache())
+	require.Equal(t, []byte("value"), cms.GetKVStore(key).Get([]byte("key")))
+	require.Nil(t, parent.Get([]byte("key")))
+}
@@ -3,6 +3,8 @@ package gaskv
 import (
 	"io"
 
+	"github.com/armon/go-metrics"
+
 	"cosmossdk.io/store/types"
 )
 
@@ -11,9 +13,11 @@ var _ types.KVStore = &Store{}
 // Store applies gas tracking to an underlying KVStore. It implements the
 // KVStore interface.
 type Store struct {
-	gasMeter  types.GasMeter
-	gasConfig types.GasConfig
-	parent    types.KVStore
+	gasMeter       types.GasMeter
+	gasConfig      types.GasConfig
+	parent         types.KVStore
+	iterBytesMeter *types.IterBytesMeter
+	metricLabels   []metrics.Label
 }
 
 // NewStore returns a reference to a new GasKVStore.
@@ -26,6 +30,34 @@ func NewStore(parent types.KVStore, gasMeter types.GasMeter, gasConfig types.Gas
 	return kvs
 }
 
+// WithIterBytesMeter sets the meter of the bytes read through the iterators of
+// the store, which may be shared with other stores. It returns the store.
+func (gs *Store) WithIterBytesMeter(meter *types.IterBytesMeter) *Store {
+	gs.iterBytesMeter = meter
+	return gs
+}
+
+// WithGasMetrics enables the telemetry of the gas consumed by the store, the
+// "store_gas_consumed" counter is incremented with the gas of each operation,
+// labeled with the provided store key and the gas descriptor of the operation.
+// It returns the store.
+func (gs *Store) WithGasMetrics(storeKey string) *Store {
+	gs.metricLabels = []metrics.Label{{Name: "store_key", Value: storeKey}}
+	return gs
+}
+
+// consumeGas consumes gas from the gas meter and records it in telemetry, if
+// enabled.
+func (gs *Store) consumeGas(amount types.Gas, descriptor string) {
+	gs.gasMeter.ConsumeGas(amount, descriptor)
+	if gs.metricLabels != nil {
+		metrics.IncrCounterWithLabels(
+			[]string{"store", "gas", "consumed"}, float32(amount),
+			append([]metrics.Label{{Name: "operation", Value: descriptor}}, gs.metricLabels...),
+		)
+	}
+}
+
 // Implements Store.
 func (gs *Store) GetStoreType() types.StoreType {
 	return gs.parent.GetStoreType()
@@ -33,12 +65,12 @@ func (gs *Store) GetStoreType() types.StoreType {
 
 // Implements KVStore.
 func (gs *Store) Get(key []byte) (value []byte) {
-	gs.gasMeter.ConsumeGas(gs.gasConfig.ReadCostFlat, types.GasReadCostFlatDesc)
+	gs.consumeGas(gs.gasConfig.ReadCostFlat, types.GasReadCostFlatDesc)
 	value = gs.parent.Get(key)
 
 	// TODO overflow-safe math?
-	gs.gasMeter.ConsumeGas(gs.gasConfig.ReadCostPerByte*types.Gas(len(key)), types.GasReadPerByteDesc)
-	gs.gasMeter.ConsumeGas(gs.gasConfig.ReadCostPerByte*types.Gas(len(value)), types.GasReadPerByteDesc)
+	gs.consumeGas(gs.gasConfig.ReadCostPerByte*types.Gas(len(key)), types.GasReadPerByteDesc)
+	gs.consumeGas(gs.gasConfig.ReadCostPerByte*types.Gas(len(value)), types.GasReadPerByteDesc)
 
 	return value
 }
@@ -47,29 +79,30 @@ func (gs *Store) Get(key []byte) (value []byte) {
 func (gs *Store) Set(key []byte, value []byte) {
 	types.AssertValidKey(key)
 	types.AssertValidValue(value)
-	gs.gasMeter.ConsumeGas(gs.gasConfig.WriteCostFlat, types.GasWriteCostFlatDesc)
+	gs.consumeGas(gs.gasConfig.WriteCostFlat, types.GasWriteCostFlatDesc)
 	// TODO overflow-safe math?
-	gs.gasMeter.ConsumeGas(gs.gasConfig.WriteCostPerByte*types.Gas(len(key)), types.GasWritePerByteDesc)
-	gs.gasMeter.ConsumeGas(gs.gasConfig.WriteCostPerByte*types.Gas(len(value)), types.GasWritePerByteDesc)
+	gs.consumeGas(gs.gasConfig.WriteCostPerByte*types.Gas(len(key)), types.GasWritePerByteDesc)
+	gs.consumeGas(gs.gasConfig.WriteCostPerByte*types.Gas(len(value)), types.GasWritePerByteDesc)
 	gs.parent.Set(key, value)
 }
 
 // Implements KVStore.
 func (gs *Store) Has(key []byte) bool {
-	gs.gasMeter.ConsumeGas(gs.gasConfig.HasCost, types.GasHasDesc)
+	gs.consumeGas(gs.gasConfig.HasCost, types.GasHasDesc)
 	return gs.parent.Has(key)
 }
 
 // Implements KVStore.
 func (gs *Store) Delete(key []byte) {
 	// charge gas to prevent certain attack vectors even though space is being freed
-	gs.gasMeter.ConsumeGas(gs.gasConfig.DeleteCost, types.GasDeleteDesc)
+	gs.consumeGas(gs.gasConfig.DeleteCost, types.GasDeleteDesc)
 	gs.parent.Delete(key)
 }
 
 // Iterator implements the KVStore interface. It returns an iterator which
 // incurs a flat gas cost for seeking to the first key/value pair and a variable
-// gas cost based on the current value's length if the iterator is valid.
+// gas cost based on the current value's length if the iterator is valid. The
+// bytes of the pairs read are charged to the iterator bytes meter, if set.
 func (gs *Store) Iterator(start, end []byte) types.Iterator {
 	return gs.iterator(start, end, true)
 }
@@ -100,23 +133,22 @@ func (gs *Store) iterator(start, end []byte, ascending bool) types.Iterator {
 		parent = gs.parent.ReverseIterator(start, end)
 	}
 
-	gi := newGasIterator(gs.gasMeter, gs.gasConfig, parent)
-	gi.(*gasIterator).consumeSeekGas()
+	gi := newGasIterator(gs, parent)
+	gi.(*gasIterator).consumeSeekGas(gs.gasConfig.IterSeekCostFlat, types.GasIterSeekCostFlatDesc)
+	gi.(*gasIterator).consumeIterBytes()
 
 	return gi
 }
 
 type gasIterator struct {
-	gasMeter  types.GasMeter
-	gasConfig types.GasConfig
-	parent    types.Iterator
+	store  *Store
+	parent types.Iterator
 }
 
-func newGasIterator(gasMeter types.GasMeter, gasConfig types.GasConfig, parent types.Iterator) types.Iterator {
+func newGasIterator(store *Store, parent types.Iterator) types.Iterator {
 	return &gasIterator{
-		gasMeter:  gasMeter,
-		gasConfig: gasConfig,
-		parent:    parent,
+		store:  store,
+		parent: parent,
 	}
 }
 
@@ -134,8 +166,9 @@ func (gi *gasIterator) Valid() bool {
 // in the iterator. It incurs a flat gas cost for seeking and a variable gas
 // cost based on the current value's length if the iterator is valid.
 func (gi *gasIterator) Next() {
-	gi.consumeSeekGas()
+	gi.consumeSeekGas(gi.store.gasConfig.IterNextCostFlat, types.GasIterNextCostFlatDesc)
 	gi.parent.Next()
+	gi.consumeIterBytes()
 }
 
 // Key implements the Iterator interface. It returns the current key and it does
@@ -162,15 +195,24 @@ func (gi *gasIterator) Error() error {
 	return gi.parent.Error()
 }
 
-// consumeSeekGas consumes on each iteration step a flat gas cost and a variable gas cost
-// based on the current value's length.
-func (gi *gasIterator) consumeSeekGas() {
+// consumeSeekGas consumes on each iteration step the provided flat gas cost, of
+// the initial seek or of a next step, and a variable gas cost based on the
+// current value's length.
+func (gi *gasIterator) consumeSeekGas(flatCost types.Gas, descriptor string) {
 	if gi.Valid() {
 		key := gi.Key()
 		value := gi.Value()
 
-		gi.gasMeter.ConsumeGas(gi.gasConfig.ReadCostPerByte*types.Gas(len(key)), types.GasValuePerByteDesc)
-		gi.gasMeter.ConsumeGas(gi.gasConfig.ReadCostPerByte*types.Gas(len(value)), types.GasValuePerByteDesc)
+		gi.store.consumeGas(gi.store.gasConfig.ReadCostPerByte*types.Gas(len(key)), types.GasValuePerByteDesc)
+		gi.store.consumeGas(gi.store.gasConfig.ReadCostPerByte*types.Gas(len(value)), types.GasValuePerByteDesc)
+	}
+	gi.store.consumeGas(flatCost, descriptor)
+}
+
+// consumeIterBytes charges the bytes of the current key/value pair, once the
+// iterator reached it, to the iterator bytes meter if set.
+func (gi *gasIterator) consumeIterBytes() {
+	if gi.store.iterBytesMeter != nil && gi.Valid() {
+		gi.store.iterBytesMeter.Consume(uint64(len(gi.Key()) + len(gi.Value())))
 	}
-	gi.gasMeter.ConsumeGas(gi.gasConfig.IterNextCostFlat, types.GasIterNextCostFlatDesc)
 }
@@ -3,7 +3,9 @@ package gaskv_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/armon/go-metrics"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/require"
 
@@ -118,3 +120,69 @@ func TestGasKVStoreOutOfGasIterator(t *testing.T) {
 	iterator.Next()
 	require.Panics(t, func() { iterator.Value() }, "Expected out-of-gas")
 }
+
+func TestGasKVStoreIteratorSeekCost(t *testing.T) {
+	mem := dbadapter.Store{DB: dbm.NewMemDB()}
+	meter := types.NewGasMeter(100000)
+	st := gaskv.NewStore(mem, meter, types.GasConfig{IterSeekCostFlat: 100, IterNextCostFlat: 1})
+	st.Set(keyFmt(1), valFmt(1))
+	st.Set(keyFmt(2), valFmt(2))
+
+	iterator := st.Iterator(nil, nil)
+	require.Equal(t, types.Gas(100), meter.GasConsumed())
+	iterator.Next()
+	iterator.Next()
+	require.False(t, iterator.Valid())
+	require.Equal(t, types.Gas(102), meter.GasConsumed())
+	require.NoError(t, iterator.Close())
+
+	iterator = st.ReverseIterator(nil, nil)
+	require.Equal(t, types.Gas(202), meter.GasConsumed())
+	require.NoError(t, iterator.Close())
+}
+
+func TestGasKVStoreIterBytesLimit(t *testing.T) {
+	pairBytes := uint64(len(keyFmt(1)) + len(valFmt(1)))
+	iterMeter := types.NewIterBytesMeter(3 * pairBytes)
+
+	// the meter is shared by the stores
+	mem1, mem2 := dbadapter.Store{DB: dbm.NewMemDB()}, dbadapter.Store{DB: dbm.NewMemDB()}
+	st1 := gaskv.NewStore(mem1, types.NewInfiniteGasMeter(), types.KVGasConfig()).WithIterBytesMeter(iterMeter)
+	st2 := gaskv.NewStore(mem2, types.NewInfiniteGasMeter(), types.KVGasConfig()).WithIterBytesMeter(iterMeter)
+	for i := 0; i < 2; i++ {
+		st1.Set(keyFmt(i), valFmt(i))
+		st2.Set(keyFmt(i), valFmt(i))
+	}
+
+	// the reads of Get are not limited
+	require.Equal(t, valFmt(0), st1.Get(keyFmt(0)))
+	require.Zero(t, iterMeter.Consumed())
+
+	iterator := st1.Iterator(nil, nil)
+	iterator.Next()
+	iterator.Next()
+	require.False(t, iterator.Valid())
+	require.Equal(t, 2*pairBytes, iterMeter.Consumed())
+
+	iterator = st2.Iterator(nil, nil)
+	require.Equal(t, 3*pairBytes, iterMeter.Consumed())
+	require.PanicsWithValue(t, types.ErrorIterBytesLimit{Limit: 3 * pairBytes}, iterator.Next)
+}
+
+func TestGasKVStoreGasMetrics(t *testing.T) {
+	sink := metrics.NewInmemSink(time.Minute, time.Minute)
+	_, err := metrics.NewGlobal(metrics.DefaultConfig(""), sink)
+	require.NoError(t, err)
+	defer metrics.NewGlobal(metrics.DefaultConfig(""), &metrics.BlackholeSink{}) //nolint:errcheck // restores the default sink
+
+	mem := dbadapter.Store{DB: dbm.NewMemDB()}
+	st := gaskv.NewStore(mem, types.NewInfiniteGasMeter(), types.KVGasConfig()).WithGasMetrics("bank")
+	st.Has(keyFmt(1))
+	st.Has(keyFmt(2))
+
+	counters := sink.Data()[0].Counters
+	counter, ok := counters["store.gas.consumed;operation=Has;store_key=bank"]
+	require.True(t, ok, counters)
+	require.Equal(t, 2, counter.Count)
+	require.Equal(t, float64(2*types.KVGasConfig().HasCost), counter.Sum)
+}
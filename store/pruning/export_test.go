@@ -3,6 +3,7 @@ package pruning
 var (
 	PruneHeightsKey         = pruneHeightsKey
 	PruneSnapshotHeightsKey = pruneSnapshotHeightsKey
+	PendingPruneHeightsKey  = pendingPruneHeightsKey
 
 	Int64SliceToBytes          = int64SliceToBytes
 	ListToBytes                = listToBytes
@@ -24,6 +24,10 @@ type Manager struct {
 	// we sync access to them to avoid soundness issues in the future if concurrency pattern changes.
 	pruneHeightsMx sync.Mutex
 	pruneHeights   []int64
+	// These are the heights returned by the last call to GetFlushAndResetPruningHeights which are not
+	// pruned yet, they are persisted until HandlePrunedHeights is called so that they are pruned after
+	// a restart. Access is synced with pruneHeightsMx.
+	pendingPruneHeights []int64
 	// Snapshots are taken in a separate goroutine from the regular execution
 	// and can be delivered asynchrounously via HandleHeightSnapshot.
 	// Therefore, we sync access to pruneSnapshotHeights with this mutex.
@@ -47,6 +51,7 @@ func (e *NegativeHeightsError) Error() string {
 var (
 	pruneHeightsKey         = []byte("s/pruneheights")
 	pruneSnapshotHeightsKey = []byte("s/prunesnapshotheights")
+	pendingPruneHeightsKey  = []byte("s/pendingpruneheights")
 )
 
 // NewManager returns a new Manager with the given db and logger.
@@ -59,6 +64,7 @@ func NewManager(db dbm.DB, logger log.Logger) *Manager {
 		logger:               logger,
 		opts:                 types.NewPruningOptions(types.PruningNothing),
 		pruneHeights:         []int64{},
+		pendingPruneHeights:  []int64{},
 		pruneSnapshotHeights: list.New(),
 	}
 }
@@ -74,7 +80,10 @@ func (m *Manager) GetOptions() types.PruningOptions {
 }
 
 // GetFlushAndResetPruningHeights returns all heights to be pruned during the next call to Prune().
-// It also flushes and resets the pruning heights.
+// It also flushes and resets the pruning heights. The returned heights are flushed as pending,
+// replacing the previous pending heights and the flushed heights to prune, until
+// HandlePrunedHeights is called, so that they are pruned after a restart if the node stops before
+// they are pruned. The heights which could not be pruned are given back with RequeuePruningHeights.
 func (m *Manager) GetFlushAndResetPruningHeights() ([]int64, error) {
 	if m.opts.GetPruningStrategy() == types.PruningNothing {
 		return []int64{}, nil
@@ -82,19 +91,70 @@ func (m *Manager) GetFlushAndResetPruningHeights() ([]int64, error) {
 	m.pruneHeightsMx.Lock()
 	defer m.pruneHeightsMx.Unlock()
 
-	// flush the updates to disk so that it is not lost if crash happens.
-	if err := m.db.SetSync(pruneHeightsKey, int64SliceToBytes(m.pruneHeights)); err != nil {
+	// flush the updates to disk so that it is not lost if crash happens. The heights are cleared
+	// from the heights to prune once pending, so that they are not loaded again after every restart.
+	if err := m.db.SetSync(pendingPruneHeightsKey, int64SliceToBytes(m.pruneHeights)); err != nil {
+		return nil, err
+	}
+	if err := m.db.DeleteSync(pruneHeightsKey); err != nil {
 		return nil, err
 	}
 
 	// Return a copy to prevent data races.
 	pruningHeights := make([]int64, len(m.pruneHeights))
 	copy(pruningHeights, m.pruneHeights)
+	m.pendingPruneHeights = append(m.pendingPruneHeights[:0], pruningHeights...)
 	m.pruneHeights = m.pruneHeights[:0]
 
 	return pruningHeights, nil
 }
 
+// HandlePrunedHeights removes the given heights, returned by GetFlushAndResetPruningHeights, from
+// the pending heights once they are pruned. Flushes the update to disk.
+func (m *Manager) HandlePrunedHeights(heights []int64) error {
+	if len(heights) == 0 {
+		return nil
+	}
+	m.pruneHeightsMx.Lock()
+	defer m.pruneHeightsMx.Unlock()
+
+	pendingPruneHeights := removePruningHeights(m.pendingPruneHeights, heights)
+	if err := m.db.SetSync(pendingPruneHeightsKey, int64SliceToBytes(pendingPruneHeights)); err != nil {
+		return err
+	}
+	m.pendingPruneHeights = pendingPruneHeights
+	return nil
+}
+
+// RequeuePruningHeights gives back the given heights, returned by GetFlushAndResetPruningHeights,
+// which could not be pruned. They are returned again by the next call to
+// GetFlushAndResetPruningHeights. Flushes the update to disk.
+func (m *Manager) RequeuePruningHeights(heights []int64) error {
+	if len(heights) == 0 {
+		return nil
+	}
+	m.pruneHeightsMx.Lock()
+	defer m.pruneHeightsMx.Unlock()
+
+	pruneHeights := mergePruningHeights(heights, m.pruneHeights)
+	if err := m.db.SetSync(pruneHeightsKey, int64SliceToBytes(pruneHeights)); err != nil {
+		return err
+	}
+	m.pruneHeights = pruneHeights
+	// the heights are flushed with the heights to prune, they are not pending anymore
+	m.pendingPruneHeights = removePruningHeights(m.pendingPruneHeights, heights)
+	return nil
+}
+
+// PruningBacklog returns the number of heights waiting to be pruned, either to be returned by the
+// next call to GetFlushAndResetPruningHeights or pending.
+func (m *Manager) PruningBacklog() int {
+	m.pruneHeightsMx.Lock()
+	defer m.pruneHeightsMx.Unlock()
+
+	return len(m.pruneHeights) + len(m.pendingPruneHeights)
+}
+
 // HandleHeight determines if previousHeight height needs to be kept for pruning at the right interval prescribed by
 // the pruning strategy. Returns previousHeight, if it was kept to be pruned at the next call to Prune(), 0 otherwise.
 // previousHeight must be greater than 0 for the handling to take effect since valid heights start at 1 and 0 represents
@@ -192,10 +252,17 @@ func (m *Manager) LoadPruningHeights(db dbm.DB) error {
 		return err
 	}
 
-	if len(loadedPruneHeights) > 0 {
+	// the pending heights were not pruned before the restart, they are pruned again
+	loadedPendingPruneHeights, err := loadPendingPruningHeights(db)
+	if err != nil {
+		return err
+	}
+
+	if len(loadedPruneHeights) > 0 || len(loadedPendingPruneHeights) > 0 {
 		m.pruneHeightsMx.Lock()
 		defer m.pruneHeightsMx.Unlock()
-		m.pruneHeights = loadedPruneHeights
+		m.pruneHeights = mergePruningHeights(loadedPendingPruneHeights, loadedPruneHeights)
+		m.pendingPruneHeights = []int64{}
 	}
 
 	loadedPruneSnapshotHeights, err := loadPruningSnapshotHeights(db)
@@ -217,6 +284,46 @@ func loadPruningHeights(db dbm.DB) ([]int64, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to get pruned heights: %w", err)
 	}
+	return bytesToInt64Slice(bz)
+}
+
+func loadPendingPruningHeights(db dbm.DB) ([]int64, error) {
+	bz, err := db.Get(pendingPruneHeightsKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending pruned heights: %w", err)
+	}
+	return bytesToInt64Slice(bz)
+}
+
+// mergePruningHeights returns the heights of both slices in order, without duplicates.
+func mergePruningHeights(first, second []int64) []int64 {
+	merged := make([]int64, 0, len(first)+len(second))
+	seen := make(map[int64]bool, len(first)+len(second))
+	for _, h := range append(first[:len(first):len(first)], second...) {
+		if !seen[h] {
+			seen[h] = true
+			merged = append(merged, h)
+		}
+	}
+	return merged
+}
+
+// removePruningHeights returns the heights which are not removed.
+func removePruningHeights(heights, removed []int64) []int64 {
+	isRemoved := make(map[int64]bool, len(removed))
+	for _, h := range removed {
+		isRemoved[h] = true
+	}
+	remaining := make([]int64, 0, len(heights))
+	for _, h := range heights {
+		if !isRemoved[h] {
+			remaining = append(remaining, h)
+		}
+	}
+	return remaining
+}
+
+func bytesToInt64Slice(bz []byte) ([]int64, error) {
 	if len(bz) == 0 {
 		return []int64{}, nil
 	}
@@ -436,6 +436,66 @@ func TestFlushLoad(t *testing.T) {
 	}
 }
 
+func TestPendingPruningHeights_FlushLoad(t *testing.T) {
+	db := db.NewMemDB()
+	manager := pruning.NewManager(db, log.NewNopLogger())
+	manager.SetOptions(types.NewCustomPruningOptions(0, 10))
+
+	for h := int64(1); h <= 5; h++ {
+		manager.HandleHeight(h)
+	}
+	require.Equal(t, 5, manager.PruningBacklog())
+
+	heights, err := manager.GetFlushAndResetPruningHeights()
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2, 3, 4, 5}, heights)
+	require.Equal(t, 5, manager.PruningBacklog())
+
+	// the flushed heights are only pending
+	bz, err := db.Get(pruning.PruneHeightsKey)
+	require.NoError(t, err)
+	require.Empty(t, bz)
+	manager.HandleHeight(6)
+
+	// the pending heights are pruned after a restart
+	restarted := pruning.NewManager(db, log.NewNopLogger())
+	restarted.SetOptions(manager.GetOptions())
+	require.NoError(t, restarted.LoadPruningHeights(db))
+	require.Equal(t, 6, restarted.PruningBacklog())
+	loaded, err := restarted.GetFlushAndResetPruningHeights()
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2, 3, 4, 5, 6}, loaded)
+
+	// the pruned heights are not pending anymore
+	require.NoError(t, manager.HandlePrunedHeights([]int64{1, 2, 3}))
+	require.Equal(t, 3, manager.PruningBacklog())
+	bz, err = db.Get(pruning.PendingPruneHeightsKey)
+	require.NoError(t, err)
+	require.Equal(t, pruning.Int64SliceToBytes([]int64{4, 5}), bz)
+
+	// the heights which could not be pruned are returned again
+	require.NoError(t, manager.RequeuePruningHeights([]int64{4, 5}))
+	require.Equal(t, 3, manager.PruningBacklog())
+	heights, err = manager.GetFlushAndResetPruningHeights()
+	require.NoError(t, err)
+	require.Equal(t, []int64{4, 5, 6}, heights)
+
+	// the requeued heights are not loaded again after a restart once pruned
+	bz, err = db.Get(pruning.PruneHeightsKey)
+	require.NoError(t, err)
+	require.Empty(t, bz)
+	require.NoError(t, manager.HandlePrunedHeights(heights))
+	require.Equal(t, 0, manager.PruningBacklog())
+	manager.HandleHeight(7)
+
+	restarted = pruning.NewManager(db, log.NewNopLogger())
+	restarted.SetOptions(manager.GetOptions())
+	require.NoError(t, restarted.LoadPruningHeights(db))
+	loaded, err = restarted.GetFlushAndResetPruningHeights()
+	require.NoError(t, err)
+	require.Equal(t, []int64{7}, loaded)
+}
+
 func TestLoadPruningHeights(t *testing.T) {
 	var (
 		manager = pruning.NewManager(db.NewMemDB(), log.NewNopLogger())
@@ -0,0 +1,349 @@
+package rootmulti
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	ics23 "github.com/confio/ics23/go"
+	dbm "github.com/cosmos/cosmos-db"
+	protoio "github.com/cosmos/gogoproto/io"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/iavl"
+	sdkmaps "cosmossdk.io/store/internal/maps"
+	"cosmossdk.io/store/metrics"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	"cosmossdk.io/store/types"
+)
+
+const (
+	storeTypeMap   types.StoreType = 100
+	proofOpMapType                 = "ics23:map"
+)
+
+// mapBackend is a commitment backend whose stores commit to the simple Merkle hash of
+// their key/value pairs, saving all of them at each version.
+type mapBackend struct{}
+
+var _ types.CommitmentBackend = mapBackend{}
+
+func (mapBackend) LoadStore(db dbm.DB, _ types.StoreKey, id types.CommitID, initialVersion uint64) (types.CommitmentStore, error) {
+	store := &mapStore{db: db, initialVersion: int64(initialVersion)}
+	return store, store.LoadVersionForOverwriting(id.Version)
+}
+
+func (mapBackend) ProofOpType() string { return proofOpMapType }
+
+func (mapBackend) ProofSpec() *ics23.ProofSpec { return ics23.TendermintSpec }
+
+type mapStore struct {
+	dbadapter.Store
+	db             dbm.DB
+	version        int64
+	initialVersion int64
+}
+
+var _ types.CommitmentStore = (*mapStore)(nil)
+
+func (s *mapStore) versionPrefix(version int64) []byte {
+	return binary.BigEndian.AppendUint64(nil, uint64(version))
+}
+
+func (s *mapStore) load(version int64) map[string][]byte {
+	m := map[string][]byte{}
+	if version == 0 {
+		return m
+	}
+	itr, err := dbm.IteratePrefix(s.db, s.versionPrefix(version))
+	if err != nil {
+		panic(err)
+	}
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		m[string(itr.Key()[8:])] = itr.Value()
+	}
+	return m
+}
+
+func (s *mapStore) hash(version int64) []byte {
+	m := s.load(version)
+	if len(m) == 0 {
+		return nil
+	}
+	return sdkmaps.HashFromMap(m)
+}
+
+func (s *mapStore) GetStoreType() types.StoreType { return storeTypeMap }
+
+func (s *mapStore) Commit() types.CommitID {
+	version := s.version + 1
+	if s.version == 0 && s.initialVersion > 1 {
+		version = s.initialVersion
+	}
+	itr := s.Store.Iterator(nil, nil)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		if err := s.db.Set(append(s.versionPrefix(version), itr.Key()...), itr.Value()); err != nil {
+			panic(err)
+		}
+	}
+	s.version = version
+	return s.LastCommitID()
+}
+
+func (s *mapStore) LastCommitID() types.CommitID {
+	return types.CommitID{Version: s.version, Hash: s.hash(s.version)}
+}
+
+func (s *mapStore) SetPruning(pruningtypes.PruningOptions) {}
+
+func (s *mapStore) GetPruning() pruningtypes.PruningOptions {
+	return pruningtypes.NewPruningOptions(pruningtypes.PruningNothing)
+}
+
+func (s *mapStore) SetInitialVersion(version int64) { s.initialVersion = version }
+
+func (s *mapStore) GetImmutable(version int64) (types.KVStore, error) {
+	if version > s.version || (version != s.version && !s.hasVersion(version)) {
+		return nil, fmt.Errorf("version %d does not exist", version)
+	}
+	store := dbadapter.Store{DB: dbm.NewMemDB()}
+	for k, v := range s.load(version) {
+		store.Set([]byte(k), v)
+	}
+	return store, nil
+}
+
+func (s *mapStore) hasVersion(version int64) bool {
+	itr, err := dbm.IteratePrefix(s.db, s.versionPrefix(version))
+	if err != nil {
+		panic(err)
+	}
+	defer itr.Close()
+	return itr.Valid()
+}
+
+func (s *mapStore) DeleteVersions(versions ...int64) error {
+	for _, version := range versions {
+		for k := range s.load(version) {
+			if err := s.db.Delete(append(s.versionPrefix(version), k...)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *mapStore) LoadVersionForOverwriting(version int64) error {
+	for v := version + 1; v <= s.version; v++ {
+		if err := s.DeleteVersions(v); err != nil {
+			return err
+		}
+	}
+	s.version = version
+	s.Store = dbadapter.Store{DB: dbm.NewMemDB()}
+	for k, v := range s.load(version) {
+		s.Store.Set([]byte(k), v)
+	}
+	return nil
+}
+
+func (s *mapStore) Export(version int64) (types.Iterator, error) {
+	store, err := s.GetImmutable(version)
+	if err != nil {
+		return nil, err
+	}
+	return store.Iterator(nil, nil), nil
+}
+
+func (s *mapStore) Import(version int64) (types.CommitmentImporter, error) {
+	if s.version != 0 {
+		return nil, fmt.Errorf("cannot import into non-empty store at version %d", s.version)
+	}
+	return &mapImporter{store: s, version: version}, nil
+}
+
+// mapImporter saves the imported key/value pairs at the version of the import.
+type mapImporter struct {
+	store   *mapStore
+	version int64
+	batch   dbm.Batch
+}
+
+func (i *mapImporter) Add(key, value []byte) error {
+	if i.batch == nil {
+		i.batch = i.store.db.NewBatch()
+	}
+	return i.batch.Set(append(i.store.versionPrefix(i.version), key...), value)
+}
+
+func (i *mapImporter) Commit() error {
+	if i.batch != nil {
+		if err := i.batch.Write(); err != nil {
+			return err
+		}
+	}
+	return i.store.LoadVersionForOverwriting(i.version)
+}
+
+func (i *mapImporter) Close() error {
+	if i.batch == nil {
+		return nil
+	}
+	err := i.batch.Close()
+	i.batch = nil
+	return err
+}
+
+func (s *mapStore) Query(req abci.RequestQuery) abci.ResponseQuery {
+	if req.Path != "/key" {
+		return types.QueryResult(fmt.Errorf("unexpected query path %s", req.Path), false)
+	}
+	m := s.load(s.version)
+	res := abci.ResponseQuery{Key: req.Data, Value: m[string(req.Data)], Height: s.version}
+	if req.Prove {
+		op, err := types.ProofOpFromMap(m, string(req.Data))
+		if err != nil {
+			return types.QueryResult(err, false)
+		}
+		op.Type = proofOpMapType
+		res.ProofOps = &cmtprotocrypto.ProofOps{Ops: []cmtprotocrypto.ProofOp{op}}
+	}
+	return res
+}
+
+func newMultiStoreWithMapBackend(db dbm.DB, storeTypes map[string]types.StoreType) *Store {
+	store := NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	store.RegisterCommitmentBackend(storeTypeMap, mapBackend{})
+	for name, typ := range storeTypes {
+		store.MountStoreWithDB(types.NewKVStoreKey(name), typ, nil)
+	}
+	return store
+}
+
+func TestCommitmentBackend(t *testing.T) {
+	db := dbm.NewMemDB()
+	storeTypes := map[string]types.StoreType{"iavl": types.StoreTypeIAVL, "map": storeTypeMap}
+	store := newMultiStoreWithMapBackend(db, storeTypes)
+	require.NoError(t, store.LoadLatestVersion())
+
+	require.IsType(t, &iavl.Store{}, store.GetStoreByName("iavl"))
+	mapStore := store.GetStoreByName("map").(types.KVStore)
+	mapStore.Set([]byte("a"), []byte("1"))
+	mapStore.Set([]byte("b"), []byte("2"))
+	store.GetStoreByName("iavl").(types.KVStore).Set([]byte("a"), []byte("1"))
+	store.Commit()
+	mapStore.Set([]byte("b"), []byte("3"))
+	cid := store.Commit()
+
+	// the store is included in the commit info
+	info, err := getCommitInfo(db, cid.Version)
+	require.NoError(t, err)
+	require.Len(t, info.StoreInfos, 2)
+	require.Equal(t, "map", info.StoreInfos[1].Name)
+	require.Equal(t, store.GetCommitKVStore(store.keysByName["map"]).LastCommitID(), info.StoreInfos[1].CommitId)
+
+	// the proofs of the store are chained to the multi-store proof
+	res := store.Query(abci.RequestQuery{Path: "/map/key", Data: []byte("b"), Prove: true})
+	require.EqualValues(t, 0, res.Code, res.Log)
+	require.Equal(t, []byte("3"), res.Value)
+	prt := DefaultProofRuntime()
+	RegisterCommitmentProofOps(prt, mapBackend{})
+	require.NoError(t, prt.VerifyValue(res.ProofOps, cid.Hash, "/map/b", []byte("3")))
+	require.Error(t, prt.VerifyValue(res.ProofOps, cid.Hash, "/map/b", []byte("2")))
+	require.Error(t, DefaultProofRuntime().VerifyValue(res.ProofOps, cid.Hash, "/map/b", []byte("3")))
+
+	// previous versions are served by the store
+	cms, err := store.CacheMultiStoreWithVersion(1)
+	require.NoError(t, err)
+	require.Equal(t, []byte("2"), cms.GetKVStore(store.keysByName["map"]).Get([]byte("b")))
+
+	// the store is reloaded from the database
+	store = newMultiStoreWithMapBackend(db, storeTypes)
+	require.NoError(t, store.LoadLatestVersion())
+	require.Equal(t, cid, store.LastCommitID())
+	require.Equal(t, []byte("3"), store.GetStoreByName("map").(types.KVStore).Get([]byte("b")))
+
+	// and rolled back
+	require.NoError(t, store.RollbackToVersion(1))
+	require.Equal(t, []byte("2"), store.GetStoreByName("map").(types.KVStore).Get([]byte("b")))
+	_, err = store.CacheMultiStoreWithVersion(2)
+	require.Error(t, err)
+}
+
+func TestCommitmentBackend_Snapshot(t *testing.T) {
+	storeTypes := map[string]types.StoreType{"iavl": types.StoreTypeIAVL, "map": storeTypeMap}
+	source := newMultiStoreWithMapBackend(dbm.NewMemDB(), storeTypes)
+	require.NoError(t, source.LoadLatestVersion())
+
+	mapStore := source.GetStoreByName("map").(types.KVStore)
+	mapStore.Set([]byte("a"), []byte("1"))
+	mapStore.Set([]byte("b"), []byte("2"))
+	source.GetStoreByName("iavl").(types.KVStore).Set([]byte("a"), []byte("1"))
+	source.Commit()
+	mapStore.Set([]byte("b"), []byte("3"))
+	mapStore.Set([]byte("c"), []byte{})
+	cid := source.Commit()
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, source.Snapshot(uint64(cid.Version), protoio.NewDelimitedWriter(buf)))
+
+	target := newMultiStoreWithMapBackend(dbm.NewMemDB(), storeTypes)
+	require.NoError(t, target.LoadLatestVersion())
+	_, err := target.Restore(uint64(cid.Version), snapshottypes.CurrentFormat, protoio.NewDelimitedReader(buf, 1<<20))
+	require.NoError(t, err)
+
+	require.Equal(t, cid, target.LastCommitID())
+	restored := target.GetStoreByName("map").(types.KVStore)
+	require.Equal(t, []byte("1"), restored.Get([]byte("a")))
+	require.Equal(t, []byte("3"), restored.Get([]byte("b")))
+	require.True(t, restored.Has([]byte("c")))
+	require.Equal(t, []byte("1"), target.GetStoreByName("iavl").(types.KVStore).Get([]byte("a")))
+}
+
+func TestCommitmentBackend_Migration(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := newMultiStoreWithMapBackend(db, map[string]types.StoreType{"bank": types.StoreTypeIAVL, "other": types.StoreTypeIAVL})
+	require.NoError(t, store.LoadLatestVersion())
+	store.GetStoreByName("bank").(types.KVStore).Set([]byte("alice"), []byte("10"))
+	store.GetStoreByName("bank").(types.KVStore).Set([]byte("bob"), []byte("20"))
+	store.Commit()
+
+	// the IAVL store is migrated to a store of the commitment backend under the same name
+	storeTypes := map[string]types.StoreType{"bank": storeTypeMap, "other": types.StoreTypeIAVL}
+	store = newMultiStoreWithMapBackend(db, storeTypes)
+	require.NoError(t, store.LoadLatestVersionAndUpgrade(&types.StoreUpgrades{
+		Migrated: []types.StoreMigration{{Key: "bank", OldType: types.StoreTypeIAVL}},
+	}))
+	cid := store.Commit()
+
+	bank := store.GetStoreByName("bank")
+	require.IsType(t, &mapStore{}, bank)
+	require.Equal(t, []byte("10"), bank.(types.KVStore).Get([]byte("alice")))
+	require.Equal(t, []byte("20"), bank.(types.KVStore).Get([]byte("bob")))
+	info, err := getCommitInfo(db, cid.Version)
+	require.NoError(t, err)
+	require.Len(t, info.StoreInfos, 2)
+	require.Equal(t, types.StoreInfo{Name: "bank", CommitId: bank.(types.CommitKVStore).LastCommitID()}, info.StoreInfos[0])
+	require.EqualValues(t, 2, info.StoreInfos[0].CommitId.Version)
+
+	// the migrated store is loaded without upgrades
+	store = newMultiStoreWithMapBackend(db, storeTypes)
+	require.NoError(t, store.LoadLatestVersion())
+	require.Equal(t, cid, store.LastCommitID())
+	require.Equal(t, []byte("10"), store.GetStoreByName("bank").(types.KVStore).Get([]byte("alice")))
+}
+
+func TestRegisterCommitmentBackend(t *testing.T) {
+	store := NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	require.Panics(t, func() { store.RegisterCommitmentBackend(types.StoreTypeIAVL, mapBackend{}) })
+	store.RegisterCommitmentBackend(storeTypeMap, mapBackend{})
+	require.Panics(t, func() { store.RegisterCommitmentBackend(storeTypeMap, mapBackend{}) })
+}
@@ -25,3 +25,11 @@ func DefaultProofRuntime() (prt *merkle.ProofRuntime) {
 	prt.RegisterOpDecoder(storetypes.ProofOpSimpleMerkleCommitment, storetypes.CommitmentOpDecoder)
 	return
 }
+
+// RegisterCommitmentProofOps registers the decoders of the ProofOps of the stores of
+// the commitment backends in the proof runtime.
+func RegisterCommitmentProofOps(prt *merkle.ProofRuntime, backends ...storetypes.CommitmentBackend) {
+	for _, backend := range backends {
+		prt.RegisterOpDecoder(backend.ProofOpType(), storetypes.NewCommitmentOpDecoder(backend.ProofOpType(), backend.ProofSpec()))
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"io"
 	"math/rand"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"cosmossdk.io/log"
@@ -157,6 +159,41 @@ func TestMultistoreSnapshot_Checksum(t *testing.T) {
 	}
 }
 
+func TestMultistoreSnapshot_Concurrency(t *testing.T) {
+	// Stores exported concurrently must produce the same snapshot output as a serial export
+	store := newMultiStoreWithGeneratedData(dbm.NewMemDB(), 5, 1000)
+	version := uint64(store.LastCommitID().Version)
+	spoolDir := filepath.Join(t.TempDir(), "spool")
+	store.SetSnapshotSpoolDir(spoolDir)
+
+	snapshot := func(concurrency int) []byte {
+		store.SetSnapshotConcurrency(concurrency)
+		ch := make(chan io.ReadCloser)
+		go func() {
+			streamWriter := snapshots.NewStreamWriter(ch)
+			defer streamWriter.Close()
+			require.NoError(t, store.Snapshot(version, streamWriter))
+		}()
+		var bz []byte
+		for chunk := range ch {
+			chunkBz, err := io.ReadAll(chunk)
+			require.NoError(t, err)
+			bz = append(bz, chunkBz...)
+		}
+		return bz
+	}
+
+	serial := snapshot(1)
+	require.NotEmpty(t, serial)
+	require.Equal(t, serial, snapshot(2))
+	require.Equal(t, serial, snapshot(8))
+
+	// the spool files are removed once the snapshot is taken
+	entries, err := os.ReadDir(spoolDir)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
 func TestMultistoreSnapshot_Errors(t *testing.T) {
 	store := newMultiStoreWithMixedMountsAndBasicData(dbm.NewMemDB())
 
@@ -225,6 +262,60 @@ func TestMultistoreSnapshotRestore(t *testing.T) {
 	}
 }
 
+func TestMultistoreSnapshotRestore_Incremental(t *testing.T) {
+	source := newMultiStoreWithGeneratedData(dbm.NewMemDB(), 3, 1000)
+	store0 := source.GetStoreByName("store0").(types.CommitKVStore)
+	store0.Set([]byte("new"), []byte{1})
+	store0.Delete([]byte{0, 0, 0, 0, 0, 0, 0, 1})
+	source.Commit()
+	version := uint64(source.LastCommitID().Version)
+	require.EqualValues(t, 2, version)
+
+	snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	manager := snapshots.NewManager(snapshotStore, snapshottypes.NewSnapshotOptions(0, 0), source, nil, log.NewNopLogger())
+
+	// the incremental snapshot requires its base snapshot
+	_, err = manager.CreateIncremental(version, 1)
+	require.Error(t, err)
+	full, err := manager.Create(1)
+	require.NoError(t, err)
+	incremental, err := manager.CreateIncremental(version, 1)
+	require.NoError(t, err)
+	require.Equal(t, snapshottypes.IncrementalFormat, incremental.Format)
+	require.EqualValues(t, 1, incremental.Metadata.BaseHeight)
+
+	// only the nodes which changed since the base snapshot are recorded
+	size := func(snapshot *snapshottypes.Snapshot) int {
+		_, chunks, err := snapshotStore.Load(snapshot.Height, snapshot.Format)
+		require.NoError(t, err)
+		n := 0
+		for chunk := range chunks {
+			bz, err := io.ReadAll(chunk)
+			require.NoError(t, err)
+			n += len(bz)
+		}
+		return n
+	}
+	require.Less(t, size(incremental), size(full)/10)
+
+	// the target restores the incremental snapshot from the base snapshot in its snapshot store
+	target := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	for _, key := range source.StoreKeysByName() {
+		target.MountStoreWithDB(types.NewKVStoreKey(key.Name()), types.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, target.LoadLatestVersion())
+	targetManager := snapshots.NewManager(snapshotStore, snapshottypes.NewSnapshotOptions(0, 0), target, nil, log.NewNopLogger())
+	require.True(t, snapshots.IsFormatSupported(targetManager, snapshottypes.IncrementalFormat))
+	require.NoError(t, targetManager.RestoreLocalSnapshot(version, snapshottypes.IncrementalFormat))
+
+	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+	for _, key := range source.StoreKeysByName() {
+		assertStoresEqual(t, source.GetStoreByName(key.Name()).(types.CommitKVStore),
+			target.GetStoreByName(key.Name()).(types.CommitKVStore), "store %q not equal", key.Name())
+	}
+}
+
 func benchmarkMultistoreSnapshot(b *testing.B, stores uint8, storeKeys uint64) {
 	b.Skip("Noisy with slow setup time, please see https://github.com/cosmos/cosmos-sdk/issues/8855.")
 
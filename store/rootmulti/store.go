@@ -1,11 +1,15 @@
 package rootmulti
 
 import (
+	"bufio"
 	"errors"
 	"fmt"
 	"io"
 	"math"
+	"os"
+	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -26,6 +30,7 @@ import (
 	"cosmossdk.io/store/pruning"
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	snapshottypes "cosmossdk.io/store/snapshots/types"
+	"cosmossdk.io/store/storage"
 	"cosmossdk.io/store/tracekv"
 	"cosmossdk.io/store/transient"
 	"cosmossdk.io/store/types"
@@ -34,10 +39,18 @@ import (
 const (
 	latestVersionKey = "s/latest"
 	commitInfoKeyFmt = "s/%d" // s/<version>
+	walEntryKey      = "s/wal"
+
+	// stateStorageImportBatchSize is the number of writes applied at once when
+	// importing the state into the state storage.
+	stateStorageImportBatchSize = 10_000
 )
 
 const iavlDisablefastNodeDefault = false
 
+// errSnapshotAborted is returned by the exports of the stores aborted along with a snapshot.
+var errSnapshotAborted = errors.New("snapshot aborted")
+
 // keysFromStoreKeyMap returns a slice of keys for the provided map lexically sorted by StoreKey.Name()
 func keysFromStoreKeyMap[V any](m map[types.StoreKey]V) []types.StoreKey {
 	keys := make([]types.StoreKey, 0, len(m))
@@ -68,6 +81,10 @@ type Store struct {
 	initialVersion      int64
 	removalMap          map[types.StoreKey]bool
 
+	// commitmentBackends are the registered commitment backends of the store types
+	// which are not built in.
+	commitmentBackends map[types.StoreType]types.CommitmentBackend
+
 	traceWriter       io.Writer
 	traceContext      types.TraceContext
 	traceContextMutex sync.Mutex
@@ -76,6 +93,36 @@ type Store struct {
 
 	listeners map[types.StoreKey]*types.MemoryListener
 
+	// stateStorage keeps the state of the persistent stores at every version,
+	// the writes to them are recorded by changesetListener and applied to it
+	// on Commit.
+	stateStorage types.StateStorage
+
+	// writeAheadLog enables the write-ahead log of the changesets recorded by
+	// changesetListener, written before the stores are committed.
+	writeAheadLog bool
+
+	// changesetListener records the writes to the persistent stores if the
+	// state storage or the write-ahead log is enabled.
+	changesetListener *types.MemoryListener
+
+	// snapshotConcurrency is the maximum number of stores exported concurrently
+	// when taking a snapshot.
+	snapshotConcurrency int
+	// snapshotSpoolDir is the directory of the spool files of the stores exported
+	// concurrently, the default temporary directory is used if it is empty.
+	snapshotSpoolDir string
+
+	// pruningConcurrency is the number of stores pruned concurrently in the
+	// background, the stores are pruned in Commit if it is 0. The background
+	// pruning holds pruningMtx for reading while it deletes a version of a
+	// store, and Commit holds it while the stores are committed.
+	pruningConcurrency int
+	pruningMtx         sync.RWMutex
+	// pruningDone is closed once the background pruning is done, it is nil if
+	// the background pruning was never started.
+	pruningDone chan struct{}
+
 	metrics metrics.StoreMetrics
 }
 
@@ -99,7 +146,9 @@ func NewStore(db dbm.DB, logger log.Logger, metricGatherer metrics.StoreMetrics)
 		keysByName:          make(map[string]types.StoreKey),
 		listeners:           make(map[types.StoreKey]*types.MemoryListener),
 		removalMap:          make(map[types.StoreKey]bool),
+		commitmentBackends:  make(map[types.StoreType]types.CommitmentBackend),
 		pruningManager:      pruning.NewManager(db, logger),
+		snapshotConcurrency: 1,
 		metrics:             metricGatherer,
 	}
 }
@@ -121,12 +170,74 @@ func (rs *Store) SetMetrics(metrics metrics.StoreMetrics) {
 	rs.metrics = metrics
 }
 
+// SetSnapshotConcurrency sets the maximum number of stores exported concurrently when
+// taking a snapshot, it defaults to 1, i.e. the stores are exported serially. The stores
+// exported concurrently are spooled to temporary files until they are written to the
+// snapshot, which needs up to the size of the snapshot in the spool directory.
+func (rs *Store) SetSnapshotConcurrency(concurrency int) {
+	rs.snapshotConcurrency = concurrency
+}
+
+// SetSnapshotSpoolDir sets the directory of the spool files of the stores exported
+// concurrently, it should be on the disk of the snapshots rather than the default
+// temporary directory, which is often a small or memory-backed filesystem.
+func (rs *Store) SetSnapshotSpoolDir(dir string) {
+	rs.snapshotSpoolDir = dir
+}
+
+// SetPruningConcurrency sets the number of stores pruned concurrently in the background. The
+// pruned heights are then deleted by a background worker instead of in Commit, one version of a
+// store at a time, so that Commit waits at most for the deletion of a version. If the previous
+// heights are still being pruned at a pruning interval, the heights are pruned at a later one.
+// It is 0 by default, the stores being pruned in Commit.
+func (rs *Store) SetPruningConcurrency(concurrency int) {
+	rs.pruningConcurrency = concurrency
+}
+
 // SetSnapshotInterval sets the interval at which the snapshots are taken.
 // It is used by the store to determine which heights to retain until after the snapshot is complete.
 func (rs *Store) SetSnapshotInterval(snapshotInterval uint64) {
 	rs.pruningManager.SetSnapshotInterval(snapshotInterval)
 }
 
+// SetStateStorage sets the storage keeping the state of the persistent stores
+// at every version, from which the queries at versions no longer available in
+// the stores are served. It must be called before loading a version.
+func (rs *Store) SetStateStorage(ss types.StateStorage) {
+	rs.stateStorage = ss
+	if rs.changesetListener == nil {
+		rs.changesetListener = types.NewMemoryListener()
+	}
+}
+
+// SetWriteAheadLog enables or disables the write-ahead log of the commits. When
+// enabled, the changeset of a version is written to the database before the
+// stores commit it, and an incomplete commit, where the node stopped after some
+// of the stores committed the version, is completed from it when the latest
+// version is loaded. See RecoverCommit. It must be called before loading a
+// version.
+func (rs *Store) SetWriteAheadLog(enabled bool) {
+	rs.writeAheadLog = enabled
+	if enabled && rs.changesetListener == nil {
+		rs.changesetListener = types.NewMemoryListener()
+	}
+}
+
+// RegisterCommitmentBackend registers the commitment backend of the stores of a
+// StoreType, so that they can be mounted alongside the IAVL stores. It must be
+// called before loading a version, and panics if the store type is built in or
+// already registered.
+func (rs *Store) RegisterCommitmentBackend(typ types.StoreType, backend types.CommitmentBackend) {
+	switch typ {
+	case types.StoreTypeMulti, types.StoreTypeDB, types.StoreTypeIAVL, types.StoreTypeTransient, types.StoreTypeMemory:
+		panic(fmt.Sprintf("cannot register a commitment backend for the built-in store type %v", typ))
+	}
+	if _, ok := rs.commitmentBackends[typ]; ok {
+		panic(fmt.Sprintf("commitment backend already registered for store type %v", typ))
+	}
+	rs.commitmentBackends[typ] = backend
+}
+
 func (rs *Store) SetIAVLCacheSize(cacheSize int) {
 	rs.iavlCacheSize = cacheSize
 }
@@ -209,6 +320,9 @@ func (rs *Store) LoadVersion(ver int64) error {
 }
 
 func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
+	// the stores must not be pruned while they are replaced
+	rs.waitPruning()
+
 	infos := make(map[string]types.StoreInfo)
 
 	rs.logger.Debug("loadVersion", "ver", ver)
@@ -252,9 +366,14 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 		rs.logger.Debug("loadVersion commitID", "key", key, "ver", ver, "hash", fmt.Sprintf("%x", commitID.Hash))
 
 		// If it has been added, set the initial version
-		if upgrades.IsAdded(key.Name()) || upgrades.RenamedFrom(key.Name()) != "" {
+		oldType, migrated := upgrades.MigratedFrom(key.Name())
+		if upgrades.IsAdded(key.Name()) || upgrades.RenamedFrom(key.Name()) != "" || migrated {
 			storeParams.initialVersion = uint64(ver) + 1
-		} else if commitID.Version != ver && storeParams.typ == types.StoreTypeIAVL {
+			if migrated {
+				// the store of the new type starts empty
+				commitID = types.CommitID{}
+			}
+		} else if commitID.Version != ver && rs.isVersionedStoreType(storeParams.typ) {
 			return fmt.Errorf("version of store %s mismatch root store's version; expected %d got %d; new stores should be added using StoreUpgrades", key.Name(), ver, commitID.Version)
 		}
 
@@ -267,7 +386,7 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 
 		// If it was deleted, remove all data
 		if upgrades.IsDeleted(key.Name()) {
-			if err := deleteKVStore(store.(types.KVStore)); err != nil {
+			if err := deleteKVStore(rs.listenChangeset(key, storeParams.typ, store)); err != nil {
 				return errorsmod.Wrapf(err, "failed to delete store %s", key.Name())
 			}
 			rs.removalMap[key] = true
@@ -284,7 +403,7 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 			}
 
 			// move all data
-			if err := moveKVStoreData(oldStore.(types.KVStore), store.(types.KVStore)); err != nil {
+			if err := moveKVStoreData(rs.listenChangeset(oldKey, storeParams.typ, oldStore), rs.listenChangeset(key, storeParams.typ, store)); err != nil {
 				return errorsmod.Wrapf(err, "failed to move store %s -> %s", oldName, key.Name())
 			}
 
@@ -292,6 +411,22 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 			newStores[oldKey] = oldStore
 			// this will ensure it's not perpetually stored in commitInfo
 			rs.removalMap[oldKey] = true
+		} else if migrated {
+			// handle migrations like renames, the old store having the same name
+			oldKey := types.NewKVStoreKey(key.Name())
+			oldParams := newStoreParams(oldKey, storeParams.db, oldType, 0)
+
+			oldStore, err := rs.loadCommitStoreFromParams(oldKey, rs.getCommitID(infos, key.Name()), oldParams)
+			if err != nil {
+				return errorsmod.Wrapf(err, "failed to load old store %s of type %v", key.Name(), oldType)
+			}
+
+			if err := moveKVStoreData(rs.listenChangeset(oldKey, oldType, oldStore), rs.listenChangeset(key, storeParams.typ, store)); err != nil {
+				return errorsmod.Wrapf(err, "failed to migrate store %s from type %v to %v", key.Name(), oldType, storeParams.typ)
+			}
+
+			newStores[oldKey] = oldStore
+			rs.removalMap[oldKey] = true
 		}
 	}
 
@@ -303,9 +438,140 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 		return err
 	}
 
+	// complete or roll back the commit of the next version if the node stopped
+	// while committing it, the commit of upgrades is only rolled back since
+	// they are run again
+	if rs.writeAheadLog && ver == GetLatestVersion(rs.db) {
+		var err error
+		if ver, err = rs.recoverCommit(ver, upgrades); err != nil {
+			return errorsmod.Wrap(err, "failed to recover commit")
+		}
+	}
+
+	if rs.stateStorage != nil {
+		if err := rs.loadStateStorage(ver); err != nil {
+			return errorsmod.Wrap(err, "failed to load state storage")
+		}
+	}
+
+	return nil
+}
+
+// loadStateStorage checks the state storage is consistent with the loaded
+// version, and imports the state of the persistent stores into it if it is
+// empty. The state storage is ahead when the node stopped after applying the
+// changeset of a version but before committing the stores, or when the
+// multi-store was rolled back: it is then truncated to the loaded version, or
+// imported again if the loaded version was pruned from it.
+func (rs *Store) loadStateStorage(ver int64) error {
+	latest, err := rs.stateStorage.GetLatestVersion()
+	if err != nil {
+		return err
+	}
+
+	if latest > uint64(ver) {
+		earliest, err := rs.stateStorage.GetEarliestVersion()
+		if err != nil {
+			return err
+		}
+
+		truncated := uint64(ver)
+		if truncated < earliest {
+			truncated = 0
+		}
+
+		rs.logger.Info("truncating state storage", "latest", latest, "version", truncated)
+		if err := rs.stateStorage.Truncate(truncated); err != nil {
+			return err
+		}
+		latest = truncated
+	}
+
+	switch {
+	case latest == 0 && ver > 0:
+		return rs.importStateStorage(ver)
+
+	case latest != 0 && latest < uint64(ver):
+		return fmt.Errorf("state storage at version %d is behind the multi-store at version %d", latest, ver)
+	}
+
 	return nil
 }
 
+// importStateStorage writes the current state of the persistent stores to the
+// state storage at the provided version.
+func (rs *Store) importStateStorage(ver int64) error {
+	rs.logger.Info("importing state into state storage", "version", ver)
+
+	keys := keysFromStoreKeyMap(rs.stores)
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Name() < keys[j].Name()
+	})
+
+	var changeset []*types.StoreKVPair
+	for _, key := range keys {
+		store := rs.stores[key]
+		if !rs.isPersistentStoreType(store.GetStoreType()) {
+			continue
+		}
+
+		itr := store.Iterator(nil, nil)
+		for ; itr.Valid(); itr.Next() {
+			changeset = append(changeset, &types.StoreKVPair{StoreKey: key.Name(), Key: itr.Key(), Value: itr.Value()})
+			if len(changeset) < stateStorageImportBatchSize {
+				continue
+			}
+
+			if err := rs.stateStorage.ApplyChangeset(uint64(ver), changeset); err != nil {
+				itr.Close()
+				return err
+			}
+			changeset = nil
+		}
+		if err := itr.Close(); err != nil {
+			return err
+		}
+	}
+
+	// the last changeset is applied even if empty, to record the version
+	return rs.stateStorage.ApplyChangeset(uint64(ver), changeset)
+}
+
+// isPersistentStoreType returns true if the stores of the type are persisted,
+// and hence kept in the state storage.
+func (rs *Store) isPersistentStoreType(typ types.StoreType) bool {
+	return rs.isVersionedStoreType(typ) || typ == types.StoreTypeDB
+}
+
+// isVersionedStoreType returns true if the stores of the type are Merkleized
+// and versioned, i.e. IAVL stores or stores of a registered commitment backend.
+func (rs *Store) isVersionedStoreType(typ types.StoreType) bool {
+	_, ok := rs.commitmentBackends[typ]
+	return typ == types.StoreTypeIAVL || ok
+}
+
+// listenChangeset wraps the store of the provided type to record its writes
+// for the state storage or the write-ahead log, if enabled and the store is
+// persistent.
+func (rs *Store) listenChangeset(key types.StoreKey, typ types.StoreType, store types.KVStore) types.KVStore {
+	if rs.changesetListener == nil || !rs.isPersistentStoreType(typ) {
+		return store
+	}
+
+	return listenkv.NewStore(store, key, rs.changesetListener)
+}
+
+// wrapListeners wraps the store with the listeners observing its writes.
+func (rs *Store) wrapListeners(key types.StoreKey, store types.KVStore) types.KVStore {
+	// Wire the listenkv.Store to allow listeners to observe the writes from the cache store,
+	// set same listeners on cache store will observe duplicated writes.
+	if rs.ListeningEnabled(key) {
+		store = listenkv.NewStore(store, key, rs.listeners[key])
+	}
+
+	return rs.listenChangeset(key, rs.storesParams[key].typ, store)
+}
+
 func (rs *Store) getCommitID(infos map[string]types.StoreInfo, name string) types.CommitID {
 	info, ok := infos[name]
 	if !ok {
@@ -469,7 +735,31 @@ func (rs *Store) Commit() types.CommitID {
 		version = previousHeight + 1
 	}
 
+	var changeset []*types.StoreKVPair
+	if rs.changesetListener != nil {
+		changeset = rs.changesetListener.PopStateCache()
+	}
+
+	// The changeset is written ahead of the commit of the stores, if the node
+	// stops before all the stores are committed the commit is completed from
+	// it on restart. The entry is removed when the metadata is flushed.
+	if rs.writeAheadLog {
+		if err := writeWALEntry(rs.db, version, changeset); err != nil {
+			panic(fmt.Errorf("failed to write changeset to write-ahead log: %w", err))
+		}
+	}
+
+	// The changeset is applied to the state storage first, if the node stops
+	// before the stores are committed it is applied again on replay.
+	if rs.stateStorage != nil {
+		if err := rs.stateStorage.ApplyChangeset(uint64(version), changeset); err != nil {
+			panic(fmt.Errorf("failed to apply changeset to state storage: %w", err))
+		}
+	}
+
+	rs.pruningMtx.Lock()
 	rs.lastCommitInfo = commitStores(version, rs.stores, rs.removalMap)
+	rs.pruningMtx.Unlock()
 	defer rs.flushMetadata(rs.db, version, rs.lastCommitInfo)
 
 	// remove remnants of removed stores
@@ -477,7 +767,10 @@ func (rs *Store) Commit() types.CommitID {
 		if _, ok := rs.stores[sk]; ok {
 			delete(rs.stores, sk)
 			delete(rs.storesParams, sk)
-			delete(rs.keysByName, sk.Name())
+			// a migrated store keeps its name
+			if rs.keysByName[sk.Name()] == sk {
+				delete(rs.keysByName, sk.Name())
+			}
 		}
 	}
 	// reset the removalMap
@@ -508,13 +801,7 @@ func (rs *Store) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.Cac
 func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 	stores := make(map[types.StoreKey]types.CacheWrapper)
 	for k, v := range rs.stores {
-		store := types.KVStore(v)
-		// Wire the listenkv.Store to allow listeners to observe the writes from the cache store,
-		// set same listeners on cache store will observe duplicated writes.
-		if rs.ListeningEnabled(k) {
-			store = listenkv.NewStore(store, k, rs.listeners[k])
-		}
-		stores[k] = store
+		stores[k] = rs.wrapListeners(k, v)
 	}
 	return cachemulti.NewStore(rs.db, stores, rs.keysByName, rs.traceWriter, rs.getTracingContext())
 }
@@ -538,10 +825,27 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 			var err error
 			cacheStore, err = store.(*iavl.Store).GetImmutable(version)
 			if err != nil {
+				// the version may still be served by the state storage
+				if rs.stateStorage != nil {
+					return rs.cacheMultiStoreFromStateStorage(version)
+				}
+
 				return nil, err
 			}
 		default:
 			cacheStore = store
+
+			if commitmentStore, ok := store.(types.CommitmentStore); ok {
+				var err error
+				cacheStore, err = commitmentStore.GetImmutable(version)
+				if err != nil {
+					if rs.stateStorage != nil {
+						return rs.cacheMultiStoreFromStateStorage(version)
+					}
+
+					return nil, err
+				}
+			}
 		}
 
 		// Wire the listenkv.Store to allow listeners to observe the writes from the cache store,
@@ -556,6 +860,38 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 	return cachemulti.NewStore(rs.db, cachedStores, rs.keysByName, rs.traceWriter, rs.getTracingContext()), nil
 }
 
+// cacheMultiStoreFromStateStorage returns a branch of the multi-store at the
+// provided version, whose persistent stores are served by the state storage.
+func (rs *Store) cacheMultiStoreFromStateStorage(version int64) (types.CacheMultiStore, error) {
+	earliest, err := rs.stateStorage.GetEarliestVersion()
+	if err != nil {
+		return nil, err
+	}
+	latest, err := rs.stateStorage.GetLatestVersion()
+	if err != nil {
+		return nil, err
+	}
+	if version <= 0 || uint64(version) < earliest || uint64(version) > latest || version > rs.LatestVersion() {
+		return nil, fmt.Errorf("version %d does not exist in the stores nor in the state storage", version)
+	}
+
+	cachedStores := make(map[types.StoreKey]types.CacheWrapper)
+	for key, store := range rs.stores {
+		var cacheStore types.KVStore = store
+		if rs.isPersistentStoreType(store.GetStoreType()) {
+			cacheStore = storage.NewStore(rs.stateStorage, key.Name(), uint64(version))
+		}
+
+		if rs.ListeningEnabled(key) {
+			cacheStore = listenkv.NewStore(cacheStore, key, rs.listeners[key])
+		}
+
+		cachedStores[key] = cacheStore
+	}
+
+	return cachemulti.NewStore(rs.db, cachedStores, rs.keysByName, rs.traceWriter, rs.getTracingContext()), nil
+}
+
 // GetStore returns a mounted Store for a given StoreKey. If the StoreKey does
 // not exist, it will panic. If the Store is wrapped in an inter-block cache, it
 // will be unwrapped prior to being returned.
@@ -587,31 +923,162 @@ func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
 	if rs.TracingEnabled() {
 		store = tracekv.NewStore(store, rs.traceWriter, rs.getTracingContext())
 	}
-	if rs.ListeningEnabled(key) {
-		store = listenkv.NewStore(store, key, rs.listeners[key])
-	}
 
-	return store
+	return rs.wrapListeners(key, store)
 }
 
 func (rs *Store) handlePruning(version int64) error {
 	rs.pruningManager.HandleHeight(version - 1) // we should never prune the current version.
+	defer rs.reportPruningBacklog()
 	if !rs.pruningManager.ShouldPruneAtHeight(version) {
 		return nil
 	}
+	if rs.pruningConcurrency > 0 {
+		return rs.pruneStoresInBackground(version)
+	}
 	rs.logger.Info("prune start", "height", version)
 	defer rs.logger.Info("prune end", "height", version)
 	return rs.PruneStores(true, nil)
 }
 
+// reportPruningBacklog reports the number of heights waiting to be pruned.
+func (rs *Store) reportPruningBacklog() {
+	rs.metrics.SetGauge(float32(rs.pruningManager.PruningBacklog()), "store", "pruning", "backlog")
+}
+
+// pruneStoresInBackground starts pruning the heights of the pruning manager in the background. If
+// the previous heights are still being pruned, the heights are left to the next pruning interval.
+func (rs *Store) pruneStoresInBackground(version int64) error {
+	if rs.pruningDone != nil {
+		select {
+		case <-rs.pruningDone:
+		default:
+			rs.logger.Debug("previous heights are still being pruned", "height", version)
+			return nil
+		}
+	}
+
+	heights, err := rs.pruningManager.GetFlushAndResetPruningHeights()
+	if err != nil {
+		return err
+	}
+	if len(heights) == 0 {
+		rs.logger.Debug("no heights to be pruned from pruning manager")
+		return nil
+	}
+
+	// the stores are listed here as they may be removed by the next commits
+	pruners := rs.storePruners()
+	concurrency := rs.pruningConcurrency
+	done := make(chan struct{})
+	rs.pruningDone = done
+	go func() {
+		defer close(done)
+		defer rs.reportPruningBacklog()
+
+		rs.logger.Info("prune start", "height", version, "heights", len(heights))
+		if err := pruneStoresConcurrently(&rs.pruningMtx, pruners, heights, concurrency); err != nil {
+			rs.logger.Error("failed to prune stores, the heights will be pruned again", "height", version, "err", err)
+			if err := rs.pruningManager.RequeuePruningHeights(heights); err != nil {
+				rs.logger.Error("failed to requeue pruning heights", "err", err)
+			}
+			return
+		}
+		if err := rs.pruningManager.HandlePrunedHeights(heights); err != nil {
+			rs.logger.Error("failed to flush pruned heights", "err", err)
+		}
+		rs.logger.Info("prune end", "height", version)
+	}()
+	return nil
+}
+
+// waitPruning waits for the background pruning to be done.
+func (rs *Store) waitPruning() {
+	if rs.pruningDone != nil {
+		<-rs.pruningDone
+	}
+}
+
+// storePruner deletes versions of a store.
+type storePruner struct {
+	name           string
+	deleteVersions func(versions ...int64) error
+}
+
+// storePruners returns the pruners of the versioned stores.
+func (rs *Store) storePruners() []storePruner {
+	var pruners []storePruner
+	for key, store := range rs.stores {
+		if commitmentStore, ok := store.(types.CommitmentStore); ok {
+			pruners = append(pruners, storePruner{name: key.Name(), deleteVersions: commitmentStore.DeleteVersions})
+			continue
+		}
+
+		// If the store is wrapped with an inter-block cache, we must first unwrap
+		// it to get the underlying IAVL store.
+		if store.GetStoreType() == types.StoreTypeIAVL {
+			iavlStore := rs.GetCommitKVStore(key).(*iavl.Store)
+			pruners = append(pruners, storePruner{name: key.Name(), deleteVersions: iavlStore.DeleteVersions})
+		}
+	}
+	return pruners
+}
+
+// pruneStoresConcurrently deletes the heights from the stores, up to concurrency stores at a time.
+// The heights of a store are deleted one at a time, holding mtx for reading.
+func pruneStoresConcurrently(mtx *sync.RWMutex, pruners []storePruner, heights []int64, concurrency int) error {
+	var (
+		wg       sync.WaitGroup
+		errMtx   sync.Mutex
+		firstErr error
+	)
+	ch := make(chan storePruner)
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for pruner := range ch {
+				if err := pruneStore(mtx, pruner, heights); err != nil {
+					errMtx.Lock()
+					if firstErr == nil {
+						firstErr = err
+					}
+					errMtx.Unlock()
+				}
+			}
+		}()
+	}
+	for _, pruner := range pruners {
+		ch <- pruner
+	}
+	close(ch)
+	wg.Wait()
+	return firstErr
+}
+
+func pruneStore(mtx *sync.RWMutex, pruner storePruner, heights []int64) error {
+	for _, height := range heights {
+		mtx.RLock()
+		err := pruner.deleteVersions(height)
+		mtx.RUnlock()
+		// the height may have been pruned already, e.g. before a restart
+		if err != nil && !errors.Is(err, iavltree.ErrVersionDoesNotExist) {
+			return fmt.Errorf("failed to prune height %d of store %s: %w", height, pruner.name, err)
+		}
+	}
+	return nil
+}
+
 // PruneStores prunes the specific heights of the multi store.
 // If clearPruningManager is true, the pruning manager will return the pruning heights,
 // and they are appended to the pruningHeights to be pruned.
 func (rs *Store) PruneStores(clearPruningManager bool, pruningHeights []int64) (err error) {
+	rs.waitPruning()
+
 	if clearPruningManager {
-		heights, err := rs.pruningManager.GetFlushAndResetPruningHeights()
-		if err != nil {
-			return err
+		heights, flushErr := rs.pruningManager.GetFlushAndResetPruningHeights()
+		if flushErr != nil {
+			return flushErr
 		}
 
 		if len(heights) == 0 {
@@ -619,6 +1086,11 @@ func (rs *Store) PruneStores(clearPruningManager bool, pruningHeights []int64) (
 		}
 
 		pruningHeights = append(pruningHeights, heights...)
+		defer func() {
+			if err == nil {
+				err = rs.pruningManager.HandlePrunedHeights(heights)
+			}
+		}()
 	}
 
 	if len(pruningHeights) == 0 {
@@ -631,6 +1103,13 @@ func (rs *Store) PruneStores(clearPruningManager bool, pruningHeights []int64) (
 	for key, store := range rs.stores {
 		rs.logger.Debug("pruning store", "key", key) // Also log store.name (a private variable)?
 
+		if commitmentStore, ok := store.(types.CommitmentStore); ok {
+			if err := commitmentStore.DeleteVersions(pruningHeights...); err != nil {
+				return err
+			}
+			continue
+		}
+
 		// If the store is wrapped with an inter-block cache, we must first unwrap
 		// it to get the underlying IAVL store.
 		if store.GetStoreType() != types.StoreTypeIAVL {
@@ -722,10 +1201,10 @@ func (rs *Store) Query(req abci.RequestQuery) abci.ResponseQuery {
 func (rs *Store) SetInitialVersion(version int64) error {
 	rs.initialVersion = version
 
-	// Loop through all the stores, if it's an IAVL store, then set initial
-	// version on it.
+	// Loop through all the stores, if it's an IAVL store or a store of a
+	// commitment backend, then set initial version on it.
 	for key, store := range rs.stores {
-		if store.GetStoreType() == types.StoreTypeIAVL {
+		if rs.isVersionedStoreType(store.GetStoreType()) {
 			// If the store is wrapped with an inter-block cache, we must first unwrap
 			// it to get the underlying IAVL store.
 			store = rs.GetCommitKVStore(key)
@@ -768,17 +1247,15 @@ func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
 		return errorsmod.Wrapf(types.ErrLogic, "cannot snapshot future height %v", height)
 	}
 
-	// Collect stores to snapshot (only IAVL stores are supported)
-	type namedStore struct {
-		*iavl.Store
-		name string
-	}
-	stores := []namedStore{}
+	// Collect stores to snapshot (only IAVL stores and stores of commitment backends are supported)
+	stores := []snapshotStore{}
 	keys := keysFromStoreKeyMap(rs.stores)
 	for _, key := range keys {
 		switch store := rs.GetCommitKVStore(key).(type) {
 		case *iavl.Store:
-			stores = append(stores, namedStore{name: key.Name(), Store: store})
+			stores = append(stores, snapshotStore{name: key.Name(), iavl: store})
+		case types.CommitmentStore:
+			stores = append(stores, snapshotStore{name: key.Name(), commitment: store})
 		case *transient.Store, *mem.Store:
 			// Non-persisted stores shouldn't be snapshotted
 			continue
@@ -791,70 +1268,245 @@ func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
 		return strings.Compare(stores[i].name, stores[j].name) == -1
 	})
 
-	// Export each IAVL store. Stores are serialized as a stream of SnapshotItem Protobuf
+	// Export each store. Stores are serialized as a stream of SnapshotItem Protobuf
 	// messages. The first item contains a SnapshotStore with store metadata (i.e. name),
-	// and the following messages contain a SnapshotNode (i.e. an ExportNode). Store changes
+	// and the following messages contain a SnapshotNode (i.e. an ExportNode) for IAVL
+	// stores, or a SnapshotKVItem for the stores of commitment backends. Store changes
 	// are demarcated by new SnapshotStore items.
-	for _, store := range stores {
-		rs.logger.Debug("starting snapshot", "store", store.name, "height", height)
-		exporter, err := store.Export(int64(height))
-		if err != nil {
-			rs.logger.Error("snapshot failed; exporter error", "store", store.name, "err", err)
-			return err
+	if rs.snapshotConcurrency <= 1 || len(stores) <= 1 {
+		for _, store := range stores {
+			if err := rs.exportStore(store, height, protoWriter, nil); err != nil {
+				return err
+			}
 		}
+		return nil
+	}
+	return rs.exportStoresConcurrently(stores, height, protoWriter)
+}
 
-		err = func() error {
-			defer exporter.Close()
+// snapshotStore is a store to snapshot, either an IAVL store or a store of a commitment
+// backend.
+type snapshotStore struct {
+	name       string
+	iavl       *iavl.Store
+	commitment types.CommitmentStore
+}
 
-			err := protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
-				Item: &snapshottypes.SnapshotItem_Store{
-					Store: &snapshottypes.SnapshotStoreItem{
-						Name: store.name,
-					},
-				},
-			})
-			if err != nil {
-				rs.logger.Error("snapshot failed; item store write failed", "store", store.name, "err", err)
-				return err
-			}
+// exportStore writes the snapshot items of a store at the given height, the export stops
+// early if the abort channel is closed.
+func (rs *Store) exportStore(store snapshotStore, height uint64, protoWriter protoio.Writer, abort <-chan struct{}) error {
+	rs.logger.Debug("starting snapshot", "store", store.name, "height", height)
+	if store.commitment != nil {
+		return rs.exportCommitmentStore(store, height, protoWriter, abort)
+	}
 
-			nodeCount := 0
-			for {
-				node, err := exporter.Next()
-				if err == iavltree.ErrorExportDone {
-					rs.logger.Debug("snapshot Done", "store", store.name, "nodeCount", nodeCount)
-					nodeCount = 0
-					break
-				} else if err != nil {
-					return err
-				}
-				err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
-					Item: &snapshottypes.SnapshotItem_IAVL{
-						IAVL: &snapshottypes.SnapshotIAVLItem{
-							Key:     node.Key,
-							Value:   node.Value,
-							Height:  int32(node.Height),
-							Version: node.Version,
-						},
-					},
-				})
-				if err != nil {
-					return err
-				}
-				nodeCount++
-			}
+	exporter, err := store.iavl.Export(int64(height))
+	if err != nil {
+		rs.logger.Error("snapshot failed; exporter error", "store", store.name, "err", err)
+		return err
+	}
+	defer exporter.Close()
+
+	err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+		Item: &snapshottypes.SnapshotItem_Store{
+			Store: &snapshottypes.SnapshotStoreItem{
+				Name: store.name,
+			},
+		},
+	})
+	if err != nil {
+		rs.logger.Error("snapshot failed; item store write failed", "store", store.name, "err", err)
+		return err
+	}
+
+	nodeCount := 0
+	for {
+		select {
+		case <-abort:
+			return errSnapshotAborted
+		default:
+		}
 
+		node, err := exporter.Next()
+		if err == iavltree.ErrorExportDone {
+			rs.logger.Debug("snapshot Done", "store", store.name, "nodeCount", nodeCount)
 			return nil
-		}()
+		} else if err != nil {
+			return err
+		}
+		err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+			Item: &snapshottypes.SnapshotItem_IAVL{
+				IAVL: &snapshottypes.SnapshotIAVLItem{
+					Key:     node.Key,
+					Value:   node.Value,
+					Height:  int32(node.Height),
+					Version: node.Version,
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+		nodeCount++
+	}
+}
 
+// exportCommitmentStore writes the snapshot items of a store of a commitment backend at the
+// given height, the export stops early if the abort channel is closed.
+func (rs *Store) exportCommitmentStore(store snapshotStore, height uint64, protoWriter protoio.Writer, abort <-chan struct{}) error {
+	itr, err := store.commitment.Export(int64(height))
+	if err != nil {
+		rs.logger.Error("snapshot failed; exporter error", "store", store.name, "err", err)
+		return err
+	}
+	defer itr.Close()
+
+	err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+		Item: &snapshottypes.SnapshotItem_Store{
+			Store: &snapshottypes.SnapshotStoreItem{
+				Name: store.name,
+			},
+		},
+	})
+	if err != nil {
+		rs.logger.Error("snapshot failed; item store write failed", "store", store.name, "err", err)
+		return err
+	}
+
+	pairCount := 0
+	for ; itr.Valid(); itr.Next() {
+		select {
+		case <-abort:
+			return errSnapshotAborted
+		default:
+		}
+
+		err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+			Item: &snapshottypes.SnapshotItem_KV{
+				KV: &snapshottypes.SnapshotKVItem{
+					Key:   itr.Key(),
+					Value: itr.Value(),
+				},
+			},
+		})
 		if err != nil {
 			return err
 		}
+		pairCount++
+	}
+	if err := itr.Error(); err != nil {
+		return err
+	}
+	rs.logger.Debug("snapshot Done", "store", store.name, "pairCount", pairCount)
+	return nil
+}
+
+// exportStoresConcurrently exports the stores concurrently, up to the snapshot concurrency,
+// each store to its own stream spooled to a temporary file. The streams are written to the
+// snapshot in the order of the stores, as soon as they are complete, so the snapshot output is
+// identical to the one of a serial export.
+func (rs *Store) exportStoresConcurrently(stores []snapshotStore, height uint64, protoWriter protoio.Writer) error {
+	if rs.snapshotSpoolDir != "" {
+		if err := os.MkdirAll(rs.snapshotSpoolDir, 0o755); err != nil {
+			return errorsmod.Wrap(err, "failed to create snapshot spool directory")
+		}
 	}
+	dir, err := os.MkdirTemp(rs.snapshotSpoolDir, "snapshot-")
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to create snapshot spool directory")
+	}
+	defer os.RemoveAll(dir)
+
+	results := make([]chan error, len(stores))
+	for i := range results {
+		results[i] = make(chan error, 1)
+	}
+	abort := make(chan struct{})
+	var wg sync.WaitGroup
+	defer func() {
+		close(abort)
+		wg.Wait()
+	}()
+
+	// the exports are started in the order of the stores, so the first streams complete first
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		sem := make(chan struct{}, rs.snapshotConcurrency)
+		for i, store := range stores {
+			select {
+			case sem <- struct{}{}:
+			case <-abort:
+				return
+			}
+			wg.Add(1)
+			go func(i int, store snapshotStore) {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+				results[i] <- rs.spoolStore(store, height, spoolPath(dir, i), abort)
+			}(i, store)
+		}
+	}()
 
+	for i := range stores {
+		if err := <-results[i]; err != nil {
+			return err
+		}
+		if err := copySpooledStore(spoolPath(dir, i), protoWriter); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// spoolStore exports a store at the given height to a file.
+func (rs *Store) spoolStore(store snapshotStore, height uint64, path string, abort <-chan struct{}) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return errorsmod.Wrapf(err, "failed to create snapshot spool file for store %q", store.name)
+	}
+	defer file.Close()
+
+	bufWriter := bufio.NewWriter(file)
+	if err := rs.exportStore(store, height, protoio.NewDelimitedWriter(bufWriter), abort); err != nil {
+		return err
+	}
+	if err := bufWriter.Flush(); err != nil {
+		return errorsmod.Wrapf(err, "failed to write snapshot spool file for store %q", store.name)
+	}
+	return file.Close()
+}
+
+// spoolPath returns the path of the file the i-th store is spooled to.
+func spoolPath(dir string, i int) string {
+	return filepath.Join(dir, strconv.Itoa(i))
+}
+
+// copySpooledStore writes the snapshot items of a store spooled to a file.
+func copySpooledStore(path string, protoWriter protoio.Writer) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to open snapshot spool file")
+	}
+	defer file.Close()
+
+	protoReader := protoio.NewDelimitedReader(bufio.NewReader(file), math.MaxInt32)
+	for {
+		item := &snapshottypes.SnapshotItem{}
+		err := protoReader.ReadMsg(item)
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return errorsmod.Wrap(err, "failed to read snapshot spool file")
+		}
+		if err := protoWriter.WriteMsg(item); err != nil {
+			return err
+		}
+	}
+}
+
 // Restore implements snapshottypes.Snapshotter.
 // returns next snapshot item and error.
 func (rs *Store) Restore(
@@ -862,9 +1514,32 @@ func (rs *Store) Restore(
 ) (snapshottypes.SnapshotItem, error) {
 	// Import nodes into stores. The first item is expected to be a SnapshotItem containing
 	// a SnapshotStoreItem, telling us which store to import into. The following items will contain
-	// SnapshotNodeItem (i.e. ExportNode) until we reach the next SnapshotStoreItem or EOF.
+	// SnapshotNodeItem (i.e. ExportNode), or SnapshotKVItem for the stores of commitment backends,
+	// until we reach the next SnapshotStoreItem or EOF.
 	var importer *iavltree.Importer
+	var kvImporter types.CommitmentImporter
 	var snapshotItem snapshottypes.SnapshotItem
+
+	// commitImport commits the import of the current store, if any
+	commitImport := func() error {
+		if importer != nil {
+			if err := importer.Commit(); err != nil {
+				return errorsmod.Wrap(err, "IAVL commit failed")
+			}
+			importer.Close()
+			importer = nil
+		}
+		if kvImporter != nil {
+			if err := kvImporter.Commit(); err != nil {
+				return errorsmod.Wrap(err, "commitment store commit failed")
+			}
+			if err := kvImporter.Close(); err != nil {
+				return errorsmod.Wrap(err, "commitment store import failed")
+			}
+			kvImporter = nil
+		}
+		return nil
+	}
 loop:
 	for {
 		snapshotItem = snapshottypes.SnapshotItem{}
@@ -877,22 +1552,25 @@ loop:
 
 		switch item := snapshotItem.Item.(type) {
 		case *snapshottypes.SnapshotItem_Store:
-			if importer != nil {
-				err = importer.Commit()
+			if err := commitImport(); err != nil {
+				return snapshottypes.SnapshotItem{}, err
+			}
+			switch store := rs.GetStoreByName(item.Store.Name).(type) {
+			case *iavl.Store:
+				importer, err = store.Import(int64(height))
 				if err != nil {
-					return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "IAVL commit failed")
+					return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "import failed")
 				}
-				importer.Close()
-			}
-			store, ok := rs.GetStoreByName(item.Store.Name).(*iavl.Store)
-			if !ok || store == nil {
-				return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic, "cannot import into non-IAVL store %q", item.Store.Name)
-			}
-			importer, err = store.Import(int64(height))
-			if err != nil {
-				return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "import failed")
+				defer importer.Close()
+			case types.CommitmentStore:
+				kvImporter, err = store.Import(int64(height))
+				if err != nil {
+					return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "import failed")
+				}
+				defer kvImporter.Close()
+			default:
+				return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic, "cannot import into store %q of type %T", item.Store.Name, store)
 			}
-			defer importer.Close()
 			// Importer height must reflect the node height (which usually matches the block height, but not always)
 			rs.logger.Debug("restoring snapshot", "store", item.Store.Name)
 
@@ -924,20 +1602,40 @@ loop:
 				return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "IAVL node import failed")
 			}
 
+		case *snapshottypes.SnapshotItem_KV:
+			if kvImporter == nil {
+				rs.logger.Error("failed to restore; received key/value item before store item")
+				return snapshottypes.SnapshotItem{}, errorsmod.Wrap(types.ErrLogic, "received key/value item before store item")
+			}
+			// Protobuf does not differentiate between []byte{} and nil, the stores do not allow
+			// nil keys nor nil values.
+			key, value := item.KV.Key, item.KV.Value
+			if key == nil {
+				key = []byte{}
+			}
+			if value == nil {
+				value = []byte{}
+			}
+			if err := kvImporter.Add(key, value); err != nil {
+				return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "key/value import failed")
+			}
+
 		default:
 			break loop
 		}
 	}
 
-	if importer != nil {
-		err := importer.Commit()
-		if err != nil {
-			return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "IAVL commit failed")
-		}
-		importer.Close()
+	if err := commitImport(); err != nil {
+		return snapshottypes.SnapshotItem{}, err
 	}
 
 	rs.flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)))
+
+	if rs.stateStorage != nil {
+		if err := rs.loadStateStorage(int64(height)); err != nil {
+			return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "failed to import the restored state into state storage")
+		}
+	}
 	return snapshotItem, rs.LoadLatestVersion()
 }
 
@@ -997,7 +1695,20 @@ func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID
 		return mem.NewStore(), nil
 
 	default:
-		panic(fmt.Sprintf("unrecognized store type %v", params.typ))
+		backend, ok := rs.commitmentBackends[params.typ]
+		if !ok {
+			panic(fmt.Sprintf("unrecognized store type %v", params.typ))
+		}
+
+		// the stores of a commitment backend have their own prefix, so that a store
+		// can be migrated to it under the same name
+		if params.db != nil {
+			db = dbm.NewPrefixDB(params.db, []byte("s/c/"))
+		} else {
+			db = dbm.NewPrefixDB(rs.db, []byte("s/c:"+params.key.Name()+"/"))
+		}
+
+		return backend.LoadStore(db, key, id, params.initialVersion)
 	}
 }
 
@@ -1026,20 +1737,11 @@ func (rs *Store) RollbackToVersion(target int64) error {
 		return fmt.Errorf("invalid rollback height target: %d", target)
 	}
 
-	for key, store := range rs.stores {
-		if store.GetStoreType() == types.StoreTypeIAVL {
-			// If the store is wrapped with an inter-block cache, we must first unwrap
-			// it to get the underlying IAVL store.
-			store = rs.GetCommitKVStore(key)
-			var err error
-			if rs.lazyLoading {
-				_, err = store.(*iavl.Store).LazyLoadVersionForOverwriting(target)
-			} else {
-				_, err = store.(*iavl.Store).LoadVersionForOverwriting(target)
-			}
-			if err != nil {
-				return err
-			}
+	rs.waitPruning()
+
+	for key := range rs.stores {
+		if err := rs.loadStoreVersionForOverwriting(key, target); err != nil {
+			return err
 		}
 	}
 
@@ -1048,6 +1750,152 @@ func (rs *Store) RollbackToVersion(target int64) error {
 	return rs.LoadLatestVersion()
 }
 
+// loadStoreVersionForOverwriting loads the given version of a versioned store,
+// deleting its later versions. Other stores are left untouched.
+func (rs *Store) loadStoreVersionForOverwriting(key types.StoreKey, version int64) error {
+	// If the store is wrapped with an inter-block cache, we must first unwrap
+	// it to get the underlying store.
+	switch store := rs.GetCommitKVStore(key).(type) {
+	case types.CommitmentStore:
+		return store.LoadVersionForOverwriting(version)
+
+	case *iavl.Store:
+		var err error
+		if rs.lazyLoading {
+			_, err = store.LazyLoadVersionForOverwriting(version)
+		} else {
+			_, err = store.LoadVersionForOverwriting(version)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// StoresAhead returns the names of the versioned stores which committed the
+// version following the latest version of the multi-store, i.e. the stores of
+// an incomplete commit, sorted by name.
+func (rs *Store) StoresAhead() []string {
+	next := GetLatestVersion(rs.db) + 1
+
+	var names []string
+	for _, key := range keysFromStoreKeyMap(rs.stores) {
+		if rs.removalMap[key] {
+			continue
+		}
+
+		switch store := rs.GetCommitKVStore(key).(type) {
+		case types.CommitmentStore:
+			if _, err := store.GetImmutable(next); err == nil {
+				names = append(names, key.Name())
+			}
+
+		case *iavl.Store:
+			if store.VersionExists(next) {
+				names = append(names, key.Name())
+			}
+		}
+	}
+
+	return names
+}
+
+// RecoverCommit repairs an incomplete commit of the version following the
+// latest version of the multi-store, where the node stopped after some of the
+// stores committed it. The commit is completed from the write-ahead log if it
+// holds the changeset of the version, otherwise the stores ahead are rolled
+// back to the latest version. It returns the latest version once recovered.
+//
+// The commit is recovered when the latest version is loaded if the write-ahead
+// log is enabled, see SetWriteAheadLog.
+func (rs *Store) RecoverCommit() (int64, error) {
+	rs.waitPruning()
+	return rs.recoverCommit(GetLatestVersion(rs.db), nil)
+}
+
+// recoverCommit recovers the commit of the version following the loaded
+// version. If the version was loaded with store upgrades, the stores ahead are
+// only rolled back since the upgrades are committed again.
+func (rs *Store) recoverCommit(version int64, upgrades *types.StoreUpgrades) (int64, error) {
+	replay := upgrades == nil ||
+		len(upgrades.Added)+len(upgrades.Renamed)+len(upgrades.Deleted)+len(upgrades.Migrated) == 0
+
+	entry, err := getWALEntry(rs.db)
+	if err != nil {
+		return version, err
+	}
+
+	ahead := rs.StoresAhead()
+	if entry == nil && len(ahead) == 0 {
+		return version, nil
+	}
+
+	// the stores ahead are rolled back first, their fast nodes are indexed
+	// at the next version. The stores created by upgrades start at the next
+	// version and are loaded empty.
+	for _, name := range ahead {
+		if _, migrated := upgrades.MigratedFrom(name); migrated || upgrades.IsAdded(name) || upgrades.RenamedFrom(name) != "" {
+			continue
+		}
+
+		rs.logger.Info("rolling back store ahead of the multi-store", "store", name, "version", version)
+		if err := rs.loadStoreVersionForOverwriting(rs.keysByName[name], version); err != nil {
+			return version, errorsmod.Wrapf(err, "failed to roll back store %s", name)
+		}
+	}
+
+	if entry == nil || entry.Version != version+1 || !replay {
+		if entry != nil {
+			rs.logger.Info("discarding write-ahead log entry", "version", entry.Version)
+			if err := rs.db.DeleteSync([]byte(walEntryKey)); err != nil {
+				return version, err
+			}
+		}
+		return version, nil
+	}
+
+	rs.logger.Info("replaying commit from write-ahead log", "version", entry.Version, "stores_ahead", ahead)
+
+	// the writes to the other persistent stores are not buffered, only the
+	// versioned stores are written again
+	for _, pair := range entry.Changeset {
+		key, ok := rs.keysByName[pair.StoreKey]
+		if !ok || !rs.isVersionedStoreType(rs.storesParams[key].typ) {
+			continue
+		}
+
+		store := rs.GetCommitKVStore(key)
+		if pair.Delete {
+			store.Delete(pair.Key)
+		} else {
+			store.Set(pair.Key, pair.Value)
+		}
+	}
+
+	if rs.stateStorage != nil {
+		latest, err := rs.stateStorage.GetLatestVersion()
+		if err != nil {
+			return version, err
+		}
+		if latest == uint64(version) {
+			if err := rs.stateStorage.ApplyChangeset(uint64(entry.Version), entry.Changeset); err != nil {
+				return version, errorsmod.Wrap(err, "failed to apply changeset to state storage")
+			}
+		}
+	}
+
+	rs.pruningMtx.Lock()
+	rs.lastCommitInfo = commitStores(entry.Version, rs.stores, rs.removalMap)
+	rs.pruningMtx.Unlock()
+	rs.flushMetadata(rs.db, entry.Version, rs.lastCommitInfo)
+
+	if err := rs.handlePruning(entry.Version); err != nil {
+		return entry.Version, err
+	}
+
+	return entry.Version, nil
+}
+
 func (rs *Store) flushMetadata(db dbm.DB, version int64, cInfo *types.CommitInfo) {
 	rs.logger.Debug("flushing metadata", "height", version)
 	batch := db.NewBatch()
@@ -1061,6 +1909,13 @@ func (rs *Store) flushMetadata(db dbm.DB, version int64, cInfo *types.CommitInfo
 
 	flushLatestVersion(batch, version)
 
+	// the commit is complete, its write-ahead log entry is no longer needed
+	if rs.writeAheadLog {
+		if err := batch.Delete([]byte(walEntryKey)); err != nil {
+			panic(fmt.Errorf("error on batch delete %w", err))
+		}
+	}
+
 	if err := batch.WriteSync(); err != nil {
 		panic(fmt.Errorf("error on batch write %w", err))
 	}
@@ -1180,3 +2035,31 @@ func flushLatestVersion(batch dbm.Batch, version int64) {
 
 	batch.Set([]byte(latestVersionKey), bz)
 }
+
+// writeWALEntry writes the changeset of the version being committed to the
+// write-ahead log, replacing the entry of the previous version.
+func writeWALEntry(db dbm.DB, version int64, changeset []*types.StoreKVPair) error {
+	bz, err := (&types.WALEntry{Version: version, Changeset: changeset}).Marshal()
+	if err != nil {
+		return err
+	}
+
+	return db.SetSync([]byte(walEntryKey), bz)
+}
+
+// getWALEntry returns the entry of the write-ahead log, or nil if there is none.
+func getWALEntry(db dbm.DB) (*types.WALEntry, error) {
+	bz, err := db.Get([]byte(walEntryKey))
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to get write-ahead log entry")
+	} else if bz == nil {
+		return nil, nil
+	}
+
+	entry := &types.WALEntry{}
+	if err := entry.Unmarshal(bz); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to unmarshal write-ahead log entry")
+	}
+
+	return entry, nil
+}
@@ -2,13 +2,17 @@ package rootmulti
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"cosmossdk.io/log"
 	abci "github.com/cometbft/cometbft/abci/types"
 	dbm "github.com/cosmos/cosmos-db"
+	iavltree "github.com/cosmos/iavl"
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/store/cachemulti"
@@ -16,6 +20,7 @@ import (
 	sdkmaps "cosmossdk.io/store/internal/maps"
 	"cosmossdk.io/store/metrics"
 	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/storage"
 	"cosmossdk.io/store/types"
 )
 
@@ -511,6 +516,100 @@ func TestMultiStore_Pruning(t *testing.T) {
 	}
 }
 
+func TestMultiStore_PruningInBackground(t *testing.T) {
+	testCases := []struct {
+		name        string
+		numVersions int64
+		po          pruningtypes.PruningOptions
+		deleted     []int64
+		saved       []int64
+	}{
+		{"prune everything", 12, pruningtypes.NewPruningOptions(pruningtypes.PruningEverything), []int64{1, 2, 3, 4, 5, 6, 7}, []int64{8, 9, 10, 11, 12}},
+		{"prune some; no batch", 10, pruningtypes.NewCustomPruningOptions(2, 1), []int64{1, 2, 3, 4, 6, 5, 7}, []int64{8, 9, 10}},
+		{"prune some; small batch", 10, pruningtypes.NewCustomPruningOptions(2, 3), []int64{1, 2, 3, 4, 5, 6}, []int64{7, 8, 9, 10}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			db := dbm.NewMemDB()
+			ms := newMultiStoreWithMounts(db, tc.po)
+			ms.SetPruningConcurrency(2)
+			gauges := &gaugeMetrics{}
+			ms.SetMetrics(gauges)
+			require.NoError(t, ms.LoadLatestVersion())
+
+			for i := int64(0); i < tc.numVersions; i++ {
+				ms.Commit()
+				ms.waitPruning()
+			}
+
+			for _, v := range tc.saved {
+				_, err := ms.CacheMultiStoreWithVersion(v)
+				require.NoError(t, err, "expected no error when loading height: %d", v)
+			}
+
+			for _, v := range tc.deleted {
+				_, err := ms.CacheMultiStoreWithVersion(v)
+				require.Error(t, err, "expected error when loading height: %d", v)
+			}
+
+			require.Equal(t, ms.pruningManager.PruningBacklog(), int(gauges.get("store", "pruning", "backlog")))
+		})
+	}
+}
+
+func TestMultiStore_PruningInBackground_Postponed(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewCustomPruningOptions(0, 2))
+	ms.SetPruningConcurrency(1)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	// simulate a background pruning in progress
+	ms.Commit()
+	done := make(chan struct{})
+	ms.pruningDone = done
+	ms.Commit()
+	ms.Commit()
+	ms.Commit()
+
+	// the heights are left to the next pruning interval while the previous ones are being pruned
+	require.Equal(t, 3, ms.pruningManager.PruningBacklog())
+	close(done)
+	ms.Commit()
+	ms.Commit()
+	ms.waitPruning()
+	require.Equal(t, 0, ms.pruningManager.PruningBacklog())
+	for v := int64(1); v <= 5; v++ {
+		_, err := ms.CacheMultiStoreWithVersion(v)
+		require.Error(t, err, "expected error when loading height: %d", v)
+	}
+	_, err := ms.CacheMultiStoreWithVersion(6)
+	require.NoError(t, err)
+}
+
+func TestPruneStoresConcurrently_VersionDoesNotExist(t *testing.T) {
+	var (
+		mtx     sync.RWMutex
+		deleted []int64
+	)
+	pruner := storePruner{name: "store", deleteVersions: func(versions ...int64) error {
+		if versions[0] == 2 {
+			return fmt.Errorf("%w: %d", iavltree.ErrVersionDoesNotExist, versions[0])
+		}
+		deleted = append(deleted, versions...)
+		return nil
+	}}
+
+	// the heights already pruned are skipped
+	require.NoError(t, pruneStoresConcurrently(&mtx, []storePruner{pruner}, []int64{1, 2, 3}, 1))
+	require.Equal(t, []int64{1, 3}, deleted)
+
+	failing := storePruner{name: "failing", deleteVersions: func(...int64) error { return errors.New("failure") }}
+	require.Error(t, pruneStoresConcurrently(&mtx, []storePruner{pruner, failing}, []int64{1}, 1))
+}
+
 func TestMultiStore_Pruning_SameHeightsTwice(t *testing.T) {
 	const (
 		numVersions int64  = 10
@@ -549,14 +648,15 @@ func TestMultiStore_Pruning_SameHeightsTwice(t *testing.T) {
 	err := ms.LoadVersion(numVersions - 1)
 	require.NoError(t, err)
 
-	// Ensure already pruned heights were loaded
+	// Ensure already pruned heights were not loaded again
 	heights, err := ms.pruningManager.GetFlushAndResetPruningHeights()
 	require.NoError(t, err)
-	require.Equal(t, expectedHeights, heights)
+	require.Empty(t, heights)
 
 	require.NoError(t, ms.pruningManager.LoadPruningHeights(db))
 
 	// Test pruning the same heights again
+	require.NoError(t, ms.PruneStores(false, expectedHeights))
 	lastCommitInfo = ms.Commit()
 	require.Equal(t, numVersions, lastCommitInfo.Version)
 
@@ -899,6 +999,194 @@ func TestStateListeners(t *testing.T) {
 	require.Empty(t, ms.PopStateCache())
 }
 
+func TestStateStorage(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningEverything))
+	ss := storage.NewDatabase(dbm.NewMemDB(), pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	ms.SetStateStorage(ss)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	for i := 1; i <= 20; i++ {
+		cacheMulti := ms.CacheMultiStore()
+		cacheMulti.GetKVStore(testStoreKey1).Set([]byte("key"), []byte(fmt.Sprint(i)))
+		cacheMulti.GetKVStore(testStoreKey2).Set([]byte(fmt.Sprintf("key%02d", i)), []byte{1})
+		cacheMulti.GetKVStore(testStoreKey2).Delete([]byte(fmt.Sprintf("key%02d", i-1)))
+		cacheMulti.Write()
+		ms.Commit()
+	}
+
+	latest, err := ss.GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint64(20), latest)
+
+	// the version was pruned from the IAVL stores, it is served by the state
+	// storage
+	require.False(t, ms.GetStoreByName("store1").(*iavl.Store).VersionExists(3))
+	cacheMulti, err := ms.CacheMultiStoreWithVersion(3)
+	require.NoError(t, err)
+	require.Equal(t, []byte("3"), cacheMulti.GetKVStore(testStoreKey1).Get([]byte("key")))
+
+	it := cacheMulti.GetKVStore(testStoreKey2).Iterator(nil, nil)
+	require.True(t, it.Valid())
+	require.Equal(t, []byte("key03"), it.Key())
+	it.Next()
+	require.False(t, it.Valid())
+	require.NoError(t, it.Close())
+
+	_, err = ms.CacheMultiStoreWithVersion(21)
+	require.Error(t, err)
+
+	// the state is imported into an empty state storage
+	ms = newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningEverything))
+	ss = storage.NewDatabase(dbm.NewMemDB(), pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	ms.SetStateStorage(ss)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	earliest, err := ss.GetEarliestVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint64(20), earliest)
+	value, err := ss.Get(testStoreKey1.Name(), 20, []byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("20"), value)
+
+	// a state storage ahead of the multi-store at a pruned version is emptied
+	// and imported again
+	ms = newMultiStoreWithMounts(dbm.NewMemDB(), pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	ms.SetStateStorage(ss)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	latest, err = ss.GetLatestVersion()
+	require.NoError(t, err)
+	require.Zero(t, latest)
+}
+
+func TestStateStorage_Rollback(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	ss := storage.NewDatabase(dbm.NewMemDB(), pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	ms.SetStateStorage(ss)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	for i := 1; i <= 10; i++ {
+		cacheMulti := ms.CacheMultiStore()
+		cacheMulti.GetKVStore(testStoreKey1).Set([]byte("key"), []byte(fmt.Sprint(i)))
+		cacheMulti.GetKVStore(testStoreKey1).Set([]byte(fmt.Sprintf("key%02d", i)), []byte{1})
+		cacheMulti.Write()
+		ms.Commit()
+	}
+
+	// the state storage is truncated to the version the multi-store is rolled
+	// back to
+	require.NoError(t, ms.RollbackToVersion(5))
+	ms = newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	ms.SetStateStorage(ss)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	latest, err := ss.GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), latest)
+	_, err = ss.Get(testStoreKey1.Name(), 6, []byte("key"))
+	require.Error(t, err)
+
+	// the versions after the rollback are committed again with other values
+	cacheMulti := ms.CacheMultiStore()
+	cacheMulti.GetKVStore(testStoreKey1).Set([]byte("key"), []byte("new"))
+	cacheMulti.Write()
+	ms.Commit()
+
+	value, err := ss.Get(testStoreKey1.Name(), 6, []byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("new"), value)
+	ok, err := ss.Has(testStoreKey1.Name(), 6, []byte("key06"))
+	require.NoError(t, err)
+	require.False(t, ok, "the writes of the rolled back versions must be removed")
+	value, err = ss.Get(testStoreKey1.Name(), 5, []byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("5"), value)
+}
+
+func TestWriteAheadLog(t *testing.T) {
+	write := func(ms *Store, i int) {
+		cacheMulti := ms.CacheMultiStore()
+		cacheMulti.GetKVStore(testStoreKey1).Set([]byte("key"), []byte(fmt.Sprint(i)))
+		cacheMulti.GetKVStore(testStoreKey2).Set([]byte(fmt.Sprintf("key%02d", i)), []byte{1})
+		cacheMulti.GetKVStore(testStoreKey2).Delete([]byte(fmt.Sprintf("key%02d", i-1)))
+		cacheMulti.Write()
+	}
+
+	// the reference store commits all the versions
+	expected := newMultiStoreWithMounts(dbm.NewMemDB(), pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, expected.LoadLatestVersion())
+	for i := 1; i <= 3; i++ {
+		write(expected, i)
+		expected.Commit()
+	}
+
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	ms.SetWriteAheadLog(true)
+	require.NoError(t, ms.LoadLatestVersion())
+	for i := 1; i <= 2; i++ {
+		write(ms, i)
+		ms.Commit()
+	}
+	entry, err := getWALEntry(db)
+	require.NoError(t, err)
+	require.Nil(t, entry, "the entry is removed once committed")
+
+	// the node stops after the changeset is logged and store1 is committed
+	write(ms, 3)
+	require.NoError(t, writeWALEntry(db, 3, ms.changesetListener.PopStateCache()))
+	ms.GetCommitKVStore(testStoreKey1).Commit()
+	require.Equal(t, []string{"store1"}, ms.StoresAhead())
+
+	// the commit is completed on restart
+	ms = newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	ms.SetWriteAheadLog(true)
+	require.NoError(t, ms.LoadLatestVersion())
+	require.Equal(t, expected.LastCommitID(), ms.LastCommitID())
+	require.Empty(t, ms.StoresAhead())
+	for _, key := range []types.StoreKey{testStoreKey1, testStoreKey2, testStoreKey3} {
+		require.Equal(t, expected.GetCommitKVStore(key).LastCommitID(), ms.GetCommitKVStore(key).LastCommitID())
+	}
+	entry, err = getWALEntry(db)
+	require.NoError(t, err)
+	require.Nil(t, entry)
+
+	// the next commits are unaffected
+	write(expected, 4)
+	write(ms, 4)
+	require.Equal(t, expected.Commit(), ms.Commit())
+}
+
+func TestRecoverCommit(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, ms.LoadLatestVersion())
+	ms.GetStoreByName("store1").(types.KVStore).Set([]byte("key"), []byte("1"))
+	cid := ms.Commit()
+
+	// the node stops after store1 is committed, without write-ahead log
+	ms.GetStoreByName("store1").(types.KVStore).Set([]byte("key"), []byte("2"))
+	ms.GetCommitKVStore(testStoreKey1).Commit()
+
+	ms = newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, ms.LoadLatestVersion())
+	require.Equal(t, []string{"store1"}, ms.StoresAhead())
+
+	// the store ahead is rolled back
+	version, err := ms.RecoverCommit()
+	require.NoError(t, err)
+	require.EqualValues(t, 1, version)
+	require.Empty(t, ms.StoresAhead())
+	require.False(t, ms.GetStoreByName("store1").(*iavl.Store).VersionExists(2))
+	require.Equal(t, []byte("1"), ms.GetStoreByName("store1").(types.KVStore).Get([]byte("key")))
+
+	ms = newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, ms.LoadLatestVersion())
+	require.Equal(t, cid, ms.LastCommitID())
+}
+
 type commitKVStoreStub struct {
 	types.CommitKVStore
 	Committed int
@@ -971,3 +1259,26 @@ func TestCommitStores(t *testing.T) {
 		})
 	}
 }
+
+// gaugeMetrics records the values of the gauges.
+type gaugeMetrics struct {
+	metrics.NoOpMetrics
+
+	mtx    sync.Mutex
+	gauges map[string]float32
+}
+
+func (m *gaugeMetrics) SetGauge(val float32, keys ...string) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	if m.gauges == nil {
+		m.gauges = map[string]float32{}
+	}
+	m.gauges[strings.Join(keys, ".")] = val
+}
+
+func (m *gaugeMetrics) get(keys ...string) float32 {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	return m.gauges[strings.Join(keys, ".")]
+}
@@ -0,0 +1,172 @@
+package snapshots
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/store/snapshots/types"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// archiveMetadataName is the name of the archive entry holding the snapshot metadata,
+// the chunks are stored in the following entries, named after their index.
+const archiveMetadataName = "metadata"
+
+// ExportArchive writes the snapshot of the given height and format to a single gzipped tar
+// archive, containing the snapshot metadata followed by its chunks. The archive can be
+// imported in the snapshot store of another node with ImportArchive.
+func (s *Store) ExportArchive(height uint64, format uint32, w io.Writer) error {
+	snapshot, chunks, err := s.Load(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return errors.Wrapf(storetypes.ErrLogic, "snapshot for height %v format %v does not exist", height, format)
+	}
+	defer DrainChunks(chunks)
+
+	gzipWriter := gzip.NewWriter(w)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	metadata, err := proto.Marshal(snapshot)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode snapshot metadata")
+	}
+	if err := writeArchiveEntry(tarWriter, archiveMetadataName, metadata); err != nil {
+		return err
+	}
+
+	index := uint32(0)
+	for chunk := range chunks {
+		bz, err := io.ReadAll(chunk)
+		chunk.Close()
+		if err != nil {
+			return errors.Wrapf(err, "failed to load snapshot chunk %d", index)
+		}
+		if err := writeArchiveEntry(tarWriter, strconv.FormatUint(uint64(index), 10), bz); err != nil {
+			return err
+		}
+		index++
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return errors.Wrap(err, "failed to close snapshot archive")
+	}
+	return gzipWriter.Close()
+}
+
+// ImportArchive reads a snapshot archive written by ExportArchive and saves the snapshot
+// in the store. The hashes of the chunks and of the snapshot are verified against the
+// archive metadata, the snapshot is not saved if they do not match.
+func (s *Store) ImportArchive(r io.Reader) (*types.Snapshot, error) {
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read snapshot archive")
+	}
+	defer gzipReader.Close()
+	tarReader := tar.NewReader(gzipReader)
+
+	metadata, err := readArchiveEntry(tarReader, archiveMetadataName)
+	if err != nil {
+		return nil, err
+	}
+	expected := &types.Snapshot{}
+	if err := proto.Unmarshal(metadata, expected); err != nil {
+		return nil, errors.Wrap(err, "failed to decode snapshot metadata")
+	}
+	if expected.Chunks == 0 || uint32(len(expected.Metadata.ChunkHashes)) != expected.Chunks {
+		return nil, errors.Wrapf(types.ErrInvalidMetadata, "snapshot has %v chunk hashes, but %v chunks",
+			len(expected.Metadata.ChunkHashes), expected.Chunks)
+	}
+
+	// chunks are verified before being saved, the first error stops the import. The
+	// reader stops as well once done is closed, if the snapshot could not be saved.
+	ch := make(chan io.ReadCloser)
+	done := make(chan struct{})
+	chunkErrCh := make(chan error, 1)
+	go func() {
+		defer close(ch)
+		chunkErrCh <- readArchiveChunks(tarReader, expected, ch, done)
+	}()
+
+	var snapshot *types.Snapshot
+	if expected.Format == types.IncrementalFormat {
+		snapshot, err = s.SaveIncremental(expected.Height, expected.Metadata.BaseHeight, ch)
+	} else {
+		snapshot, err = s.Save(expected.Height, expected.Format, ch)
+	}
+	close(done)
+	chunkErr := <-chunkErrCh
+	if err != nil {
+		return nil, err
+	}
+	if chunkErr == nil && !bytes.Equal(snapshot.Hash, expected.Hash) {
+		chunkErr = errors.Wrapf(types.ErrChunkHashMismatch, "snapshot hash: expected %x, got %x",
+			expected.Hash, snapshot.Hash)
+	}
+	if chunkErr != nil {
+		if err := s.Delete(snapshot.Height, snapshot.Format); err != nil {
+			return nil, fmt.Errorf("%w, and failed to delete the imported snapshot: %s", chunkErr, err)
+		}
+		return nil, chunkErr
+	}
+	return snapshot, nil
+}
+
+// readArchiveChunks reads the chunks of the expected snapshot from the archive and
+// sends them to ch once their hash is verified, until done is closed.
+func readArchiveChunks(tarReader *tar.Reader, expected *types.Snapshot, ch chan<- io.ReadCloser, done <-chan struct{}) error {
+	for i := uint32(0); i < expected.Chunks; i++ {
+		bz, err := readArchiveEntry(tarReader, strconv.FormatUint(uint64(i), 10))
+		if err != nil {
+			return err
+		}
+		hash := sha256.Sum256(bz)
+		if !bytes.Equal(hash[:], expected.Metadata.ChunkHashes[i]) {
+			return errors.Wrapf(types.ErrChunkHashMismatch, "chunk %d: expected %x, got %x",
+				i, expected.Metadata.ChunkHashes[i], hash)
+		}
+		select {
+		case ch <- io.NopCloser(bytes.NewReader(bz)):
+		case <-done:
+			return nil
+		}
+	}
+	return nil
+}
+
+func writeArchiveEntry(tarWriter *tar.Writer, name string, bz []byte) error {
+	err := tarWriter.WriteHeader(&tar.Header{
+		Name: name,
+		Mode: 0o644,
+		Size: int64(len(bz)),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to write snapshot archive entry %s", name)
+	}
+	_, err = tarWriter.Write(bz)
+	return errors.Wrapf(err, "failed to write snapshot archive entry %s", name)
+}
+
+func readArchiveEntry(tarReader *tar.Reader, name string) ([]byte, error) {
+	header, err := tarReader.Next()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read snapshot archive entry %s", name)
+	}
+	if header.Name != name {
+		return nil, errors.Wrapf(types.ErrInvalidMetadata, "expected snapshot archive entry %s, got %s", name, header.Name)
+	}
+	bz, err := io.ReadAll(tarReader)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read snapshot archive entry %s", name)
+	}
+	return bz, nil
+}
@@ -0,0 +1,123 @@
+package snapshots_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	db "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/snapshots"
+	"cosmossdk.io/store/snapshots/types"
+)
+
+func TestStore_Archive(t *testing.T) {
+	store := setupStore(t)
+
+	// exporting a missing snapshot errors
+	require.Error(t, store.ExportArchive(9, 1, new(bytes.Buffer)))
+
+	archive := new(bytes.Buffer)
+	require.NoError(t, store.ExportArchive(2, 2, archive))
+
+	target, err := snapshots.NewStore(db.NewMemDB(), GetTempDir(t))
+	require.NoError(t, err)
+	snapshot, err := target.ImportArchive(bytes.NewReader(archive.Bytes()))
+	require.NoError(t, err)
+
+	expected, err := store.Get(2, 2)
+	require.NoError(t, err)
+	require.Equal(t, expected, snapshot)
+
+	_, chunks, err := target.Load(2, 2)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{{2, 2, 0}, {2, 2, 1}, {2, 2, 2}}, readChunks(chunks))
+
+	// importing the same snapshot twice errors
+	_, err = target.ImportArchive(bytes.NewReader(archive.Bytes()))
+	require.Error(t, err)
+}
+
+func TestStore_ImportArchive_Invalid(t *testing.T) {
+	store := setupStore(t)
+	snapshot, err := store.Get(2, 2)
+	require.NoError(t, err)
+
+	testCases := map[string]struct {
+		snapshot *types.Snapshot
+		chunks   [][]byte
+		errIs    error
+	}{
+		"chunk hash mismatch": {
+			snapshot: snapshot,
+			chunks:   [][]byte{{2, 2, 0}, {9, 9, 9}, {2, 2, 2}},
+			errIs:    types.ErrChunkHashMismatch,
+		},
+		"snapshot hash mismatch": {
+			snapshot: &types.Snapshot{
+				Height:   snapshot.Height,
+				Format:   snapshot.Format,
+				Chunks:   snapshot.Chunks,
+				Hash:     []byte{1, 2, 3},
+				Metadata: snapshot.Metadata,
+			},
+			chunks: [][]byte{{2, 2, 0}, {2, 2, 1}, {2, 2, 2}},
+			errIs:  types.ErrChunkHashMismatch,
+		},
+		"missing chunk": {
+			snapshot: snapshot,
+			chunks:   [][]byte{{2, 2, 0}, {2, 2, 1}},
+		},
+		"no chunks": {
+			snapshot: &types.Snapshot{Height: 2, Format: 2},
+			errIs:    types.ErrInvalidMetadata,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			target, err := snapshots.NewStore(db.NewMemDB(), GetTempDir(t))
+			require.NoError(t, err)
+
+			_, err = target.ImportArchive(makeArchive(t, tc.snapshot, tc.chunks))
+			require.Error(t, err)
+			if tc.errIs != nil {
+				require.ErrorIs(t, err, tc.errIs)
+			}
+
+			// nothing is left in the store
+			imported, err := target.Get(2, 2)
+			require.NoError(t, err)
+			require.Nil(t, imported)
+		})
+	}
+}
+
+// makeArchive writes an archive in the format of Store.ExportArchive.
+func makeArchive(t *testing.T, snapshot *types.Snapshot, chunks [][]byte) *bytes.Buffer {
+	t.Helper()
+	buf := new(bytes.Buffer)
+	gzipWriter := gzip.NewWriter(buf)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	metadata, err := proto.Marshal(snapshot)
+	require.NoError(t, err)
+	entries := [][]byte{metadata}
+	entries = append(entries, chunks...)
+	for i, entry := range entries {
+		name := "metadata"
+		if i > 0 {
+			name = string(rune('0' + i - 1))
+		}
+		require.NoError(t, tarWriter.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(entry))}))
+		_, err := tarWriter.Write(entry)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, tarWriter.Close())
+	require.NoError(t, gzipWriter.Close())
+	return buf
+}
@@ -0,0 +1,244 @@
+package snapshots
+
+import (
+	"bytes"
+	"io"
+
+	protoio "github.com/cosmos/gogoproto/io"
+	"github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/store/snapshots/types"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// An incremental snapshot is written from the items of the snapshot at its height in the current
+// format, and the items of its base snapshot. The IAVL nodes of a store are exported in post-order,
+// so the nodes which did not change since the base height, i.e. those with a version lower than or
+// equal to the base height, are found in the same order in the export of the store in the base
+// snapshot. These nodes are replaced by SnapshotIAVLCopyItem items, skipping the nodes of the base
+// snapshot which were removed and copying the following unchanged ones. All the other items are
+// written as is.
+
+// baseReader reads the items of a base snapshot, looking ahead of one item.
+type baseReader struct {
+	reader protoio.Reader
+	next   *types.SnapshotItem
+	eof    bool
+}
+
+func newBaseReader(reader protoio.Reader) *baseReader {
+	return &baseReader{reader: reader}
+}
+
+// peek returns the next item of the base snapshot without consuming it, or nil at the end of
+// the snapshot.
+func (r *baseReader) peek() (*types.SnapshotItem, error) {
+	if r.next == nil && !r.eof {
+		item := &types.SnapshotItem{}
+		err := r.reader.ReadMsg(item)
+		if err == io.EOF {
+			r.eof = true
+			return nil, nil
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read base snapshot item")
+		}
+		r.next = item
+	}
+	return r.next, nil
+}
+
+// seekStore moves to the IAVL nodes of the named store in the base snapshot, and returns false if
+// the base snapshot does not contain the store. Stores are sorted by name in snapshots.
+func (r *baseReader) seekStore(name string) (bool, error) {
+	for {
+		item, err := r.peek()
+		if err != nil || item == nil {
+			return false, err
+		}
+		switch item := item.Item.(type) {
+		case *types.SnapshotItem_Store:
+			if item.Store.Name > name {
+				return false, nil
+			}
+			r.next = nil
+			if item.Store.Name == name {
+				return true, nil
+			}
+		case *types.SnapshotItem_IAVL, *types.SnapshotItem_KV:
+			r.next = nil
+		default:
+			// the extensions follow the stores
+			return false, nil
+		}
+	}
+}
+
+// nextNode consumes and returns the next IAVL node of the current store in the base snapshot,
+// or nil at the end of the store.
+func (r *baseReader) nextNode() (*types.SnapshotIAVLItem, error) {
+	item, err := r.peek()
+	if err != nil || item == nil {
+		return nil, err
+	}
+	node := item.GetIAVL()
+	if node != nil {
+		r.next = nil
+	}
+	return node, nil
+}
+
+// incrementalWriter writes an incremental snapshot to the underlying writer, given the items of
+// the snapshot at its height in the current format.
+type incrementalWriter struct {
+	base       *baseReader
+	writer     protoio.Writer
+	baseHeight uint64
+
+	// skip and copy are the pending SnapshotIAVLCopyItem
+	skip uint64
+	copy uint64
+}
+
+var _ protoio.Writer = (*incrementalWriter)(nil)
+
+func newIncrementalWriter(base protoio.Reader, writer protoio.Writer, baseHeight uint64) *incrementalWriter {
+	return &incrementalWriter{
+		base:       newBaseReader(base),
+		writer:     writer,
+		baseHeight: baseHeight,
+	}
+}
+
+// WriteMsg implements protoio.Writer.
+func (w *incrementalWriter) WriteMsg(msg proto.Message) error {
+	item, ok := msg.(*types.SnapshotItem)
+	if !ok {
+		return errors.Wrapf(storetypes.ErrLogic, "unexpected snapshot message %T", msg)
+	}
+	if node := item.GetIAVL(); node != nil && node.Version <= int64(w.baseHeight) {
+		copied, err := w.copyNode(node)
+		if err != nil || copied {
+			return err
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if store := item.GetStore(); store != nil {
+		if _, err := w.base.seekStore(store.Name); err != nil {
+			return err
+		}
+	}
+	return w.writer.WriteMsg(item)
+}
+
+// copyNode looks up an unchanged IAVL node in the current store of the base snapshot, skipping
+// the nodes before it, and returns false if it is not found, in which case the node is written
+// as is, as well as the following ones of the store.
+func (w *incrementalWriter) copyNode(node *types.SnapshotIAVLItem) (bool, error) {
+	skipped := uint64(0)
+	for {
+		baseNode, err := w.base.nextNode()
+		if err != nil || baseNode == nil {
+			return false, err
+		}
+		if baseNode.Version == node.Version && baseNode.Height == node.Height &&
+			bytes.Equal(baseNode.Key, node.Key) && bytes.Equal(baseNode.Value, node.Value) {
+			break
+		}
+		skipped++
+	}
+
+	if skipped > 0 && w.copy > 0 {
+		if err := w.Flush(); err != nil {
+			return false, err
+		}
+	}
+	w.skip += skipped
+	w.copy++
+	return true, nil
+}
+
+// Flush writes the pending SnapshotIAVLCopyItem, it must be called once all the items are written.
+func (w *incrementalWriter) Flush() error {
+	if w.copy == 0 {
+		return nil
+	}
+	err := w.writer.WriteMsg(&types.SnapshotItem{
+		Item: &types.SnapshotItem_IAVLCopy{
+			IAVLCopy: &types.SnapshotIAVLCopyItem{
+				Skip: w.skip,
+				Copy: w.copy,
+			},
+		},
+	})
+	w.skip, w.copy = 0, 0
+	return err
+}
+
+// incrementalReader reads the items of the snapshot in the current format at the height of an
+// incremental snapshot, given the items of the incremental snapshot and of its base snapshot.
+type incrementalReader struct {
+	base   *baseReader
+	reader protoio.Reader
+
+	// copy is the number of nodes left to copy from the base snapshot
+	copy uint64
+}
+
+var _ protoio.Reader = (*incrementalReader)(nil)
+
+func newIncrementalReader(base, reader protoio.Reader) *incrementalReader {
+	return &incrementalReader{
+		base:   newBaseReader(base),
+		reader: reader,
+	}
+}
+
+// ReadMsg implements protoio.Reader.
+func (r *incrementalReader) ReadMsg(msg proto.Message) error {
+	item, ok := msg.(*types.SnapshotItem)
+	if !ok {
+		return errors.Wrapf(storetypes.ErrLogic, "unexpected snapshot message %T", msg)
+	}
+
+	for r.copy == 0 {
+		item.Reset()
+		if err := r.reader.ReadMsg(item); err != nil {
+			return err
+		}
+		switch i := item.Item.(type) {
+		case *types.SnapshotItem_Store:
+			_, err := r.base.seekStore(i.Store.Name)
+			return err
+		case *types.SnapshotItem_IAVLCopy:
+			for n := uint64(0); n < i.IAVLCopy.Skip; n++ {
+				if err := r.copyNode(&types.SnapshotItem{}); err != nil {
+					return err
+				}
+			}
+			r.copy = i.IAVLCopy.Copy
+		default:
+			return nil
+		}
+	}
+
+	r.copy--
+	return r.copyNode(item)
+}
+
+// copyNode reads the next IAVL node of the current store of the base snapshot into the item.
+func (r *incrementalReader) copyNode(item *types.SnapshotItem) error {
+	node, err := r.base.nextNode()
+	if err != nil {
+		return err
+	}
+	if node == nil {
+		return errors.Wrap(types.ErrInvalidMetadata, "missing IAVL nodes in the base snapshot")
+	}
+	*item = types.SnapshotItem{Item: &types.SnapshotItem_IAVL{IAVL: node}}
+	return nil
+}
@@ -10,6 +10,8 @@ import (
 	"sort"
 	"sync"
 
+	protoio "github.com/cosmos/gogoproto/io"
+
 	"cosmossdk.io/log"
 
 	errorsmod "cosmossdk.io/errors"
@@ -159,6 +161,21 @@ func (m *Manager) GetSnapshotBlockRetentionHeights() int64 {
 
 // Create creates a snapshot and returns its metadata.
 func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
+	return m.create(height, 0)
+}
+
+// CreateIncremental creates an incremental snapshot, which only records the changes of the
+// state since the snapshot of the base height, and returns its metadata. The base snapshot
+// must be in the current format, and is required to restore the incremental snapshot.
+func (m *Manager) CreateIncremental(height, baseHeight uint64) (*types.Snapshot, error) {
+	if baseHeight == 0 {
+		return nil, errorsmod.Wrap(storetypes.ErrLogic, "base height cannot be 0")
+	}
+	return m.create(height, baseHeight)
+}
+
+// create creates a snapshot, incremental if the base height is not 0, and returns its metadata.
+func (m *Manager) create(height, baseHeight uint64) (*types.Snapshot, error) {
 	if m == nil {
 		return nil, errorsmod.Wrap(storetypes.ErrLogic, "no snapshot store configured")
 	}
@@ -180,16 +197,31 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 			"a more recent snapshot already exists at height %v", latest.Height)
 	}
 
+	if baseHeight != 0 {
+		base, err := m.store.Get(baseHeight, types.CurrentFormat)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "failed to examine base snapshot")
+		}
+		if base == nil {
+			return nil, errorsmod.Wrapf(storetypes.ErrLogic,
+				"base snapshot for height %v format %v does not exist", baseHeight, types.CurrentFormat)
+		}
+	}
+
 	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
 	ch := make(chan io.ReadCloser)
-	go m.createSnapshot(height, ch)
+	go m.createSnapshot(height, baseHeight, ch)
 
+	if baseHeight != 0 {
+		return m.store.SaveIncremental(height, baseHeight, ch)
+	}
 	return m.store.Save(height, types.CurrentFormat, ch)
 }
 
 // createSnapshot do the heavy work of snapshotting after the validations of request are done
-// the produced chunks are written to the channel.
-func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
+// the produced chunks are written to the channel. The snapshot is incremental if the base
+// height is not 0.
+func (m *Manager) createSnapshot(height, baseHeight uint64, ch chan<- io.ReadCloser) {
 	streamWriter := NewStreamWriter(ch)
 	if streamWriter == nil {
 		return
@@ -200,14 +232,46 @@ func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
 		}
 	}()
 
-	if err := m.multistore.Snapshot(height, streamWriter); err != nil {
+	if baseHeight == 0 {
+		if err := m.writeSnapshot(height, streamWriter); err != nil {
+			streamWriter.CloseWithError(err)
+		}
+		return
+	}
+
+	_, chunks, err := m.store.Load(baseHeight, types.CurrentFormat)
+	if err != nil {
+		streamWriter.CloseWithError(err)
+		return
+	}
+	defer DrainChunks(chunks)
+	baseReader, err := NewStreamReader(chunks)
+	if err != nil {
+		streamWriter.CloseWithError(err)
+		return
+	}
+	defer baseReader.Close()
+
+	incrementalWriter := newIncrementalWriter(baseReader, streamWriter, baseHeight)
+	if err := m.writeSnapshot(height, incrementalWriter); err != nil {
 		streamWriter.CloseWithError(err)
 		return
 	}
+	if err := incrementalWriter.Flush(); err != nil {
+		streamWriter.CloseWithError(err)
+	}
+}
+
+// writeSnapshot writes the items of the snapshot of the multistore and the extensions at the
+// given height.
+func (m *Manager) writeSnapshot(height uint64, protoWriter protoio.Writer) error {
+	if err := m.multistore.Snapshot(height, protoWriter); err != nil {
+		return err
+	}
 	for _, name := range m.sortedExtensionNames() {
 		extension := m.extensions[name]
 		// write extension metadata
-		err := streamWriter.WriteMsg(&types.SnapshotItem{
+		err := protoWriter.WriteMsg(&types.SnapshotItem{
 			Item: &types.SnapshotItem_Extension{
 				Extension: &types.SnapshotExtensionMeta{
 					Name:   name,
@@ -216,17 +280,16 @@ func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
 			},
 		})
 		if err != nil {
-			streamWriter.CloseWithError(err)
-			return
+			return err
 		}
 		payloadWriter := func(payload []byte) error {
-			return types.WriteExtensionPayload(streamWriter, payload)
+			return types.WriteExtensionPayload(protoWriter, payload)
 		}
 		if err := extension.SnapshotExtension(height, payloadWriter); err != nil {
-			streamWriter.CloseWithError(err)
-			return
+			return err
 		}
 	}
+	return nil
 }
 
 // List lists snapshots, mirroring ABCI ListSnapshots. It can be concurrent with other operations.
@@ -249,6 +312,15 @@ func (m *Manager) LoadChunk(height uint64, format uint32, chunk uint32) ([]byte,
 	return io.ReadAll(reader)
 }
 
+// ExportArchive writes the snapshot of the given height and format to a single archive,
+// see Store.ExportArchive. It can be concurrent with other operations.
+func (m *Manager) ExportArchive(height uint64, format uint32, w io.Writer) error {
+	if m == nil {
+		return errorsmod.Wrap(storetypes.ErrLogic, "no snapshot store configured")
+	}
+	return m.store.ExportArchive(height, format, w)
+}
+
 // Prune prunes snapshots, if no other operations are in progress.
 func (m *Manager) Prune(retain uint32) (uint64, error) {
 	err := m.begin(opPrune)
@@ -274,7 +346,7 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	defer m.mtx.Unlock()
 
 	// check multistore supported format preemptive
-	if snapshot.Format != types.CurrentFormat {
+	if !IsFormatSupported(m, snapshot.Format) {
 		return errorsmod.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
 	}
 	if snapshot.Height == 0 {
@@ -284,6 +356,20 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 		return errorsmod.Wrapf(types.ErrInvalidMetadata,
 			"snapshot height %v cannot exceed %v", snapshot.Height, int64(math.MaxInt64))
 	}
+	if snapshot.Format == types.IncrementalFormat {
+		// the base snapshot must be available locally
+		baseHeight := snapshot.Metadata.BaseHeight
+		if baseHeight == 0 || baseHeight >= snapshot.Height {
+			return errorsmod.Wrapf(types.ErrInvalidMetadata, "invalid base height %v", baseHeight)
+		}
+		base, err := m.store.Get(baseHeight, types.CurrentFormat)
+		if err != nil {
+			return errorsmod.Wrap(err, "failed to examine base snapshot")
+		}
+		if base == nil {
+			return errorsmod.Wrapf(types.ErrInvalidMetadata, "base snapshot for height %v does not exist", baseHeight)
+		}
+	}
 
 	err := m.beginLocked(opRestore)
 	if err != nil {
@@ -320,10 +406,26 @@ func (m *Manager) restoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.Re
 	}
 	defer streamReader.Close()
 
+	// an incremental snapshot is restored as the snapshot in the current format it was created from
+	var protoReader protoio.Reader = streamReader
+	if snapshot.Format == types.IncrementalFormat {
+		_, chunks, err := m.store.Load(snapshot.Metadata.BaseHeight, types.CurrentFormat)
+		if err != nil {
+			return err
+		}
+		defer DrainChunks(chunks)
+		baseReader, err := NewStreamReader(chunks)
+		if err != nil {
+			return err
+		}
+		defer baseReader.Close()
+		protoReader = newIncrementalReader(baseReader, streamReader)
+	}
+
 	// payloadReader reads an extension payload for extension snapshotter, it returns `io.EOF` at extension boundaries.
 	payloadReader := func() ([]byte, error) {
 		nextItem.Reset()
-		if err := streamReader.ReadMsg(&nextItem); err != nil {
+		if err := protoReader.ReadMsg(&nextItem); err != nil {
 			return nil, err
 		}
 		payload := nextItem.GetExtensionPayload()
@@ -333,7 +435,7 @@ func (m *Manager) restoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.Re
 		return payload.Payload, nil
 	}
 
-	nextItem, err = m.multistore.Restore(snapshot.Height, snapshot.Format, streamReader)
+	nextItem, err = m.multistore.Restore(snapshot.Height, types.CurrentFormat, protoReader)
 	if err != nil {
 		return errorsmod.Wrap(err, "multistore restore")
 	}
@@ -418,6 +520,57 @@ func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
 	return false, nil
 }
 
+// RestoreLocalSnapshot restores the snapshot of the given height and format from the
+// local snapshot store, for example one imported from an archive, by feeding its chunks
+// to Restore and RestoreChunk.
+func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
+	if m == nil {
+		return errorsmod.Wrap(storetypes.ErrLogic, "no snapshot store configured")
+	}
+
+	snapshot, chunks, err := m.store.Load(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return errorsmod.Wrapf(storetypes.ErrLogic, "snapshot for height %v format %v does not exist", height, format)
+	}
+	defer DrainChunks(chunks)
+
+	if err := m.Restore(*snapshot); err != nil {
+		return err
+	}
+
+	var done bool
+	for chunk := range chunks {
+		bz, err := io.ReadAll(chunk)
+		chunk.Close()
+		if err != nil {
+			m.abortRestore()
+			return errorsmod.Wrap(err, "failed to load snapshot chunk")
+		}
+		done, err = m.RestoreChunk(bz)
+		if err != nil {
+			m.abortRestore()
+			return err
+		}
+	}
+	if !done {
+		m.abortRestore()
+		return errorsmod.Wrap(storetypes.ErrLogic, "restore ended prematurely")
+	}
+	return nil
+}
+
+// abortRestore ends an ongoing restore, if any.
+func (m *Manager) abortRestore() {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	if m.operation == opRestore {
+		m.endLocked()
+	}
+}
+
 // sortedExtensionNames sort extension names for deterministic iteration.
 func (m *Manager) sortedExtensionNames() []string {
 	names := make([]string, 0, len(m.extensions))
@@ -429,8 +582,14 @@ func (m *Manager) sortedExtensionNames() []string {
 	return names
 }
 
+// SupportedFormats implements types.FormatSupporter, it returns the snapshot formats the
+// manager can restore from.
+func (m *Manager) SupportedFormats() []uint32 {
+	return []uint32{types.CurrentFormat, types.IncrementalFormat}
+}
+
 // IsFormatSupported returns if the snapshotter supports restoration from given format.
-func IsFormatSupported(snapshotter types.ExtensionSnapshotter, format uint32) bool {
+func IsFormatSupported(snapshotter types.FormatSupporter, format uint32) bool {
 	for _, i := range snapshotter.SupportedFormats() {
 		if i == format {
 			return true
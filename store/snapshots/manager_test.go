@@ -236,3 +236,41 @@ func TestManager_Restore(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestManager_RestoreLocalSnapshot(t *testing.T) {
+	store := setupStore(t)
+	items := [][]byte{
+		{1, 2, 3},
+		{4, 5, 6},
+		{7, 8, 9},
+	}
+	source := &mockSnapshotter{
+		items:         items,
+		prunedHeights: make(map[int64]struct{}),
+	}
+	manager := snapshots.NewManager(store, opts, source, nil, log.NewNopLogger())
+	snapshot, err := manager.Create(5)
+	require.NoError(t, err)
+
+	target := &mockSnapshotter{
+		prunedHeights: make(map[int64]struct{}),
+	}
+	manager = snapshots.NewManager(store, opts, target, nil, log.NewNopLogger())
+
+	// restoring a missing snapshot errors
+	err = manager.RestoreLocalSnapshot(9, snapshot.Format)
+	require.Error(t, err)
+
+	err = manager.RestoreLocalSnapshot(snapshot.Height, snapshot.Format)
+	require.NoError(t, err)
+	assert.Equal(t, items, target.items)
+
+	// the restore operation is ended, a failed restore ends it as well
+	err = manager.RestoreLocalSnapshot(snapshot.Height, snapshot.Format)
+	require.Error(t, err)
+	_, err = manager.Create(6)
+	require.NoError(t, err)
+
+	// nil manager should return error
+	require.Error(t, (*snapshots.Manager)(nil).RestoreLocalSnapshot(snapshot.Height, snapshot.Format))
+}
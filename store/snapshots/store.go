@@ -192,6 +192,7 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 	pruned := uint64(0)
 	prunedHeights := make(map[uint64]bool)
 	skip := make(map[uint64]bool)
+	bases := make(map[uint64]bool)
 	for ; iter.Valid(); iter.Next() {
 		height, format, err := decodeKey(iter.Key())
 		if err != nil {
@@ -199,6 +200,17 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 		}
 		if skip[height] || uint32(len(skip)) < retain {
 			skip[height] = true
+			// the base snapshots of the retained incremental snapshots are retained as well
+			snapshot := &types.Snapshot{}
+			if err := proto.Unmarshal(iter.Value(), snapshot); err != nil {
+				return 0, errors.Wrap(err, "failed to decode snapshot metadata")
+			}
+			if snapshot.Metadata.BaseHeight != 0 {
+				bases[snapshot.Metadata.BaseHeight] = true
+			}
+			continue
+		}
+		if bases[height] {
 			continue
 		}
 		err = s.Delete(height, format)
@@ -225,7 +237,30 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 func (s *Store) Save(
 	height uint64, format uint32, chunks <-chan io.ReadCloser,
 ) (*types.Snapshot, error) {
+	return s.save(&types.Snapshot{Height: height, Format: format}, chunks)
+}
+
+// SaveIncremental saves an incremental snapshot to disk, returning it. The base height is
+// the height of the snapshot in the current format the incremental snapshot is based on.
+func (s *Store) SaveIncremental(
+	height, baseHeight uint64, chunks <-chan io.ReadCloser,
+) (*types.Snapshot, error) {
+	if baseHeight == 0 || baseHeight >= height {
+		DrainChunks(chunks)
+		return nil, errors.Wrapf(storetypes.ErrLogic,
+			"invalid base height %v for incremental snapshot at height %v", baseHeight, height)
+	}
+	return s.save(&types.Snapshot{
+		Height:   height,
+		Format:   types.IncrementalFormat,
+		Metadata: types.Metadata{BaseHeight: baseHeight},
+	}, chunks)
+}
+
+// save saves the chunks of a snapshot to disk, along with its metadata.
+func (s *Store) save(snapshot *types.Snapshot, chunks <-chan io.ReadCloser) (*types.Snapshot, error) {
 	defer DrainChunks(chunks)
+	height, format := snapshot.Height, snapshot.Format
 	if height == 0 {
 		return nil, errors.Wrap(storetypes.ErrLogic, "snapshot height cannot be 0")
 	}
@@ -253,11 +288,6 @@ func (s *Store) Save(
 			"snapshot already exists for height %v format %v", height, format)
 	}
 
-	snapshot := &types.Snapshot{
-		Height: height,
-		Format: format,
-	}
-
 	dirCreated := false
 	index := uint32(0)
 	snapshotHasher := sha256.New()
@@ -325,6 +355,12 @@ func (s *Store) saveSnapshot(snapshot *types.Snapshot) error {
 	return errors.Wrap(err, "failed to store snapshot")
 }
 
+// SpoolDir returns the directory of the temporary files spooled while taking a
+// snapshot, which is on the same disk as the snapshots.
+func (s *Store) SpoolDir() string {
+	return filepath.Join(s.dir, "spool")
+}
+
 // pathHeight generates the path to a height, containing multiple snapshot formats.
 func (s *Store) pathHeight(height uint64) string {
 	return filepath.Join(s.dir, strconv.FormatUint(height, 10))
@@ -261,6 +261,32 @@ func TestStore_Prune(t *testing.T) {
 	assert.Empty(t, snapshots)
 }
 
+func TestStore_Prune_IncrementalBase(t *testing.T) {
+	store := setupStore(t)
+	_, err := store.SaveIncremental(4, 1, makeChunks([][]byte{{4, 4, 0}}))
+	require.NoError(t, err)
+
+	// The base snapshot at height 1 of the retained incremental snapshot is not pruned
+	pruned, err := store.Prune(1)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, pruned)
+
+	snapshots, err := store.List()
+	require.NoError(t, err)
+	heights := []uint64{}
+	for _, snapshot := range snapshots {
+		heights = append(heights, snapshot.Height)
+	}
+	assert.Equal(t, []uint64{4, 1}, heights)
+	assert.EqualValues(t, 1, snapshots[0].Metadata.BaseHeight)
+
+	// The base height must be lower than the height of the incremental snapshot
+	_, err = store.SaveIncremental(5, 5, makeChunks([][]byte{{5, 4, 0}}))
+	require.Error(t, err)
+	_, err = store.SaveIncremental(5, 0, makeChunks([][]byte{{5, 4, 0}}))
+	require.Error(t, err)
+}
+
 func TestStore_Save(t *testing.T) {
 	store := setupStore(t)
 	// Saving a snapshot should work
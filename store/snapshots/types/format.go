@@ -4,3 +4,9 @@ package types
 // must be identical across all nodes for a given height, so this must be bumped when the binary
 // snapshot output changes.
 const CurrentFormat uint32 = 3
+
+// IncrementalFormat is the format of incremental snapshots, which only record the changes of the
+// state since a base snapshot in the CurrentFormat. The IAVL nodes which did not change since the
+// base snapshot are replaced by SnapshotIAVLCopyItem items, so restoring an incremental snapshot
+// requires its base snapshot, whose height is saved in the snapshot metadata.
+const IncrementalFormat uint32 = 4
@@ -103,6 +103,10 @@ func (m *Snapshot) GetMetadata() Metadata {
 // Metadata contains SDK-specific snapshot metadata.
 type Metadata struct {
 	ChunkHashes [][]byte `protobuf:"bytes,1,rep,name=chunk_hashes,json=chunkHashes,proto3" json:"chunk_hashes,omitempty"`
+	// base_height is the height of the base snapshot of an incremental snapshot.
+	//
+	// Since: cosmos-sdk 0.50
+	BaseHeight uint64 `protobuf:"varint,2,opt,name=base_height,json=baseHeight,proto3" json:"base_height,omitempty"`
 }
 
 func (m *Metadata) Reset()         { *m = Metadata{} }
@@ -145,6 +149,13 @@ func (m *Metadata) GetChunkHashes() [][]byte {
 	return nil
 }
 
+func (m *Metadata) GetBaseHeight() uint64 {
+	if m != nil {
+		return m.BaseHeight
+	}
+	return 0
+}
+
 // SnapshotItem is an item contained in a rootmulti.Store snapshot.
 //
 // Since: cosmos-sdk 0.46
@@ -152,11 +163,12 @@ type SnapshotItem struct {
 	// item is the specific type of snapshot item.
 	//
 	// Types that are valid to be assigned to Item:
-	//
 	//	*SnapshotItem_Store
 	//	*SnapshotItem_IAVL
 	//	*SnapshotItem_Extension
 	//	*SnapshotItem_ExtensionPayload
+	//	*SnapshotItem_IAVLCopy
+	//	*SnapshotItem_KV
 	Item isSnapshotItem_Item `protobuf_oneof:"item"`
 }
 
@@ -211,11 +223,19 @@ type SnapshotItem_Extension struct {
 type SnapshotItem_ExtensionPayload struct {
 	ExtensionPayload *SnapshotExtensionPayload `protobuf:"bytes,4,opt,name=extension_payload,json=extensionPayload,proto3,oneof" json:"extension_payload,omitempty"`
 }
+type SnapshotItem_IAVLCopy struct {
+	IAVLCopy *SnapshotIAVLCopyItem `protobuf:"bytes,5,opt,name=iavl_copy,json=iavlCopy,proto3,oneof" json:"iavl_copy,omitempty"`
+}
+type SnapshotItem_KV struct {
+	KV *SnapshotKVItem `protobuf:"bytes,6,opt,name=kv,proto3,oneof" json:"kv,omitempty"`
+}
 
 func (*SnapshotItem_Store) isSnapshotItem_Item()            {}
 func (*SnapshotItem_IAVL) isSnapshotItem_Item()             {}
 func (*SnapshotItem_Extension) isSnapshotItem_Item()        {}
 func (*SnapshotItem_ExtensionPayload) isSnapshotItem_Item() {}
+func (*SnapshotItem_IAVLCopy) isSnapshotItem_Item()         {}
+func (*SnapshotItem_KV) isSnapshotItem_Item()               {}
 
 func (m *SnapshotItem) GetItem() isSnapshotItem_Item {
 	if m != nil {
@@ -252,6 +272,20 @@ func (m *SnapshotItem) GetExtensionPayload() *SnapshotExtensionPayload {
 	return nil
 }
 
+func (m *SnapshotItem) GetIAVLCopy() *SnapshotIAVLCopyItem {
+	if x, ok := m.GetItem().(*SnapshotItem_IAVLCopy); ok {
+		return x.IAVLCopy
+	}
+	return nil
+}
+
+func (m *SnapshotItem) GetKV() *SnapshotKVItem {
+	if x, ok := m.GetItem().(*SnapshotItem_KV); ok {
+		return x.KV
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*SnapshotItem) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -259,6 +293,8 @@ func (*SnapshotItem) XXX_OneofWrappers() []interface{} {
 		(*SnapshotItem_IAVL)(nil),
 		(*SnapshotItem_Extension)(nil),
 		(*SnapshotItem_ExtensionPayload)(nil),
+		(*SnapshotItem_IAVLCopy)(nil),
+		(*SnapshotItem_KV)(nil),
 	}
 }
 
@@ -382,6 +418,119 @@ func (m *SnapshotIAVLItem) GetHeight() int32 {
 	return 0
 }
 
+// SnapshotIAVLCopyItem copies exported IAVL nodes from the base snapshot of an
+// incremental snapshot: the next skip nodes of the store in the base snapshot are
+// dropped, and the following copy nodes are copied.
+//
+// Since: cosmos-sdk 0.50
+type SnapshotIAVLCopyItem struct {
+	Skip uint64 `protobuf:"varint,1,opt,name=skip,proto3" json:"skip,omitempty"`
+	Copy uint64 `protobuf:"varint,2,opt,name=copy,proto3" json:"copy,omitempty"`
+}
+
+func (m *SnapshotIAVLCopyItem) Reset()         { *m = SnapshotIAVLCopyItem{} }
+func (m *SnapshotIAVLCopyItem) String() string { return proto.CompactTextString(m) }
+func (*SnapshotIAVLCopyItem) ProtoMessage()    {}
+func (*SnapshotIAVLCopyItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d5cca1aa5b69183, []int{5}
+}
+func (m *SnapshotIAVLCopyItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotIAVLCopyItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotIAVLCopyItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SnapshotIAVLCopyItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotIAVLCopyItem.Merge(m, src)
+}
+func (m *SnapshotIAVLCopyItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotIAVLCopyItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotIAVLCopyItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotIAVLCopyItem proto.InternalMessageInfo
+
+func (m *SnapshotIAVLCopyItem) GetSkip() uint64 {
+	if m != nil {
+		return m.Skip
+	}
+	return 0
+}
+
+func (m *SnapshotIAVLCopyItem) GetCopy() uint64 {
+	if m != nil {
+		return m.Copy
+	}
+	return 0
+}
+
+// SnapshotKVItem is an exported key/value pair of a store of a commitment
+// backend.
+//
+// Since: cosmos-sdk 0.50
+type SnapshotKVItem struct {
+	Key   []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *SnapshotKVItem) Reset()         { *m = SnapshotKVItem{} }
+func (m *SnapshotKVItem) String() string { return proto.CompactTextString(m) }
+func (*SnapshotKVItem) ProtoMessage()    {}
+func (*SnapshotKVItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d5cca1aa5b69183, []int{6}
+}
+func (m *SnapshotKVItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotKVItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotKVItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SnapshotKVItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotKVItem.Merge(m, src)
+}
+func (m *SnapshotKVItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotKVItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotKVItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotKVItem proto.InternalMessageInfo
+
+func (m *SnapshotKVItem) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *SnapshotKVItem) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
 // SnapshotExtensionMeta contains metadata about an external snapshotter.
 //
 // Since: cosmos-sdk 0.46
@@ -394,7 +543,7 @@ func (m *SnapshotExtensionMeta) Reset()         { *m = SnapshotExtensionMeta{} }
 func (m *SnapshotExtensionMeta) String() string { return proto.CompactTextString(m) }
 func (*SnapshotExtensionMeta) ProtoMessage()    {}
 func (*SnapshotExtensionMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3d5cca1aa5b69183, []int{5}
+	return fileDescriptor_3d5cca1aa5b69183, []int{7}
 }
 func (m *SnapshotExtensionMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -448,7 +597,7 @@ func (m *SnapshotExtensionPayload) Reset()         { *m = SnapshotExtensionPaylo
 func (m *SnapshotExtensionPayload) String() string { return proto.CompactTextString(m) }
 func (*SnapshotExtensionPayload) ProtoMessage()    {}
 func (*SnapshotExtensionPayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3d5cca1aa5b69183, []int{6}
+	return fileDescriptor_3d5cca1aa5b69183, []int{8}
 }
 func (m *SnapshotExtensionPayload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -490,6 +639,8 @@ func init() {
 	proto.RegisterType((*SnapshotItem)(nil), "cosmos.store.snapshots.v1.SnapshotItem")
 	proto.RegisterType((*SnapshotStoreItem)(nil), "cosmos.store.snapshots.v1.SnapshotStoreItem")
 	proto.RegisterType((*SnapshotIAVLItem)(nil), "cosmos.store.snapshots.v1.SnapshotIAVLItem")
+	proto.RegisterType((*SnapshotIAVLCopyItem)(nil), "cosmos.store.snapshots.v1.SnapshotIAVLCopyItem")
+	proto.RegisterType((*SnapshotKVItem)(nil), "cosmos.store.snapshots.v1.SnapshotKVItem")
 	proto.RegisterType((*SnapshotExtensionMeta)(nil), "cosmos.store.snapshots.v1.SnapshotExtensionMeta")
 	proto.RegisterType((*SnapshotExtensionPayload)(nil), "cosmos.store.snapshots.v1.SnapshotExtensionPayload")
 }
@@ -499,38 +650,45 @@ func init() {
 }
 
 var fileDescriptor_3d5cca1aa5b69183 = []byte{
-	// 496 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0x8e, 0xd7, 0xb4, 0x74, 0x2f, 0x41, 0xea, 0xac, 0x81, 0x02, 0x87, 0x2c, 0x84, 0x03, 0x91,
-	0x80, 0x94, 0x65, 0x1c, 0xb9, 0x50, 0x98, 0x94, 0x09, 0x90, 0x26, 0x4f, 0xe2, 0xc0, 0x65, 0xf2,
-	0x56, 0xd3, 0x44, 0x6d, 0xe2, 0xaa, 0xf6, 0x22, 0xfa, 0x2f, 0xf8, 0x23, 0xfc, 0x8f, 0x1d, 0x77,
-	0xe4, 0x34, 0x50, 0xfb, 0x47, 0x90, 0xed, 0x26, 0xa0, 0x6d, 0x45, 0xdb, 0xed, 0x7d, 0x2f, 0xdf,
-	0xf7, 0xf9, 0xf9, 0xcb, 0x33, 0x44, 0xa7, 0x5c, 0x14, 0x5c, 0xf4, 0x85, 0xe4, 0x33, 0xd6, 0x17,
-	0x25, 0x9d, 0x8a, 0x8c, 0x4b, 0xd1, 0xaf, 0x76, 0x1b, 0x10, 0x4f, 0x67, 0x5c, 0x72, 0xfc, 0xc8,
-	0x30, 0x63, 0xcd, 0x8c, 0x1b, 0x66, 0x5c, 0xed, 0x3e, 0xde, 0x1e, 0xf1, 0x11, 0xd7, 0xac, 0xbe,
-	0xaa, 0x8c, 0x20, 0xfc, 0x81, 0xa0, 0x7b, 0xb4, 0xa2, 0xe1, 0x87, 0xd0, 0xc9, 0x58, 0x3e, 0xca,
-	0xa4, 0x87, 0x02, 0x14, 0xd9, 0x64, 0x85, 0x54, 0xff, 0x2b, 0x9f, 0x15, 0x54, 0x7a, 0x1b, 0x01,
-	0x8a, 0xee, 0x93, 0x15, 0x52, 0xfd, 0xd3, 0xec, 0xac, 0x1c, 0x0b, 0xaf, 0x65, 0xfa, 0x06, 0x61,
-	0x0c, 0x76, 0x46, 0x45, 0xe6, 0xd9, 0x01, 0x8a, 0x5c, 0xa2, 0x6b, 0xbc, 0x0f, 0xdd, 0x82, 0x49,
-	0x3a, 0xa4, 0x92, 0x7a, 0xed, 0x00, 0x45, 0x4e, 0xf2, 0x34, 0x5e, 0x3b, 0x6c, 0xfc, 0x69, 0x45,
-	0x1d, 0xd8, 0xe7, 0x97, 0x3b, 0x16, 0x69, 0xa4, 0xe1, 0x4b, 0xe8, 0xd6, 0xdf, 0xf0, 0x13, 0x70,
-	0xf5, 0x81, 0xc7, 0xea, 0x00, 0x26, 0x3c, 0x14, 0xb4, 0x22, 0x97, 0x38, 0xba, 0x97, 0xea, 0x56,
-	0xf8, 0x6b, 0x03, 0xdc, 0xfa, 0x7a, 0x07, 0x92, 0x15, 0xf8, 0x3d, 0xb4, 0xf5, 0x71, 0xfa, 0x86,
-	0x4e, 0xf2, 0xe2, 0x3f, 0x33, 0xd4, 0xba, 0x23, 0xf5, 0x49, 0x89, 0x53, 0x8b, 0x18, 0x31, 0xfe,
-	0x00, 0x76, 0x4e, 0xab, 0x89, 0x8e, 0xc3, 0x49, 0x9e, 0xdf, 0xc2, 0xe4, 0xe0, 0xed, 0xe7, 0x8f,
-	0xca, 0x63, 0xd0, 0x5d, 0x5c, 0xee, 0xd8, 0x0a, 0xa5, 0x16, 0xd1, 0x26, 0xf8, 0x10, 0x36, 0xd9,
-	0x37, 0xc9, 0x4a, 0x91, 0xf3, 0x52, 0x07, 0xe9, 0x24, 0xaf, 0x6e, 0xe1, 0xb8, 0x5f, 0x6b, 0x54,
-	0x1e, 0xa9, 0x45, 0xfe, 0x9a, 0xe0, 0x13, 0xd8, 0x6a, 0xc0, 0xf1, 0x94, 0xce, 0x27, 0x9c, 0x0e,
-	0xf5, 0xcf, 0x70, 0x92, 0xbd, 0xbb, 0x38, 0x1f, 0x1a, 0x69, 0x6a, 0x91, 0x1e, 0xbb, 0xd2, 0x1b,
-	0x74, 0xc0, 0xce, 0x25, 0x2b, 0xc2, 0x67, 0xb0, 0x75, 0x2d, 0x28, 0xb5, 0x00, 0x25, 0x2d, 0x4c,
-	0xc8, 0x9b, 0x44, 0xd7, 0xe1, 0x04, 0x7a, 0x57, 0xc3, 0xc0, 0x3d, 0x68, 0x8d, 0xd9, 0x5c, 0xd3,
-	0x5c, 0xa2, 0x4a, 0xbc, 0x0d, 0xed, 0x8a, 0x4e, 0xce, 0x98, 0x8e, 0xd6, 0x25, 0x06, 0x60, 0x0f,
-	0xee, 0x55, 0x6c, 0xd6, 0x04, 0xd4, 0x22, 0x35, 0xfc, 0x67, 0x65, 0xd5, 0xfd, 0xda, 0xf5, 0xca,
-	0x86, 0xef, 0xe0, 0xc1, 0x8d, 0x41, 0xdd, 0x34, 0xda, 0xba, 0xfd, 0x0e, 0x5f, 0x83, 0xb7, 0x2e,
-	0x13, 0x35, 0x52, 0x9d, 0xac, 0x19, 0xbf, 0x86, 0x83, 0x37, 0xe7, 0x0b, 0x1f, 0x5d, 0x2c, 0x7c,
-	0xf4, 0x7b, 0xe1, 0xa3, 0xef, 0x4b, 0xdf, 0xba, 0x58, 0xfa, 0xd6, 0xcf, 0xa5, 0x6f, 0x7d, 0x09,
-	0x4d, 0xf6, 0x62, 0x38, 0x8e, 0x73, 0x7e, 0xed, 0x35, 0xcb, 0xf9, 0x94, 0x89, 0x93, 0x8e, 0x7e,
-	0x97, 0x7b, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xef, 0xe9, 0x8e, 0x10, 0xf4, 0x03, 0x00, 0x00,
+	// 604 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xb6, 0x13, 0x27, 0xa4, 0x63, 0x83, 0xda, 0x55, 0x41, 0x86, 0x83, 0x13, 0xcc, 0x01, 0x23,
+	0x90, 0x43, 0x53, 0x0e, 0x1c, 0x10, 0x12, 0x29, 0x95, 0x5c, 0x15, 0x50, 0xb5, 0x95, 0x7a, 0x40,
+	0x48, 0xd1, 0xb6, 0x5d, 0x6a, 0xcb, 0x71, 0xd6, 0xca, 0xba, 0x16, 0xb9, 0xf2, 0x04, 0xbc, 0x08,
+	0xef, 0xd1, 0x63, 0x8f, 0x9c, 0x22, 0xe4, 0xbe, 0x08, 0xda, 0xb1, 0x1d, 0xa0, 0x3f, 0x28, 0xdc,
+	0xe6, 0xfb, 0x3c, 0xf3, 0x79, 0xf6, 0x9b, 0xd9, 0x05, 0xef, 0x48, 0xc8, 0x44, 0xc8, 0xbe, 0xcc,
+	0xc4, 0x94, 0xf7, 0xe5, 0x84, 0xa5, 0x32, 0x14, 0x99, 0xec, 0xe7, 0x1b, 0x0b, 0xe0, 0xa7, 0x53,
+	0x91, 0x09, 0x72, 0xbf, 0xcc, 0xf4, 0x31, 0xd3, 0x5f, 0x64, 0xfa, 0xf9, 0xc6, 0x83, 0xf5, 0x13,
+	0x71, 0x22, 0x30, 0xab, 0xaf, 0xa2, 0xb2, 0xc0, 0xfd, 0xae, 0x43, 0x67, 0xbf, 0x4a, 0x23, 0xf7,
+	0xa0, 0x1d, 0xf2, 0xe8, 0x24, 0xcc, 0x6c, 0xbd, 0xa7, 0x7b, 0x06, 0xad, 0x90, 0xe2, 0x3f, 0x8b,
+	0x69, 0xc2, 0x32, 0xbb, 0xd1, 0xd3, 0xbd, 0xdb, 0xb4, 0x42, 0x8a, 0x3f, 0x0a, 0x4f, 0x27, 0xb1,
+	0xb4, 0x9b, 0x25, 0x5f, 0x22, 0x42, 0xc0, 0x08, 0x99, 0x0c, 0x6d, 0xa3, 0xa7, 0x7b, 0x16, 0xc5,
+	0x98, 0x6c, 0x43, 0x27, 0xe1, 0x19, 0x3b, 0x66, 0x19, 0xb3, 0x5b, 0x3d, 0xdd, 0x33, 0x07, 0x8f,
+	0xfc, 0x1b, 0x9b, 0xf5, 0xdf, 0x57, 0xa9, 0x43, 0xe3, 0x6c, 0xde, 0xd5, 0xe8, 0xa2, 0xd4, 0xfd,
+	0x00, 0x9d, 0xfa, 0x1b, 0x79, 0x08, 0x16, 0xfe, 0x70, 0xa4, 0x7e, 0xc0, 0xa5, 0xad, 0xf7, 0x9a,
+	0x9e, 0x45, 0x4d, 0xe4, 0x02, 0xa4, 0x48, 0x17, 0xcc, 0x43, 0x26, 0xf9, 0xa8, 0x3a, 0x56, 0x03,
+	0x8f, 0x05, 0x8a, 0x0a, 0x90, 0x71, 0xbf, 0x1a, 0x60, 0xd5, 0xe7, 0xdf, 0xc9, 0x78, 0x42, 0xde,
+	0x42, 0x0b, 0xfb, 0x41, 0x0b, 0xcc, 0xc1, 0xb3, 0x7f, 0x34, 0x59, 0xd7, 0xed, 0xab, 0x4f, 0xaa,
+	0x38, 0xd0, 0x68, 0x59, 0x4c, 0x76, 0xc1, 0x88, 0x58, 0x3e, 0xc6, 0x1f, 0x9a, 0x83, 0xa7, 0x4b,
+	0x88, 0xec, 0xbc, 0x39, 0x78, 0xa7, 0x34, 0x86, 0x9d, 0x62, 0xde, 0x35, 0x14, 0x0a, 0x34, 0x8a,
+	0x22, 0x64, 0x0f, 0x56, 0xf8, 0x97, 0x8c, 0x4f, 0x64, 0x24, 0x26, 0xe8, 0xb4, 0x39, 0x78, 0xbe,
+	0x84, 0xe2, 0x76, 0x5d, 0xa3, 0x0c, 0x0b, 0x34, 0xfa, 0x5b, 0x84, 0x1c, 0xc2, 0xda, 0x02, 0x8c,
+	0x52, 0x36, 0x1b, 0x0b, 0x76, 0x8c, 0xd3, 0x32, 0x07, 0x9b, 0xff, 0xa3, 0xbc, 0x57, 0x96, 0x06,
+	0x1a, 0x5d, 0xe5, 0x97, 0x38, 0xf2, 0x09, 0x56, 0x54, 0xf7, 0xa3, 0x23, 0x91, 0xce, 0xaa, 0x89,
+	0xf7, 0x97, 0xf4, 0x61, 0x4b, 0xa4, 0x33, 0xf4, 0xc2, 0x2a, 0xe6, 0xdd, 0x4e, 0xcd, 0x04, 0x1a,
+	0xed, 0x28, 0x45, 0x15, 0x93, 0x2d, 0x68, 0xc4, 0xb9, 0xdd, 0x46, 0xd9, 0x27, 0x4b, 0xc8, 0xee,
+	0x1e, 0xa0, 0x60, 0xbb, 0x98, 0x77, 0x1b, 0xbb, 0x07, 0x81, 0x46, 0x1b, 0x71, 0x3e, 0x6c, 0x83,
+	0x11, 0x65, 0x3c, 0x71, 0x1f, 0xc3, 0xda, 0x95, 0x59, 0xaa, 0x25, 0x9e, 0xb0, 0xa4, 0xdc, 0x83,
+	0x15, 0x8a, 0xb1, 0x3b, 0x86, 0xd5, 0xcb, 0xf3, 0x22, 0xab, 0xd0, 0x8c, 0xf9, 0x0c, 0xd3, 0x2c,
+	0xaa, 0x42, 0xb2, 0x0e, 0xad, 0x9c, 0x8d, 0x4f, 0x39, 0x4e, 0xdf, 0xa2, 0x25, 0x20, 0x36, 0xdc,
+	0xca, 0xf9, 0x74, 0x31, 0xc3, 0x26, 0xad, 0xe1, 0x1f, 0xd7, 0x4e, 0x8d, 0xa0, 0x55, 0x5f, 0x3b,
+	0xf7, 0x35, 0xac, 0x5f, 0xe7, 0x8a, 0xea, 0x4c, 0xc6, 0x51, 0x5a, 0x5d, 0x52, 0x8c, 0x15, 0x87,
+	0x46, 0x97, 0x1b, 0x8e, 0xb1, 0xfb, 0x12, 0xee, 0xfc, 0x7d, 0xfc, 0x65, 0x7b, 0x75, 0xb7, 0xe0,
+	0xee, 0xb5, 0x5b, 0x74, 0x9d, 0x29, 0x37, 0xbd, 0x0e, 0xee, 0x0b, 0xb0, 0x6f, 0x5a, 0x18, 0x65,
+	0x46, 0xbd, 0x76, 0x65, 0x33, 0x35, 0x1c, 0xbe, 0x3a, 0x2b, 0x1c, 0xfd, 0xbc, 0x70, 0xf4, 0x9f,
+	0x85, 0xa3, 0x7f, 0xbb, 0x70, 0xb4, 0xf3, 0x0b, 0x47, 0xfb, 0x71, 0xe1, 0x68, 0x1f, 0xdd, 0x72,
+	0xca, 0xf2, 0x38, 0xf6, 0x23, 0x71, 0xe5, 0x2d, 0xcc, 0x66, 0x29, 0x97, 0x87, 0x6d, 0x7c, 0xd5,
+	0x36, 0x7f, 0x0d, 0x00, 0x1b, 0xb6, 0xf0, 0xff, 0x32, 0x05, 0x00, 0x00,
 }
 
 func (m *Snapshot) Marshal() (dAtA []byte, err error) {
@@ -608,6 +766,11 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BaseHeight != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.BaseHeight))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.ChunkHashes) > 0 {
 		for iNdEx := len(m.ChunkHashes) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ChunkHashes[iNdEx])
@@ -736,6 +899,48 @@ func (m *SnapshotItem_ExtensionPayload) MarshalToSizedBuffer(dAtA []byte) (int,
 	}
 	return len(dAtA) - i, nil
 }
+func (m *SnapshotItem_IAVLCopy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotItem_IAVLCopy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.IAVLCopy != nil {
+		{
+			size, err := m.IAVLCopy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSnapshot(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *SnapshotItem_KV) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotItem_KV) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.KV != nil {
+		{
+			size, err := m.KV.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSnapshot(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
 func (m *SnapshotStoreItem) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -813,6 +1018,76 @@ func (m *SnapshotIAVLItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SnapshotIAVLCopyItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotIAVLCopyItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotIAVLCopyItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Copy != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Copy))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Skip != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Skip))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SnapshotKVItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotKVItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotKVItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SnapshotExtensionMeta) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -925,6 +1200,9 @@ func (m *Metadata) Size() (n int) {
 			n += 1 + l + sovSnapshot(uint64(l))
 		}
 	}
+	if m.BaseHeight != 0 {
+		n += 1 + sovSnapshot(uint64(m.BaseHeight))
+	}
 	return n
 }
 
@@ -988,6 +1266,30 @@ func (m *SnapshotItem_ExtensionPayload) Size() (n int) {
 	}
 	return n
 }
+func (m *SnapshotItem_IAVLCopy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IAVLCopy != nil {
+		l = m.IAVLCopy.Size()
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	return n
+}
+func (m *SnapshotItem_KV) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.KV != nil {
+		l = m.KV.Size()
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	return n
+}
 func (m *SnapshotStoreItem) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1024,6 +1326,38 @@ func (m *SnapshotIAVLItem) Size() (n int) {
 	return n
 }
 
+func (m *SnapshotIAVLCopyItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Skip != 0 {
+		n += 1 + sovSnapshot(uint64(m.Skip))
+	}
+	if m.Copy != 0 {
+		n += 1 + sovSnapshot(uint64(m.Copy))
+	}
+	return n
+}
+
+func (m *SnapshotKVItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	return n
+}
+
 func (m *SnapshotExtensionMeta) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1294,6 +1628,25 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			m.ChunkHashes = append(m.ChunkHashes, make([]byte, postIndex-iNdEx))
 			copy(m.ChunkHashes[len(m.ChunkHashes)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseHeight", wireType)
+			}
+			m.BaseHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSnapshot(dAtA[iNdEx:])
@@ -1484,6 +1837,76 @@ func (m *SnapshotItem) Unmarshal(dAtA []byte) error {
 			}
 			m.Item = &SnapshotItem_ExtensionPayload{v}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IAVLCopy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SnapshotIAVLCopyItem{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Item = &SnapshotItem_IAVLCopy{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KV", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SnapshotKVItem{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Item = &SnapshotItem_KV{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSnapshot(dAtA[iNdEx:])
@@ -1743,6 +2166,212 @@ func (m *SnapshotIAVLItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SnapshotIAVLCopyItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotIAVLCopyItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotIAVLCopyItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skip", wireType)
+			}
+			m.Skip = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Skip |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Copy", wireType)
+			}
+			m.Copy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Copy |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapshotKVItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotKVItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotKVItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SnapshotExtensionMeta) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
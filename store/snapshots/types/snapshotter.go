@@ -26,6 +26,13 @@ type Snapshotter interface {
 	Restore(height uint64, format uint32, protoReader protoio.Reader) (SnapshotItem, error)
 }
 
+// FormatSupporter is a snapshot restorer which supports a set of snapshot formats, the formats
+// are negotiated with snapshots.IsFormatSupported.
+type FormatSupporter interface {
+	// SupportedFormats returns a list of formats it can restore from.
+	SupportedFormats() []uint32
+}
+
 // ExtensionPayloadReader read extension payloads,
 // it returns io.EOF when reached either end of stream or the extension boundaries.
 type ExtensionPayloadReader = func() ([]byte, error)
@@ -44,8 +51,7 @@ type ExtensionSnapshotter interface {
 	// It's defined within the extension, different from the global format for the whole state-sync snapshot.
 	SnapshotFormat() uint32
 
-	// SupportedFormats returns a list of formats it can restore from.
-	SupportedFormats() []uint32
+	FormatSupporter
 
 	// SnapshotExtension writes extension payloads into the underlying protobuf stream.
 	SnapshotExtension(height uint64, payloadWriter ExtensionPayloadWriter) error
@@ -0,0 +1,272 @@
+// Package statediff persists the change sets of the committed blocks, to serve
+// the state changes of a block without replaying it.
+package statediff
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/prefix"
+	"cosmossdk.io/store/types"
+)
+
+// The change sets are kept under keys made of the change prefix, the height of
+// the block, the store key and the index of the change in the change set:
+//
+//	c | bigendian(height) | uvarint(len(storeKey)) | storeKey | bigendian(index)
+//
+// The changes of a block are hence contiguous, grouped by store and ordered as
+// in the change set within a store.
+var (
+	changePrefix = []byte{'c'}
+
+	latestHeightKey   = []byte("m/latest")
+	earliestHeightKey = []byte("m/earliest")
+)
+
+const (
+	heightLen = 8
+
+	// pruneBatchSize is the number of deletions written at once while pruning.
+	pruneBatchSize = 10_000
+)
+
+// Store keeps the change sets of the recent blocks in a key/value database.
+type Store struct {
+	db         dbm.DB
+	keepRecent uint64
+
+	// mtx serializes the writes, the reads are served by the database.
+	mtx sync.Mutex
+}
+
+// NewStore returns a Store keeping the change sets of the keepRecent latest
+// heights in db, or of all the heights if keepRecent is 0.
+func NewStore(db dbm.DB, keepRecent uint64) *Store {
+	return &Store{db: db, keepRecent: keepRecent}
+}
+
+// heightPrefix returns the prefix of the changes of a height.
+func heightPrefix(height int64) []byte {
+	bz := make([]byte, 0, len(changePrefix)+heightLen)
+	bz = append(bz, changePrefix...)
+	return binary.BigEndian.AppendUint64(bz, uint64(height))
+}
+
+// storePrefix returns the prefix of the changes of a store at a height.
+func storePrefix(height int64, storeKey string) []byte {
+	bz := binary.AppendUvarint(heightPrefix(height), uint64(len(storeKey)))
+	return append(bz, storeKey...)
+}
+
+// GetLatestHeight returns the latest height of the change sets, 0 if none was
+// saved.
+func (s *Store) GetLatestHeight() (int64, error) {
+	return s.getHeight(latestHeightKey)
+}
+
+// GetEarliestHeight returns the earliest height of the retained change sets.
+func (s *Store) GetEarliestHeight() (int64, error) {
+	return s.getHeight(earliestHeightKey)
+}
+
+func (s *Store) getHeight(key []byte) (int64, error) {
+	bz, err := s.db.Get(key)
+	if err != nil || bz == nil {
+		return 0, err
+	}
+	if len(bz) != heightLen {
+		return 0, fmt.Errorf("invalid state diff height of length %d", len(bz))
+	}
+
+	return int64(binary.BigEndian.Uint64(bz)), nil
+}
+
+func encodeHeight(height int64) []byte {
+	return binary.BigEndian.AppendUint64(nil, uint64(height))
+}
+
+// HasHeight returns whether the change set of the height is retained.
+func (s *Store) HasHeight(height int64) (bool, error) {
+	latest, err := s.GetLatestHeight()
+	if err != nil {
+		return false, err
+	}
+	earliest, err := s.GetEarliestHeight()
+	if err != nil {
+		return false, err
+	}
+
+	return latest > 0 && height >= earliest && height <= latest, nil
+}
+
+// Save saves the change set of the block at the height, and prunes the change
+// sets of the heights which are no longer retained.
+//
+// Saving a height which is not above the latest one, e.g. after a rollback,
+// overwrites its change set and drops the change sets of the heights above it.
+// Saving a height above the next one, e.g. after a failed save, drops the
+// change sets of the heights below it, so that a missing height is never
+// served as an empty change set.
+func (s *Store) Save(height int64, changeSet []*types.StoreKVPair) error {
+	if height <= 0 {
+		return fmt.Errorf("invalid state diff height %d", height)
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	latest, err := s.GetLatestHeight()
+	if err != nil {
+		return err
+	}
+	earliest, err := s.GetEarliestHeight()
+	if err != nil {
+		return err
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	if height <= latest {
+		if err := s.deleteHeights(batch, height, latest+1); err != nil {
+			return err
+		}
+	}
+
+	for i, pair := range changeSet {
+		bz, err := pair.Marshal()
+		if err != nil {
+			return err
+		}
+		key := binary.BigEndian.AppendUint32(storePrefix(height, pair.StoreKey), uint32(i))
+		if err := batch.Set(key, bz); err != nil {
+			return err
+		}
+	}
+
+	if err := batch.Set(latestHeightKey, encodeHeight(height)); err != nil {
+		return err
+	}
+	if latest == 0 || height < earliest {
+		if err := batch.Set(earliestHeightKey, encodeHeight(height)); err != nil {
+			return err
+		}
+	}
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+
+	var pruneHeight int64
+	if latest > 0 && height > latest+1 {
+		pruneHeight = height
+	}
+	if s.keepRecent > 0 && uint64(height) > s.keepRecent && height-int64(s.keepRecent)+1 > pruneHeight {
+		pruneHeight = height - int64(s.keepRecent) + 1
+	}
+	if pruneHeight > 0 {
+		return s.prune(pruneHeight)
+	}
+
+	return nil
+}
+
+// deleteHeights deletes the change sets of the heights in [start, end) in the
+// batch.
+func (s *Store) deleteHeights(batch dbm.Batch, start, end int64) error {
+	it, err := s.db.Iterator(heightPrefix(start), heightPrefix(end))
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		if err := batch.Delete(it.Key()); err != nil {
+			return err
+		}
+	}
+
+	return it.Error()
+}
+
+// prune deletes the change sets of the heights below the new earliest height.
+func (s *Store) prune(earliest int64) error {
+	current, err := s.GetEarliestHeight()
+	if err != nil || current >= earliest {
+		return err
+	}
+
+	it, err := s.db.Iterator(heightPrefix(current), heightPrefix(earliest))
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	batch := s.db.NewBatch()
+	defer func() { batch.Close() }()
+
+	count := 0
+	for ; it.Valid(); it.Next() {
+		if err := batch.Delete(it.Key()); err != nil {
+			return err
+		}
+		count++
+		if count%pruneBatchSize == 0 {
+			if err := batch.Write(); err != nil {
+				return err
+			}
+			batch.Close()
+			batch = s.db.NewBatch()
+		}
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+
+	if err := batch.Set(earliestHeightKey, encodeHeight(earliest)); err != nil {
+		return err
+	}
+
+	return batch.WriteSync()
+}
+
+// ChangeSetStore returns a view of the changes at the height, which must not be
+// written. The changes are restricted to a store if the store key is not empty,
+// and the values are the protobuf encoded StoreKVPair.
+func (s *Store) ChangeSetStore(height int64, storeKey string) types.KVStore {
+	return prefix.NewStore(dbadapter.Store{DB: s.db}, changeSetPrefix(height, storeKey))
+}
+
+func changeSetPrefix(height int64, storeKey string) []byte {
+	if storeKey == "" {
+		return heightPrefix(height)
+	}
+
+	return storePrefix(height, storeKey)
+}
+
+// GetChangeSet returns the changes at the height, restricted to a store if the
+// store key is not empty.
+func (s *Store) GetChangeSet(height int64, storeKey string) ([]*types.StoreKVPair, error) {
+	p := changeSetPrefix(height, storeKey)
+	it, err := s.db.Iterator(p, types.PrefixEndBytes(p))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var changeSet []*types.StoreKVPair
+	for ; it.Valid(); it.Next() {
+		pair := &types.StoreKVPair{}
+		if err := pair.Unmarshal(it.Value()); err != nil {
+			return nil, err
+		}
+		changeSet = append(changeSet, pair)
+	}
+
+	return changeSet, it.Error()
+}
@@ -0,0 +1,127 @@
+package statediff_test
+
+import (
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/statediff"
+	"cosmossdk.io/store/types"
+)
+
+func TestStoreSaveAndGet(t *testing.T) {
+	s := statediff.NewStore(dbm.NewMemDB(), 0)
+
+	has, err := s.HasHeight(1)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	changeSet := []*types.StoreKVPair{
+		{StoreKey: "bank", Key: []byte("b"), Value: []byte("1")},
+		{StoreKey: "acc", Key: []byte("a"), Value: []byte("2")},
+		{StoreKey: "bank", Key: []byte("a"), Delete: true},
+	}
+	require.NoError(t, s.Save(1, changeSet))
+	require.NoError(t, s.Save(2, nil))
+
+	// the changes of a store keep the order of the change set
+	bank, err := s.GetChangeSet(1, "bank")
+	require.NoError(t, err)
+	require.Equal(t, []*types.StoreKVPair{changeSet[0], changeSet[2]}, bank)
+
+	all, err := s.GetChangeSet(1, "")
+	require.NoError(t, err)
+	require.Equal(t, []*types.StoreKVPair{changeSet[1], changeSet[0], changeSet[2]}, all)
+
+	// a block without changes is retained
+	has, err = s.HasHeight(2)
+	require.NoError(t, err)
+	require.True(t, has)
+	empty, err := s.GetChangeSet(2, "")
+	require.NoError(t, err)
+	require.Empty(t, empty)
+
+	require.Error(t, s.Save(0, nil))
+}
+
+func TestStoreSaveRollback(t *testing.T) {
+	s := statediff.NewStore(dbm.NewMemDB(), 0)
+
+	for height := int64(1); height <= 3; height++ {
+		pair := &types.StoreKVPair{StoreKey: "bank", Key: []byte{byte(height)}, Value: []byte("v")}
+		require.NoError(t, s.Save(height, []*types.StoreKVPair{pair}))
+	}
+
+	// saving a height again overwrites its changes and drops the heights above
+	replayed := &types.StoreKVPair{StoreKey: "acc", Key: []byte("a"), Value: []byte("w")}
+	require.NoError(t, s.Save(2, []*types.StoreKVPair{replayed}))
+
+	latest, err := s.GetLatestHeight()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), latest)
+	changeSet, err := s.GetChangeSet(2, "")
+	require.NoError(t, err)
+	require.Equal(t, []*types.StoreKVPair{replayed}, changeSet)
+	changeSet, err = s.GetChangeSet(3, "")
+	require.NoError(t, err)
+	require.Empty(t, changeSet)
+	has, err := s.HasHeight(3)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// the earlier heights are kept
+	changeSet, err = s.GetChangeSet(1, "bank")
+	require.NoError(t, err)
+	require.Len(t, changeSet, 1)
+}
+
+func TestStoreSaveGap(t *testing.T) {
+	s := statediff.NewStore(dbm.NewMemDB(), 0)
+
+	require.NoError(t, s.Save(1, []*types.StoreKVPair{{StoreKey: "bank", Key: []byte("a"), Value: []byte("v")}}))
+
+	// a missing height is never served, the retained heights start after it
+	require.NoError(t, s.Save(3, nil))
+	earliest, err := s.GetEarliestHeight()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), earliest)
+	for height := int64(1); height <= 2; height++ {
+		has, err := s.HasHeight(height)
+		require.NoError(t, err)
+		require.False(t, has)
+	}
+	changeSet, err := s.GetChangeSet(1, "")
+	require.NoError(t, err)
+	require.Empty(t, changeSet)
+}
+
+func TestStorePrune(t *testing.T) {
+	s := statediff.NewStore(dbm.NewMemDB(), 2)
+
+	for height := int64(1); height <= 5; height++ {
+		pair := &types.StoreKVPair{StoreKey: "bank", Key: []byte{byte(height)}, Value: []byte("v")}
+		require.NoError(t, s.Save(height, []*types.StoreKVPair{pair}))
+	}
+
+	earliest, err := s.GetEarliestHeight()
+	require.NoError(t, err)
+	require.Equal(t, int64(4), earliest)
+	latest, err := s.GetLatestHeight()
+	require.NoError(t, err)
+	require.Equal(t, int64(5), latest)
+
+	for height := int64(1); height <= 5; height++ {
+		has, err := s.HasHeight(height)
+		require.NoError(t, err)
+		require.Equal(t, height >= 4, has)
+
+		changeSet, err := s.GetChangeSet(height, "bank")
+		require.NoError(t, err)
+		if height >= 4 {
+			require.Len(t, changeSet, 1)
+		} else {
+			require.Empty(t, changeSet)
+		}
+	}
+}
@@ -0,0 +1,430 @@
+// Package storage implements a versioned state storage of the multi-store on
+// top of a key/value database, to serve queries at past heights.
+package storage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/types"
+)
+
+// pruneBatchSize is the number of deletions written at once while pruning.
+const pruneBatchSize = 10_000
+
+var _ types.StateStorage = (*Database)(nil)
+
+// Database is a StateStorage keeping every version of the values in a
+// key/value database. Each value is stored under the version it was written
+// at, a value at a version is therefore the one of the latest write at or
+// before it.
+type Database struct {
+	db      dbm.DB
+	pruning pruningtypes.PruningOptions
+
+	// mtx serializes the writes, the reads are served by the database.
+	mtx sync.Mutex
+}
+
+// NewDatabase returns a Database storing the state in db, and pruning the
+// versions according to the provided options.
+func NewDatabase(db dbm.DB, pruning pruningtypes.PruningOptions) *Database {
+	return &Database{db: db, pruning: pruning}
+}
+
+// GetLatestVersion implements types.StateStorage.
+func (d *Database) GetLatestVersion() (uint64, error) {
+	return d.getVersion(latestVersionKey)
+}
+
+// GetEarliestVersion implements types.StateStorage.
+func (d *Database) GetEarliestVersion() (uint64, error) {
+	return d.getVersion(earliestVersionKey)
+}
+
+func (d *Database) getVersion(key []byte) (uint64, error) {
+	bz, err := d.db.Get(key)
+	if err != nil || bz == nil {
+		return 0, err
+	}
+	if len(bz) != versionLen {
+		return 0, fmt.Errorf("invalid state storage version of length %d", len(bz))
+	}
+
+	return binary.BigEndian.Uint64(bz), nil
+}
+
+// checkVersion returns an error if the version cannot be queried.
+func (d *Database) checkVersion(version uint64) error {
+	latest, err := d.GetLatestVersion()
+	if err != nil {
+		return err
+	}
+	earliest, err := d.GetEarliestVersion()
+	if err != nil {
+		return err
+	}
+
+	if latest == 0 || version < earliest || version > latest {
+		return fmt.Errorf("version %d is not available in the state storage, available versions: [%d, %d]", version, earliest, latest)
+	}
+
+	return nil
+}
+
+// Has implements types.StateStorage.
+func (d *Database) Has(storeKey string, version uint64, key []byte) (bool, error) {
+	value, err := d.Get(storeKey, version, key)
+	return value != nil, err
+}
+
+// Get implements types.StateStorage.
+func (d *Database) Get(storeKey string, version uint64, key []byte) ([]byte, error) {
+	if err := d.checkVersion(version); err != nil {
+		return nil, err
+	}
+
+	prefix := appendKey(storePrefix(storeKey), key)
+	it, err := d.db.ReverseIterator(appendVersion(prefix, 0), versionsEnd(prefix, version))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	if !it.Valid() {
+		return nil, it.Error()
+	}
+
+	return decodeValue(it.Value())
+}
+
+// versionsEnd returns the exclusive end of the versions of a key, up to and
+// including the provided version.
+func versionsEnd(prefix []byte, version uint64) []byte {
+	if version == math.MaxUint64 {
+		return types.PrefixEndBytes(prefix)
+	}
+
+	return appendVersion(prefix, version+1)
+}
+
+// Iterator implements types.StateStorage.
+func (d *Database) Iterator(storeKey string, version uint64, start, end []byte) (types.Iterator, error) {
+	return d.newIterator(storeKey, version, start, end, false)
+}
+
+// ReverseIterator implements types.StateStorage.
+func (d *Database) ReverseIterator(storeKey string, version uint64, start, end []byte) (types.Iterator, error) {
+	return d.newIterator(storeKey, version, start, end, true)
+}
+
+func (d *Database) newIterator(storeKey string, version uint64, start, end []byte, reverse bool) (types.Iterator, error) {
+	if err := d.checkVersion(version); err != nil {
+		return nil, err
+	}
+
+	prefix := storePrefix(storeKey)
+	rawStart, rawEnd := prefix, types.PrefixEndBytes(prefix)
+	if start != nil {
+		rawStart = appendKey(prefix, start)
+	}
+	if end != nil {
+		rawEnd = appendKey(prefix, end)
+	}
+
+	var (
+		source dbm.Iterator
+		err    error
+	)
+	if reverse {
+		source, err = d.db.ReverseIterator(rawStart, rawEnd)
+	} else {
+		source, err = d.db.Iterator(rawStart, rawEnd)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return newIterator(source, prefix, version, start, end, reverse), nil
+}
+
+// ApplyChangeset implements types.StateStorage. The versions are pruned once
+// the changeset is written, according to the pruning options.
+func (d *Database) ApplyChangeset(version uint64, changeset []*types.StoreKVPair) error {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+
+	if version == 0 {
+		return fmt.Errorf("cannot apply a changeset at version 0")
+	}
+
+	latest, err := d.GetLatestVersion()
+	if err != nil {
+		return err
+	}
+	if version < latest {
+		return fmt.Errorf("cannot apply a changeset at version %d lower than the latest version %d", version, latest)
+	}
+	earliest, err := d.GetEarliestVersion()
+	if err != nil {
+		return err
+	}
+
+	batch := d.db.NewBatch()
+	defer batch.Close()
+
+	// the last write to a key wins
+	writes := make(map[string]*types.StoreKVPair, len(changeset))
+	for _, pair := range changeset {
+		writes[string(dataKey(pair.StoreKey, pair.Key, version))] = pair
+	}
+
+	keys := make([]string, 0, len(writes))
+	for key := range writes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		pair := writes[key]
+		if err := batch.Set([]byte(key), encodeValue(pair.Value, pair.Delete)); err != nil {
+			return err
+		}
+	}
+
+	if err := batch.Set(latestVersionKey, appendVersion(nil, version)); err != nil {
+		return err
+	}
+	if earliest == 0 {
+		if err := batch.Set(earliestVersionKey, appendVersion(nil, version)); err != nil {
+			return err
+		}
+	}
+
+	if err := batch.Write(); err != nil {
+		return err
+	}
+
+	keepRecent, interval := d.pruning.KeepRecent, d.pruning.Interval
+	if d.pruning.Strategy == pruningtypes.PruningNothing || interval == 0 || version%interval != 0 || version <= keepRecent {
+		return nil
+	}
+
+	return d.prune(version - keepRecent)
+}
+
+// Prune implements types.StateStorage. The values which are overwritten by a
+// later write at or before version are removed, as well as the deletions, and
+// the earliest version becomes the next one.
+func (d *Database) Prune(version uint64) error {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+
+	return d.prune(version)
+}
+
+func (d *Database) prune(version uint64) error {
+	earliest, err := d.GetEarliestVersion()
+	if err != nil {
+		return err
+	}
+	latest, err := d.GetLatestVersion()
+	if err != nil {
+		return err
+	}
+	if version < earliest || version >= latest {
+		return nil
+	}
+
+	// the earliest version is updated first, so that the versions are not
+	// queried while they are being pruned
+	if err := d.db.Set(earliestVersionKey, appendVersion(nil, version+1)); err != nil {
+		return err
+	}
+
+	// The deletions are written once the iterator is closed, since databases
+	// such as MemDB lock themselves while being iterated.
+	start := dataPrefix
+	for start != nil {
+		deletions, next, err := d.collectPruned(start, version)
+		if err != nil {
+			return err
+		}
+
+		batch := d.db.NewBatch()
+		for _, key := range deletions {
+			if err := batch.Delete(key); err != nil {
+				batch.Close()
+				return err
+			}
+		}
+		if err := batch.Write(); err != nil {
+			batch.Close()
+			return err
+		}
+		if err := batch.Close(); err != nil {
+			return err
+		}
+
+		start = next
+	}
+
+	return nil
+}
+
+// collectPruned returns the keys to delete to prune the provided version,
+// scanning from start until about pruneBatchSize deletions are found. It
+// returns where to resume the scan from, or nil once it completed.
+func (d *Database) collectPruned(start []byte, version uint64) (deletions [][]byte, next []byte, err error) {
+	it, err := d.db.Iterator(start, types.PrefixEndBytes(dataPrefix))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer it.Close()
+
+	// retained is the latest value of the current key at or before version,
+	// which is kept unless it is a deletion.
+	var (
+		current      []byte
+		retained     []byte
+		retainedDead bool
+	)
+	finishKey := func() {
+		if retained != nil && retainedDead {
+			deletions = append(deletions, retained)
+		}
+	}
+
+	for ; it.Valid(); it.Next() {
+		key, v, err := splitDataKey(it.Key())
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if !bytes.Equal(key, current) {
+			finishKey()
+			if len(deletions) >= pruneBatchSize {
+				return deletions, bytes.Clone(key), nil
+			}
+			current, retained = bytes.Clone(key), nil
+		}
+		if v > version {
+			continue
+		}
+
+		// the versions are ascending, the retained value is overwritten
+		if retained != nil {
+			deletions = append(deletions, retained)
+		}
+
+		value := it.Value()
+		retained, retainedDead = bytes.Clone(it.Key()), len(value) > 0 && value[0] == valueTombstone
+	}
+	if err := it.Error(); err != nil {
+		return nil, nil, err
+	}
+	finishKey()
+
+	return deletions, nil, nil
+}
+
+// Truncate implements types.StateStorage. The versions cannot be truncated
+// below the earliest version, since the pruned values cannot be restored, but
+// the storage can be emptied.
+func (d *Database) Truncate(version uint64) error {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+
+	earliest, err := d.GetEarliestVersion()
+	if err != nil {
+		return err
+	}
+	latest, err := d.GetLatestVersion()
+	if err != nil {
+		return err
+	}
+	if version >= latest {
+		return nil
+	}
+	if version != 0 && version < earliest {
+		return fmt.Errorf("cannot truncate the state storage to version %d lower than the earliest version %d", version, earliest)
+	}
+
+	start := dataPrefix
+	for start != nil {
+		deletions, next, err := d.collectTruncated(start, version)
+		if err != nil {
+			return err
+		}
+
+		batch := d.db.NewBatch()
+		for _, key := range deletions {
+			if err := batch.Delete(key); err != nil {
+				batch.Close()
+				return err
+			}
+		}
+		if err := batch.Write(); err != nil {
+			batch.Close()
+			return err
+		}
+		if err := batch.Close(); err != nil {
+			return err
+		}
+
+		start = next
+	}
+
+	// the latest version is updated last, so that an interrupted truncation is
+	// resumed by truncating again
+	if version == 0 {
+		if err := d.db.Delete(earliestVersionKey); err != nil {
+			return err
+		}
+		return d.db.Delete(latestVersionKey)
+	}
+
+	return d.db.Set(latestVersionKey, appendVersion(nil, version))
+}
+
+// collectTruncated returns the keys of the values written after the provided
+// version, scanning from start until pruneBatchSize deletions are found. It
+// returns where to resume the scan from, or nil once it completed.
+func (d *Database) collectTruncated(start []byte, version uint64) (deletions [][]byte, next []byte, err error) {
+	it, err := d.db.Iterator(start, types.PrefixEndBytes(dataPrefix))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		if len(deletions) >= pruneBatchSize {
+			return deletions, bytes.Clone(it.Key()), nil
+		}
+
+		_, v, err := splitDataKey(it.Key())
+		if err != nil {
+			return nil, nil, err
+		}
+		if v > version {
+			deletions = append(deletions, bytes.Clone(it.Key()))
+		}
+	}
+	if err := it.Error(); err != nil {
+		return nil, nil, err
+	}
+
+	return deletions, nil, nil
+}
+
+// Close closes the underlying database.
+func (d *Database) Close() error {
+	return d.db.Close()
+}
@@ -0,0 +1,285 @@
+package storage_test
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/storage"
+	"cosmossdk.io/store/types"
+)
+
+var (
+	testStores = []string{"store", "store1"}
+	// the keys contain zero bytes and prefixes of each other, to exercise the
+	// encoding of the keys
+	testKeys = [][]byte{
+		{0x00}, {0x00, 0x00}, {0x00, 0xFF}, {0x01}, []byte("a"), []byte("a\x00"), []byte("a\x00b"),
+		[]byte("a\x01"), []byte("ab"), []byte("b"), {0xFF}, {0xFF, 0x00},
+	}
+)
+
+// model is the state of the stores at each version.
+type model map[uint64]map[string]map[string][]byte
+
+// applyRandomChangeset applies random writes at the version to the database
+// and to the model.
+func applyRandomChangeset(t *testing.T, r *rand.Rand, db *storage.Database, m model, version uint64) {
+	t.Helper()
+
+	state := make(map[string]map[string][]byte)
+	for store, kvs := range m[version-1] {
+		state[store] = make(map[string][]byte)
+		for k, v := range kvs {
+			state[store][k] = v
+		}
+	}
+
+	var changeset []*types.StoreKVPair
+	for i := 0; i < 1+r.Intn(8); i++ {
+		store := testStores[r.Intn(len(testStores))]
+		key := testKeys[r.Intn(len(testKeys))]
+		if state[store] == nil {
+			state[store] = make(map[string][]byte)
+		}
+
+		if r.Intn(3) == 0 {
+			changeset = append(changeset, &types.StoreKVPair{StoreKey: store, Key: key, Delete: true})
+			delete(state[store], string(key))
+		} else {
+			value := []byte(fmt.Sprintf("v%d.%d", version, i))
+			if r.Intn(5) == 0 {
+				value = []byte{}
+			}
+			changeset = append(changeset, &types.StoreKVPair{StoreKey: store, Key: key, Value: value})
+			state[store][string(key)] = value
+		}
+	}
+
+	require.NoError(t, db.ApplyChangeset(version, changeset))
+	m[version] = state
+}
+
+// requireState checks the state of the stores at the version.
+func requireState(t *testing.T, db *storage.Database, m model, version uint64) {
+	t.Helper()
+
+	for _, store := range testStores {
+		state := m[version][store]
+
+		for _, key := range testKeys {
+			value, err := db.Get(store, version, key)
+			require.NoError(t, err)
+			require.Equal(t, state[string(key)], value, "store %s, version %d, key %x", store, version, key)
+
+			ok, err := db.Has(store, version, key)
+			require.NoError(t, err)
+			require.Equal(t, state[string(key)] != nil, ok)
+		}
+
+		var keys []string
+		for k := range state {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		// iterate over a random domain
+		start, end := testKeys[rand.Intn(len(testKeys))], testKeys[rand.Intn(len(testKeys))]
+		var expected []string
+		for _, k := range keys {
+			if k >= string(start) && k < string(end) {
+				expected = append(expected, k)
+			}
+		}
+
+		it, err := db.Iterator(store, version, start, end)
+		require.NoError(t, err)
+		var got []string
+		for ; it.Valid(); it.Next() {
+			got = append(got, string(it.Key()))
+			require.Equal(t, state[string(it.Key())], it.Value())
+		}
+		require.NoError(t, it.Error())
+		require.NoError(t, it.Close())
+		require.Equal(t, expected, got, "store %s, version %d", store, version)
+
+		it, err = db.ReverseIterator(store, version, nil, nil)
+		require.NoError(t, err)
+		got = nil
+		for ; it.Valid(); it.Next() {
+			got = append(got, string(it.Key()))
+		}
+		require.NoError(t, it.Close())
+
+		expected = nil
+		for i := len(keys) - 1; i >= 0; i-- {
+			expected = append(expected, keys[i])
+		}
+		require.Equal(t, expected, got, "store %s, version %d", store, version)
+	}
+}
+
+func TestDatabase(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	db := storage.NewDatabase(dbm.NewMemDB(), pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+
+	m := model{}
+	for version := uint64(1); version <= 50; version++ {
+		applyRandomChangeset(t, r, db, m, version)
+	}
+
+	latest, err := db.GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint64(50), latest)
+
+	earliest, err := db.GetEarliestVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), earliest)
+
+	for version := uint64(1); version <= 50; version++ {
+		requireState(t, db, m, version)
+	}
+
+	_, err = db.Get("store", 51, []byte("a"))
+	require.Error(t, err)
+}
+
+func TestDatabasePrune(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	db := storage.NewDatabase(dbm.NewMemDB(), pruningtypes.NewCustomPruningOptions(5, 10))
+
+	m := model{}
+	for version := uint64(1); version <= 42; version++ {
+		applyRandomChangeset(t, r, db, m, version)
+	}
+
+	// pruned at version 40, keeping the last 5 versions
+	earliest, err := db.GetEarliestVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint64(36), earliest)
+
+	for version := uint64(1); version < earliest; version++ {
+		_, err := db.Get("store", version, []byte("a"))
+		require.Error(t, err)
+		_, err = db.Iterator("store", version, nil, nil)
+		require.Error(t, err)
+	}
+	for version := earliest; version <= 42; version++ {
+		requireState(t, db, m, version)
+	}
+
+	require.NoError(t, db.Prune(41))
+	earliest, err = db.GetEarliestVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), earliest)
+	requireState(t, db, m, 42)
+}
+
+func TestDatabaseApplyChangeset(t *testing.T) {
+	db := storage.NewDatabase(dbm.NewMemDB(), pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+
+	require.Error(t, db.ApplyChangeset(0, nil))
+
+	require.NoError(t, db.ApplyChangeset(5, []*types.StoreKVPair{
+		{StoreKey: "store", Key: []byte("a"), Value: []byte("1")},
+		{StoreKey: "store", Key: []byte("a"), Value: []byte("2")},
+	}))
+	value, err := db.Get("store", 5, []byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("2"), value, "the last write must win")
+
+	// applying the latest version again merges the changes
+	require.NoError(t, db.ApplyChangeset(5, []*types.StoreKVPair{
+		{StoreKey: "store", Key: []byte("b"), Value: []byte("1")},
+	}))
+	ok, err := db.Has("store", 5, []byte("a"))
+	require.NoError(t, err)
+	require.True(t, ok)
+	ok, err = db.Has("store", 5, []byte("b"))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	require.Error(t, db.ApplyChangeset(4, nil))
+
+	earliest, err := db.GetEarliestVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), earliest)
+}
+
+func TestDatabaseTruncate(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	db := storage.NewDatabase(dbm.NewMemDB(), pruningtypes.NewCustomPruningOptions(5, 10))
+
+	m := model{}
+	for version := uint64(1); version <= 42; version++ {
+		applyRandomChangeset(t, r, db, m, version)
+	}
+
+	require.NoError(t, db.Truncate(38))
+	latest, err := db.GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint64(38), latest)
+	for version := uint64(36); version <= 38; version++ {
+		requireState(t, db, m, version)
+	}
+	_, err = db.Get("store", 39, []byte("a"))
+	require.Error(t, err)
+
+	// the truncated versions are applied again
+	delete(m, 39)
+	applyRandomChangeset(t, r, db, m, 39)
+	requireState(t, db, m, 39)
+
+	// the pruned versions cannot be restored, but the storage can be emptied
+	require.Error(t, db.Truncate(35))
+	require.NoError(t, db.Truncate(0))
+	latest, err = db.GetLatestVersion()
+	require.NoError(t, err)
+	require.Zero(t, latest)
+	earliest, err := db.GetEarliestVersion()
+	require.NoError(t, err)
+	require.Zero(t, earliest)
+
+	it, err := db.Iterator("store", 0, nil, nil)
+	require.Error(t, err)
+	require.Nil(t, it)
+}
+
+func TestStore(t *testing.T) {
+	db := storage.NewDatabase(dbm.NewMemDB(), pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, db.ApplyChangeset(1, []*types.StoreKVPair{
+		{StoreKey: "store", Key: []byte("a"), Value: []byte("1")},
+		{StoreKey: "store", Key: []byte("b"), Value: []byte("1")},
+	}))
+	require.NoError(t, db.ApplyChangeset(2, []*types.StoreKVPair{
+		{StoreKey: "store", Key: []byte("a"), Delete: true},
+	}))
+
+	store := storage.NewStore(db, "store", 1)
+	require.Equal(t, []byte("1"), store.Get([]byte("a")))
+	require.Panics(t, func() { store.Set([]byte("a"), []byte("2")) })
+	require.Panics(t, func() { store.Delete([]byte("a")) })
+
+	// writes to a branch of the store are kept in the branch
+	branch := store.CacheWrap().(types.KVStore)
+	branch.Set([]byte("c"), []byte("1"))
+	require.True(t, branch.Has([]byte("c")))
+	require.False(t, store.Has([]byte("c")))
+
+	store = storage.NewStore(db, "store", 2)
+	require.False(t, store.Has([]byte("a")))
+
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+	require.True(t, it.Valid())
+	require.Equal(t, []byte("b"), it.Key())
+	it.Next()
+	require.False(t, it.Valid())
+
+	require.Panics(t, func() { storage.NewStore(db, "store", 3).Get([]byte("a")) })
+}
@@ -0,0 +1,149 @@
+package storage
+
+import (
+	"bytes"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/store/types"
+)
+
+var _ types.Iterator = (*iterator)(nil)
+
+// iterator iterates over the keys of a store at a version. The underlying
+// iterator yields every version of each key, grouped and in ascending order
+// of the versions, the iterator yields the latest value of each key at or
+// before the version, skipping the deleted keys.
+type iterator struct {
+	source  dbm.Iterator
+	prefix  []byte
+	version uint64
+	reverse bool
+
+	start, end []byte
+
+	key, value []byte
+	valid      bool
+	err        error
+}
+
+func newIterator(source dbm.Iterator, prefix []byte, version uint64, start, end []byte, reverse bool) *iterator {
+	it := &iterator{
+		source:  source,
+		prefix:  prefix,
+		version: version,
+		reverse: reverse,
+		start:   start,
+		end:     end,
+	}
+	it.advance()
+
+	return it
+}
+
+// advance moves to the next key which has a value at the version.
+func (it *iterator) advance() {
+	it.valid, it.key, it.value = false, nil, nil
+
+	for it.err == nil && it.source.Valid() {
+		key, value, found := it.nextKey()
+		if it.err != nil || !found || value == nil {
+			continue
+		}
+
+		it.key, it.value, it.valid = key, value, true
+		return
+	}
+
+	if it.err == nil {
+		it.err = it.source.Error()
+	}
+}
+
+// nextKey consumes the versions of the current key of the underlying
+// iterator, and returns its value at the version if any.
+func (it *iterator) nextKey() (key, value []byte, found bool) {
+	rawKey, _, err := splitDataKey(it.source.Key())
+	if err != nil {
+		it.err = err
+		return nil, nil, false
+	}
+	rawKey = bytes.Clone(rawKey)
+
+	var rawValue []byte
+	for ; it.source.Valid(); it.source.Next() {
+		current, v, err := splitDataKey(it.source.Key())
+		if err != nil {
+			it.err = err
+			return nil, nil, false
+		}
+		if !bytes.Equal(current, rawKey) {
+			break
+		}
+
+		// the versions are ascending when iterating forward and descending in
+		// reverse, the first version at or before it wins in reverse
+		if v <= it.version && (!it.reverse || rawValue == nil) {
+			rawValue = bytes.Clone(it.source.Value())
+		}
+	}
+
+	if rawValue == nil {
+		return nil, nil, false
+	}
+
+	if key, err = decodeKey(it.prefix, rawKey); err != nil {
+		it.err = err
+		return nil, nil, false
+	}
+	if value, err = decodeValue(rawValue); err != nil {
+		it.err = err
+		return nil, nil, false
+	}
+
+	return key, value, true
+}
+
+// Domain implements types.Iterator.
+func (it *iterator) Domain() (start, end []byte) {
+	return it.start, it.end
+}
+
+// Valid implements types.Iterator.
+func (it *iterator) Valid() bool {
+	return it.valid
+}
+
+// Key implements types.Iterator.
+func (it *iterator) Key() []byte {
+	it.assertValid()
+	return it.key
+}
+
+// Value implements types.Iterator.
+func (it *iterator) Value() []byte {
+	it.assertValid()
+	return it.value
+}
+
+// Next implements types.Iterator.
+func (it *iterator) Next() {
+	it.assertValid()
+	it.advance()
+}
+
+// Error implements types.Iterator.
+func (it *iterator) Error() error {
+	return it.err
+}
+
+// Close implements types.Iterator.
+func (it *iterator) Close() error {
+	return it.source.Close()
+}
+
+func (it *iterator) assertValid() {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+}
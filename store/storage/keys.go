@@ -0,0 +1,139 @@
+package storage
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// The values are kept under keys made of the data prefix, the store key, the
+// escaped key and the version:
+//
+//	d | uvarint(len(storeKey)) | storeKey | escape(key) | 0x00 0x00 | bigendian(version)
+//
+// The zero bytes of the key are escaped as 0x00 0xFF, and the escaped key is
+// terminated by 0x00 0x00. The escaping preserves the order of the keys and no
+// escaped key is a prefix of another, hence the versions of a key are
+// contiguous and ordered, and the keys of a store are ordered as in the store.
+var (
+	dataPrefix = []byte{'d'}
+
+	latestVersionKey   = []byte("m/latest")
+	earliestVersionKey = []byte("m/earliest")
+)
+
+const (
+	versionLen = 8
+
+	// valueTombstone marks a key deleted at a version.
+	valueTombstone byte = 0
+	// valueSet prefixes the value of a key set at a version.
+	valueSet byte = 1
+)
+
+var (
+	keyTerminator = []byte{0x00, 0x00}
+	escapedZero   = []byte{0x00, 0xFF}
+)
+
+// storePrefix returns the prefix of the keys of a store.
+func storePrefix(storeKey string) []byte {
+	prefix := make([]byte, 0, len(dataPrefix)+binary.MaxVarintLen64+len(storeKey))
+	prefix = append(prefix, dataPrefix...)
+	prefix = binary.AppendUvarint(prefix, uint64(len(storeKey)))
+
+	return append(prefix, storeKey...)
+}
+
+// appendKey appends the escaped and terminated key to the prefix.
+func appendKey(prefix, key []byte) []byte {
+	bz := make([]byte, 0, len(prefix)+len(key)+len(keyTerminator))
+	bz = append(bz, prefix...)
+	for _, b := range key {
+		if b == 0x00 {
+			bz = append(bz, escapedZero...)
+		} else {
+			bz = append(bz, b)
+		}
+	}
+
+	return append(bz, keyTerminator...)
+}
+
+// appendVersion returns a copy of bz followed by the big-endian version, bz is
+// never modified so that several versions can be appended to the same key.
+func appendVersion(bz []byte, version uint64) []byte {
+	res := make([]byte, len(bz), len(bz)+versionLen)
+	copy(res, bz)
+
+	return binary.BigEndian.AppendUint64(res, version)
+}
+
+// dataKey returns the key of the value of a key of a store at a version.
+func dataKey(storeKey string, key []byte, version uint64) []byte {
+	return appendVersion(appendKey(storePrefix(storeKey), key), version)
+}
+
+// splitDataKey returns the escaped and terminated key including its prefix,
+// and the version of a key of the data prefix.
+func splitDataKey(bz []byte) ([]byte, uint64, error) {
+	if len(bz) < len(dataPrefix)+len(keyTerminator)+versionLen {
+		return nil, 0, fmt.Errorf("invalid state storage key of length %d", len(bz))
+	}
+
+	split := len(bz) - versionLen
+	return bz[:split], binary.BigEndian.Uint64(bz[split:]), nil
+}
+
+// decodeKey returns the key of an escaped and terminated key, following the
+// provided store prefix.
+func decodeKey(prefix, bz []byte) ([]byte, error) {
+	if len(bz) < len(prefix)+len(keyTerminator) {
+		return nil, errors.New("invalid state storage key: too short")
+	}
+
+	escaped := bz[len(prefix) : len(bz)-len(keyTerminator)]
+	key := make([]byte, 0, len(escaped))
+	for i := 0; i < len(escaped); i++ {
+		b := escaped[i]
+		if b == 0x00 {
+			if i+1 >= len(escaped) || escaped[i+1] != 0xFF {
+				return nil, errors.New("invalid state storage key: bad escape sequence")
+			}
+			i++
+		}
+		key = append(key, b)
+	}
+
+	return key, nil
+}
+
+// encodeValue returns the stored value of a key set to value, or deleted.
+func encodeValue(value []byte, deleted bool) []byte {
+	if deleted {
+		return []byte{valueTombstone}
+	}
+
+	bz := make([]byte, 0, 1+len(value))
+	bz = append(bz, valueSet)
+
+	return append(bz, value...)
+}
+
+// decodeValue returns the value of a stored value, nil if the key was deleted.
+func decodeValue(bz []byte) ([]byte, error) {
+	if len(bz) == 0 {
+		return nil, errors.New("invalid state storage value: empty")
+	}
+
+	switch bz[0] {
+	case valueTombstone:
+		return nil, nil
+	case valueSet:
+		value := make([]byte, len(bz)-1)
+		copy(value, bz[1:])
+		return value, nil
+	default:
+		return nil, fmt.Errorf("invalid state storage value flag %d", bz[0])
+	}
+}
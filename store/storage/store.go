@@ -0,0 +1,93 @@
+package storage
+
+import (
+	"io"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/tracekv"
+	"cosmossdk.io/store/types"
+)
+
+var _ types.KVStore = (*Store)(nil)
+
+// Store is a read-only KVStore of the state of a store at a version, served
+// by a StateStorage. It panics on errors, like the other KVStores do.
+type Store struct {
+	ss       types.StateStorage
+	storeKey string
+	version  uint64
+}
+
+// NewStore returns the store with the provided key at version.
+func NewStore(ss types.StateStorage, storeKey string, version uint64) *Store {
+	return &Store{ss: ss, storeKey: storeKey, version: version}
+}
+
+// GetStoreType implements types.Store.
+func (s *Store) GetStoreType() types.StoreType {
+	return types.StoreTypeDB
+}
+
+// Get implements types.KVStore.
+func (s *Store) Get(key []byte) []byte {
+	types.AssertValidKey(key)
+
+	value, err := s.ss.Get(s.storeKey, s.version, key)
+	if err != nil {
+		panic(err)
+	}
+
+	return value
+}
+
+// Has implements types.KVStore.
+func (s *Store) Has(key []byte) bool {
+	types.AssertValidKey(key)
+
+	ok, err := s.ss.Has(s.storeKey, s.version, key)
+	if err != nil {
+		panic(err)
+	}
+
+	return ok
+}
+
+// Set implements types.KVStore, it panics since the store is read-only.
+func (s *Store) Set(_, _ []byte) {
+	panic("cannot write to a historical state store")
+}
+
+// Delete implements types.KVStore, it panics since the store is read-only.
+func (s *Store) Delete(_ []byte) {
+	panic("cannot delete from a historical state store")
+}
+
+// Iterator implements types.KVStore.
+func (s *Store) Iterator(start, end []byte) types.Iterator {
+	it, err := s.ss.Iterator(s.storeKey, s.version, start, end)
+	if err != nil {
+		panic(err)
+	}
+
+	return it
+}
+
+// ReverseIterator implements types.KVStore.
+func (s *Store) ReverseIterator(start, end []byte) types.Iterator {
+	it, err := s.ss.ReverseIterator(s.storeKey, s.version, start, end)
+	if err != nil {
+		panic(err)
+	}
+
+	return it
+}
+
+// CacheWrap implements types.CacheWrapper.
+func (s *Store) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements types.CacheWrapper.
+func (s *Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
@@ -15,5 +15,5 @@ func NewCommitMultiStore(db dbm.DB, logger log.Logger, metricGatherer metrics.St
 }
 
 func NewCommitKVStoreCacheManager() types.MultiStorePersistentCache {
-	return cache.NewCommitKVStoreCacheManager(cache.DefaultCommitKVStoreCacheSize)
+	return cache.NewCommitKVStoreCacheManager(cache.DefaultConfig())
 }
@@ -0,0 +1,159 @@
+package file
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	protoio "github.com/cosmos/gogoproto/io"
+
+	"cosmossdk.io/store/streaming"
+	storetypes "cosmossdk.io/store/types"
+)
+
+const (
+	// DefaultMaxFileSize is the default size in bytes after which the sink
+	// rotates to a new file.
+	DefaultMaxFileSize = 100 << 20
+
+	// maxBlockSize is the maximum size of a block record read by a Reader.
+	maxBlockSize = 1 << 30
+
+	filePrefix = "blocks-"
+	fileSuffix = ".pb"
+)
+
+var _ streaming.Sink = (*Sink)(nil)
+
+// Sink writes the streamed blocks to rotating files in a directory, each block
+// is a length-prefixed protobuf StreamedBlock record.
+//
+// A file is named after the height of its first block, so that the files sort
+// in the order of the blocks, and the sink starts a new file at the next block
+// once the current file reaches the maximum file size.
+type Sink struct {
+	dir         string
+	maxFileSize int64
+	fsync       bool
+
+	file *os.File
+	size int64
+}
+
+// NewSink returns a Sink writing to the directory, which is created if it does
+// not exist. If fsync is set, every block is synced to the disk before it is
+// acknowledged.
+func NewSink(dir string, maxFileSize int64, fsync bool) (*Sink, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create the streaming directory: %w", err)
+	}
+	if maxFileSize <= 0 {
+		maxFileSize = DefaultMaxFileSize
+	}
+
+	return &Sink{
+		dir:         dir,
+		maxFileSize: maxFileSize,
+		fsync:       fsync,
+	}, nil
+}
+
+// FileName returns the name of the file starting at the given block height.
+func FileName(height int64) string {
+	return fmt.Sprintf("%s%020d%s", filePrefix, height, fileSuffix)
+}
+
+// Write implements streaming.Sink.
+func (s *Sink) Write(block *storetypes.StreamedBlock) error {
+	if s.file == nil || s.size >= s.maxFileSize {
+		if err := s.rotate(block.BlockHeight); err != nil {
+			return err
+		}
+	}
+
+	bz, err := block.Marshal()
+	if err != nil {
+		return err
+	}
+
+	record := binary.AppendUvarint(make([]byte, 0, binary.MaxVarintLen64+len(bz)), uint64(len(bz)))
+	record = append(record, bz...)
+	if _, err := s.file.Write(record); err != nil {
+		return err
+	}
+	s.size += int64(len(record))
+
+	if s.fsync {
+		return s.file.Sync()
+	}
+
+	return nil
+}
+
+// rotate closes the current file and opens the file starting at the height. An
+// existing file of the same name is truncated, since it holds blocks which are
+// streamed again.
+func (s *Sink) rotate(height int64) error {
+	if err := s.closeFile(); err != nil {
+		return err
+	}
+
+	path := filepath.Join(s.dir, FileName(height))
+	f, err := os.OpenFile(filepath.Clean(path), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+
+	s.file = f
+	s.size = 0
+
+	return nil
+}
+
+func (s *Sink) closeFile() error {
+	if s.file == nil {
+		return nil
+	}
+
+	var err error
+	if s.fsync {
+		err = s.file.Sync()
+	}
+	if cerr := s.file.Close(); err == nil {
+		err = cerr
+	}
+	s.file = nil
+
+	return err
+}
+
+// Close implements streaming.Sink.
+func (s *Sink) Close() error {
+	return s.closeFile()
+}
+
+// Reader reads the blocks of a file written by a Sink.
+type Reader struct {
+	r protoio.ReadCloser
+}
+
+// NewReader returns a Reader of the blocks written to r.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{r: protoio.NewDelimitedReader(r, maxBlockSize)}
+}
+
+// Next returns the next block, or io.EOF if all the blocks were read.
+func (r *Reader) Next() (*storetypes.StreamedBlock, error) {
+	block := &storetypes.StreamedBlock{}
+	if err := r.r.ReadMsg(block); err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, fmt.Errorf("truncated block record: %w", err)
+		}
+		return nil, err
+	}
+
+	return block, nil
+}
@@ -0,0 +1,100 @@
+package file_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/streaming"
+	"cosmossdk.io/store/streaming/file"
+	storetypes "cosmossdk.io/store/types"
+)
+
+func readBlocks(t *testing.T, path string) []*storetypes.StreamedBlock {
+	t.Helper()
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var blocks []*storetypes.StreamedBlock
+	r := file.NewReader(f)
+	for {
+		block, err := r.Next()
+		if err == io.EOF {
+			return blocks
+		}
+		require.NoError(t, err)
+		blocks = append(blocks, block)
+	}
+}
+
+func TestSinkRotation(t *testing.T) {
+	dir := t.TempDir()
+	sink, err := file.NewSink(dir, 1, true)
+	require.NoError(t, err)
+
+	l := streaming.NewListener(sink, streaming.DefaultListenerOptions())
+	ctx := context.Background()
+	for height := int64(1); height <= 3; height++ {
+		require.NoError(t, l.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: tmproto.Header{Height: height}}, abci.ResponseBeginBlock{}))
+		require.NoError(t, l.ListenEndBlock(ctx, abci.RequestEndBlock{Height: height}, abci.ResponseEndBlock{}))
+		changeSet := []*storetypes.StoreKVPair{{StoreKey: "bank", Key: []byte{byte(height)}, Value: []byte("value")}}
+		require.NoError(t, l.ListenCommit(ctx, abci.ResponseCommit{}, changeSet))
+	}
+	require.NoError(t, l.Close())
+
+	// every block exceeds the maximum file size, so each one has its own file
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	for i, entry := range entries {
+		height := int64(i + 1)
+		require.Equal(t, file.FileName(height), entry.Name())
+
+		blocks := readBlocks(t, filepath.Join(dir, entry.Name()))
+		require.Len(t, blocks, 1)
+		require.Equal(t, height, blocks[0].BlockHeight)
+		require.Equal(t, height, blocks[0].Metadata.RequestEndBlock.Height)
+		require.Equal(t, []byte{byte(height)}, blocks[0].ChangeSet[0].Key)
+	}
+}
+
+func TestSinkSingleFile(t *testing.T) {
+	dir := t.TempDir()
+	sink, err := file.NewSink(dir, 0, false)
+	require.NoError(t, err)
+
+	for height := int64(5); height < 10; height++ {
+		require.NoError(t, sink.Write(&storetypes.StreamedBlock{BlockHeight: height}))
+	}
+	require.NoError(t, sink.Close())
+
+	blocks := readBlocks(t, filepath.Join(dir, file.FileName(5)))
+	require.Len(t, blocks, 5)
+	for i, block := range blocks {
+		require.Equal(t, int64(5+i), block.BlockHeight)
+	}
+}
+
+func TestReaderTruncatedRecord(t *testing.T) {
+	dir := t.TempDir()
+	sink, err := file.NewSink(dir, 0, false)
+	require.NoError(t, err)
+	require.NoError(t, sink.Write(&storetypes.StreamedBlock{BlockHeight: 1, ChangeSet: []*storetypes.StoreKVPair{{Key: []byte("key")}}}))
+	require.NoError(t, sink.Close())
+
+	path := filepath.Join(dir, file.FileName(1))
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	_, err = file.NewReader(bytes.NewReader(bz[:len(bz)-1])).Next()
+	require.ErrorContains(t, err, "truncated block record")
+}
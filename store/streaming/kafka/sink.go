@@ -0,0 +1,69 @@
+package kafka
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"cosmossdk.io/store/streaming"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// Producer is a synchronous producer of a Kafka-protocol broker, it is the
+// extension point of the kafka sink. No Kafka client is bundled with the store:
+// the application implements Producer with a thin adapter over the producer of
+// the Kafka client library of its choice (see the README for an example), and
+// a local stand-in can be used for testing.
+type Producer interface {
+	// SendMessage produces the message to the topic, it returns once the
+	// broker has acknowledged the message.
+	SendMessage(topic string, key, value []byte) error
+	// Close flushes the pending messages and closes the producer.
+	Close() error
+}
+
+var _ streaming.Sink = (*Sink)(nil)
+
+// Sink produces each streamed block as a single message to a topic. The key of
+// the message is the big-endian height of the block, which lets the consumers
+// deduplicate the blocks produced again after a restart, and the value is the
+// protobuf encoded StreamedBlock.
+//
+// The maximum message size of the topic must be large enough for the blocks.
+type Sink struct {
+	producer Producer
+	topic    string
+}
+
+// NewSink returns a Sink producing the blocks to the topic.
+func NewSink(producer Producer, topic string) *Sink {
+	return &Sink{
+		producer: producer,
+		topic:    topic,
+	}
+}
+
+// MessageKey returns the key of the message of the block at the given height.
+func MessageKey(height int64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(height))
+	return key
+}
+
+// Write implements streaming.Sink.
+func (s *Sink) Write(block *storetypes.StreamedBlock) error {
+	bz, err := block.Marshal()
+	if err != nil {
+		return err
+	}
+
+	if err := s.producer.SendMessage(s.topic, MessageKey(block.BlockHeight), bz); err != nil {
+		return fmt.Errorf("failed to produce block %d to topic %s: %w", block.BlockHeight, s.topic, err)
+	}
+
+	return nil
+}
+
+// Close implements streaming.Sink.
+func (s *Sink) Close() error {
+	return s.producer.Close()
+}
@@ -0,0 +1,70 @@
+package kafka_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/streaming"
+	"cosmossdk.io/store/streaming/kafka"
+	storetypes "cosmossdk.io/store/types"
+)
+
+type message struct {
+	topic      string
+	key, value []byte
+}
+
+// mockProducer is a local stand-in for a Kafka broker.
+type mockProducer struct {
+	messages []message
+	err      error
+	closed   bool
+}
+
+func (p *mockProducer) SendMessage(topic string, key, value []byte) error {
+	if p.err != nil {
+		return p.err
+	}
+	p.messages = append(p.messages, message{topic: topic, key: key, value: value})
+	return nil
+}
+
+func (p *mockProducer) Close() error {
+	p.closed = true
+	return nil
+}
+
+func TestSink(t *testing.T) {
+	producer := &mockProducer{}
+	l := streaming.NewListener(kafka.NewSink(producer, "blocks"), streaming.DefaultListenerOptions())
+
+	ctx := context.Background()
+	changeSet := []*storetypes.StoreKVPair{{StoreKey: "acc", Delete: true, Key: []byte("key")}}
+	require.NoError(t, l.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: tmproto.Header{Height: 7}}, abci.ResponseBeginBlock{}))
+	require.NoError(t, l.ListenCommit(ctx, abci.ResponseCommit{Data: []byte("hash")}, changeSet))
+
+	require.Len(t, producer.messages, 1)
+	msg := producer.messages[0]
+	require.Equal(t, "blocks", msg.topic)
+	require.Equal(t, kafka.MessageKey(7), msg.key)
+
+	var block storetypes.StreamedBlock
+	require.NoError(t, block.Unmarshal(msg.value))
+	require.Equal(t, int64(7), block.BlockHeight)
+	require.Equal(t, changeSet, block.ChangeSet)
+	require.Equal(t, []byte("hash"), block.Metadata.ResponseCommit.Data)
+
+	// an unacknowledged message fails the block
+	producer.err = errors.New("broker unavailable")
+	require.NoError(t, l.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: tmproto.Header{Height: 8}}, abci.ResponseBeginBlock{}))
+	err := l.ListenCommit(ctx, abci.ResponseCommit{}, nil)
+	require.ErrorContains(t, err, "failed to produce block 8 to topic blocks: broker unavailable")
+
+	require.NoError(t, l.Close())
+	require.True(t, producer.closed)
+}
@@ -0,0 +1,238 @@
+package streaming
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+// Sink is the destination of the blocks streamed by a Listener.
+type Sink interface {
+	// Write delivers the block to the sink, the block must be durably stored,
+	// or acknowledged by the remote system, when it returns without error.
+	Write(block *storetypes.StreamedBlock) error
+	// Close flushes the pending writes and releases the resources of the sink.
+	Close() error
+}
+
+// ListenerOptions defines how a Listener delivers the blocks to its sink.
+type ListenerOptions struct {
+	// BufferSize is the number of blocks which can wait to be written to the
+	// sink. When the buffer is full the sink is behind, and the blocks are
+	// dropped, unless Ack is set.
+	BufferSize int
+	// Ack makes ListenCommit wait until the block is written to the sink, a
+	// failure to write it is returned by ListenCommit.
+	Ack bool
+	// AckTimeout is the maximum time ListenCommit waits for the sink to write
+	// the block when Ack is set, it waits indefinitely if 0.
+	AckTimeout time.Duration
+}
+
+// DefaultListenerOptions returns the default options of a Listener.
+func DefaultListenerOptions() ListenerOptions {
+	return ListenerOptions{
+		BufferSize: 100,
+		Ack:        true,
+		AckTimeout: 30 * time.Second,
+	}
+}
+
+var _ storetypes.ABCIListener = (*Listener)(nil)
+
+// Listener is an in-process ABCIListener which collects the ABCI messages and
+// the state changes of each block, and writes them as a StreamedBlock to a Sink
+// in the background.
+//
+// A block which cannot be delivered to the sink is never silently dropped: the
+// error is returned by the listening hooks, which halts the node when the
+// streaming manager is configured to stop on errors.
+type Listener struct {
+	sink Sink
+	opts ListenerOptions
+
+	// block is the block being collected, it is only accessed by the ABCI hooks.
+	block *storetypes.StreamedBlock
+
+	queue     chan pendingBlock
+	done      chan struct{}
+	closeOnce sync.Once
+	closeErr  error
+
+	mtx sync.Mutex
+	err error // error of a background write, reported by the next hook
+}
+
+type pendingBlock struct {
+	block *storetypes.StreamedBlock
+	ack   chan error
+}
+
+// NewListener returns a Listener writing the blocks to the sink.
+func NewListener(sink Sink, opts ListenerOptions) *Listener {
+	if opts.BufferSize < 1 {
+		opts.BufferSize = 1
+	}
+
+	l := &Listener{
+		sink:  sink,
+		opts:  opts,
+		queue: make(chan pendingBlock, opts.BufferSize),
+		done:  make(chan struct{}),
+	}
+	go l.run()
+
+	return l
+}
+
+func (l *Listener) run() {
+	defer close(l.done)
+
+	for p := range l.queue {
+		err := l.sink.Write(p.block)
+		if p.ack != nil {
+			p.ack <- err
+		} else if err != nil {
+			l.setErr(fmt.Errorf("failed to stream block %d: %w", p.block.BlockHeight, err))
+		}
+	}
+}
+
+func (l *Listener) setErr(err error) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	if l.err == nil {
+		l.err = err
+	}
+}
+
+// takeErr returns and clears the error of a background write.
+func (l *Listener) takeErr() error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	err := l.err
+	l.err = nil
+	return err
+}
+
+// ListenBeginBlock starts collecting a new block.
+func (l *Listener) ListenBeginBlock(_ context.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	l.block = &storetypes.StreamedBlock{
+		BlockHeight: req.Header.Height,
+		Metadata: &storetypes.BlockMetadata{
+			RequestBeginBlock:  &req,
+			ResponseBeginBlock: &res,
+		},
+	}
+
+	return l.takeErr()
+}
+
+// ListenEndBlock adds the EndBlock messages to the block.
+func (l *Listener) ListenEndBlock(ctx context.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	block := l.currentBlock(ctx)
+	block.Metadata.RequestEndBlock = &req
+	block.Metadata.ResponseEndBlock = &res
+
+	return nil
+}
+
+// ListenDeliverTx adds the DeliverTx messages to the block.
+func (l *Listener) ListenDeliverTx(ctx context.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	block := l.currentBlock(ctx)
+	block.Metadata.DeliverTxs = append(block.Metadata.DeliverTxs, &storetypes.BlockMetadata_DeliverTx{
+		Request:  &req,
+		Response: &res,
+	})
+
+	return nil
+}
+
+// ListenCommit completes the block with the Commit response and the state
+// changes, and hands it to the sink. It returns an error if the block cannot be
+// buffered, because the sink is behind, or, in ack mode, if the sink fails to
+// write it in time.
+func (l *Listener) ListenCommit(ctx context.Context, res abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	block := l.currentBlock(ctx)
+	block.Metadata.ResponseCommit = &res
+	block.ChangeSet = changeSet
+	l.block = nil
+
+	if err := l.takeErr(); err != nil {
+		return err
+	}
+
+	p := pendingBlock{block: block}
+	if !l.opts.Ack {
+		select {
+		case l.queue <- p:
+			return nil
+		default:
+			return fmt.Errorf("streaming sink is behind, block %d was dropped", block.BlockHeight)
+		}
+	}
+
+	p.ack = make(chan error, 1)
+	var timeout <-chan time.Time
+	if l.opts.AckTimeout > 0 {
+		timer := time.NewTimer(l.opts.AckTimeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	select {
+	case l.queue <- p:
+	case <-timeout:
+		return fmt.Errorf("streaming sink is behind, block %d was not acknowledged in %s", block.BlockHeight, l.opts.AckTimeout)
+	}
+
+	select {
+	case err := <-p.ack:
+		if err != nil {
+			return fmt.Errorf("failed to stream block %d: %w", block.BlockHeight, err)
+		}
+		return nil
+	case <-timeout:
+		return fmt.Errorf("streaming sink is behind, block %d was not acknowledged in %s", block.BlockHeight, l.opts.AckTimeout)
+	}
+}
+
+// currentBlock returns the block being collected, it starts a new one if the
+// listener missed the BeginBlock of the block.
+func (l *Listener) currentBlock(ctx context.Context) *storetypes.StreamedBlock {
+	if l.block == nil {
+		var height int64
+		if sCtx, ok := ctx.(storetypes.Context); ok {
+			height = sCtx.BlockHeight()
+		}
+		l.block = &storetypes.StreamedBlock{
+			BlockHeight: height,
+			Metadata:    &storetypes.BlockMetadata{},
+		}
+	}
+
+	return l.block
+}
+
+// Close waits for the buffered blocks to be written and closes the sink. The
+// listener must not be used after it is closed.
+func (l *Listener) Close() error {
+	l.closeOnce.Do(func() {
+		close(l.queue)
+		<-l.done
+
+		l.closeErr = l.sink.Close()
+		if err := l.takeErr(); err != nil && l.closeErr == nil {
+			l.closeErr = err
+		}
+	})
+
+	return l.closeErr
+}
@@ -0,0 +1,142 @@
+package streaming
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+type mockSink struct {
+	mtx     sync.Mutex
+	blocks  []*storetypes.StreamedBlock
+	err     error
+	blocked chan struct{}
+	closed  bool
+}
+
+func (s *mockSink) Write(block *storetypes.StreamedBlock) error {
+	if s.blocked != nil {
+		<-s.blocked
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.err != nil {
+		return s.err
+	}
+	s.blocks = append(s.blocks, block)
+	return nil
+}
+
+func (s *mockSink) Close() error {
+	s.closed = true
+	return nil
+}
+
+func (s *mockSink) written() []*storetypes.StreamedBlock {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.blocks
+}
+
+func streamBlock(l *Listener, height int64, changeSet []*storetypes.StoreKVPair) error {
+	ctx := context.Background()
+	req := abci.RequestBeginBlock{Header: tmproto.Header{Height: height}}
+	if err := l.ListenBeginBlock(ctx, req, abci.ResponseBeginBlock{}); err != nil {
+		return err
+	}
+	if err := l.ListenDeliverTx(ctx, abci.RequestDeliverTx{Tx: []byte("tx")}, abci.ResponseDeliverTx{Code: 1}); err != nil {
+		return err
+	}
+	if err := l.ListenEndBlock(ctx, abci.RequestEndBlock{Height: height}, abci.ResponseEndBlock{}); err != nil {
+		return err
+	}
+	return l.ListenCommit(ctx, abci.ResponseCommit{Data: []byte("hash")}, changeSet)
+}
+
+func TestListenerAck(t *testing.T) {
+	sink := &mockSink{}
+	l := NewListener(sink, ListenerOptions{BufferSize: 1, Ack: true})
+
+	changeSet := []*storetypes.StoreKVPair{{StoreKey: "acc", Key: []byte("key"), Value: []byte("value")}}
+	require.NoError(t, streamBlock(l, 1, changeSet))
+
+	// the block is written when ListenCommit returns
+	blocks := sink.written()
+	require.Len(t, blocks, 1)
+	require.Equal(t, int64(1), blocks[0].BlockHeight)
+	require.Equal(t, changeSet, blocks[0].ChangeSet)
+	require.Len(t, blocks[0].Metadata.DeliverTxs, 1)
+	require.Equal(t, []byte("tx"), blocks[0].Metadata.DeliverTxs[0].Request.Tx)
+	require.Equal(t, int64(1), blocks[0].Metadata.RequestEndBlock.Height)
+	require.Equal(t, []byte("hash"), blocks[0].Metadata.ResponseCommit.Data)
+
+	// a failure of the sink is returned by ListenCommit
+	sink.err = errors.New("disk full")
+	err := streamBlock(l, 2, nil)
+	require.ErrorContains(t, err, "failed to stream block 2: disk full")
+
+	require.NoError(t, l.Close())
+	require.True(t, sink.closed)
+}
+
+func TestListenerAckTimeout(t *testing.T) {
+	sink := &mockSink{blocked: make(chan struct{})}
+	l := NewListener(sink, ListenerOptions{BufferSize: 1, Ack: true, AckTimeout: 10 * time.Millisecond})
+
+	err := streamBlock(l, 1, nil)
+	require.ErrorContains(t, err, "block 1 was not acknowledged")
+
+	close(sink.blocked)
+	require.NoError(t, l.Close())
+	require.Len(t, sink.written(), 1)
+}
+
+func TestListenerSinkBehind(t *testing.T) {
+	sink := &mockSink{blocked: make(chan struct{})}
+	l := NewListener(sink, ListenerOptions{BufferSize: 1})
+
+	// the first block is taken by the background writer, the second one is
+	// buffered and the third one does not fit in the buffer
+	require.NoError(t, streamBlock(l, 1, nil))
+	require.Eventually(t, func() bool { return len(l.queue) == 0 }, time.Second, time.Millisecond)
+	require.NoError(t, streamBlock(l, 2, nil))
+	err := streamBlock(l, 3, nil)
+	require.ErrorContains(t, err, "block 3 was dropped")
+
+	close(sink.blocked)
+	require.NoError(t, l.Close())
+	blocks := sink.written()
+	require.Len(t, blocks, 2)
+	require.Equal(t, int64(2), blocks[1].BlockHeight)
+}
+
+func TestListenerBackgroundError(t *testing.T) {
+	sink := &mockSink{err: errors.New("disk full")}
+	l := NewListener(sink, ListenerOptions{BufferSize: 10})
+
+	require.NoError(t, streamBlock(l, 1, nil))
+	require.Eventually(t, func() bool {
+		l.mtx.Lock()
+		defer l.mtx.Unlock()
+		return l.err != nil
+	}, time.Second, time.Millisecond)
+
+	// the failure of the background write is reported by the next block
+	err := streamBlock(l, 2, nil)
+	require.ErrorContains(t, err, "failed to stream block 1: disk full")
+
+	sink.mtx.Lock()
+	sink.err = nil
+	sink.mtx.Unlock()
+	require.NoError(t, streamBlock(l, 3, nil))
+	require.NoError(t, l.Close())
+}
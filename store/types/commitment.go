@@ -0,0 +1,79 @@
+package types
+
+import (
+	ics23 "github.com/confio/ics23/go"
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+// CommitmentBackend is a Merkleized commitment structure, an alternative to IAVL, backing the
+// stores of a StoreType. Once registered in a CommitMultiStore with RegisterCommitmentBackend,
+// stores of this type can be mounted alongside the IAVL stores: their commit IDs are included
+// in the CommitInfo of the multi-store, and their proofs are chained to the multi-store proof.
+//
+// The backend separates the state commitment from its storage, the database of a store being
+// provided by the multi-store.
+type CommitmentBackend interface {
+	// LoadStore loads the store of the key at the given commit ID from its database. The
+	// store of a key added or migrated by StoreUpgrades is loaded at an empty commit ID, and
+	// starts at the provided initial version, which is 0 otherwise.
+	LoadStore(db dbm.DB, key StoreKey, id CommitID, initialVersion uint64) (CommitmentStore, error)
+
+	// ProofOpType returns the type of the ProofOps of the commitment proofs of the stores,
+	// returned by their queries. It must differ from the built-in ProofOpIAVLCommitment and
+	// ProofOpSimpleMerkleCommitment types.
+	ProofOpType() string
+
+	// ProofSpec returns the ICS23 proof spec of the commitment proofs of the stores.
+	ProofSpec() *ics23.ProofSpec
+}
+
+// CommitmentStore is a store of a CommitmentBackend.
+//
+// The store answers the queries of the "/key" path with an ICS23 CommitmentOp of the type
+// and the spec of the backend when a proof is requested, like the IAVL stores.
+type CommitmentStore interface {
+	CommitKVStore
+	Queryable
+	StoreWithInitialVersion
+
+	// GetImmutable returns a read-only store at a previously committed version, or an
+	// error if the version does not exist or was pruned.
+	GetImmutable(version int64) (KVStore, error)
+
+	// DeleteVersions deletes the given committed versions, when they are pruned.
+	DeleteVersions(versions ...int64) error
+
+	// LoadVersionForOverwriting loads the given committed version, the later versions
+	// being deleted, when the multi-store is rolled back.
+	LoadVersionForOverwriting(version int64) error
+
+	// Export returns an iterator over the key/value pairs of the store at a committed
+	// version, in ascending key order, which are written to the state sync snapshots.
+	Export(version int64) (Iterator, error)
+
+	// Import returns an importer restoring the key/value pairs of a state sync snapshot
+	// into the empty store, at the given version.
+	Import(version int64) (CommitmentImporter, error)
+}
+
+// CommitmentImporter imports the key/value pairs of a store of a CommitmentBackend from a
+// state sync snapshot, in the order they were exported.
+type CommitmentImporter interface {
+	// Add adds a key/value pair to the store.
+	Add(key, value []byte) error
+
+	// Commit commits the added key/value pairs at the version of the import.
+	Commit() error
+
+	// Close releases the resources of the importer, discarding the key/value pairs if
+	// they were not committed.
+	Close() error
+}
+
+// StoreMigration defines the migration of a sub-store to another store type, e.g. to a
+// store of a CommitmentBackend. All data previously in the store of the old type is moved
+// to the store of the type it is now mounted with, under the same name.
+type StoreMigration struct {
+	Key     string    `json:"key"`
+	OldType StoreType `json:"old_type"`
+}
@@ -7,6 +7,7 @@ import (
 
 // Gas consumption descriptors.
 const (
+	GasIterSeekCostFlatDesc = "IterSeekFlat"
 	GasIterNextCostFlatDesc = "IterNextFlat"
 	GasValuePerByteDesc     = "ValuePerByte"
 	GasWritePerByteDesc     = "WritePerByte"
@@ -37,6 +38,12 @@ type ErrorGasOverflow struct {
 	Descriptor string
 }
 
+// ErrorIterBytesLimit defines an error thrown when the bytes read through the
+// iterators of the stores exceed the limit of their IterBytesMeter.
+type ErrorIterBytesLimit struct {
+	Limit uint64
+}
+
 // GasMeter interface to track gas consumption
 type GasMeter interface {
 	GasConsumed() Gas
@@ -225,6 +232,7 @@ type GasConfig struct {
 	ReadCostPerByte  Gas
 	WriteCostFlat    Gas
 	WriteCostPerByte Gas
+	IterSeekCostFlat Gas
 	IterNextCostFlat Gas
 }
 
@@ -237,6 +245,7 @@ func KVGasConfig() GasConfig {
 		ReadCostPerByte:  3,
 		WriteCostFlat:    2000,
 		WriteCostPerByte: 30,
+		IterSeekCostFlat: 30,
 		IterNextCostFlat: 30,
 	}
 }
@@ -250,6 +259,39 @@ func TransientGasConfig() GasConfig {
 		ReadCostPerByte:  0,
 		WriteCostFlat:    200,
 		WriteCostPerByte: 3,
+		IterSeekCostFlat: 3,
 		IterNextCostFlat: 3,
 	}
 }
+
+// IterBytesMeter tracks the bytes of the keys and values read through the
+// iterators of the stores sharing it, e.g. the stores of a transaction, and
+// panics with ErrorIterBytesLimit once they exceed its limit.
+type IterBytesMeter struct {
+	limit    uint64
+	consumed uint64
+}
+
+// NewIterBytesMeter returns a reference to a new IterBytesMeter.
+func NewIterBytesMeter(limit uint64) *IterBytesMeter {
+	return &IterBytesMeter{limit: limit}
+}
+
+// Limit returns the limit of the meter.
+func (m *IterBytesMeter) Limit() uint64 {
+	return m.limit
+}
+
+// Consumed returns the bytes read.
+func (m *IterBytesMeter) Consumed() uint64 {
+	return m.consumed
+}
+
+// Consume records bytes read through an iterator.
+func (m *IterBytesMeter) Consume(n uint64) {
+	var overflow bool
+	m.consumed, overflow = addUint64Overflow(m.consumed, n)
+	if overflow || m.consumed > m.limit {
+		panic(ErrorIterBytesLimit{Limit: m.limit})
+	}
+}
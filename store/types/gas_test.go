@@ -118,6 +118,7 @@ func TestTransientGasConfig(t *testing.T) {
 		ReadCostPerByte:  0,
 		WriteCostFlat:    200,
 		WriteCostPerByte: 3,
+		IterSeekCostFlat: 3,
 		IterNextCostFlat: 3,
 	})
 }
@@ -235,10 +235,73 @@ func (m *BlockMetadata_DeliverTx) GetResponse() *types.ResponseDeliverTx {
 	return nil
 }
 
+// StreamedBlock contains the abci messages and the state changes of a block, it is
+// the record written by the built-in streaming listeners.
+type StreamedBlock struct {
+	BlockHeight int64          `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Metadata    *BlockMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ChangeSet   []*StoreKVPair `protobuf:"bytes,3,rep,name=change_set,json=changeSet,proto3" json:"change_set,omitempty"`
+}
+
+func (m *StreamedBlock) Reset()         { *m = StreamedBlock{} }
+func (m *StreamedBlock) String() string { return proto.CompactTextString(m) }
+func (*StreamedBlock) ProtoMessage()    {}
+func (*StreamedBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6caeb9d7b7c7c10, []int{2}
+}
+func (m *StreamedBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamedBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamedBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamedBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamedBlock.Merge(m, src)
+}
+func (m *StreamedBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamedBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamedBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamedBlock proto.InternalMessageInfo
+
+func (m *StreamedBlock) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *StreamedBlock) GetMetadata() *BlockMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *StreamedBlock) GetChangeSet() []*StoreKVPair {
+	if m != nil {
+		return m.ChangeSet
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*StoreKVPair)(nil), "cosmos.store.v1beta1.StoreKVPair")
 	proto.RegisterType((*BlockMetadata)(nil), "cosmos.store.v1beta1.BlockMetadata")
 	proto.RegisterType((*BlockMetadata_DeliverTx)(nil), "cosmos.store.v1beta1.BlockMetadata.DeliverTx")
+	proto.RegisterType((*StreamedBlock)(nil), "cosmos.store.v1beta1.StreamedBlock")
 }
 
 func init() {
@@ -246,36 +309,40 @@ func init() {
 }
 
 var fileDescriptor_b6caeb9d7b7c7c10 = []byte{
-	// 458 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0x9b, 0xb5, 0x2b, 0xed, 0x5b, 0x60, 0xc3, 0x54, 0x28, 0xda, 0xa4, 0x90, 0x15, 0x0e,
-	0xbd, 0xe0, 0x68, 0xe5, 0x88, 0xc4, 0xa1, 0x80, 0x84, 0x34, 0xfe, 0x29, 0xfc, 0x39, 0x70, 0x89,
-	0x92, 0xe6, 0xd5, 0x64, 0x9a, 0xda, 0xc5, 0xf6, 0xaa, 0xf5, 0x1b, 0x70, 0xe4, 0x63, 0x71, 0xdc,
-	0x91, 0x23, 0x6a, 0xbf, 0x07, 0x42, 0xb1, 0xd3, 0x74, 0x19, 0xcd, 0xcd, 0x7e, 0xf2, 0x3c, 0xbf,
-	0x3c, 0xaf, 0x2d, 0xc3, 0xe3, 0x89, 0x50, 0x33, 0xa1, 0x02, 0xa5, 0x85, 0xc4, 0x60, 0x71, 0x9a,
-	0xa0, 0x8e, 0x4f, 0x83, 0x8c, 0x29, 0x8d, 0x9c, 0xf1, 0x73, 0x3a, 0x97, 0x42, 0x0b, 0xd2, 0xb7,
-	0x2e, 0x6a, 0x5c, 0xb4, 0x70, 0x1d, 0x1d, 0x6b, 0xe4, 0x29, 0xca, 0x19, 0xe3, 0x3a, 0x88, 0x93,
-	0x09, 0x0b, 0xf4, 0x72, 0x8e, 0xca, 0x46, 0x06, 0xdf, 0xa0, 0xf7, 0x31, 0x77, 0x9f, 0x7d, 0xf9,
-	0x10, 0x33, 0x49, 0x8e, 0xa1, 0x6b, 0xc2, 0xd1, 0x14, 0x97, 0xae, 0xe3, 0x3b, 0xc3, 0x6e, 0xd8,
-	0x31, 0xc2, 0x19, 0x2e, 0xc9, 0x03, 0x68, 0xa7, 0x98, 0xa1, 0x46, 0x77, 0xcf, 0x77, 0x86, 0x9d,
-	0xb0, 0xd8, 0x91, 0x43, 0x68, 0xe6, 0xf6, 0xa6, 0xef, 0x0c, 0x6f, 0x87, 0xf9, 0x92, 0xf4, 0x61,
-	0x7f, 0x11, 0x67, 0x17, 0xe8, 0xb6, 0x8c, 0x66, 0x37, 0x83, 0xbf, 0x2d, 0xb8, 0x33, 0xce, 0xc4,
-	0x64, 0xfa, 0x16, 0x75, 0x9c, 0xc6, 0x3a, 0x26, 0x21, 0xdc, 0x97, 0xf8, 0xfd, 0x02, 0x95, 0x8e,
-	0x12, 0x3c, 0x67, 0x3c, 0x4a, 0xf2, 0xcf, 0xe6, 0xc7, 0xbd, 0xd1, 0x80, 0x6e, 0x8b, 0xd3, 0xbc,
-	0x38, 0x0d, 0xad, 0x77, 0x9c, 0x5b, 0x0d, 0x28, 0xbc, 0x27, 0x6f, 0x4a, 0xe4, 0x33, 0xf4, 0x25,
-	0xaa, 0xb9, 0xe0, 0x0a, 0x2b, 0xd0, 0x3d, 0x03, 0x7d, 0xb4, 0x03, 0x6a, 0xcd, 0xd7, 0xa8, 0x44,
-	0xfe, 0xa7, 0x91, 0x77, 0xd0, 0x4b, 0x31, 0x63, 0x0b, 0x94, 0x91, 0xbe, 0x54, 0x6e, 0xd3, 0x6f,
-	0x0e, 0x7b, 0xa3, 0x27, 0x74, 0xd7, 0x89, 0xd3, 0xca, 0x90, 0xf4, 0xa5, 0x8d, 0x7d, 0xba, 0x0c,
-	0x21, 0xdd, 0x2c, 0x15, 0x79, 0x03, 0x9b, 0xee, 0x11, 0xf2, 0xb4, 0xe8, 0xd8, 0x32, 0x1d, 0xfd,
-	0xba, 0xc1, 0x5f, 0xf1, 0xd4, 0x16, 0x3c, 0x90, 0x55, 0x81, 0xbc, 0x87, 0xb2, 0xf3, 0x35, 0xdc,
-	0xbe, 0xc1, 0x9d, 0xd4, 0x8e, 0x5c, 0xf2, 0x0e, 0xe5, 0x0d, 0x85, 0xbc, 0x86, 0x83, 0x12, 0x38,
-	0x11, 0xb3, 0x19, 0xd3, 0x6e, 0xdb, 0xd0, 0x1e, 0xd6, 0xd2, 0x5e, 0x18, 0x5b, 0x78, 0x57, 0x56,
-	0xf6, 0x47, 0x3f, 0x1c, 0xe8, 0x96, 0x47, 0x40, 0x9e, 0xc1, 0xad, 0xa2, 0x7b, 0x71, 0xcb, 0x27,
-	0x75, 0xc3, 0x6e, 0x8f, 0x6d, 0x93, 0x20, 0xcf, 0xa1, 0xb3, 0x81, 0x17, 0xd7, 0x39, 0xa8, 0x6d,
-	0xb3, 0x8d, 0x97, 0x99, 0xf1, 0xe8, 0xd7, 0xca, 0x73, 0xae, 0x56, 0x9e, 0xf3, 0x67, 0xe5, 0x39,
-	0x3f, 0xd7, 0x5e, 0xe3, 0x6a, 0xed, 0x35, 0x7e, 0xaf, 0xbd, 0xc6, 0x57, 0xd7, 0xde, 0xa3, 0x4a,
-	0xa7, 0x94, 0x89, 0xe2, 0x95, 0x99, 0x67, 0x92, 0xb4, 0xcd, 0x3b, 0x79, 0xfa, 0x2f, 0x00, 0x00,
-	0xff, 0xff, 0x2b, 0x2f, 0x77, 0x06, 0x82, 0x03, 0x00, 0x00,
+	// 524 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xcf, 0x6f, 0x12, 0x41,
+	0x14, 0xee, 0x00, 0x45, 0x78, 0xb4, 0xb6, 0x8e, 0xc4, 0x6c, 0xda, 0x64, 0x05, 0xf4, 0xc0, 0xc5,
+	0x21, 0xc5, 0xa3, 0x89, 0x1a, 0xd4, 0xa4, 0x49, 0xfd, 0x95, 0x41, 0x3d, 0x78, 0xd9, 0x2c, 0xec,
+	0x0b, 0x8c, 0xb0, 0xbb, 0x38, 0x33, 0x25, 0xe5, 0x3f, 0xf0, 0xe8, 0x3f, 0xe3, 0xff, 0xe0, 0xb1,
+	0x47, 0x8f, 0x06, 0xfe, 0x0f, 0x63, 0x76, 0x66, 0x59, 0xa0, 0x42, 0xbc, 0xcd, 0x7c, 0xf3, 0x7d,
+	0xdf, 0x7e, 0xef, 0xcd, 0xbc, 0x85, 0x87, 0xfd, 0x58, 0x85, 0xb1, 0x6a, 0x29, 0x1d, 0x4b, 0x6c,
+	0x4d, 0xcf, 0x7a, 0xa8, 0xfd, 0xb3, 0xd6, 0x58, 0x28, 0x8d, 0x91, 0x88, 0x06, 0x6c, 0x22, 0x63,
+	0x1d, 0xd3, 0xaa, 0x65, 0x31, 0xc3, 0x62, 0x29, 0xeb, 0xe4, 0x54, 0x63, 0x14, 0xa0, 0x0c, 0x45,
+	0xa4, 0x5b, 0x7e, 0xaf, 0x2f, 0x5a, 0x7a, 0x36, 0x41, 0x65, 0x25, 0x8d, 0x2f, 0x50, 0xe9, 0x26,
+	0xec, 0x8b, 0x4f, 0xef, 0x7d, 0x21, 0xe9, 0x29, 0x94, 0x8d, 0xd8, 0x1b, 0xe1, 0xcc, 0x21, 0x35,
+	0xd2, 0x2c, 0xf3, 0x92, 0x01, 0x2e, 0x70, 0x46, 0xef, 0x41, 0x31, 0xc0, 0x31, 0x6a, 0x74, 0x72,
+	0x35, 0xd2, 0x2c, 0xf1, 0x74, 0x47, 0x8f, 0x21, 0x9f, 0xd0, 0xf3, 0x35, 0xd2, 0x3c, 0xe0, 0xc9,
+	0x92, 0x56, 0x61, 0x7f, 0xea, 0x8f, 0x2f, 0xd1, 0x29, 0x18, 0xcc, 0x6e, 0x1a, 0x7f, 0x0a, 0x70,
+	0xd8, 0x19, 0xc7, 0xfd, 0xd1, 0x1b, 0xd4, 0x7e, 0xe0, 0x6b, 0x9f, 0x72, 0xb8, 0x2b, 0xf1, 0xeb,
+	0x25, 0x2a, 0xed, 0xf5, 0x70, 0x20, 0x22, 0xaf, 0x97, 0x1c, 0x9b, 0x0f, 0x57, 0xda, 0x0d, 0xb6,
+	0x0a, 0xce, 0x92, 0xe0, 0x8c, 0x5b, 0x6e, 0x27, 0xa1, 0x1a, 0x23, 0x7e, 0x47, 0xde, 0x84, 0xe8,
+	0x47, 0xa8, 0x4a, 0x54, 0x93, 0x38, 0x52, 0xb8, 0x61, 0x9a, 0x33, 0xa6, 0x0f, 0xb6, 0x98, 0x5a,
+	0xf2, 0x9a, 0x2b, 0x95, 0xff, 0x60, 0xf4, 0x2d, 0x54, 0x02, 0x1c, 0x8b, 0x29, 0x4a, 0x4f, 0x5f,
+	0x29, 0x27, 0x5f, 0xcb, 0x37, 0x2b, 0xed, 0x47, 0x6c, 0x5b, 0xc7, 0xd9, 0x46, 0x91, 0xec, 0xa5,
+	0x95, 0x7d, 0xb8, 0xe2, 0x10, 0x2c, 0x97, 0x8a, 0xbe, 0x86, 0x65, 0x76, 0x0f, 0xa3, 0x20, 0xcd,
+	0x58, 0x30, 0x19, 0x6b, 0xbb, 0x0a, 0x7f, 0x15, 0x05, 0x36, 0xe0, 0x91, 0xdc, 0x04, 0xe8, 0x3b,
+	0xc8, 0x32, 0xaf, 0xd9, 0xed, 0x1b, 0xbb, 0xfa, 0xce, 0x92, 0x33, 0xbf, 0x63, 0x79, 0x03, 0xa1,
+	0xe7, 0x70, 0x94, 0x19, 0xf6, 0xe3, 0x30, 0x14, 0xda, 0x29, 0x1a, 0xb7, 0xfb, 0x3b, 0xdd, 0x5e,
+	0x18, 0x1a, 0xbf, 0x2d, 0x37, 0xf6, 0x27, 0xdf, 0x08, 0x94, 0xb3, 0x16, 0xd0, 0x27, 0x70, 0x2b,
+	0xcd, 0xee, 0x90, 0x9d, 0xe9, 0xcc, 0xf9, 0xaa, 0x6d, 0x4b, 0x05, 0x7d, 0x0a, 0xa5, 0xa5, 0xb9,
+	0x93, 0xdb, 0xf9, 0x46, 0x2c, 0x61, 0x25, 0xcf, 0x34, 0x8d, 0x1f, 0x04, 0x0e, 0xbb, 0x5a, 0xa2,
+	0x1f, 0x62, 0x5a, 0x66, 0x1d, 0x0e, 0x4c, 0xab, 0xbc, 0x21, 0x8a, 0xc1, 0xd0, 0x66, 0xca, 0xf3,
+	0x8a, 0xc1, 0xce, 0x0d, 0x44, 0x9f, 0x41, 0x29, 0x4c, 0xaf, 0x32, 0x7b, 0x43, 0xff, 0xbf, 0x75,
+	0x9e, 0x89, 0xe8, 0x73, 0x80, 0xfe, 0xd0, 0x8f, 0x06, 0xe8, 0x29, 0xd4, 0xe9, 0xc3, 0xa9, 0x6f,
+	0xb7, 0x58, 0x1b, 0x45, 0x5e, 0xb6, 0xa2, 0x2e, 0xea, 0x4e, 0xfb, 0xe7, 0xdc, 0x25, 0xd7, 0x73,
+	0x97, 0xfc, 0x9e, 0xbb, 0xe4, 0xfb, 0xc2, 0xdd, 0xbb, 0x5e, 0xb8, 0x7b, 0xbf, 0x16, 0xee, 0xde,
+	0x67, 0xc7, 0xda, 0xa8, 0x60, 0xc4, 0x44, 0x9c, 0xfe, 0x1d, 0xcc, 0x78, 0xf7, 0x8a, 0x66, 0xbe,
+	0x1f, 0xff, 0x1d, 0x00, 0xf8, 0x94, 0x86, 0xb1, 0x3a, 0x04, 0x00, 0x00,
 }
 
 func (m *StoreKVPair) Marshal() (dAtA []byte, err error) {
@@ -476,6 +543,60 @@ func (m *BlockMetadata_DeliverTx) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *StreamedBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamedBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamedBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChangeSet) > 0 {
+		for iNdEx := len(m.ChangeSet) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChangeSet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintListening(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintListening(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintListening(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintListening(dAtA []byte, offset int, v uint64) int {
 	offset -= sovListening(v)
 	base := offset
@@ -563,6 +684,28 @@ func (m *BlockMetadata_DeliverTx) Size() (n int) {
 	return n
 }
 
+func (m *StreamedBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovListening(uint64(m.BlockHeight))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovListening(uint64(l))
+	}
+	if len(m.ChangeSet) > 0 {
+		for _, e := range m.ChangeSet {
+			l = e.Size()
+			n += 1 + l + sovListening(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovListening(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1125,6 +1268,145 @@ func (m *BlockMetadata_DeliverTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *StreamedBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowListening
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamedBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamedBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowListening
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowListening
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthListening
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthListening
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &BlockMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowListening
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthListening
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthListening
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangeSet = append(m.ChangeSet, &StoreKVPair{})
+			if err := m.ChangeSet[len(m.ChangeSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipListening(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthListening
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipListening(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -91,6 +91,28 @@ func CommitmentOpDecoder(pop cmtprotocrypto.ProofOp) (merkle.ProofOperator, erro
 	return op, nil
 }
 
+// NewCommitmentOpDecoder returns a decoder of the ProofOps of the given type into CommitmentOps with
+// the given spec, e.g. for the proofs of the stores of a CommitmentBackend.
+func NewCommitmentOpDecoder(opType string, spec *ics23.ProofSpec) merkle.OpDecoder {
+	return func(pop cmtprotocrypto.ProofOp) (merkle.ProofOperator, error) {
+		if pop.Type != opType {
+			return nil, errorsmod.Wrapf(ErrInvalidProof, "unexpected ProofOp.Type; got %s, want %s", pop.Type, opType)
+		}
+
+		proof := &ics23.CommitmentProof{}
+		if err := proof.Unmarshal(pop.Data); err != nil {
+			return nil, err
+		}
+
+		return CommitmentOp{
+			Type:  pop.Type,
+			Key:   pop.Key,
+			Spec:  spec,
+			Proof: proof,
+		}, nil
+	}
+}
+
 func (op CommitmentOp) GetKey() []byte {
 	return op.Key
 }
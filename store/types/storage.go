@@ -0,0 +1,43 @@
+package types
+
+// StateStorage defines a flat, versioned key/value storage of the state of the
+// multi-store. It keeps the values of the persistent stores at every committed
+// version, so that the state at past heights can be queried without keeping
+// the corresponding versions of the commitment structure (e.g. IAVL).
+type StateStorage interface {
+	// GetLatestVersion returns the latest version applied, or 0 if the storage
+	// is empty.
+	GetLatestVersion() (uint64, error)
+
+	// GetEarliestVersion returns the earliest version which can be queried, or 0
+	// if the storage is empty.
+	GetEarliestVersion() (uint64, error)
+
+	// Has returns true if the key exists in the store at the provided version.
+	Has(storeKey string, version uint64, key []byte) (bool, error)
+
+	// Get returns the value of the key in the store at the provided version, or
+	// nil if it does not exist.
+	Get(storeKey string, version uint64, key []byte) ([]byte, error)
+
+	// Iterator returns an iterator over the domain [start, end) of the store at
+	// the provided version, in ascending order of the keys.
+	Iterator(storeKey string, version uint64, start, end []byte) (Iterator, error)
+
+	// ReverseIterator returns an iterator over the domain [start, end) of the
+	// store at the provided version, in descending order of the keys.
+	ReverseIterator(storeKey string, version uint64, start, end []byte) (Iterator, error)
+
+	// ApplyChangeset writes the changes of the provided version, which must not
+	// be lower than the latest version. Applying the latest version again merges
+	// the changes into it.
+	ApplyChangeset(version uint64, changeset []*StoreKVPair) error
+
+	// Prune removes the values which are not needed to query the versions
+	// greater than the provided one.
+	Prune(version uint64) error
+
+	// Truncate removes the values written after the provided version, which
+	// becomes the latest version. Truncating to version 0 empties the storage.
+	Truncate(version uint64) error
+}
@@ -45,9 +45,10 @@ type Queryable interface {
 
 // StoreUpgrades defines a series of transformations to apply the multistore db upon load
 type StoreUpgrades struct {
-	Added   []string      `json:"added"`
-	Renamed []StoreRename `json:"renamed"`
-	Deleted []string      `json:"deleted"`
+	Added    []string         `json:"added"`
+	Renamed  []StoreRename    `json:"renamed"`
+	Deleted  []string         `json:"deleted"`
+	Migrated []StoreMigration `json:"migrated"`
 }
 
 // StoreRename defines a name change of a sub-store.
@@ -98,6 +99,20 @@ func (s *StoreUpgrades) RenamedFrom(key string) string {
 	return ""
 }
 
+// MigratedFrom returns the old store type if the given key was migrated
+// to another store type, and false otherwise.
+func (s *StoreUpgrades) MigratedFrom(key string) (StoreType, bool) {
+	if s == nil {
+		return 0, false
+	}
+	for _, m := range s.Migrated {
+		if m.Key == key {
+			return m.OldType, true
+		}
+	}
+	return 0, false
+}
+
 type MultiStore interface {
 	Store
 
@@ -191,6 +206,22 @@ type CommitMultiStore interface {
 	// SetIAVLLazyLoading enable/disable lazy loading on iavl.
 	SetLazyLoading(lazyLoading bool)
 
+	// SetPruningConcurrency sets the number of stores pruned concurrently in
+	// the background, pruning is done in Commit if it is 0.
+	SetPruningConcurrency(concurrency int)
+
+	// SetWriteAheadLog enables the write-ahead log of the commits, an
+	// incomplete commit being completed or rolled back on load.
+	SetWriteAheadLog(enabled bool)
+
+	// RegisterCommitmentBackend registers the commitment backend of the stores
+	// of a StoreType, it must be called before the stores are loaded.
+	RegisterCommitmentBackend(typ StoreType, backend CommitmentBackend)
+
+	// SetStateStorage sets the storage keeping the state of the persistent
+	// stores at every version, used to serve queries at past versions.
+	SetStateStorage(ss StateStorage)
+
 	// RollbackToVersion rollback the db to specific version(height).
 	RollbackToVersion(version int64) error
 
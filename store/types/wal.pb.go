@@ -0,0 +1,368 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/store/v1beta1/wal.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// WALEntry is the write-ahead log entry of a version of the multi-store, it
+// contains the changeset of the version, written before the stores commit it
+// so that the commit can be replayed if the node stops before it is complete.
+//
+// Since: cosmos-sdk 0.50
+type WALEntry struct {
+	Version   int64          `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Changeset []*StoreKVPair `protobuf:"bytes,2,rep,name=changeset,proto3" json:"changeset,omitempty"`
+}
+
+func (m *WALEntry) Reset()         { *m = WALEntry{} }
+func (m *WALEntry) String() string { return proto.CompactTextString(m) }
+func (*WALEntry) ProtoMessage()    {}
+func (*WALEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a127ae27b85d46ba, []int{0}
+}
+func (m *WALEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WALEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WALEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WALEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WALEntry.Merge(m, src)
+}
+func (m *WALEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *WALEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_WALEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WALEntry proto.InternalMessageInfo
+
+func (m *WALEntry) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *WALEntry) GetChangeset() []*StoreKVPair {
+	if m != nil {
+		return m.Changeset
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*WALEntry)(nil), "cosmos.store.v1beta1.WALEntry")
+}
+
+func init() { proto.RegisterFile("cosmos/store/v1beta1/wal.proto", fileDescriptor_a127ae27b85d46ba) }
+
+var fileDescriptor_a127ae27b85d46ba = []byte{
+	// 197 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xce, 0x2f, 0xce,
+	0xcd, 0x2f, 0xd6, 0x2f, 0x2e, 0xc9, 0x2f, 0x4a, 0xd5, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34,
+	0xd4, 0x2f, 0x4f, 0xcc, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x81, 0xc8, 0xeb, 0x81,
+	0xe5, 0xf5, 0xa0, 0xf2, 0x52, 0x2a, 0x58, 0x75, 0xe5, 0x64, 0x16, 0x97, 0xa4, 0xe6, 0x65, 0xe6,
+	0xa5, 0x43, 0xf4, 0x2a, 0xa5, 0x72, 0x71, 0x84, 0x3b, 0xfa, 0xb8, 0xe6, 0x95, 0x14, 0x55, 0x0a,
+	0x49, 0x70, 0xb1, 0x97, 0xa5, 0x16, 0x15, 0x67, 0xe6, 0xe7, 0x49, 0x30, 0x2a, 0x30, 0x6a, 0x30,
+	0x07, 0xc1, 0xb8, 0x42, 0xf6, 0x5c, 0x9c, 0xc9, 0x19, 0x89, 0x79, 0xe9, 0xa9, 0xc5, 0xa9, 0x25,
+	0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46, 0x8a, 0x7a, 0xd8, 0x6c, 0xd5, 0x0b, 0x06, 0xf1, 0xbc,
+	0xc3, 0x02, 0x12, 0x33, 0x8b, 0x82, 0x10, 0x7a, 0x9c, 0x8c, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0,
+	0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8,
+	0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x02, 0x62, 0x4c, 0x71, 0x4a, 0xb6, 0x5e, 0x66, 0x3e, 0xd4, 0xb1,
+	0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0x17, 0x1a, 0x03, 0x06, 0x00, 0xb2, 0x2a, 0x62,
+	0x3b, 0xff, 0x00, 0x00, 0x00,
+}
+
+func (m *WALEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WALEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WALEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changeset) > 0 {
+		for iNdEx := len(m.Changeset) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changeset[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Version != 0 {
+		i = encodeVarintWal(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintWal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovWal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *WALEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovWal(uint64(m.Version))
+	}
+	if len(m.Changeset) > 0 {
+		for _, e := range m.Changeset {
+			l = e.Size()
+			n += 1 + l + sovWal(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovWal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozWal(x uint64) (n int) {
+	return sovWal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *WALEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WALEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WALEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changeset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changeset = append(m.Changeset, &StoreKVPair{})
+			if err := m.Changeset[len(m.Changeset)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipWal(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowWal
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowWal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowWal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthWal
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupWal
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthWal
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthWal        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowWal          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupWal = fmt.Errorf("proto: unexpected end of group")
+)
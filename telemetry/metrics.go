@@ -52,6 +52,10 @@ type Config struct {
 	// Example:
 	// [["chain_id", "cosmoshub-1"]]
 	GlobalLabels [][]string `mapstructure:"global-labels"`
+
+	// StoreGasMetrics enables the store_gas_consumed counter, attributing the
+	// gas consumed by the store operations to the store key and the operation.
+	StoreGasMetrics bool `mapstructure:"store-gas-metrics"`
 }
 
 // Metrics defines a wrapper around application telemetry functionality. It allows
@@ -2579,6 +2579,7 @@ type TestUpdatedTxBody struct {
 	Memo                         string       `protobuf:"bytes,2,opt,name=memo,proto3" json:"memo,omitempty"`
 	TimeoutHeight                int64        `protobuf:"varint,3,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
 	SomeNewField                 uint64       `protobuf:"varint,4,opt,name=some_new_field,json=someNewField,proto3" json:"some_new_field,omitempty"`
+	SomeNewCriticalField         uint64       `protobuf:"varint,5,opt,name=some_new_critical_field,json=someNewCriticalField,proto3" json:"some_new_critical_field,omitempty"`
 	SomeNewFieldNonCriticalField string       `protobuf:"bytes,1050,opt,name=some_new_field_non_critical_field,json=someNewFieldNonCriticalField,proto3" json:"some_new_field_non_critical_field,omitempty"`
 	ExtensionOptions             []*types.Any `protobuf:"bytes,1023,rep,name=extension_options,json=extensionOptions,proto3" json:"extension_options,omitempty"`
 	NonCriticalExtensionOptions  []*types.Any `protobuf:"bytes,2047,rep,name=non_critical_extension_options,json=nonCriticalExtensionOptions,proto3" json:"non_critical_extension_options,omitempty"`
@@ -2645,6 +2646,13 @@ func (m *TestUpdatedTxBody) GetSomeNewField() uint64 {
 	return 0
 }
 
+func (m *TestUpdatedTxBody) GetSomeNewCriticalField() uint64 {
+	if m != nil {
+		return m.SomeNewCriticalField
+	}
+	return 0
+}
+
 func (m *TestUpdatedTxBody) GetSomeNewFieldNonCriticalField() string {
 	if m != nil {
 		return m.SomeNewFieldNonCriticalField
@@ -2818,110 +2826,111 @@ func init() {
 func init() { proto.RegisterFile("testpb/unknonwnproto.proto", fileDescriptor_fe4560133be9209a) }
 
 var fileDescriptor_fe4560133be9209a = []byte{
-	// 1641 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4f, 0x8f, 0x1a, 0xc9,
-	0x15, 0x9f, 0xa2, 0x81, 0x81, 0x37, 0x18, 0xe3, 0xca, 0x68, 0xd3, 0x8b, 0xd7, 0x98, 0xb4, 0x76,
-	0x1d, 0x12, 0xc9, 0x60, 0x1a, 0x56, 0x8a, 0xf6, 0x10, 0x2d, 0xd8, 0x9e, 0x1d, 0x47, 0xce, 0x38,
-	0xea, 0x78, 0x9d, 0x68, 0x2f, 0xa8, 0xe9, 0x2e, 0xa0, 0x35, 0x50, 0x35, 0xe9, 0xaa, 0xf6, 0xc0,
-	0x6d, 0x6f, 0x7b, 0xdd, 0x5b, 0xa4, 0x7c, 0x81, 0x9c, 0xa2, 0xfd, 0x0a, 0xb9, 0xc5, 0xb7, 0x58,
-	0xca, 0x25, 0x27, 0x2b, 0xb2, 0x0f, 0x51, 0x4e, 0x39, 0xe5, 0x9c, 0xa8, 0xaa, 0xff, 0xd0, 0x78,
-	0x60, 0x96, 0x99, 0x4d, 0xe2, 0xb5, 0xb4, 0x17, 0xa8, 0x7a, 0xf5, 0xab, 0x57, 0xef, 0xfd, 0xde,
-	0x9f, 0xee, 0x6a, 0xa8, 0x0a, 0xc2, 0xc5, 0xc9, 0xb0, 0x15, 0xd0, 0x63, 0xca, 0xe8, 0x29, 0x3d,
-	0xf1, 0x99, 0x60, 0x4d, 0xf5, 0x8b, 0xf3, 0xe1, 0x5a, 0x75, 0x7f, 0xcc, 0xc6, 0x4c, 0x89, 0x5a,
-	0x72, 0x14, 0xae, 0x56, 0xdf, 0x1d, 0x33, 0x36, 0x9e, 0x92, 0x96, 0x9a, 0x0d, 0x83, 0x51, 0xcb,
-	0xa6, 0x8b, 0x68, 0xa9, 0xea, 0x30, 0x3e, 0x63, 0xbc, 0x25, 0xe6, 0xad, 0xa7, 0xed, 0x21, 0x11,
-	0x76, 0xbb, 0x25, 0xe6, 0xe1, 0x9a, 0x21, 0xa0, 0x78, 0x37, 0xe0, 0x82, 0xcd, 0x88, 0xdf, 0xc6,
-	0x65, 0xc8, 0x78, 0xae, 0x8e, 0xea, 0xa8, 0x91, 0xb3, 0x32, 0x9e, 0x8b, 0x31, 0x64, 0xa9, 0x3d,
-	0x23, 0x7a, 0xa6, 0x8e, 0x1a, 0x45, 0x4b, 0x8d, 0xf1, 0x8f, 0xa0, 0xc2, 0x83, 0x21, 0x77, 0x7c,
-	0xef, 0x44, 0x78, 0x8c, 0x0e, 0x46, 0x84, 0xe8, 0x5a, 0x1d, 0x35, 0x32, 0xd6, 0xd5, 0xb4, 0xfc,
-	0x80, 0x10, 0xac, 0xc3, 0xee, 0x89, 0xbd, 0x98, 0x11, 0x2a, 0xf4, 0x5d, 0xa5, 0x21, 0x9e, 0x1a,
-	0x5f, 0x65, 0x96, 0xc7, 0x9a, 0x67, 0x8e, 0xad, 0x42, 0xc1, 0xa3, 0x6e, 0xc0, 0x85, 0xbf, 0x50,
-	0x47, 0xe7, 0xac, 0x64, 0x9e, 0x98, 0xa4, 0xa5, 0x4c, 0xda, 0x87, 0xdc, 0x88, 0x9c, 0x12, 0x5f,
-	0xcf, 0x2a, 0x3b, 0xc2, 0x09, 0xbe, 0x0e, 0x05, 0x9f, 0x70, 0xe2, 0x3f, 0x25, 0xae, 0xfe, 0xdb,
-	0x42, 0x1d, 0x35, 0x34, 0x2b, 0x11, 0xe0, 0x1f, 0x43, 0xd6, 0xf1, 0xc4, 0x42, 0xcf, 0xd7, 0x51,
-	0xa3, 0x6c, 0xbe, 0xd3, 0x0c, 0xa9, 0x6d, 0x26, 0x36, 0x35, 0xef, 0x7a, 0x62, 0x61, 0x29, 0x0c,
-	0xfe, 0x08, 0xae, 0xcc, 0x3c, 0xee, 0x90, 0xe9, 0xd4, 0xa6, 0x84, 0x05, 0x5c, 0x87, 0x3a, 0x6a,
-	0xec, 0x99, 0xfb, 0xcd, 0x90, 0xf1, 0x66, 0xcc, 0x78, 0xb3, 0x47, 0x17, 0xd6, 0x2a, 0xd4, 0xf8,
-	0x04, 0xb2, 0x52, 0x13, 0x2e, 0x40, 0xf6, 0xa1, 0xcd, 0x78, 0x65, 0x07, 0x97, 0x01, 0x1e, 0x32,
-	0xde, 0xa3, 0x63, 0x32, 0x25, 0xbc, 0x82, 0x70, 0x09, 0x0a, 0xbf, 0xb0, 0xa7, 0xac, 0x37, 0x15,
-	0xac, 0x92, 0xc1, 0x00, 0xf9, 0x9f, 0x33, 0xee, 0xb0, 0xd3, 0x8a, 0x86, 0xf7, 0x60, 0xf7, 0xc8,
-	0xf6, 0x7c, 0x36, 0xf4, 0x2a, 0x59, 0xa3, 0x09, 0x85, 0x23, 0xc2, 0x05, 0x71, 0xbb, 0xbd, 0x6d,
-	0xc2, 0x64, 0xfc, 0x19, 0xc5, 0x1b, 0x3a, 0x5b, 0x6d, 0xc0, 0x75, 0xc8, 0xd8, 0x5d, 0x3d, 0x5b,
-	0xd7, 0x1a, 0x7b, 0x66, 0x25, 0xe6, 0x23, 0x3e, 0xd2, 0xca, 0xd8, 0x5d, 0xdc, 0x86, 0x9c, 0x47,
-	0x5d, 0x32, 0xd7, 0x73, 0x0a, 0x74, 0x7d, 0x15, 0xd4, 0xe9, 0x35, 0x1f, 0xc8, 0xd5, 0xfb, 0x54,
-	0xf8, 0x0b, 0x2b, 0x44, 0x56, 0x7f, 0x06, 0xb0, 0x14, 0xe2, 0x0a, 0x68, 0xc7, 0x64, 0xa1, 0xec,
-	0xd0, 0x2c, 0x39, 0xc4, 0xb7, 0x20, 0xf7, 0xd4, 0x9e, 0x06, 0xa1, 0x25, 0xeb, 0xce, 0x0d, 0x97,
-	0x3f, 0xca, 0xfc, 0x04, 0x19, 0xbf, 0x8e, 0x1d, 0x32, 0xb7, 0x73, 0xa8, 0x01, 0x79, 0xaa, 0xf0,
-	0x2a, 0x57, 0xce, 0x28, 0xef, 0xf4, 0xac, 0x68, 0xdd, 0xb8, 0x17, 0x6b, 0x6e, 0x9f, 0xd5, 0xbc,
-	0xd4, 0xb2, 0xd6, 0x44, 0x73, 0xa9, 0xe5, 0xe3, 0x24, 0x42, 0xfd, 0x33, 0x5a, 0x2a, 0xa0, 0xd9,
-	0x63, 0x12, 0x25, 0xb3, 0x1c, 0xae, 0xcb, 0x63, 0x63, 0x98, 0x84, 0xec, 0x92, 0x1a, 0x64, 0x10,
-	0x87, 0x9b, 0x82, 0xd8, 0xb7, 0x32, 0xc3, 0xae, 0x31, 0x4d, 0x58, 0x5c, 0x7b, 0x86, 0xac, 0x66,
-	0x79, 0x06, 0xb2, 0xe4, 0xf0, 0x6b, 0x39, 0xec, 0xc7, 0xde, 0xcb, 0x1a, 0xf4, 0x59, 0x20, 0x88,
-	0xaa, 0xc1, 0xa2, 0x15, 0x4e, 0x8c, 0x27, 0x09, 0xb3, 0xfd, 0x0b, 0x33, 0xbb, 0xd4, 0x1d, 0xf9,
-	0xae, 0x25, 0xbe, 0x1b, 0x9f, 0xa7, 0xfa, 0x47, 0x67, 0xab, 0x6c, 0x28, 0x43, 0x86, 0x8f, 0xa2,
-	0x46, 0x95, 0xe1, 0x23, 0xfc, 0x1e, 0x14, 0x79, 0xe0, 0x3b, 0x13, 0xdb, 0x1f, 0x93, 0xa8, 0x6f,
-	0x2c, 0x05, 0xb8, 0x0e, 0x7b, 0x2e, 0xe1, 0xc2, 0xa3, 0xb6, 0xec, 0x65, 0x7a, 0x4e, 0x29, 0x4a,
-	0x8b, 0xf0, 0x2d, 0x28, 0x3b, 0x3e, 0x71, 0x3d, 0x31, 0x70, 0x6c, 0xdf, 0x1d, 0x50, 0x16, 0xb6,
-	0xb8, 0xc3, 0x1d, 0xab, 0x14, 0xca, 0xef, 0xda, 0xbe, 0x7b, 0xc4, 0xf0, 0x0d, 0x28, 0x3a, 0x13,
-	0xf2, 0x9b, 0x80, 0x48, 0x48, 0x21, 0x82, 0x14, 0x42, 0xd1, 0x11, 0xc3, 0xb7, 0xa1, 0xc0, 0x7c,
-	0x6f, 0xec, 0x51, 0x7b, 0xaa, 0x17, 0x15, 0x0d, 0xd7, 0x5e, 0xef, 0x45, 0x6d, 0x2b, 0x81, 0xf4,
-	0x8b, 0x49, 0x47, 0x35, 0x5e, 0x64, 0xa0, 0xf4, 0x98, 0x70, 0xf1, 0x84, 0xf8, 0xdc, 0x63, 0xb4,
-	0x8d, 0x4b, 0x80, 0xe6, 0x51, 0x6d, 0xa1, 0x39, 0x36, 0x00, 0xd9, 0x11, 0xb1, 0xfb, 0xb1, 0xc6,
-	0x34, 0xdc, 0x42, 0xb6, 0xc4, 0x0c, 0xa3, 0xc0, 0x6e, 0xc0, 0x0c, 0x25, 0xc6, 0x89, 0x12, 0x6a,
-	0x03, 0xc6, 0xc1, 0x0d, 0x40, 0x6e, 0xd4, 0x14, 0xd6, 0x62, 0xfa, 0xd9, 0x67, 0x2f, 0x6e, 0xee,
-	0x58, 0xc8, 0xc5, 0x65, 0x40, 0x44, 0xf5, 0xdc, 0xdc, 0xe1, 0x8e, 0x85, 0x08, 0x7e, 0x1f, 0xd0,
-	0x48, 0x11, 0xb7, 0x61, 0xa7, 0x44, 0x8d, 0xa4, 0x0d, 0x63, 0xc5, 0xdd, 0xa6, 0xa6, 0x8b, 0xc6,
-	0x12, 0x33, 0xd1, 0x8b, 0xe7, 0xd9, 0x39, 0xc1, 0x1f, 0x00, 0x3a, 0xd6, 0x4b, 0x1b, 0x58, 0xee,
-	0x67, 0x9f, 0xbf, 0xb8, 0x89, 0x2c, 0x74, 0xdc, 0xcf, 0x81, 0xc6, 0x83, 0x99, 0xf1, 0xaf, 0x55,
-	0x82, 0xcd, 0x8b, 0x11, 0x6c, 0x6e, 0x41, 0xb0, 0xb9, 0x05, 0xc1, 0xa6, 0x24, 0xd8, 0x38, 0x9f,
-	0x60, 0xf3, 0x12, 0xd4, 0x9a, 0x6f, 0x82, 0x5a, 0x7c, 0x1d, 0x8a, 0x94, 0x9c, 0x0e, 0x46, 0x1e,
-	0x99, 0xba, 0xfa, 0xbb, 0x75, 0xd4, 0xc8, 0x5a, 0x05, 0x4a, 0x4e, 0x0f, 0xe4, 0x3c, 0xe6, 0xfd,
-	0x0b, 0x6d, 0x85, 0xf7, 0xce, 0xc5, 0x78, 0xef, 0x6c, 0xc1, 0x7b, 0x67, 0x0b, 0xde, 0x3b, 0x5b,
-	0xf0, 0xde, 0xb9, 0x04, 0xef, 0x9d, 0x37, 0xc2, 0xfb, 0x6d, 0xc0, 0x94, 0xd1, 0x81, 0xe3, 0x7b,
-	0xc2, 0x73, 0xec, 0x69, 0x14, 0x80, 0x2f, 0x54, 0x3f, 0xb2, 0x2a, 0x94, 0xd1, 0xbb, 0xd1, 0xca,
-	0x4a, 0x24, 0xfe, 0x99, 0x81, 0x6a, 0xda, 0xf4, 0x87, 0x8c, 0x92, 0x47, 0x94, 0x3c, 0x1a, 0x3d,
-	0x91, 0x0f, 0xe5, 0xb7, 0x2c, 0x2e, 0x6f, 0x05, 0xe3, 0x7f, 0xcf, 0xc3, 0xf7, 0x5f, 0x67, 0xfc,
-	0x48, 0x3d, 0x75, 0xc6, 0xdf, 0x72, 0xba, 0x5b, 0xcb, 0xb4, 0xbf, 0xb9, 0x0e, 0x93, 0xf2, 0xe4,
-	0x2d, 0xa8, 0x00, 0xfc, 0x53, 0xc8, 0x7b, 0x94, 0x12, 0xbf, 0xad, 0x97, 0x95, 0xea, 0x5b, 0x5f,
-	0xe3, 0x53, 0xf3, 0x81, 0x42, 0x5b, 0xd1, 0xae, 0x64, 0xbf, 0xa9, 0x5f, 0xbd, 0xc0, 0x7e, 0x33,
-	0xda, 0x6f, 0x56, 0x7f, 0x8f, 0x20, 0x1f, 0xaa, 0x4c, 0xbd, 0xdd, 0x68, 0x1b, 0xdf, 0x6e, 0x3e,
-	0x91, 0xaf, 0xe6, 0x94, 0xf8, 0x51, 0xb4, 0xdb, 0xdb, 0x59, 0x1b, 0xfe, 0xa9, 0x1f, 0x2b, 0xdc,
-	0x5f, 0xbd, 0x23, 0x5f, 0xd8, 0x63, 0x61, 0xea, 0xe8, 0x62, 0x7c, 0xb4, 0xba, 0x35, 0x45, 0x47,
-	0xcb, 0x71, 0xf5, 0x0f, 0xb1, 0xa5, 0xe6, 0x19, 0xb8, 0x0e, 0xbb, 0x0e, 0x0b, 0x68, 0x7c, 0x8d,
-	0x2b, 0x5a, 0xf1, 0xf4, 0x72, 0xf6, 0x9a, 0xff, 0x0d, 0x7b, 0xe3, 0x4a, 0xfb, 0xc7, 0x6a, 0xa5,
-	0x75, 0xbf, 0xab, 0xb4, 0x6f, 0x71, 0xa5, 0x75, 0xbf, 0x61, 0xa5, 0x75, 0xff, 0xaf, 0x95, 0xd6,
-	0xfd, 0x46, 0x95, 0xa6, 0x6d, 0xac, 0xb4, 0xaf, 0xfe, 0x47, 0x95, 0xd6, 0xdd, 0xaa, 0xd2, 0xcc,
-	0x73, 0x2b, 0x6d, 0x3f, 0x7d, 0x91, 0xd7, 0xa2, 0x6b, 0x7b, 0x5c, 0x6b, 0x7f, 0x42, 0x50, 0x4e,
-	0x9d, 0x77, 0x70, 0xef, 0x32, 0x97, 0x95, 0x37, 0x7a, 0x75, 0x88, 0x3d, 0xf9, 0x0b, 0x5a, 0x79,
-	0x23, 0x3a, 0xb8, 0xd7, 0xfe, 0x95, 0x27, 0x26, 0xf7, 0xe7, 0xc2, 0xb7, 0x7b, 0x74, 0xf1, 0x66,
-	0xbc, 0x8a, 0x50, 0x3d, 0xba, 0x48, 0x6c, 0xb9, 0xa0, 0x57, 0x8f, 0xa1, 0x94, 0xde, 0x2d, 0xef,
-	0x73, 0xb6, 0x72, 0x63, 0x03, 0x69, 0x71, 0xad, 0xdb, 0xd2, 0xe1, 0xb0, 0xef, 0x69, 0xb2, 0xc3,
-	0x95, 0xc2, 0x0e, 0xa7, 0x66, 0x8e, 0xf1, 0x47, 0x04, 0x15, 0x79, 0xe0, 0xa7, 0x27, 0xae, 0x2d,
-	0x88, 0xfb, 0x78, 0x6e, 0xd9, 0xa7, 0xf8, 0x06, 0xc0, 0x90, 0xb9, 0x8b, 0xc1, 0x70, 0x21, 0x08,
-	0x57, 0x67, 0x94, 0xac, 0xa2, 0x94, 0xf4, 0xa5, 0x00, 0xdf, 0x82, 0xab, 0x76, 0x20, 0x26, 0x03,
-	0x8f, 0x8e, 0x58, 0x84, 0xc9, 0x28, 0xcc, 0x15, 0x29, 0x7e, 0x40, 0x47, 0x2c, 0xc4, 0xd5, 0x00,
-	0xb8, 0x37, 0xa6, 0xb6, 0x08, 0x7c, 0xc2, 0x75, 0xad, 0xae, 0x35, 0x4a, 0x56, 0x4a, 0x82, 0x6b,
-	0xb0, 0x97, 0xdc, 0x33, 0x06, 0x1f, 0xaa, 0xfb, 0x7b, 0xc9, 0x2a, 0xc6, 0x37, 0x8d, 0x0f, 0xf1,
-	0x07, 0x50, 0x5e, 0xae, 0xb7, 0xef, 0x98, 0x5d, 0xfd, 0xf3, 0x82, 0xc2, 0x94, 0x62, 0x8c, 0x14,
-	0x1a, 0x5f, 0x6a, 0x70, 0x6d, 0xc5, 0x85, 0x3e, 0x73, 0x17, 0xf8, 0x0e, 0x14, 0x66, 0x84, 0x73,
-	0x7b, 0xac, 0x3c, 0xd0, 0x36, 0xa6, 0x56, 0x82, 0x92, 0xd5, 0x3c, 0x23, 0x33, 0x16, 0x57, 0xb3,
-	0x1c, 0x4b, 0x13, 0x84, 0x37, 0x23, 0x2c, 0x10, 0x83, 0x09, 0xf1, 0xc6, 0x13, 0x11, 0xf1, 0x78,
-	0x25, 0x92, 0x1e, 0x2a, 0x21, 0x7e, 0x1f, 0xca, 0x9c, 0xcd, 0xc8, 0x60, 0x79, 0x6d, 0xca, 0xaa,
-	0x6b, 0x53, 0x49, 0x4a, 0x8f, 0x22, 0x63, 0xf1, 0x21, 0xfc, 0x60, 0x15, 0x35, 0x58, 0xd3, 0x82,
-	0x7f, 0x17, 0xb6, 0xe0, 0xf7, 0xd2, 0x3b, 0x8f, 0x5e, 0x6f, 0xc7, 0x7d, 0xb8, 0x46, 0xe6, 0x82,
-	0x50, 0x99, 0x23, 0x03, 0xa6, 0x3e, 0xe5, 0x72, 0xfd, 0xdf, 0xbb, 0xe7, 0xb8, 0x59, 0x49, 0xf0,
-	0x8f, 0x42, 0x38, 0xfe, 0x0c, 0x6a, 0x2b, 0xc7, 0xaf, 0x51, 0x78, 0xf5, 0x1c, 0x85, 0xd7, 0x53,
-	0xcf, 0x88, 0xfb, 0xaf, 0xe9, 0x36, 0x9e, 0x21, 0xf8, 0x5e, 0x2a, 0x24, 0xbd, 0x28, 0x2d, 0xf0,
-	0xc7, 0x50, 0x92, 0xf1, 0x27, 0xbe, 0xca, 0x9d, 0x38, 0x30, 0x37, 0x9a, 0xe1, 0xa7, 0xef, 0xa6,
-	0x98, 0x37, 0xa3, 0x4f, 0xdf, 0xcd, 0x5f, 0x2a, 0x98, 0xdc, 0x64, 0xed, 0xf1, 0x64, 0xcc, 0x71,
-	0x63, 0xf9, 0xf5, 0x6b, 0xcf, 0x7c, 0x67, 0xcd, 0xc6, 0x03, 0x42, 0xc2, 0xaf, 0x62, 0x2b, 0xd9,
-	0xd5, 0x51, 0x71, 0x4b, 0x65, 0x57, 0x67, 0xdb, 0xec, 0xfa, 0x61, 0x98, 0x5c, 0x16, 0x39, 0x21,
-	0xd2, 0x95, 0x4f, 0x3d, 0x2a, 0x54, 0xaa, 0xd0, 0x60, 0x16, 0xda, 0x9f, 0xb5, 0xd4, 0xb8, 0x7f,
-	0xf8, 0xec, 0x65, 0x0d, 0x3d, 0x7f, 0x59, 0x43, 0x7f, 0x7b, 0x59, 0x43, 0x5f, 0xbe, 0xaa, 0xed,
-	0x3c, 0x7f, 0x55, 0xdb, 0xf9, 0xeb, 0xab, 0xda, 0xce, 0x67, 0xcd, 0xb1, 0x27, 0x26, 0xc1, 0xb0,
-	0xe9, 0xb0, 0x59, 0x2b, 0xfa, 0xc8, 0x1f, 0xfe, 0xdd, 0xe6, 0xee, 0x71, 0x4b, 0x56, 0x7d, 0x20,
-	0xbc, 0xa9, 0x1a, 0xb8, 0xb6, 0xb0, 0x87, 0x79, 0x45, 0x74, 0xe7, 0x3f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x3a, 0xea, 0x0d, 0xa7, 0x67, 0x18, 0x00, 0x00,
+	// 1651 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4f, 0x8f, 0x1a, 0xc9,
+	0x15, 0x9f, 0xa2, 0x61, 0x06, 0xde, 0x60, 0x8c, 0x2b, 0xa3, 0xdd, 0x5e, 0xbc, 0xc6, 0xa4, 0xb5,
+	0xeb, 0x90, 0x48, 0x06, 0xd3, 0x60, 0x29, 0xda, 0x43, 0xb4, 0x60, 0x7b, 0x76, 0x1c, 0x39, 0xe3,
+	0xa8, 0xe2, 0x75, 0xa2, 0xbd, 0xa0, 0x86, 0x2e, 0xa0, 0x35, 0x50, 0x35, 0xe9, 0xaa, 0xf6, 0xc0,
+	0x6d, 0x6f, 0x7b, 0xcd, 0x2d, 0x52, 0xbe, 0x40, 0x4e, 0xd1, 0x7e, 0x85, 0xdc, 0xd6, 0xb7, 0x58,
+	0xca, 0x25, 0x27, 0x2b, 0xb2, 0x0f, 0x51, 0x4e, 0x39, 0xe5, 0x9c, 0xa8, 0xaa, 0xff, 0x00, 0x63,
+	0x98, 0x65, 0x66, 0x93, 0x8c, 0x2d, 0xe5, 0x32, 0x54, 0xbd, 0xfa, 0xd5, 0xfb, 0xf3, 0xab, 0xf7,
+	0x5e, 0x77, 0xf5, 0x40, 0x49, 0x52, 0x21, 0x8f, 0x7b, 0xf5, 0x80, 0x1d, 0x31, 0xce, 0x4e, 0xd8,
+	0xb1, 0xcf, 0x25, 0xaf, 0xe9, 0xbf, 0x78, 0x3b, 0x5c, 0x2b, 0xed, 0x0d, 0xf9, 0x90, 0x6b, 0x51,
+	0x5d, 0x8d, 0xc2, 0xd5, 0xd2, 0x07, 0x43, 0xce, 0x87, 0x63, 0x5a, 0xd7, 0xb3, 0x5e, 0x30, 0xa8,
+	0x3b, 0x6c, 0x16, 0x2d, 0x95, 0xfa, 0x5c, 0x4c, 0xb8, 0xa8, 0xcb, 0x69, 0xfd, 0x59, 0xa3, 0x47,
+	0xa5, 0xd3, 0xa8, 0xcb, 0x69, 0xb8, 0x66, 0x49, 0xc8, 0xdd, 0x0b, 0x84, 0xe4, 0x13, 0xea, 0x37,
+	0x70, 0x01, 0x52, 0x9e, 0x6b, 0xa2, 0x0a, 0xaa, 0x66, 0x48, 0xca, 0x73, 0x31, 0x86, 0x34, 0x73,
+	0x26, 0xd4, 0x4c, 0x55, 0x50, 0x35, 0x47, 0xf4, 0x18, 0xff, 0x10, 0x8a, 0x22, 0xe8, 0x89, 0xbe,
+	0xef, 0x1d, 0x4b, 0x8f, 0xb3, 0xee, 0x80, 0x52, 0xd3, 0xa8, 0xa0, 0x6a, 0x8a, 0x5c, 0x5d, 0x94,
+	0xef, 0x53, 0x8a, 0x4d, 0xd8, 0x39, 0x76, 0x66, 0x13, 0xca, 0xa4, 0xb9, 0xa3, 0x35, 0xc4, 0x53,
+	0xeb, 0xeb, 0xd4, 0xdc, 0xac, 0xfd, 0x86, 0xd9, 0x12, 0x64, 0x3d, 0xe6, 0x06, 0x42, 0xfa, 0x33,
+	0x6d, 0x3a, 0x43, 0x92, 0x79, 0xe2, 0x92, 0xb1, 0xe0, 0xd2, 0x1e, 0x64, 0x06, 0xf4, 0x84, 0xfa,
+	0x66, 0x5a, 0xfb, 0x11, 0x4e, 0xf0, 0x75, 0xc8, 0xfa, 0x54, 0x50, 0xff, 0x19, 0x75, 0xcd, 0xdf,
+	0x66, 0x2b, 0xa8, 0x6a, 0x90, 0x44, 0x80, 0x7f, 0x04, 0xe9, 0xbe, 0x27, 0x67, 0xe6, 0x76, 0x05,
+	0x55, 0x0b, 0xf6, 0x7b, 0xb5, 0x90, 0xda, 0x5a, 0xe2, 0x53, 0xed, 0x9e, 0x27, 0x67, 0x44, 0x63,
+	0xf0, 0x27, 0x70, 0x65, 0xe2, 0x89, 0x3e, 0x1d, 0x8f, 0x1d, 0x46, 0x79, 0x20, 0x4c, 0xa8, 0xa0,
+	0xea, 0xae, 0xbd, 0x57, 0x0b, 0x19, 0xaf, 0xc5, 0x8c, 0xd7, 0xda, 0x6c, 0x46, 0x96, 0xa1, 0xd6,
+	0x67, 0x90, 0x56, 0x9a, 0x70, 0x16, 0xd2, 0x8f, 0x1c, 0x2e, 0x8a, 0x5b, 0xb8, 0x00, 0xf0, 0x88,
+	0x8b, 0x36, 0x1b, 0xd2, 0x31, 0x15, 0x45, 0x84, 0xf3, 0x90, 0xfd, 0xb9, 0x33, 0xe6, 0xed, 0xb1,
+	0xe4, 0xc5, 0x14, 0x06, 0xd8, 0xfe, 0x19, 0x17, 0x7d, 0x7e, 0x52, 0x34, 0xf0, 0x2e, 0xec, 0x1c,
+	0x3a, 0x9e, 0xcf, 0x7b, 0x5e, 0x31, 0x6d, 0xd5, 0x20, 0x7b, 0x48, 0x85, 0xa4, 0x6e, 0xab, 0xbd,
+	0xc9, 0x31, 0x59, 0x7f, 0x42, 0xf1, 0x86, 0xe6, 0x46, 0x1b, 0x70, 0x05, 0x52, 0x4e, 0xcb, 0x4c,
+	0x57, 0x8c, 0xea, 0xae, 0x5d, 0x8c, 0xf9, 0x88, 0x4d, 0x92, 0x94, 0xd3, 0xc2, 0x0d, 0xc8, 0x78,
+	0xcc, 0xa5, 0x53, 0x33, 0xa3, 0x41, 0xd7, 0x97, 0x41, 0xcd, 0x76, 0xed, 0xa1, 0x5a, 0x7d, 0xc0,
+	0xa4, 0x3f, 0x23, 0x21, 0xb2, 0xf4, 0x53, 0x80, 0xb9, 0x10, 0x17, 0xc1, 0x38, 0xa2, 0x33, 0xed,
+	0x87, 0x41, 0xd4, 0x10, 0xdf, 0x82, 0xcc, 0x33, 0x67, 0x1c, 0x84, 0x9e, 0xac, 0xb2, 0x1b, 0x2e,
+	0x7f, 0x92, 0xfa, 0x31, 0xb2, 0x7e, 0x15, 0x07, 0x64, 0x6f, 0x16, 0x50, 0x15, 0xb6, 0x99, 0xc6,
+	0x9b, 0xc6, 0x2a, 0xe5, 0xcd, 0x36, 0x89, 0xd6, 0xad, 0xfb, 0xb1, 0xe6, 0xc6, 0x9b, 0x9a, 0xe7,
+	0x5a, 0x56, 0xba, 0x68, 0xcf, 0xb5, 0x7c, 0x9a, 0x9c, 0x50, 0xe7, 0x0d, 0x2d, 0x45, 0x30, 0x9c,
+	0x21, 0x8d, 0x92, 0x59, 0x0d, 0x57, 0xe5, 0xb1, 0xd5, 0x4b, 0x8e, 0xec, 0x82, 0x1a, 0xd4, 0x21,
+	0xf6, 0xd6, 0x1d, 0x62, 0x87, 0xa4, 0x7a, 0x2d, 0x6b, 0x9c, 0xb0, 0xb8, 0xd2, 0xc6, 0x80, 0x86,
+	0x36, 0x10, 0x51, 0xc3, 0x6f, 0xe5, 0xb0, 0x13, 0x47, 0xaf, 0x6a, 0xd0, 0xe7, 0x81, 0xa4, 0xba,
+	0x06, 0x73, 0x24, 0x9c, 0x58, 0x4f, 0x13, 0x66, 0x3b, 0xe7, 0x66, 0x76, 0xae, 0x3b, 0x8a, 0xdd,
+	0x48, 0x62, 0xb7, 0xbe, 0x5c, 0xe8, 0x1f, 0xcd, 0x8d, 0xb2, 0xa1, 0x00, 0x29, 0x31, 0x88, 0x1a,
+	0x55, 0x4a, 0x0c, 0xf0, 0x87, 0x90, 0x13, 0x81, 0xdf, 0x1f, 0x39, 0xfe, 0x90, 0x46, 0x7d, 0x63,
+	0x2e, 0xc0, 0x15, 0xd8, 0x75, 0xa9, 0x90, 0x1e, 0x73, 0x54, 0x2f, 0x33, 0x33, 0x5a, 0xd1, 0xa2,
+	0x08, 0xdf, 0x82, 0x42, 0xdf, 0xa7, 0xae, 0x27, 0xbb, 0x7d, 0xc7, 0x77, 0xbb, 0x8c, 0x87, 0x2d,
+	0xee, 0x60, 0x8b, 0xe4, 0x43, 0xf9, 0x3d, 0xc7, 0x77, 0x0f, 0x39, 0xbe, 0x01, 0xb9, 0xfe, 0x88,
+	0xfe, 0x3a, 0xa0, 0x0a, 0x92, 0x8d, 0x20, 0xd9, 0x50, 0x74, 0xc8, 0xf1, 0x6d, 0xc8, 0x72, 0xdf,
+	0x1b, 0x7a, 0xcc, 0x19, 0x9b, 0x39, 0x4d, 0xc3, 0xb5, 0xd3, 0xbd, 0xa8, 0x41, 0x12, 0x48, 0x27,
+	0x97, 0x74, 0x54, 0xeb, 0x65, 0x0a, 0xf2, 0x4f, 0xa8, 0x90, 0x4f, 0xa9, 0x2f, 0x3c, 0xce, 0x1a,
+	0x38, 0x0f, 0x68, 0x1a, 0xd5, 0x16, 0x9a, 0x62, 0x0b, 0x90, 0x13, 0x11, 0xbb, 0x17, 0x6b, 0x5c,
+	0x84, 0x13, 0xe4, 0x28, 0x4c, 0xcf, 0x34, 0xce, 0xc2, 0xf4, 0x14, 0xa6, 0x1f, 0x25, 0xd4, 0x1a,
+	0x4c, 0x1f, 0x57, 0x01, 0xb9, 0x66, 0x66, 0x3d, 0xa6, 0x93, 0x7e, 0xfe, 0xf2, 0xe6, 0x16, 0x41,
+	0x2e, 0x2e, 0x00, 0xa2, 0xba, 0xe7, 0x66, 0x0e, 0xb6, 0x08, 0xa2, 0xf8, 0x23, 0x40, 0x03, 0x4d,
+	0xdc, 0x9a, 0x9d, 0x0a, 0x35, 0x50, 0x3e, 0x0c, 0xcd, 0x6c, 0x84, 0x5a, 0xd5, 0x74, 0xd1, 0x50,
+	0x61, 0x46, 0x66, 0xee, 0x2c, 0x3f, 0x47, 0xf8, 0x63, 0x40, 0x47, 0x66, 0x7e, 0x0d, 0xcb, 0x9d,
+	0xf4, 0x8b, 0x97, 0x37, 0x11, 0x41, 0x47, 0x9d, 0x0c, 0x18, 0x22, 0x98, 0x58, 0xff, 0x5c, 0x26,
+	0xd8, 0x3e, 0x1f, 0xc1, 0xf6, 0x06, 0x04, 0xdb, 0x1b, 0x10, 0x6c, 0x2b, 0x82, 0xad, 0xb3, 0x09,
+	0xb6, 0x2f, 0x40, 0xad, 0x7d, 0x19, 0xd4, 0xe2, 0xeb, 0x90, 0x63, 0xf4, 0xa4, 0x3b, 0xf0, 0xe8,
+	0xd8, 0x35, 0x3f, 0xa8, 0xa0, 0x6a, 0x9a, 0x64, 0x19, 0x3d, 0xd9, 0x57, 0xf3, 0x98, 0xf7, 0xaf,
+	0x8c, 0x25, 0xde, 0x9b, 0xe7, 0xe3, 0xbd, 0xb9, 0x01, 0xef, 0xcd, 0x0d, 0x78, 0x6f, 0x6e, 0xc0,
+	0x7b, 0xf3, 0x02, 0xbc, 0x37, 0x2f, 0x85, 0xf7, 0xdb, 0x80, 0x19, 0x67, 0xdd, 0xbe, 0xef, 0x49,
+	0xaf, 0xef, 0x8c, 0xa3, 0x03, 0xf8, 0x4a, 0xf7, 0x23, 0x52, 0x64, 0x9c, 0xdd, 0x8b, 0x56, 0x96,
+	0x4e, 0xe2, 0x1f, 0x29, 0x28, 0x2d, 0xba, 0xfe, 0x88, 0x33, 0xfa, 0x98, 0xd1, 0xc7, 0x83, 0xa7,
+	0xea, 0xa1, 0xfc, 0x8e, 0x9d, 0xcb, 0x3b, 0xc1, 0xf8, 0xdf, 0xb6, 0xe1, 0xfd, 0xd3, 0x8c, 0x1f,
+	0xea, 0xa7, 0xce, 0xf0, 0x2d, 0xa7, 0xbb, 0x3e, 0x4f, 0xfb, 0x9b, 0xab, 0x30, 0x0b, 0x91, 0xbc,
+	0x03, 0x15, 0x80, 0x7f, 0x02, 0xdb, 0x1e, 0x63, 0xd4, 0x6f, 0x98, 0x05, 0xad, 0xfa, 0xd6, 0xb7,
+	0xc4, 0x54, 0x7b, 0xa8, 0xd1, 0x24, 0xda, 0x95, 0xec, 0xb7, 0xcd, 0xab, 0xe7, 0xd8, 0x6f, 0x47,
+	0xfb, 0xed, 0xd2, 0xef, 0x11, 0x6c, 0x87, 0x2a, 0x17, 0xde, 0x6e, 0x8c, 0xb5, 0x6f, 0x37, 0x9f,
+	0xa9, 0x57, 0x73, 0x46, 0xfd, 0xe8, 0xb4, 0x1b, 0x9b, 0x79, 0x1b, 0xfe, 0xe8, 0x3f, 0x24, 0xdc,
+	0x5f, 0xba, 0x03, 0x30, 0x17, 0x2e, 0x98, 0xce, 0xc5, 0xa6, 0xf5, 0xad, 0x29, 0x32, 0xad, 0xc6,
+	0xa5, 0x3f, 0xc4, 0x9e, 0xda, 0x6f, 0xc0, 0x4d, 0xd8, 0xe9, 0xf3, 0x80, 0xc5, 0xd7, 0xb8, 0x1c,
+	0x89, 0xa7, 0x17, 0xf3, 0xd7, 0xfe, 0x4f, 0xf8, 0x1b, 0x57, 0xda, 0xdf, 0x97, 0x2b, 0xad, 0xf5,
+	0xff, 0x4a, 0x7b, 0x8b, 0x2b, 0xad, 0xf5, 0x1d, 0x2b, 0xad, 0xf5, 0x3f, 0xad, 0xb4, 0xd6, 0x77,
+	0xaa, 0x34, 0x63, 0x6d, 0xa5, 0x7d, 0xfd, 0x5f, 0xaa, 0xb4, 0xd6, 0x46, 0x95, 0x66, 0x9f, 0x59,
+	0x69, 0x7b, 0x8b, 0x17, 0x79, 0x23, 0xba, 0xb6, 0xc7, 0xb5, 0xf6, 0x0d, 0x82, 0xc2, 0x82, 0xbd,
+	0xfd, 0xfb, 0x17, 0xb9, 0xac, 0x5c, 0xea, 0xd5, 0x21, 0x8e, 0xe4, 0xcf, 0x68, 0xe9, 0x8d, 0x68,
+	0xff, 0x7e, 0xe3, 0x97, 0x9e, 0x1c, 0x3d, 0x98, 0x4a, 0xdf, 0x69, 0xb3, 0xd9, 0xe5, 0x44, 0x15,
+	0xa1, 0xda, 0x6c, 0x96, 0xf8, 0x72, 0xce, 0xa8, 0x9e, 0x40, 0x7e, 0x71, 0xb7, 0xba, 0xcf, 0x39,
+	0x3a, 0x8c, 0x35, 0xa4, 0xc5, 0xb5, 0xee, 0xe0, 0x7c, 0xdc, 0xf7, 0x0c, 0xd5, 0xe1, 0xf2, 0x61,
+	0x87, 0xd3, 0xb3, 0xbe, 0xf5, 0x47, 0x04, 0x45, 0x65, 0xf0, 0xf3, 0x63, 0xd7, 0x91, 0xd4, 0x7d,
+	0x32, 0x25, 0xce, 0x09, 0xbe, 0x01, 0xd0, 0xe3, 0xee, 0xac, 0xdb, 0x9b, 0x49, 0x2a, 0xb4, 0x8d,
+	0x3c, 0xc9, 0x29, 0x49, 0x47, 0x09, 0xf0, 0x2d, 0xb8, 0xea, 0x04, 0x72, 0xd4, 0xf5, 0xd8, 0x80,
+	0x47, 0x98, 0x94, 0xc6, 0x5c, 0x51, 0xe2, 0x87, 0x6c, 0xc0, 0x43, 0x5c, 0x19, 0x40, 0x78, 0x43,
+	0xe6, 0xc8, 0xc0, 0xa7, 0xc2, 0x34, 0x2a, 0x46, 0x35, 0x4f, 0x16, 0x24, 0xb8, 0x0c, 0xbb, 0xc9,
+	0x3d, 0xa3, 0x7b, 0x57, 0xdf, 0xdf, 0xf3, 0x24, 0x17, 0xdf, 0x34, 0xee, 0xe2, 0x8f, 0xa1, 0x30,
+	0x5f, 0x6f, 0xdc, 0xb1, 0x5b, 0xe6, 0x97, 0x59, 0x8d, 0xc9, 0xc7, 0x18, 0x25, 0xb4, 0xbe, 0x31,
+	0xe0, 0xda, 0x52, 0x08, 0x1d, 0xee, 0xce, 0xf0, 0x1d, 0xc8, 0x4e, 0xa8, 0x10, 0xce, 0x50, 0x47,
+	0x60, 0xac, 0x4d, 0xad, 0x04, 0xa5, 0xaa, 0x79, 0x42, 0x27, 0x3c, 0xae, 0x66, 0x35, 0x56, 0x2e,
+	0x48, 0x6f, 0x42, 0x79, 0x20, 0xbb, 0x23, 0xea, 0x0d, 0x47, 0x32, 0xe2, 0xf1, 0x4a, 0x24, 0x3d,
+	0xd0, 0x42, 0xfc, 0x11, 0x14, 0x04, 0x9f, 0xd0, 0xee, 0xfc, 0xda, 0x94, 0xd6, 0xd7, 0xa6, 0xbc,
+	0x92, 0x1e, 0x46, 0xce, 0xe2, 0xbb, 0xf0, 0x7e, 0x82, 0x3a, 0xd5, 0x78, 0x33, 0x1a, 0xbe, 0x17,
+	0xc1, 0x97, 0x7b, 0xef, 0x01, 0x7c, 0x7f, 0x59, 0x79, 0x77, 0x45, 0xe7, 0xfe, 0x5d, 0xd8, 0xb9,
+	0x3f, 0x5c, 0x34, 0x78, 0x78, 0xba, 0x8b, 0x77, 0xe0, 0x1a, 0x9d, 0x4a, 0xca, 0x54, 0x6a, 0x75,
+	0xb9, 0xfe, 0x02, 0x2c, 0xcc, 0x7f, 0xed, 0x9c, 0xc1, 0x4e, 0x31, 0xc1, 0x3f, 0x0e, 0xe1, 0xf8,
+	0x0b, 0x28, 0x2f, 0x99, 0x5f, 0xa1, 0xf0, 0xea, 0x19, 0x0a, 0xaf, 0x2f, 0x3c, 0x5a, 0x1e, 0x9c,
+	0xd2, 0x6d, 0x3d, 0x47, 0xf0, 0xbd, 0x85, 0x93, 0x6c, 0x47, 0xd9, 0x84, 0x3f, 0x85, 0xbc, 0x4a,
+	0x1b, 0xea, 0xeb, 0x94, 0x8b, 0xcf, 0xf3, 0x46, 0x2d, 0xfc, 0x62, 0x5e, 0x93, 0xd3, 0x5a, 0xf4,
+	0xc5, 0xbc, 0xf6, 0x0b, 0x0d, 0x53, 0x9b, 0xc8, 0xae, 0x48, 0xc6, 0x02, 0x57, 0xe7, 0x1f, 0xcd,
+	0x76, 0xed, 0xf7, 0x56, 0x6c, 0xdc, 0xa7, 0x34, 0xfc, 0x98, 0xb6, 0x94, 0x94, 0x4d, 0xd3, 0x58,
+	0x4e, 0xca, 0xe6, 0xa6, 0x49, 0xf9, 0x83, 0x30, 0x27, 0x09, 0x3d, 0xa6, 0x2a, 0x94, 0xcf, 0x3d,
+	0x26, 0x75, 0x86, 0xb1, 0x60, 0x12, 0xfa, 0x9f, 0x26, 0x7a, 0xdc, 0x39, 0x78, 0xfe, 0xaa, 0x8c,
+	0x5e, 0xbc, 0x2a, 0xa3, 0xbf, 0xbe, 0x2a, 0xa3, 0xdf, 0xbc, 0x2e, 0x6f, 0xbd, 0x78, 0x5d, 0xde,
+	0xfa, 0xcb, 0xeb, 0xf2, 0xd6, 0x17, 0xb5, 0xa1, 0x27, 0x47, 0x41, 0xaf, 0xd6, 0xe7, 0x93, 0x7a,
+	0xf4, 0xbf, 0x81, 0xf0, 0xe7, 0xb6, 0x70, 0x8f, 0xea, 0x92, 0x0a, 0x19, 0x48, 0x6f, 0xac, 0x07,
+	0xae, 0x23, 0x9d, 0xde, 0xb6, 0x26, 0xba, 0xf9, 0xef, 0x01, 0x00, 0x97, 0x39, 0x23, 0xe2, 0x9e,
+	0x18, 0x00, 0x00,
 }
 
 func (m *Customer1) Marshal() (dAtA []byte, err error) {
@@ -5258,6 +5267,11 @@ func (m *TestUpdatedTxBody) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0xfa
 		}
 	}
+	if m.SomeNewCriticalField != 0 {
+		i = encodeVarintUnknonwnproto(dAtA, i, uint64(m.SomeNewCriticalField))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.SomeNewField != 0 {
 		i = encodeVarintUnknonwnproto(dAtA, i, uint64(m.SomeNewField))
 		i--
@@ -6435,6 +6449,9 @@ func (m *TestUpdatedTxBody) Size() (n int) {
 	if m.SomeNewField != 0 {
 		n += 1 + sovUnknonwnproto(uint64(m.SomeNewField))
 	}
+	if m.SomeNewCriticalField != 0 {
+		n += 1 + sovUnknonwnproto(uint64(m.SomeNewCriticalField))
+	}
 	if len(m.ExtensionOptions) > 0 {
 		for _, e := range m.ExtensionOptions {
 			l = e.Size()
@@ -12621,6 +12638,25 @@ func (m *TestUpdatedTxBody) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SomeNewCriticalField", wireType)
+			}
+			m.SomeNewCriticalField = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnknonwnproto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SomeNewCriticalField |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 1023:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionOptions", wireType)
@@ -13,6 +13,19 @@ import (
 	storetypes "cosmossdk.io/store/types"
 )
 
+// ExecMode defines the execution mode of a transaction, which can be set on a
+// Context by the BaseApp running it.
+type ExecMode uint8
+
+const (
+	ExecModeCheck           ExecMode = iota // Check a transaction
+	ExecModeReCheck                         // Recheck a (pending) transaction after a commit
+	ExecModeSimulate                        // Simulate a transaction
+	ExecModePrepareProposal                 // Prepare a block proposal
+	ExecModeProcessProposal                 // Process a block proposal
+	ExecModeDeliver                         // Deliver a transaction of a block
+)
+
 /*
 Context is an immutable object contains all information needed to
 process a request.
@@ -34,12 +47,16 @@ type Context struct {
 	blockGasMeter        storetypes.GasMeter
 	checkTx              bool
 	recheckTx            bool // if recheckTx == true, then checkTx must also be true
+	execMode             ExecMode
 	minGasPrice          DecCoins
 	consParams           *cmtproto.ConsensusParams
 	eventManager         EventManagerI
 	priority             int64 // The tx priority, only relevant in CheckTx
 	kvGasConfig          storetypes.GasConfig
 	transientKVGasConfig storetypes.GasConfig
+	storeGasConfigs      map[storetypes.StoreKey]storetypes.GasConfig
+	iterBytesMeter       *storetypes.IterBytesMeter
+	storeGasMetrics      bool
 	streamingManager     storetypes.StreamingManager
 }
 
@@ -59,12 +76,23 @@ func (c Context) GasMeter() storetypes.GasMeter                 { return c.gasMe
 func (c Context) BlockGasMeter() storetypes.GasMeter            { return c.blockGasMeter }
 func (c Context) IsCheckTx() bool                               { return c.checkTx }
 func (c Context) IsReCheckTx() bool                             { return c.recheckTx }
+func (c Context) ExecMode() ExecMode                            { return c.execMode }
 func (c Context) MinGasPrices() DecCoins                        { return c.minGasPrice }
 func (c Context) EventManager() EventManagerI                   { return c.eventManager }
 func (c Context) Priority() int64                               { return c.priority }
 func (c Context) KVGasConfig() storetypes.GasConfig             { return c.kvGasConfig }
 func (c Context) TransientKVGasConfig() storetypes.GasConfig    { return c.transientKVGasConfig }
 func (c Context) StreamingManager() storetypes.StreamingManager { return c.streamingManager }
+func (c Context) IterBytesMeter() *storetypes.IterBytesMeter    { return c.iterBytesMeter }
+func (c Context) StoreGasMetrics() bool                         { return c.storeGasMetrics }
+
+// StoreGasConfig returns the gas configuration of the store of the given key,
+// and false if the store uses the default KVStore or transient KVStore
+// configuration.
+func (c Context) StoreGasConfig(key storetypes.StoreKey) (storetypes.GasConfig, bool) {
+	gasConfig, ok := c.storeGasConfigs[key]
+	return gasConfig, ok
+}
 
 // clone the header before returning
 func (c Context) BlockHeader() cmtproto.Header {
@@ -216,6 +244,28 @@ func (c Context) WithTransientKVGasConfig(gasConfig storetypes.GasConfig) Contex
 	return c
 }
 
+// WithStoreGasConfigs returns a Context with updated gas configurations for the
+// stores of the given keys, overriding the KVStore and transient KVStore gas
+// configurations. The map must not be modified afterwards.
+func (c Context) WithStoreGasConfigs(gasConfigs map[storetypes.StoreKey]storetypes.GasConfig) Context {
+	c.storeGasConfigs = gasConfigs
+	return c
+}
+
+// WithIterBytesMeter returns a Context with an updated meter of the bytes read
+// through the iterators of the stores, limiting them if not nil.
+func (c Context) WithIterBytesMeter(meter *storetypes.IterBytesMeter) Context {
+	c.iterBytesMeter = meter
+	return c
+}
+
+// WithStoreGasMetrics returns a Context which records the gas consumed by the
+// operations on its stores in telemetry, by store key and operation, if enabled.
+func (c Context) WithStoreGasMetrics(enabled bool) Context {
+	c.storeGasMetrics = enabled
+	return c
+}
+
 // WithIsCheckTx enables or disables CheckTx value for verifying transactions and returns an updated Context
 func (c Context) WithIsCheckTx(isCheckTx bool) Context {
 	c.checkTx = isCheckTx
@@ -232,6 +282,12 @@ func (c Context) WithIsReCheckTx(isRecheckTx bool) Context {
 	return c
 }
 
+// WithExecMode returns a Context with an updated ExecMode.
+func (c Context) WithExecMode(m ExecMode) Context {
+	c.execMode = m
+	return c
+}
+
 // WithMinGasPrices returns a Context with an updated minimum gas price value
 func (c Context) WithMinGasPrices(gasPrices DecCoins) Context {
 	c.minGasPrice = gasPrices
@@ -286,12 +342,28 @@ func (c Context) Value(key interface{}) interface{} {
 
 // KVStore fetches a KVStore from the MultiStore.
 func (c Context) KVStore(key storetypes.StoreKey) storetypes.KVStore {
-	return gaskv.NewStore(c.ms.GetKVStore(key), c.gasMeter, c.kvGasConfig)
+	return c.gasKVStore(key, c.kvGasConfig)
 }
 
 // TransientStore fetches a TransientStore from the MultiStore.
 func (c Context) TransientStore(key storetypes.StoreKey) storetypes.KVStore {
-	return gaskv.NewStore(c.ms.GetKVStore(key), c.gasMeter, c.transientKVGasConfig)
+	return c.gasKVStore(key, c.transientKVGasConfig)
+}
+
+// gasKVStore wraps the store of the given key to consume gas according to its
+// gas configuration, or the provided default one.
+func (c Context) gasKVStore(key storetypes.StoreKey, defaultGasConfig storetypes.GasConfig) storetypes.KVStore {
+	gasConfig, ok := c.storeGasConfigs[key]
+	if !ok {
+		gasConfig = defaultGasConfig
+	}
+
+	store := gaskv.NewStore(c.ms.GetKVStore(key), c.gasMeter, gasConfig).WithIterBytesMeter(c.iterBytesMeter)
+	if c.storeGasMetrics {
+		store = store.WithGasMetrics(key.Name())
+	}
+
+	return store
 }
 
 // CacheContext returns a new Context with the multi-store cached and a new
@@ -59,6 +59,37 @@ func (s *contextTestSuite) TestCacheContext() {
 	s.Require().Len(ctx.EventManager().Events(), 2)
 }
 
+func (s *contextTestSuite) TestStoreGasConfigs() {
+	key := storetypes.NewKVStoreKey(s.T().Name())
+	tkey := storetypes.NewTransientStoreKey("transient_" + s.T().Name())
+	gasConfig := storetypes.GasConfig{HasCost: 7}
+	meter := storetypes.NewGasMeter(10000)
+
+	ctx := testutil.DefaultContext(key, tkey).
+		WithGasMeter(meter).
+		WithStoreGasConfigs(map[storetypes.StoreKey]storetypes.GasConfig{tkey: gasConfig})
+
+	_, ok := ctx.StoreGasConfig(key)
+	s.Require().False(ok)
+	cfg, ok := ctx.StoreGasConfig(tkey)
+	s.Require().True(ok)
+	s.Require().Equal(gasConfig, cfg)
+
+	// the gas config of the store overrides the transient store gas config
+	ctx.TransientStore(tkey).Has([]byte("key"))
+	s.Require().Equal(storetypes.Gas(7), meter.GasConsumed())
+	ctx.KVStore(key).Has([]byte("key"))
+	s.Require().Equal(7+ctx.KVGasConfig().HasCost, meter.GasConsumed())
+
+	// the iterators of the stores share the iterator bytes meter
+	iterMeter := storetypes.NewIterBytesMeter(100)
+	ctx = ctx.WithIterBytesMeter(iterMeter)
+	ctx.KVStore(key).Set([]byte("key"), []byte("value"))
+	itr := ctx.KVStore(key).Iterator(nil, nil)
+	s.Require().NoError(itr.Close())
+	s.Require().Equal(uint64(8), iterMeter.Consumed())
+}
+
 func (s *contextTestSuite) TestLogContext() {
 	key := storetypes.NewKVStoreKey(s.T().Name())
 	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_"+s.T().Name()))
@@ -139,6 +139,10 @@ var (
 	// supplied.
 	ErrInvalidGasLimit = errorsmod.Register(RootCodespace, 41, "invalid gas limit")
 
+	// ErrIterBytesLimit defines an error when a transaction reads more bytes
+	// through store iterators than the limit of the application.
+	ErrIterBytesLimit = errorsmod.Register(RootCodespace, 42, "iterator bytes limit exceeded")
+
 	// ErrPanic should only be set when we recovering from a panic
 	ErrPanic = errorsmod.ErrPanic
 )
@@ -0,0 +1,140 @@
+package mempool
+
+import (
+	"sort"
+
+	"github.com/huandu/skiplist"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Inspector is an optional interface of the mempools whose pending transactions
+// can be listed, for instance by the mempool gRPC service. It is meant for
+// introspection and is not used to build proposals.
+type Inspector interface {
+	// PendingTxs returns the transactions of the mempool ordered by sender and
+	// nonce, restricted to the transactions of sender if it is not empty.
+	PendingTxs(sender string) []PendingTx
+
+	// SenderCounts returns the number of transactions of the mempool by sender.
+	SenderCounts() map[string]int
+}
+
+// PendingTx is a transaction of a mempool, as listed by an Inspector.
+type PendingTx struct {
+	Tx     sdk.Tx
+	Sender string
+	Nonce  uint64
+
+	// Priority is the priority of the transaction in the mempool, it is zero if
+	// the mempool does not prioritize transactions.
+	Priority int64
+}
+
+var (
+	_ Inspector = (*SenderNonceMempool)(nil)
+	_ Inspector = (*PriorityNonceMempool[int64])(nil)
+	_ Inspector = (*LaneMempool)(nil)
+)
+
+// PendingTxs implements Inspector.
+func (snm *SenderNonceMempool) PendingTxs(sender string) []PendingTx {
+	var txs []PendingTx
+	for _, s := range sortedSenders(snm.senders, sender) {
+		for e := snm.senders[s].Front(); e != nil; e = e.Next() {
+			txs = append(txs, PendingTx{Tx: e.Value.(sdk.Tx), Sender: s, Nonce: e.Key().(uint64)})
+		}
+	}
+
+	return txs
+}
+
+// SenderCounts implements Inspector.
+func (snm *SenderNonceMempool) SenderCounts() map[string]int {
+	return senderCounts(snm.senders)
+}
+
+// PendingTxs implements Inspector. The priority of the transactions is only
+// reported for int64 priorities.
+func (mp *PriorityNonceMempool[C]) PendingTxs(sender string) []PendingTx {
+	var txs []PendingTx
+	for _, s := range sortedSenders(mp.senderIndices, sender) {
+		for e := mp.senderIndices[s].Front(); e != nil; e = e.Next() {
+			key := e.Key().(txMeta[C])
+			priority, _ := any(mp.scores[txMeta[C]{nonce: key.nonce, sender: s}].priority).(int64)
+			txs = append(txs, PendingTx{Tx: e.Value.(sdk.Tx), Sender: s, Nonce: key.nonce, Priority: priority})
+		}
+	}
+
+	return txs
+}
+
+// SenderCounts implements Inspector.
+func (mp *PriorityNonceMempool[C]) SenderCounts() map[string]int {
+	return senderCounts(mp.senderIndices)
+}
+
+// PendingTxs implements Inspector, listing the transactions of the lanes whose
+// mempool implements Inspector.
+func (mp *LaneMempool) PendingTxs(sender string) []PendingTx {
+	var txs []PendingTx
+	for _, lane := range mp.cfg.Lanes {
+		if inspector, ok := lane.Mempool.(Inspector); ok {
+			txs = append(txs, inspector.PendingTxs(sender)...)
+		}
+	}
+
+	sort.SliceStable(txs, func(i, j int) bool {
+		if txs[i].Sender != txs[j].Sender {
+			return txs[i].Sender < txs[j].Sender
+		}
+		return txs[i].Nonce < txs[j].Nonce
+	})
+
+	return txs
+}
+
+// SenderCounts implements Inspector, counting the transactions of the lanes
+// whose mempool implements Inspector.
+func (mp *LaneMempool) SenderCounts() map[string]int {
+	counts := make(map[string]int)
+	for _, lane := range mp.cfg.Lanes {
+		if inspector, ok := lane.Mempool.(Inspector); ok {
+			for sender, count := range inspector.SenderCounts() {
+				counts[sender] += count
+			}
+		}
+	}
+
+	return counts
+}
+
+// sortedSenders returns the senders of the sender indexes in order, or only
+// sender if it is not empty.
+func sortedSenders(indexes map[string]*skiplist.SkipList, sender string) []string {
+	if sender != "" {
+		if _, ok := indexes[sender]; !ok {
+			return nil
+		}
+		return []string{sender}
+	}
+
+	senders := make([]string, 0, len(indexes))
+	for s := range indexes {
+		senders = append(senders, s)
+	}
+	sort.Strings(senders)
+
+	return senders
+}
+
+func senderCounts(indexes map[string]*skiplist.SkipList) map[string]int {
+	counts := make(map[string]int, len(indexes))
+	for sender, index := range indexes {
+		if index.Len() > 0 {
+			counts[sender] = index.Len()
+		}
+	}
+
+	return counts
+}
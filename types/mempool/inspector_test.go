@@ -0,0 +1,77 @@
+package mempool_test
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func TestInspector(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	senders := []string{accounts[0].Address.String(), accounts[1].Address.String()}
+	sort.Strings(senders)
+	sender0, _ := sdk.AccAddressFromBech32(senders[0])
+	sender1, _ := sdk.AccAddressFromBech32(senders[1])
+
+	txs := []testTx{
+		{id: 0, priority: 10, nonce: 1, address: sender1},
+		{id: 1, priority: 20, nonce: 0, address: sender1},
+		{id: 2, priority: 30, nonce: 2, address: sender0},
+	}
+
+	testCases := []struct {
+		name       string
+		mempool    mempool.Mempool
+		priorities bool
+	}{
+		{"sender nonce", mempool.NewSenderNonceMempool(), false},
+		{"priority nonce", mempool.DefaultPriorityMempool(), true},
+		{"lane", newTestLaneMempool(0), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+			for _, tx := range txs {
+				require.NoError(t, tc.mempool.Insert(ctx.WithPriority(tx.priority), tx))
+			}
+
+			inspector, ok := tc.mempool.(mempool.Inspector)
+			require.True(t, ok)
+
+			// the txs are ordered by sender and nonce
+			pending := inspector.PendingTxs("")
+			require.Len(t, pending, 3)
+			for i, id := range []int{2, 1, 0} {
+				require.Equal(t, txs[id], pending[i].Tx)
+				require.Equal(t, txs[id].address.String(), pending[i].Sender)
+				require.Equal(t, txs[id].nonce, pending[i].Nonce)
+				if tc.priorities {
+					require.Equal(t, txs[id].priority, pending[i].Priority)
+				} else {
+					require.Zero(t, pending[i].Priority)
+				}
+			}
+
+			pending = inspector.PendingTxs(senders[1])
+			require.Len(t, pending, 2)
+			require.Equal(t, txs[1], pending[0].Tx)
+			require.Empty(t, inspector.PendingTxs(sdk.AccAddress("unknown").String()))
+
+			require.Equal(t, map[string]int{senders[0]: 1, senders[1]: 2}, inspector.SenderCounts())
+
+			require.NoError(t, tc.mempool.Remove(txs[2]))
+			require.Equal(t, map[string]int{senders[1]: 2}, inspector.SenderCounts())
+			require.Empty(t, inspector.PendingTxs(senders[0]))
+		})
+	}
+}
@@ -0,0 +1,374 @@
+package mempool
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/huandu/skiplist"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txsigning "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+var (
+	_ Mempool  = (*LaneMempool)(nil)
+	_ Iterator = (*laneIterator)(nil)
+)
+
+// DefaultReplacementBump is the default percentage by which the gas price of a
+// transaction must exceed the gas price of the transaction it replaces.
+const DefaultReplacementBump = 10
+
+type (
+	// Lane defines a lane of the LaneMempool: a class of transactions, such as
+	// oracle or IBC transactions, with its own ordering and share of the block
+	// space.
+	Lane struct {
+		// Name identifies the lane.
+		Name string
+
+		// Match returns whether a transaction belongs to the lane. A transaction
+		// belongs to the first lane it matches, a nil Match matches every
+		// transaction.
+		Match func(ctx context.Context, tx sdk.Tx) bool
+
+		// MaxBlockSpace is the maximum share of the block bytes used by the
+		// transactions of the lane, between 0 and 1. The lane may use all the
+		// block space left by the previous lanes if it is 0.
+		MaxBlockSpace math.LegacyDec
+
+		// Mempool stores and orders the transactions of the lane. It must not
+		// limit its number of transactions, the LaneMempool evicts them.
+		Mempool Mempool
+	}
+
+	// LaneMempoolConfig defines the configuration used to configure the
+	// LaneMempool.
+	LaneMempoolConfig struct {
+		// Lanes defines the lanes of the mempool, in the order they are selected
+		// in a block.
+		Lanes []Lane
+
+		// TxGasPrice returns the gas price of a transaction, used to evict the
+		// lowest paying transactions and to replace a transaction by fee, such
+		// as FeeDenomTxGasPrice. It is required, as the gas prices of the
+		// transactions must be comparable.
+		TxGasPrice func(ctx context.Context, tx sdk.Tx) math.LegacyDec
+
+		// ReplacementBump is the percentage by which the gas price of a
+		// transaction must exceed the gas price of the transaction of the same
+		// sender and nonce it replaces, e.g. DefaultReplacementBump.
+		ReplacementBump uint64
+
+		// MaxTx sets the maximum number of transactions allowed in the mempool with
+		// the semantics:
+		// - if MaxTx == 0, there is no cap on the number of transactions in the mempool
+		// - if MaxTx > 0, the mempool will cap the number of transactions it stores,
+		//   and will evict the transaction with the lowest gas price to insert a
+		//   transaction with a higher gas price.
+		// - if MaxTx < 0, `Insert` is a no-op.
+		MaxTx int
+	}
+
+	// LaneMempool is a mempool which splits the transactions in lanes, each one
+	// ordering its transactions with its own mempool. The transactions are
+	// selected lane after lane, and DefaultProposalHandler limits the block
+	// space used by each lane to its MaxBlockSpace.
+	//
+	// When the mempool is full, a transaction evicts the transaction with the
+	// lowest gas price of all the lanes if it pays a higher gas price, and a
+	// transaction replaces the transaction of the same sender and nonce if its
+	// gas price is higher, by at least ReplacementBump percent.
+	LaneMempool struct {
+		cfg LaneMempoolConfig
+
+		// txs indexes the transactions of all the lanes by sender and nonce, and
+		// priceIndex by ascending gas price.
+		txs        map[txKey]*laneTx
+		priceIndex *skiplist.SkipList
+	}
+
+	laneTx struct {
+		tx       sdk.Tx
+		sigs     []txsigning.SignatureV2
+		lane     int
+		gasPrice math.LegacyDec
+	}
+
+	// priceKey is the key of a transaction in the gas price index.
+	priceKey struct {
+		gasPrice math.LegacyDec
+		sender   string
+		nonce    uint64
+	}
+
+	laneIterator struct {
+		ctx     context.Context
+		mempool *LaneMempool
+		txs     [][]byte
+		lane    int
+		current Iterator
+	}
+)
+
+// FeeDenomTxGasPrice returns a TxGasPrice function pricing the transactions in
+// the fee denomination: the gas price of a transaction implementing sdk.FeeTx
+// is its fee in denom per unit of gas. The gas price of other transactions, or
+// of transactions not paying fees in denom, is zero.
+func FeeDenomTxGasPrice(denom string) func(ctx context.Context, tx sdk.Tx) math.LegacyDec {
+	return func(_ context.Context, tx sdk.Tx) math.LegacyDec {
+		feeTx, ok := tx.(sdk.FeeTx)
+		if !ok || feeTx.GetGas() == 0 {
+			return math.LegacyZeroDec()
+		}
+
+		gas := math.LegacyNewDecFromInt(math.NewIntFromUint64(feeTx.GetGas()))
+		return math.LegacyNewDecFromInt(feeTx.GetFee().AmountOf(denom)).Quo(gas)
+	}
+}
+
+// NewLaneMempool returns a LaneMempool with the lanes of the configuration. It
+// panics if a lane has no mempool or a MaxBlockSpace out of [0, 1], or if the
+// configuration has no TxGasPrice.
+func NewLaneMempool(cfg LaneMempoolConfig) *LaneMempool {
+	if len(cfg.Lanes) == 0 {
+		panic("lane mempool must have at least one lane")
+	}
+	for i, lane := range cfg.Lanes {
+		if lane.Mempool == nil {
+			panic(fmt.Sprintf("lane %s has no mempool", lane.Name))
+		}
+		if lane.MaxBlockSpace.IsNil() {
+			cfg.Lanes[i].MaxBlockSpace = math.LegacyZeroDec()
+		} else if lane.MaxBlockSpace.IsNegative() || lane.MaxBlockSpace.GT(math.LegacyOneDec()) {
+			panic(fmt.Sprintf("lane %s max block space must be between 0 and 1, got %s", lane.Name, lane.MaxBlockSpace))
+		}
+	}
+	if cfg.TxGasPrice == nil {
+		panic("lane mempool must have a TxGasPrice function")
+	}
+
+	return &LaneMempool{
+		cfg:        cfg,
+		txs:        make(map[txKey]*laneTx),
+		priceIndex: skiplist.New(priceIndexComparable),
+	}
+}
+
+// priceIndexComparable orders the transactions by ascending gas price, then
+// sender and nonce, uniquely identifying a transaction.
+var priceIndexComparable = skiplist.GreaterThanFunc(func(a, b any) int {
+	keyA := a.(priceKey)
+	keyB := b.(priceKey)
+
+	switch {
+	case keyA.gasPrice.LT(keyB.gasPrice):
+		return -1
+	case keyA.gasPrice.GT(keyB.gasPrice):
+		return 1
+	}
+
+	res := skiplist.String.Compare(keyA.sender, keyB.sender)
+	if res != 0 {
+		return res
+	}
+
+	return skiplist.Uint64.Compare(keyA.nonce, keyB.nonce)
+})
+
+// Lanes returns the lanes of the mempool, in the order they are selected.
+func (lm *LaneMempool) Lanes() []Lane {
+	return lm.cfg.Lanes
+}
+
+// Insert inserts a transaction in the first lane it matches. Transactions are
+// unique by sender and nonce, derived from the transaction's first signature.
+//
+// Inserting a transaction with the signatures of a transaction in the mempool
+// is a no-op. A different transaction with the same sender and nonce replaces
+// it only if its gas price is higher, by at least ReplacementBump percent. The
+// replaced transaction is kept if the new one fails to be inserted. Once MaxTx is
+// reached, a new transaction evicts the transaction with the lowest gas price
+// if it pays a higher gas price, otherwise ErrMempoolTxMaxCapacity is returned.
+// The later transactions of the sender of an evicted transaction stay in the
+// mempool, until they fail the sequence check.
+func (lm *LaneMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	if lm.cfg.MaxTx < 0 {
+		return nil
+	}
+
+	sigs, err := tx.(signing.SigVerifiableTx).GetSignaturesV2()
+	if err != nil {
+		return err
+	}
+	if len(sigs) == 0 {
+		return fmt.Errorf("tx must have at least one signer")
+	}
+
+	sender, err := txSender(tx.(signing.SigVerifiableTx), sigs)
+	if err != nil {
+		return err
+	}
+
+	key := txKey{address: sender, nonce: txNonce(tx, sigs)}
+	gasPrice := lm.cfg.TxGasPrice(ctx, tx)
+
+	lane := lm.matchLane(ctx, tx)
+	if lane < 0 {
+		return fmt.Errorf("tx matches no lane")
+	}
+
+	var replaced, evict *laneTx
+	if existing, ok := lm.txs[key]; ok {
+		if sameSignatures(existing.sigs, sigs) {
+			return nil
+		}
+
+		minGasPrice := existing.gasPrice.MulInt64(int64(100 + lm.cfg.ReplacementBump)).QuoInt64(100)
+		if !gasPrice.GT(existing.gasPrice) || gasPrice.LT(minGasPrice) {
+			return fmt.Errorf("%w: gas price %s, minimum %s", ErrReplacementUnderpriced, gasPrice, minGasPrice)
+		}
+
+		// the replaced tx has the key of the new one in the lanes, so it is
+		// removed first, and restored if the new one cannot be inserted
+		if err := lm.Remove(existing.tx); err != nil {
+			return err
+		}
+		replaced = existing
+	} else if lm.cfg.MaxTx > 0 && lm.CountTx() >= lm.cfg.MaxTx {
+		cheapest := lm.priceIndex.Front()
+		if cheapest == nil || !gasPrice.GT(cheapest.Key().(priceKey).gasPrice) {
+			return ErrMempoolTxMaxCapacity
+		}
+
+		evict = cheapest.Value.(*laneTx)
+	}
+
+	if err := lm.insert(ctx, key, &laneTx{tx: tx, sigs: sigs, lane: lane, gasPrice: gasPrice}); err != nil {
+		if replaced != nil {
+			if restoreErr := lm.insert(ctx, key, replaced); restoreErr != nil {
+				return errors.Join(err, restoreErr)
+			}
+		}
+		return err
+	}
+
+	if evict != nil {
+		return lm.Remove(evict.tx)
+	}
+
+	return nil
+}
+
+// insert inserts a transaction in its lane and indexes it.
+func (lm *LaneMempool) insert(ctx context.Context, key txKey, ltx *laneTx) error {
+	if err := lm.cfg.Lanes[ltx.lane].Mempool.Insert(ctx, ltx.tx); err != nil {
+		return err
+	}
+
+	lm.txs[key] = ltx
+	lm.priceIndex.Set(priceKey{gasPrice: ltx.gasPrice, sender: key.address, nonce: key.nonce}, ltx)
+
+	return nil
+}
+
+// matchLane returns the index of the first lane matching the transaction, -1
+// if none does.
+func (lm *LaneMempool) matchLane(ctx context.Context, tx sdk.Tx) int {
+	for i, lane := range lm.cfg.Lanes {
+		if lane.Match == nil || lane.Match(ctx, tx) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// sameSignatures returns whether two transactions have the same signatures,
+// i.e. are the same transaction.
+func sameSignatures(a, b []txsigning.SignatureV2) bool {
+	var bufA, bufB bytes.Buffer
+	for _, sig := range a {
+		writeSignatureData(&bufA, sig.Data)
+	}
+	for _, sig := range b {
+		writeSignatureData(&bufB, sig.Data)
+	}
+
+	return len(a) == len(b) && bufA.Len() > 0 && bytes.Equal(bufA.Bytes(), bufB.Bytes())
+}
+
+// Select returns an iterator over the transactions of the lanes, lane after
+// lane, each one in the order of its mempool.
+//
+// NOTE: It is not safe to use this iterator while removing transactions from
+// the underlying mempool.
+func (lm *LaneMempool) Select(ctx context.Context, txs [][]byte) Iterator {
+	iterator := &laneIterator{ctx: ctx, mempool: lm, txs: txs, lane: -1}
+	return iterator.nextLane()
+}
+
+// nextLane moves the iterator to the first transaction of the next non empty
+// lane.
+func (i *laneIterator) nextLane() Iterator {
+	for i.lane++; i.lane < len(i.mempool.cfg.Lanes); i.lane++ {
+		i.current = i.mempool.cfg.Lanes[i.lane].Mempool.Select(i.ctx, i.txs)
+		if i.current != nil {
+			return i
+		}
+	}
+
+	return nil
+}
+
+func (i *laneIterator) Next() Iterator {
+	if i.current = i.current.Next(); i.current != nil {
+		return i
+	}
+
+	return i.nextLane()
+}
+
+func (i *laneIterator) Tx() sdk.Tx {
+	return i.current.Tx()
+}
+
+// CountTx returns the number of transactions of all the lanes.
+func (lm *LaneMempool) CountTx() int {
+	return len(lm.txs)
+}
+
+// Remove removes a transaction from its lane.
+func (lm *LaneMempool) Remove(tx sdk.Tx) error {
+	sigs, err := tx.(signing.SigVerifiableTx).GetSignaturesV2()
+	if err != nil {
+		return err
+	}
+	if len(sigs) == 0 {
+		return fmt.Errorf("attempted to remove a tx with no signatures")
+	}
+
+	sender, err := txSender(tx.(signing.SigVerifiableTx), sigs)
+	if err != nil {
+		return err
+	}
+
+	key := txKey{address: sender, nonce: txNonce(tx, sigs)}
+	ltx, ok := lm.txs[key]
+	if !ok {
+		return ErrTxNotFound
+	}
+
+	if err := lm.cfg.Lanes[ltx.lane].Mempool.Remove(ltx.tx); err != nil {
+		return err
+	}
+	delete(lm.txs, key)
+	lm.priceIndex.Remove(priceKey{gasPrice: ltx.gasPrice, sender: key.address, nonce: key.nonce})
+
+	return nil
+}
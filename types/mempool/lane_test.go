@@ -0,0 +1,228 @@
+package mempool_test
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// feeTx is a testTx paying fees.
+type feeTx struct {
+	testTx
+	gas uint64
+	fee sdk.Coins
+}
+
+func (tx feeTx) GetGas() uint64 { return tx.gas }
+
+func (tx feeTx) GetFee() sdk.Coins { return tx.fee }
+
+func (tx feeTx) FeePayer() sdk.AccAddress { return tx.address }
+
+func (tx feeTx) FeeGranter() sdk.AccAddress { return nil }
+
+func testTxGasPrice(_ context.Context, tx sdk.Tx) math.LegacyDec {
+	return math.LegacyNewDec(tx.(testTx).priority)
+}
+
+func newTestLaneMempool(maxTx int) *mempool.LaneMempool {
+	return mempool.NewLaneMempool(mempool.LaneMempoolConfig{
+		Lanes: []mempool.Lane{
+			{
+				Name:    "oracle",
+				Match:   func(_ context.Context, tx sdk.Tx) bool { return tx.(testTx).id >= 100 },
+				Mempool: mempool.NewSenderNonceMempool(),
+			},
+			{Name: "default", Mempool: mempool.NewSenderNonceMempool()},
+		},
+		TxGasPrice:      testTxGasPrice,
+		ReplacementBump: mempool.DefaultReplacementBump,
+		MaxTx:           maxTx,
+	})
+}
+
+func TestLaneMempool_Select(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	mp := newTestLaneMempool(0)
+	ctx := context.Background()
+
+	txs := []testTx{
+		{id: 0, nonce: 0, address: accounts[0].Address},
+		{id: 100, nonce: 0, address: accounts[1].Address},
+		{id: 1, nonce: 1, address: accounts[0].Address},
+		{id: 101, nonce: 1, address: accounts[1].Address},
+	}
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx, tx))
+	}
+	require.Equal(t, 4, mp.CountTx())
+	require.Equal(t, 2, mp.Lanes()[0].Mempool.CountTx())
+
+	// the oracle lane is selected first
+	var ids []int
+	for it := mp.Select(ctx, nil); it != nil; it = it.Next() {
+		ids = append(ids, it.Tx().(testTx).id)
+	}
+	require.Equal(t, []int{100, 101, 0, 1}, ids)
+
+	require.NoError(t, mp.Remove(txs[1]))
+	require.ErrorIs(t, mp.Remove(txs[1]), mempool.ErrTxNotFound)
+	require.Equal(t, 1, mp.Lanes()[0].Mempool.CountTx())
+	require.Equal(t, 3, mp.CountTx())
+}
+
+func TestLaneMempool_Eviction(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	mp := newTestLaneMempool(2)
+	ctx := context.Background()
+
+	cheap := testTx{id: 0, priority: 1, address: accounts[0].Address}
+	expensive := testTx{id: 100, priority: 5, address: accounts[1].Address}
+	require.NoError(t, mp.Insert(ctx, cheap))
+	require.NoError(t, mp.Insert(ctx, expensive))
+
+	// a tx paying no more than the cheapest tx is rejected
+	require.ErrorIs(t, mp.Insert(ctx, testTx{id: 1, priority: 1, address: accounts[2].Address}), mempool.ErrMempoolTxMaxCapacity)
+
+	// a tx paying more evicts the cheapest tx, of any lane
+	require.NoError(t, mp.Insert(ctx, testTx{id: 1, priority: 2, address: accounts[2].Address}))
+	require.Equal(t, 2, mp.CountTx())
+	require.ErrorIs(t, mp.Remove(cheap), mempool.ErrTxNotFound)
+	require.Equal(t, 1, mp.Lanes()[1].Mempool.CountTx())
+}
+
+func TestLaneMempool_ReplaceByFee(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	mp := newTestLaneMempool(0)
+	ctx := context.Background()
+
+	tx := testTx{id: 0, priority: 100, address: accounts[0].Address, signature: []byte("sig0")}
+	require.NoError(t, mp.Insert(ctx, tx))
+
+	// inserting the same tx is a no-op
+	require.NoError(t, mp.Insert(ctx, tx))
+	require.Equal(t, 1, mp.CountTx())
+
+	// the gas price of a replacement must be 10% higher
+	underpriced := testTx{id: 1, priority: 109, address: accounts[0].Address, signature: []byte("sig1")}
+	require.ErrorIs(t, mp.Insert(ctx, underpriced), mempool.ErrReplacementUnderpriced)
+
+	// the replacement moves the tx to the lane it matches
+	replacement := testTx{id: 100, priority: 110, address: accounts[0].Address, signature: []byte("sig2")}
+	require.NoError(t, mp.Insert(ctx, replacement))
+	require.Equal(t, 1, mp.CountTx())
+	require.Equal(t, 1, mp.Lanes()[0].Mempool.CountTx())
+	require.Equal(t, 0, mp.Lanes()[1].Mempool.CountTx())
+	require.Equal(t, replacement, mp.Select(ctx, nil).Tx())
+}
+
+func TestLaneMempool_ReplaceByFeeZeroBump(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	mp := mempool.NewLaneMempool(mempool.LaneMempoolConfig{
+		Lanes:      []mempool.Lane{{Name: "default", Mempool: mempool.NewSenderNonceMempool()}},
+		TxGasPrice: testTxGasPrice,
+	})
+	ctx := context.Background()
+
+	require.NoError(t, mp.Insert(ctx, testTx{id: 0, address: accounts[0].Address, signature: []byte("sig0")}))
+
+	// the gas price of a replacement must be strictly higher
+	free := testTx{id: 1, address: accounts[0].Address, signature: []byte("sig1")}
+	require.ErrorIs(t, mp.Insert(ctx, free), mempool.ErrReplacementUnderpriced)
+
+	replacement := testTx{id: 2, priority: 1, address: accounts[0].Address, signature: []byte("sig2")}
+	require.NoError(t, mp.Insert(ctx, replacement))
+	require.Equal(t, replacement, mp.Select(ctx, nil).Tx())
+}
+
+// failingMempool is a mempool failing to insert the transactions while fail is
+// set.
+type failingMempool struct {
+	mempool.Mempool
+	fail bool
+}
+
+func (mp *failingMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	if mp.fail {
+		return errors.New("insert failed")
+	}
+	return mp.Mempool.Insert(ctx, tx)
+}
+
+func TestLaneMempool_ReplaceByFeeInsertFails(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	oracle := &failingMempool{Mempool: mempool.NewSenderNonceMempool(), fail: true}
+	mp := mempool.NewLaneMempool(mempool.LaneMempoolConfig{
+		Lanes: []mempool.Lane{
+			{
+				Name:    "oracle",
+				Match:   func(_ context.Context, tx sdk.Tx) bool { return tx.(testTx).id >= 100 },
+				Mempool: oracle,
+			},
+			{Name: "default", Mempool: mempool.NewSenderNonceMempool()},
+		},
+		TxGasPrice:      testTxGasPrice,
+		ReplacementBump: mempool.DefaultReplacementBump,
+	})
+	ctx := context.Background()
+
+	tx := testTx{id: 0, priority: 100, address: accounts[0].Address, signature: []byte("sig0")}
+	require.NoError(t, mp.Insert(ctx, tx))
+
+	// the replaced tx is kept when the replacement cannot be inserted
+	replacement := testTx{id: 100, priority: 200, address: accounts[0].Address, signature: []byte("sig1")}
+	require.Error(t, mp.Insert(ctx, replacement))
+	require.Equal(t, 1, mp.CountTx())
+	require.Equal(t, 1, mp.Lanes()[1].Mempool.CountTx())
+	require.Equal(t, tx, mp.Select(ctx, nil).Tx())
+
+	oracle.fail = false
+	require.NoError(t, mp.Insert(ctx, replacement))
+	require.Equal(t, 1, mp.CountTx())
+	require.Equal(t, replacement, mp.Select(ctx, nil).Tx())
+}
+
+func TestNewLaneMempool_InvalidConfig(t *testing.T) {
+	require.Panics(t, func() { mempool.NewLaneMempool(mempool.LaneMempoolConfig{TxGasPrice: testTxGasPrice}) })
+	require.Panics(t, func() {
+		mempool.NewLaneMempool(mempool.LaneMempoolConfig{Lanes: []mempool.Lane{{Name: "default"}}, TxGasPrice: testTxGasPrice})
+	})
+	require.Panics(t, func() {
+		mempool.NewLaneMempool(mempool.LaneMempoolConfig{
+			Lanes: []mempool.Lane{
+				{Name: "default", MaxBlockSpace: math.LegacyNewDec(2), Mempool: mempool.NewSenderNonceMempool()},
+			},
+			TxGasPrice: testTxGasPrice,
+		})
+	})
+	require.Panics(t, func() {
+		mempool.NewLaneMempool(mempool.LaneMempoolConfig{Lanes: []mempool.Lane{
+			{Name: "default", Mempool: mempool.NewSenderNonceMempool()},
+		}})
+	})
+}
+
+func TestFeeDenomTxGasPrice(t *testing.T) {
+	ctx := context.Background()
+	txGasPrice := mempool.FeeDenomTxGasPrice("stake")
+	require.True(t, txGasPrice(ctx, testTx{}).IsZero())
+
+	// only the fee in the fee denom is priced
+	tx := feeTx{gas: 200, fee: sdk.NewCoins(sdk.NewInt64Coin("atom", 100000), sdk.NewInt64Coin("stake", 100))}
+	require.Equal(t, math.LegacyNewDecWithPrec(5, 1), txGasPrice(ctx, tx))
+
+	tx.fee = sdk.NewCoins(sdk.NewInt64Coin("atom", 100000))
+	require.True(t, txGasPrice(ctx, tx).IsZero())
+
+	tx.fee = nil
+	require.True(t, txGasPrice(ctx, tx).IsZero())
+}
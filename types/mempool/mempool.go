@@ -3,8 +3,11 @@ package mempool
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	txsigning "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 )
 
 type Mempool interface {
@@ -40,4 +43,23 @@ type Iterator interface {
 var (
 	ErrTxNotFound           = errors.New("tx not found in mempool")
 	ErrMempoolTxMaxCapacity = errors.New("pool reached max tx capacity")
+
+	ErrReplacementUnderpriced = errors.New("replacement tx gas price is too low")
 )
+
+// txSender returns the address of the sender of a transaction, given its
+// signatures. It is derived from the public key of the first signature, or is
+// the first signer of the transaction if that signature has no public key, as
+// for the accounts of x/accounts which authenticate transactions themselves.
+func txSender(tx signing.SigVerifiableTx, sigs []txsigning.SignatureV2) (string, error) {
+	if sigs[0].PubKey != nil {
+		return sdk.AccAddress(sigs[0].PubKey.Address()).String(), nil
+	}
+
+	signers := tx.GetSigners()
+	if len(signers) == 0 {
+		return "", fmt.Errorf("tx must have at least one signer")
+	}
+
+	return signers[0].String(), nil
+}
@@ -50,24 +50,46 @@ type testTx struct {
 	address  sdk.AccAddress
 	// useful for debugging
 	strAddress string
+	// unordered transactions are indexed by their signature, which must be
+	// unique per transaction
+	unordered     bool
+	timeoutHeight uint64
+	signature     []byte
+	// the signers created by x/accounts have no public key
+	noPubKey bool
 }
 
-func (tx testTx) GetSigners() []sdk.AccAddress { panic("not implemented") }
+func (tx testTx) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{tx.address} }
 
 func (tx testTx) GetPubKeys() ([]cryptotypes.PubKey, error) { panic("not implemented") }
 
 func (tx testTx) GetSignaturesV2() (res []txsigning.SignatureV2, err error) {
+	var data txsigning.SignatureData
+	if tx.signature != nil {
+		data = &txsigning.SingleSignatureData{Signature: tx.signature}
+	}
+
+	var pubKey cryptotypes.PubKey
+	if !tx.noPubKey {
+		pubKey = testPubKey{address: tx.address}
+	}
+
 	res = append(res, txsigning.SignatureV2{
-		PubKey:   testPubKey{address: tx.address},
-		Data:     nil,
+		PubKey:   pubKey,
+		Data:     data,
 		Sequence: tx.nonce,
 	})
 
 	return res, nil
 }
 
+func (tx testTx) GetTimeoutHeight() uint64 { return tx.timeoutHeight }
+
+func (tx testTx) GetUnordered() bool { return tx.unordered }
+
 var (
 	_ sdk.Tx                  = (*testTx)(nil)
+	_ sdk.TxWithUnordered     = (*testTx)(nil)
 	_ signing.SigVerifiableTx = (*testTx)(nil)
 	_ cryptotypes.PubKey      = (*testPubKey)(nil)
 )
@@ -202,6 +224,31 @@ type MempoolTestSuite struct {
 	mempool     mempool.Mempool
 }
 
+func TestMempool_NoPubKeySigner(t *testing.T) {
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	tx0 := testTx{id: 0, nonce: 0, address: accounts[0].Address, noPubKey: true}
+	tx1 := testTx{id: 1, nonce: 1, address: accounts[0].Address, noPubKey: true}
+
+	mempools := map[string]mempool.Mempool{
+		"sender nonce":   mempool.NewSenderNonceMempool(),
+		"priority nonce": mempool.DefaultPriorityMempool(),
+		"lane":           newTestLaneMempool(0),
+	}
+	for name, mp := range mempools {
+		t.Run(name, func(t *testing.T) {
+			// the txs of an x/accounts signer are indexed by its address
+			require.NoError(t, mp.Insert(ctx, tx1))
+			require.NoError(t, mp.Insert(ctx, tx0))
+			require.Equal(t, 2, mp.CountTx())
+			require.Equal(t, []sdk.Tx{tx0, tx1}, fetchTxs(mp.Select(ctx, nil), 1000))
+
+			require.NoError(t, mp.Remove(tx0))
+			require.Equal(t, []sdk.Tx{tx1}, fetchTxs(mp.Select(ctx, nil), 1000))
+		})
+	}
+}
+
 func (s *MempoolTestSuite) resetMempool() {
 	s.iterations = 0
 	s.mempool = mempool.NewSenderNonceMempool()
@@ -189,7 +189,8 @@ func (mp *PriorityNonceMempool[C]) NextSenderTx(sender string) sdk.Tx {
 // transaction's first signature.
 //
 // Transactions are unique by sender and nonce. Inserting a duplicate tx is an
-// O(log n) no-op.
+// O(log n) no-op. Unordered transactions are indexed by a nonce derived from
+// their signatures instead of their sequence.
 //
 // Inserting a duplicate tx with a different priority overwrites the existing tx,
 // changing the total order of the mempool.
@@ -208,10 +209,12 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 		return fmt.Errorf("tx must have at least one signer")
 	}
 
-	sig := sigs[0]
-	sender := sdk.AccAddress(sig.PubKey.Address()).String()
+	sender, err := txSender(tx.(signing.SigVerifiableTx), sigs)
+	if err != nil {
+		return err
+	}
 	priority := mp.cfg.TxPriority.GetTxPriority(ctx, tx)
-	nonce := sig.Sequence
+	nonce := txNonce(tx, sigs)
 	key := txMeta[C]{nonce: nonce, priority: priority, sender: sender}
 
 	senderIndex, ok := mp.senderIndices[sender]
@@ -423,9 +426,11 @@ func (mp *PriorityNonceMempool[C]) Remove(tx sdk.Tx) error {
 		return fmt.Errorf("attempted to remove a tx with no signatures")
 	}
 
-	sig := sigs[0]
-	sender := sdk.AccAddress(sig.PubKey.Address()).String()
-	nonce := sig.Sequence
+	sender, err := txSender(tx.(signing.SigVerifiableTx), sigs)
+	if err != nil {
+		return err
+	}
+	nonce := txNonce(tx, sigs)
 
 	scoreKey := txMeta[C]{nonce: nonce, sender: sender}
 	score, ok := mp.scores[scoreKey]
@@ -748,3 +748,40 @@ func TestNextSenderTx_TxReplacement(t *testing.T) {
 	iter := mp.Select(ctx, nil)
 	require.Equal(t, txs[3], iter.Tx())
 }
+
+func TestPriorityNonceMempool_UnorderedTx(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	sa := accounts[0].Address
+
+	mp := mempool.DefaultPriorityMempool()
+
+	// unordered transactions of the same sender share the same sequence and
+	// timeout height, and do not conflict with each other nor with ordered ones
+	txs := []testTx{
+		{id: 0, priority: 10, nonce: 0, address: sa},
+		{id: 1, priority: 20, nonce: 0, address: sa, unordered: true, timeoutHeight: 10, signature: []byte("sig1")},
+		{id: 2, priority: 30, nonce: 0, address: sa, unordered: true, timeoutHeight: 10, signature: []byte("sig2")},
+		{id: 3, priority: 40, nonce: 0, address: sa, unordered: true, timeoutHeight: 10, signature: []byte("sig3")},
+	}
+
+	for i, tx := range txs {
+		c := ctx.WithPriority(tx.priority)
+		require.NoError(t, mp.Insert(c, tx))
+		require.Equal(t, i+1, mp.CountTx())
+	}
+
+	// inserting the same unordered transaction again is a no-op
+	require.NoError(t, mp.Insert(ctx.WithPriority(txs[1].priority), txs[1]))
+	require.Equal(t, len(txs), mp.CountTx())
+
+	selected := fetchTxs(mp.Select(ctx, nil), 1000)
+	require.Len(t, selected, len(txs))
+	require.ElementsMatch(t, []sdk.Tx{txs[0], txs[1], txs[2], txs[3]}, selected)
+
+	for i, tx := range txs {
+		require.NoError(t, mp.Remove(tx))
+		require.Equal(t, len(txs)-i-1, mp.CountTx())
+	}
+	require.ErrorIs(t, mp.Remove(txs[1]), mempool.ErrTxNotFound)
+}
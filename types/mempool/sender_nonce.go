@@ -114,7 +114,8 @@ func (snm *SenderNonceMempool) NextSenderTx(sender string) sdk.Tx {
 }
 
 // Insert adds a tx to the mempool. It returns an error if the tx does not have
-// at least one signer. Note, priority is ignored.
+// at least one signer. Note, priority is ignored. Unordered transactions are
+// indexed by a nonce derived from their signatures instead of their sequence.
 func (snm *SenderNonceMempool) Insert(_ context.Context, tx sdk.Tx) error {
 	if snm.maxTx > 0 && snm.CountTx() >= snm.maxTx {
 		return ErrMempoolTxMaxCapacity
@@ -131,9 +132,11 @@ func (snm *SenderNonceMempool) Insert(_ context.Context, tx sdk.Tx) error {
 		return fmt.Errorf("tx must have at least one signer")
 	}
 
-	sig := sigs[0]
-	sender := sdk.AccAddress(sig.PubKey.Address()).String()
-	nonce := sig.Sequence
+	sender, err := txSender(tx.(signing.SigVerifiableTx), sigs)
+	if err != nil {
+		return err
+	}
+	nonce := txNonce(tx, sigs)
 
 	senderTxs, found := snm.senders[sender]
 	if !found {
@@ -198,9 +201,11 @@ func (snm *SenderNonceMempool) Remove(tx sdk.Tx) error {
 		return fmt.Errorf("tx must have at least one signer")
 	}
 
-	sig := sigs[0]
-	sender := sdk.AccAddress(sig.PubKey.Address()).String()
-	nonce := sig.Sequence
+	sender, err := txSender(tx.(signing.SigVerifiableTx), sigs)
+	if err != nil {
+		return err
+	}
+	nonce := txNonce(tx, sigs)
 
 	senderTxs, found := snm.senders[sender]
 	if !found {
@@ -192,3 +192,34 @@ func (s *MempoolTestSuite) TestTxNotFoundOnSender() {
 	err = mp.Remove(tx)
 	require.Equal(t, mempool.ErrTxNotFound, err)
 }
+
+func (s *MempoolTestSuite) TestUnorderedTx() {
+	t := s.T()
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	mp := mempool.NewSenderNonceMempool()
+
+	// unordered transactions of the same sender share the same sequence and
+	// timeout height, and do not conflict with each other nor with ordered ones
+	txs := []testTx{
+		{id: 0, nonce: 0, address: accounts[0].Address},
+		{id: 1, nonce: 0, address: accounts[0].Address, unordered: true, timeoutHeight: 10, signature: []byte("sig1")},
+		{id: 2, nonce: 0, address: accounts[0].Address, unordered: true, timeoutHeight: 10, signature: []byte("sig2")},
+	}
+
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx, tx))
+	}
+	require.Equal(t, len(txs), mp.CountTx())
+
+	// inserting the same unordered transaction again is a no-op
+	require.NoError(t, mp.Insert(ctx, txs[2]))
+	require.Equal(t, len(txs), mp.CountTx())
+
+	require.ElementsMatch(t, []sdk.Tx{txs[0], txs[1], txs[2]}, fetchTxs(mp.Select(ctx, nil), 1000))
+
+	for _, tx := range txs {
+		require.NoError(t, mp.Remove(tx))
+	}
+	require.Equal(t, 0, mp.CountTx())
+}
@@ -0,0 +1,48 @@
+package mempool
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"io"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txsigning "github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// txNonce returns the nonce used to index a transaction by its sender, given
+// the signatures of the transaction.
+//
+// It is the sequence of the first signature for regular transactions.
+// Unordered transactions do not rely on sequences, so several of them commonly
+// share the same sequence (and timeout height). They are instead indexed by a
+// nonce derived from the hash of their signatures, which are unique per
+// transaction. This allows many unordered transactions of the same sender to
+// coexist in the mempool, while inserting the same unordered transaction twice
+// is still a no-op.
+func txNonce(tx sdk.Tx, sigs []txsigning.SignatureV2) uint64 {
+	unorderedTx, ok := tx.(sdk.TxWithUnordered)
+	if !ok || !unorderedTx.GetUnordered() {
+		return sigs[0].Sequence
+	}
+
+	h := sha256.New()
+	for _, sig := range sigs {
+		writeSignatureData(h, sig.Data)
+	}
+
+	return binary.BigEndian.Uint64(h.Sum(nil))
+}
+
+// writeSignatureData writes the raw signature bytes contained in the provided
+// signature data to w.
+func writeSignatureData(w io.Writer, data txsigning.SignatureData) {
+	switch data := data.(type) {
+	case *txsigning.SingleSignatureData:
+		_, _ = w.Write(data.Signature)
+
+	case *txsigning.MultiSignatureData:
+		for _, sig := range data.Signatures {
+			writeSignatureData(w, sig)
+		}
+	}
+}
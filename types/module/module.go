@@ -34,6 +34,7 @@ import (
 	"fmt"
 	"sort"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/genesis"
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -191,6 +192,13 @@ type HasServices interface {
 	RegisterServices(Configurator)
 }
 
+// HasCollectionsSchema is the interface for modules whose state is stored in collections,
+// it exposes the collections schema of the module store for state inspection.
+type HasCollectionsSchema interface {
+	// CollectionsSchema returns the collections schema of the module store.
+	CollectionsSchema() collections.Schema
+}
+
 // HasConsensusVersion is the interface for declaring a module consensus version.
 type HasConsensusVersion interface {
 	// ConsensusVersion is a sequence number for state-breaking change of the
@@ -721,6 +729,19 @@ func (m *Manager) GetVersionMap() VersionMap {
 	return vermap
 }
 
+// CollectionsSchemas returns the collections schemas of the modules implementing
+// HasCollectionsSchema, by module name.
+func (m *Manager) CollectionsSchemas() map[string]collections.Schema {
+	schemas := make(map[string]collections.Schema)
+	for name, mod := range m.Modules {
+		if mod, ok := mod.(HasCollectionsSchema); ok {
+			schemas[name] = mod.CollectionsSchema()
+		}
+	}
+
+	return schemas
+}
+
 // ModuleNames returns list of all module names, without any particular order.
 func (m *Manager) ModuleNames() []string {
 	return maps.Keys(m.Modules)
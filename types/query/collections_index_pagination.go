@@ -0,0 +1,153 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+)
+
+// CollectionIndex defines the minimum required API of an index of a collections.IndexedMap
+// to work with pagination. It is implemented by the indexes.Multi, indexes.MultiPair and
+// indexes.Unique types.
+type CollectionIndex[IK, PK any, I CollectionIndexIterator[IK, PK]] interface {
+	// IterateRaw allows to iterate over a raw set of byte keys of the index.
+	IterateRaw(ctx context.Context, start, end []byte, order collections.Order) (I, error)
+	// KeyCodec exposes the KeyCodec of the index, required to encode an index key from and to bytes
+	// for pagination request and response.
+	KeyCodec() collcodec.KeyCodec[IK]
+}
+
+// CollectionIndexIterator defines the minimum required API of an index iterator to work with pagination.
+type CollectionIndexIterator[IK, PK any] interface {
+	// Key returns the current key of the index.
+	Key() (IK, error)
+	// PrimaryKey returns the primary key referenced by the current key of the index.
+	PrimaryKey() (PK, error)
+	Next()
+	Valid() bool
+	Close() error
+}
+
+// CollectionIndexPaginate follows the same behaviour as CollectionPaginate but iterates over the index
+// of an indexed map, the results are the primary keys and values of the indexed map referenced by the index.
+// The keys of the PageRequest and PageResponse are the keys of the index, not the primary keys.
+//
+// Example, paginating over the balances indexed by denom of a MultiPair index, for the osmo denom only:
+//
+//	results, pageRes, err := query.CollectionIndexPaginate[
+//		collections.Pair[string, sdk.AccAddress], collections.Pair[sdk.AccAddress, string], math.Int,
+//		indexes.MultiPairIterator[string, sdk.AccAddress],
+//	](ctx, k.Balances, k.Balances.Indexes.Denom, req.Pagination, query.WithCollectionPaginationPrefix(
+//		collections.PairPrefix[string, sdk.AccAddress]("osmo"),
+//	))
+func CollectionIndexPaginate[IK, PK, V any, I CollectionIndexIterator[IK, PK], C CollectionIndex[IK, PK, I], Idx collections.Indexes[PK, V]](
+	ctx context.Context,
+	indexedMap *collections.IndexedMap[PK, V, Idx],
+	index C,
+	pageReq *PageRequest,
+	opts ...func(opt *CollectionsPaginateOptions[IK]),
+) ([]collections.KeyValue[PK, V], *PageResponse, error) {
+	return CollectionIndexFilteredPaginate[IK, PK, V, I](ctx, indexedMap, index, pageReq, nil, opts...)
+}
+
+// CollectionIndexFilteredPaginate works in the same way as CollectionFilteredPaginate but for indexes,
+// the predicateFunc is applied on the primary keys and values of the indexed map.
+// A nil predicateFunc means no filtering is applied and results are collected as is.
+func CollectionIndexFilteredPaginate[IK, PK, V any, I CollectionIndexIterator[IK, PK], C CollectionIndex[IK, PK, I], Idx collections.Indexes[PK, V]](
+	ctx context.Context,
+	indexedMap *collections.IndexedMap[PK, V, Idx],
+	index C,
+	pageReq *PageRequest,
+	predicateFunc func(key PK, value V) (include bool),
+	opts ...func(opt *CollectionsPaginateOptions[IK]),
+) ([]collections.KeyValue[PK, V], *PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &PageRequest{}
+	}
+
+	offset := pageReq.Offset
+	key := pageReq.Key
+	limit := pageReq.Limit
+	countTotal := pageReq.CountTotal
+
+	if offset > 0 && key != nil {
+		return nil, nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+
+	if limit == 0 {
+		limit = DefaultLimit
+		countTotal = true
+	}
+
+	opt := new(CollectionsPaginateOptions[IK])
+	for _, o := range opts {
+		o(opt)
+	}
+
+	var (
+		prefix []byte
+		err    error
+	)
+	if opt.Prefix != nil {
+		prefix, err = encodeKey(index.KeyCodec(), *opt.Prefix)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	results, pageRes, err := collIndexFilteredPaginate(ctx, indexedMap, index, prefix, key, pageReq.Reverse, offset, limit, countTotal, predicateFunc)
+	// invalid iter error is ignored to retain Paginate behaviour
+	if errors.Is(err, collections.ErrInvalidIterator) {
+		return results, pageRes, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	// strip the prefix from next key
+	if len(pageRes.NextKey) != 0 && prefix != nil {
+		pageRes.NextKey = pageRes.NextKey[len(prefix):]
+	}
+	return results, pageRes, nil
+}
+
+// collIndexFilteredPaginate applies the provided pagination on the records of the indexed map referenced by
+// the index. If predicateFunc is nil no filtering is applied.
+func collIndexFilteredPaginate[IK, PK, V any, I CollectionIndexIterator[IK, PK], C CollectionIndex[IK, PK, I], Idx collections.Indexes[PK, V]](
+	ctx context.Context,
+	indexedMap *collections.IndexedMap[PK, V, Idx],
+	index C,
+	prefix []byte,
+	key []byte,
+	reverse bool,
+	offset uint64,
+	limit uint64,
+	countTotal bool,
+	predicateFunc func(PK, V) bool,
+) ([]collections.KeyValue[PK, V], *PageResponse, error) {
+	start, end, order := iterRange(prefix, key, reverse)
+	iterator, err := index.IterateRaw(ctx, start, end, order)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iterator.Close()
+
+	getResult := func(iter I) (kv collections.KeyValue[PK, V], err error) {
+		pk, err := iter.PrimaryKey()
+		if err != nil {
+			return kv, err
+		}
+		value, err := indexedMap.Get(ctx, pk)
+		if err != nil {
+			return kv, err
+		}
+		return collections.KeyValue[PK, V]{Key: pk, Value: value}, nil
+	}
+
+	if len(key) != 0 {
+		return iterFilteredPaginateByKey(iterator, index.KeyCodec(), limit, getResult, predicateFunc)
+	}
+	return iterFilteredPaginateNoKey(iterator, index.KeyCodec(), offset, limit, countTotal, getResult, predicateFunc)
+}
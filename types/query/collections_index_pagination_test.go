@@ -0,0 +1,162 @@
+package query
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	"github.com/stretchr/testify/require"
+)
+
+type balanceIndexes struct {
+	Denom *indexes.MultiPair[string, string, uint64]
+}
+
+func (b balanceIndexes) IndexesList() []collections.Index[collections.Pair[string, string], uint64] {
+	return []collections.Index[collections.Pair[string, string], uint64]{b.Denom}
+}
+
+func TestCollectionIndexPagination(t *testing.T) {
+	sk, ctx := deps()
+	sb := collections.NewSchemaBuilder(sk)
+	keyCodec := collections.PairKeyCodec(collections.StringKey, collections.StringKey)
+	balances := collections.NewIndexedMap(sb, collections.NewPrefix(0), "balances", keyCodec, collections.Uint64Value, balanceIndexes{
+		Denom: indexes.NewMultiPair[uint64](sb, collections.NewPrefix(1), "balances_by_denom", keyCodec),
+	})
+
+	// address0 .. address9 hold atom, and the even addresses hold osmo too.
+	for i := uint64(0); i < 10; i++ {
+		addr := "address" + string(rune('0'+i))
+		require.NoError(t, balances.Set(ctx, collections.Join(addr, "atom"), i))
+		if i%2 == 0 {
+			require.NoError(t, balances.Set(ctx, collections.Join(addr, "osmo"), 100+i))
+		}
+	}
+
+	createResults := func(denom string, amount uint64, addrs ...uint64) []collections.KeyValue[collections.Pair[string, string], uint64] {
+		var res []collections.KeyValue[collections.Pair[string, string], uint64]
+		for _, i := range addrs {
+			res = append(res, collections.KeyValue[collections.Pair[string, string], uint64]{
+				Key:   collections.Join("address"+string(rune('0'+i)), denom),
+				Value: amount + i,
+			})
+		}
+		return res
+	}
+
+	encodeKey := func(denom string, addr uint64) []byte {
+		b, err := encodeKey(balances.Indexes.Denom.KeyCodec(), collections.Join(denom, "address"+string(rune('0'+addr))))
+		require.NoError(t, err)
+		return b
+	}
+
+	type test struct {
+		req        *PageRequest
+		denom      string
+		filter     func(key collections.Pair[string, string], value uint64) bool
+		expResp    *PageResponse
+		expResults []collections.KeyValue[collections.Pair[string, string], uint64]
+	}
+
+	tcs := map[string]test{
+		"nil pagination": {
+			req:        nil,
+			expResp:    &PageResponse{Total: 15},
+			expResults: append(createResults("atom", 0, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9), createResults("osmo", 100, 0, 2, 4, 6, 8)...),
+		},
+		"with prefix and limit": {
+			req:        &PageRequest{Limit: 3},
+			denom:      "osmo",
+			expResp:    &PageResponse{NextKey: []byte("address6")},
+			expResults: createResults("osmo", 100, 0, 2, 4),
+		},
+		"with key": {
+			req:        &PageRequest{Key: encodeKey("atom", 8), Limit: 3},
+			expResp:    &PageResponse{NextKey: encodeKey("osmo", 2)},
+			expResults: append(createResults("atom", 0, 8, 9), createResults("osmo", 100, 0)...),
+		},
+		"with offset and count total": {
+			req:        &PageRequest{Offset: 2, Limit: 2, CountTotal: true},
+			denom:      "osmo",
+			expResp:    &PageResponse{NextKey: []byte("address8"), Total: 5},
+			expResults: createResults("osmo", 100, 4, 6),
+		},
+		"with reverse": {
+			req:        &PageRequest{Limit: 2, Reverse: true},
+			denom:      "atom",
+			expResp:    &PageResponse{NextKey: []byte("address7")},
+			expResults: createResults("atom", 0, 9, 8),
+		},
+		"with reverse and key": {
+			req:        &PageRequest{Key: []byte("address7"), Limit: 2, Reverse: true},
+			denom:      "atom",
+			expResp:    &PageResponse{NextKey: []byte("address5")},
+			expResults: createResults("atom", 0, 7, 6),
+		},
+		"filtered": {
+			req:   &PageRequest{Limit: 2},
+			denom: "atom",
+			filter: func(key collections.Pair[string, string], value uint64) bool {
+				return value%3 == 0
+			},
+			expResp:    &PageResponse{NextKey: []byte("address4")},
+			expResults: createResults("atom", 0, 0, 3),
+		},
+		"unknown prefix": {
+			req:     &PageRequest{},
+			denom:   "juno",
+			expResp: nil,
+		},
+	}
+
+	for name, tc := range tcs {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			var opts []func(opt *CollectionsPaginateOptions[collections.Pair[string, string]])
+			if tc.denom != "" {
+				opts = append(opts, WithCollectionPaginationPrefix(collections.PairPrefix[string, string](tc.denom)))
+			}
+			gotResults, gotResponse, err := CollectionIndexFilteredPaginate[collections.Pair[string, string], collections.Pair[string, string], uint64, indexes.MultiPairIterator[string, string]](
+				ctx, balances, balances.Indexes.Denom, tc.req, tc.filter, opts...,
+			)
+			require.NoError(t, err)
+			require.Equal(t, tc.expResults, gotResults)
+			require.Equal(t, tc.expResp, gotResponse)
+		})
+	}
+}
+
+type accountIndexes struct {
+	Number *indexes.Unique[uint64, string, uint64]
+}
+
+func (a accountIndexes) IndexesList() []collections.Index[string, uint64] {
+	return []collections.Index[string, uint64]{a.Number}
+}
+
+func TestCollectionIndexPagination_Unique(t *testing.T) {
+	sk, ctx := deps()
+	sb := collections.NewSchemaBuilder(sk)
+	accounts := collections.NewIndexedMap(sb, collections.NewPrefix(0), "accounts", collections.StringKey, collections.Uint64Value, accountIndexes{
+		Number: indexes.NewUnique(sb, collections.NewPrefix(1), "accounts_by_number", collections.Uint64Key, collections.StringKey, func(_ string, number uint64) (uint64, error) {
+			return number, nil
+		}),
+	})
+
+	require.NoError(t, accounts.Set(ctx, "c", 0))
+	require.NoError(t, accounts.Set(ctx, "a", 1))
+	require.NoError(t, accounts.Set(ctx, "b", 2))
+
+	results, pageRes, err := CollectionIndexPaginate[uint64, string, uint64, indexes.UniqueIterator[uint64, string]](
+		ctx, accounts, accounts.Indexes.Number, &PageRequest{Limit: 2},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []collections.KeyValue[string, uint64]{{Key: "c", Value: 0}, {Key: "a", Value: 1}}, results)
+
+	results, pageRes, err = CollectionIndexPaginate[uint64, string, uint64, indexes.UniqueIterator[uint64, string]](
+		ctx, accounts, accounts.Indexes.Number, &PageRequest{Key: pageRes.NextKey},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []collections.KeyValue[string, uint64]{{Key: "b", Value: 2}}, results)
+	require.Nil(t, pageRes.NextKey)
+}
@@ -16,6 +16,14 @@ type CollectionsPaginateOptions[K any] struct {
 	Prefix *K
 }
 
+// WithCollectionPaginationPrefix applies a prefix to a collection paginated query,
+// only the keys starting with the prefix are paginated.
+func WithCollectionPaginationPrefix[K any](prefix K) func(opt *CollectionsPaginateOptions[K]) {
+	return func(opt *CollectionsPaginateOptions[K]) {
+		opt.Prefix = &prefix
+	}
+}
+
 // Collection defines the minimum required API of a collection
 // to work with pagination.
 type Collection[K, V any] interface {
@@ -115,6 +123,47 @@ func collFilteredPaginateNoKey[K, V any, C Collection[K, V]](
 		return nil, nil, err
 	}
 	defer iterator.Close()
+	return iterFilteredPaginateNoKey(iterator, coll.KeyCodec(), offset, limit, countTotal, collections.Iterator[K, V].KeyValue, predicateFunc)
+}
+
+// collFilteredPaginateByKey paginates a collection when a starting key
+// is provided in the PageRequest. Predicate is applied only if not nil.
+func collFilteredPaginateByKey[K, V any, C Collection[K, V]](
+	ctx context.Context,
+	coll C,
+	prefix []byte,
+	key []byte,
+	reverse bool,
+	limit uint64,
+	predicateFunc func(K, V) bool,
+) ([]collections.KeyValue[K, V], *PageResponse, error) {
+	iterator, err := getCollIter[K, V](ctx, coll, prefix, key, reverse)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iterator.Close()
+	return iterFilteredPaginateByKey(iterator, coll.KeyCodec(), limit, collections.Iterator[K, V].KeyValue, predicateFunc)
+}
+
+// keyIterator defines the minimum required API of an iterator to work with pagination.
+type keyIterator[K any] interface {
+	// Key returns the current key of the iterator, which is used to build the pagination next key.
+	Key() (K, error)
+	Next()
+	Valid() bool
+}
+
+// iterFilteredPaginateNoKey applies the provided pagination on the iterator results when the starting key is not set.
+// The results are fetched with getResult, and if predicateFunc is nil no filtering is applied.
+func iterFilteredPaginateNoKey[K, RK, V any, I keyIterator[K]](
+	iterator I,
+	keyCodec collcodec.KeyCodec[K],
+	offset uint64,
+	limit uint64,
+	countTotal bool,
+	getResult func(I) (collections.KeyValue[RK, V], error),
+	predicateFunc func(RK, V) bool,
+) ([]collections.KeyValue[RK, V], *PageResponse, error) {
 	// we advance the iter equal to the provided offset
 	if !advanceIter(iterator, offset) {
 		return nil, nil, collections.ErrInvalidIterator
@@ -123,14 +172,14 @@ func collFilteredPaginateNoKey[K, V any, C Collection[K, V]](
 	var (
 		count   uint64
 		nextKey []byte
-		results []collections.KeyValue[K, V]
+		results []collections.KeyValue[RK, V]
 	)
 
 	for ; iterator.Valid(); iterator.Next() {
 		switch {
 		// first case, we still haven't found all the results up to the limit
 		case count < limit:
-			kv, err := iterator.KeyValue()
+			kv, err := getResult(iterator)
 			if err != nil {
 				return nil, nil, err
 			}
@@ -149,7 +198,7 @@ func collFilteredPaginateNoKey[K, V any, C Collection[K, V]](
 			if err != nil {
 				return nil, nil, err
 			}
-			nextKey, err = encodeCollKey[K, V](coll, key)
+			nextKey, err = encodeKey(keyCodec, key)
 			if err != nil {
 				return nil, nil, err
 			}
@@ -191,27 +240,19 @@ func advanceIter[I interface {
 	return true
 }
 
-// collFilteredPaginateByKey paginates a collection when a starting key
+// iterFilteredPaginateByKey paginates the iterator results when a starting key
 // is provided in the PageRequest. Predicate is applied only if not nil.
-func collFilteredPaginateByKey[K, V any, C Collection[K, V]](
-	ctx context.Context,
-	coll C,
-	prefix []byte,
-	key []byte,
-	reverse bool,
+func iterFilteredPaginateByKey[K, RK, V any, I keyIterator[K]](
+	iterator I,
+	keyCodec collcodec.KeyCodec[K],
 	limit uint64,
-	predicateFunc func(K, V) bool,
-) ([]collections.KeyValue[K, V], *PageResponse, error) {
-	iterator, err := getCollIter[K, V](ctx, coll, prefix, key, reverse)
-	if err != nil {
-		return nil, nil, err
-	}
-	defer iterator.Close()
-
+	getResult func(I) (collections.KeyValue[RK, V], error),
+	predicateFunc func(RK, V) bool,
+) ([]collections.KeyValue[RK, V], *PageResponse, error) {
 	var (
 		count   uint64
 		nextKey []byte
-		results []collections.KeyValue[K, V]
+		results []collections.KeyValue[RK, V]
 	)
 
 	for ; iterator.Valid(); iterator.Next() {
@@ -223,14 +264,14 @@ func collFilteredPaginateByKey[K, V any, C Collection[K, V]](
 				return nil, nil, err
 			}
 
-			nextKey, err = encodeCollKey[K, V](coll, concreteKey)
+			nextKey, err = encodeKey(keyCodec, concreteKey)
 			if err != nil {
 				return nil, nil, err
 			}
 			break
 		}
 
-		kv, err := iterator.KeyValue()
+		kv, err := getResult(iterator)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -253,19 +294,45 @@ func collFilteredPaginateByKey[K, V any, C Collection[K, V]](
 
 // todo maybe move to collections?
 func encodeCollKey[K, V any, C Collection[K, V]](coll C, key K) ([]byte, error) {
-	buffer := make([]byte, coll.KeyCodec().Size(key))
-	_, err := coll.KeyCodec().Encode(buffer, key)
+	return encodeKey(coll.KeyCodec(), key)
+}
+
+func encodeKey[K any](keyCodec collcodec.KeyCodec[K], key K) ([]byte, error) {
+	buffer := make([]byte, keyCodec.Size(key))
+	_, err := keyCodec.Encode(buffer, key)
 	return buffer, err
 }
 
 func getCollIter[K, V any, C Collection[K, V]](ctx context.Context, coll C, prefix []byte, start []byte, reverse bool) (collections.Iterator[K, V], error) {
-	var end []byte
+	start, end, order := iterRange(prefix, start, reverse)
+	return coll.IterateRaw(ctx, start, end, order)
+}
+
+// iterRange returns the raw bounds and the order of the iteration over the keys of a collection
+// starting from the provided key, which is included. The keys are restricted to the ones with the
+// provided prefix, if any.
+func iterRange(prefix, key []byte, reverse bool) (start, end []byte, order collections.Order) {
+	var prefixEnd []byte
 	if prefix != nil {
-		start = append(prefix, start...)
-		end = storetypes.PrefixEndBytes(prefix)
+		prefixEnd = storetypes.PrefixEndBytes(prefix)
 	}
+	if key != nil {
+		key = append(append([]byte{}, prefix...), key...)
+	}
+
 	if reverse {
-		return coll.IterateRaw(ctx, nil, start, collections.OrderDescending)
+		// the iteration end is exclusive, the byte key which follows the
+		// starting key is used to include it.
+		end = prefixEnd
+		if key != nil {
+			end = append(key, 0)
+		}
+		return prefix, end, collections.OrderDescending
+	}
+
+	start = prefix
+	if key != nil {
+		start = key
 	}
-	return coll.IterateRaw(ctx, start, end, collections.OrderAscending)
+	return start, prefixEnd, collections.OrderAscending
 }
@@ -82,6 +82,17 @@ func TestCollectionPagination(t *testing.T) {
 			},
 			expResults: createResults(299, 200),
 		},
+		"with reverse and key": {
+			req: &PageRequest{
+				Key:     encodeKey(199),
+				Limit:   100,
+				Reverse: true,
+			},
+			expResp: &PageResponse{
+				NextKey: encodeKey(99),
+			},
+			expResults: createResults(199, 100),
+		},
 		"with offset and count total": {
 			req: &PageRequest{
 				Offset:     50,
@@ -5,8 +5,9 @@ package tx
 
 import (
 	context "context"
+	types1 "cosmossdk.io/store/types"
 	fmt "fmt"
-	types1 "github.com/cometbft/cometbft/proto/tendermint/types"
+	types2 "github.com/cometbft/cometbft/proto/tendermint/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -499,6 +500,196 @@ func (m *SimulateResponse) GetResult() *types.Result {
 	return nil
 }
 
+// SimulateBundleRequest is the request type for the Service.SimulateBundle
+// RPC method.
+type SimulateBundleRequest struct {
+	// txs_bytes are the raw transactions, simulated in order.
+	TxsBytes [][]byte `protobuf:"bytes,1,rep,name=txs_bytes,json=txsBytes,proto3" json:"txs_bytes,omitempty"`
+	// height is the height of the state the transactions are simulated on, the
+	// latest committed state is used if it is 0.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *SimulateBundleRequest) Reset()         { *m = SimulateBundleRequest{} }
+func (m *SimulateBundleRequest) String() string { return proto.CompactTextString(m) }
+func (*SimulateBundleRequest) ProtoMessage()    {}
+func (*SimulateBundleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{6}
+}
+func (m *SimulateBundleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateBundleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateBundleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateBundleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateBundleRequest.Merge(m, src)
+}
+func (m *SimulateBundleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateBundleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateBundleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateBundleRequest proto.InternalMessageInfo
+
+func (m *SimulateBundleRequest) GetTxsBytes() [][]byte {
+	if m != nil {
+		return m.TxsBytes
+	}
+	return nil
+}
+
+func (m *SimulateBundleRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// SimulateBundleResponse is the response type for the
+// Service.SimulateBundle RPC method.
+type SimulateBundleResponse struct {
+	// results are the results of the transactions, in order.
+	Results []*SimulateBundleResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// state_changes are the changes of the persistent stores resulting from the
+	// transactions, grouped by store.
+	StateChanges []*types1.StoreKVPair `protobuf:"bytes,2,rep,name=state_changes,json=stateChanges,proto3" json:"state_changes,omitempty"`
+	// height is the height of the state the transactions were simulated on.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *SimulateBundleResponse) Reset()         { *m = SimulateBundleResponse{} }
+func (m *SimulateBundleResponse) String() string { return proto.CompactTextString(m) }
+func (*SimulateBundleResponse) ProtoMessage()    {}
+func (*SimulateBundleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{7}
+}
+func (m *SimulateBundleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateBundleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateBundleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateBundleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateBundleResponse.Merge(m, src)
+}
+func (m *SimulateBundleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateBundleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateBundleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateBundleResponse proto.InternalMessageInfo
+
+func (m *SimulateBundleResponse) GetResults() []*SimulateBundleResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *SimulateBundleResponse) GetStateChanges() []*types1.StoreKVPair {
+	if m != nil {
+		return m.StateChanges
+	}
+	return nil
+}
+
+func (m *SimulateBundleResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// SimulateBundleResult is the result of the simulation of a transaction of a
+// bundle.
+type SimulateBundleResult struct {
+	// gas_info is the information about gas used in the simulation.
+	GasInfo *types.GasInfo `protobuf:"bytes,1,opt,name=gas_info,json=gasInfo,proto3" json:"gas_info,omitempty"`
+	// result is the result of the simulation, it is empty if the transaction
+	// failed.
+	Result *types.Result `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	// error is the error of the transaction, if it failed. The writes of a
+	// failed transaction are discarded, except the ones of the ante handler.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *SimulateBundleResult) Reset()         { *m = SimulateBundleResult{} }
+func (m *SimulateBundleResult) String() string { return proto.CompactTextString(m) }
+func (*SimulateBundleResult) ProtoMessage()    {}
+func (*SimulateBundleResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{8}
+}
+func (m *SimulateBundleResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateBundleResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateBundleResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateBundleResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateBundleResult.Merge(m, src)
+}
+func (m *SimulateBundleResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateBundleResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateBundleResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateBundleResult proto.InternalMessageInfo
+
+func (m *SimulateBundleResult) GetGasInfo() *types.GasInfo {
+	if m != nil {
+		return m.GasInfo
+	}
+	return nil
+}
+
+func (m *SimulateBundleResult) GetResult() *types.Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *SimulateBundleResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 // GetTxRequest is the request type for the Service.GetTx
 // RPC method.
 type GetTxRequest struct {
@@ -510,7 +701,7 @@ func (m *GetTxRequest) Reset()         { *m = GetTxRequest{} }
 func (m *GetTxRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTxRequest) ProtoMessage()    {}
 func (*GetTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{6}
+	return fileDescriptor_e0b00a618705eca7, []int{9}
 }
 func (m *GetTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -558,7 +749,7 @@ func (m *GetTxResponse) Reset()         { *m = GetTxResponse{} }
 func (m *GetTxResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTxResponse) ProtoMessage()    {}
 func (*GetTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{7}
+	return fileDescriptor_e0b00a618705eca7, []int{10}
 }
 func (m *GetTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -616,7 +807,7 @@ func (m *GetBlockWithTxsRequest) Reset()         { *m = GetBlockWithTxsRequest{}
 func (m *GetBlockWithTxsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBlockWithTxsRequest) ProtoMessage()    {}
 func (*GetBlockWithTxsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{8}
+	return fileDescriptor_e0b00a618705eca7, []int{11}
 }
 func (m *GetBlockWithTxsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -666,8 +857,8 @@ func (m *GetBlockWithTxsRequest) GetPagination() *query.PageRequest {
 type GetBlockWithTxsResponse struct {
 	// txs are the transactions in the block.
 	Txs     []*Tx           `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
-	BlockId *types1.BlockID `protobuf:"bytes,2,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
-	Block   *types1.Block   `protobuf:"bytes,3,opt,name=block,proto3" json:"block,omitempty"`
+	BlockId *types2.BlockID `protobuf:"bytes,2,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	Block   *types2.Block   `protobuf:"bytes,3,opt,name=block,proto3" json:"block,omitempty"`
 	// pagination defines a pagination for the response.
 	Pagination *query.PageResponse `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
@@ -676,7 +867,7 @@ func (m *GetBlockWithTxsResponse) Reset()         { *m = GetBlockWithTxsResponse
 func (m *GetBlockWithTxsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBlockWithTxsResponse) ProtoMessage()    {}
 func (*GetBlockWithTxsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{9}
+	return fileDescriptor_e0b00a618705eca7, []int{12}
 }
 func (m *GetBlockWithTxsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -712,14 +903,14 @@ func (m *GetBlockWithTxsResponse) GetTxs() []*Tx {
 	return nil
 }
 
-func (m *GetBlockWithTxsResponse) GetBlockId() *types1.BlockID {
+func (m *GetBlockWithTxsResponse) GetBlockId() *types2.BlockID {
 	if m != nil {
 		return m.BlockId
 	}
 	return nil
 }
 
-func (m *GetBlockWithTxsResponse) GetBlock() *types1.Block {
+func (m *GetBlockWithTxsResponse) GetBlock() *types2.Block {
 	if m != nil {
 		return m.Block
 	}
@@ -746,7 +937,7 @@ func (m *TxDecodeRequest) Reset()         { *m = TxDecodeRequest{} }
 func (m *TxDecodeRequest) String() string { return proto.CompactTextString(m) }
 func (*TxDecodeRequest) ProtoMessage()    {}
 func (*TxDecodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{10}
+	return fileDescriptor_e0b00a618705eca7, []int{13}
 }
 func (m *TxDecodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -795,7 +986,7 @@ func (m *TxDecodeResponse) Reset()         { *m = TxDecodeResponse{} }
 func (m *TxDecodeResponse) String() string { return proto.CompactTextString(m) }
 func (*TxDecodeResponse) ProtoMessage()    {}
 func (*TxDecodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{11}
+	return fileDescriptor_e0b00a618705eca7, []int{14}
 }
 func (m *TxDecodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -844,7 +1035,7 @@ func (m *TxEncodeRequest) Reset()         { *m = TxEncodeRequest{} }
 func (m *TxEncodeRequest) String() string { return proto.CompactTextString(m) }
 func (*TxEncodeRequest) ProtoMessage()    {}
 func (*TxEncodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{12}
+	return fileDescriptor_e0b00a618705eca7, []int{15}
 }
 func (m *TxEncodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -893,7 +1084,7 @@ func (m *TxEncodeResponse) Reset()         { *m = TxEncodeResponse{} }
 func (m *TxEncodeResponse) String() string { return proto.CompactTextString(m) }
 func (*TxEncodeResponse) ProtoMessage()    {}
 func (*TxEncodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{13}
+	return fileDescriptor_e0b00a618705eca7, []int{16}
 }
 func (m *TxEncodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -941,7 +1132,7 @@ func (m *TxEncodeAminoRequest) Reset()         { *m = TxEncodeAminoRequest{} }
 func (m *TxEncodeAminoRequest) String() string { return proto.CompactTextString(m) }
 func (*TxEncodeAminoRequest) ProtoMessage()    {}
 func (*TxEncodeAminoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{14}
+	return fileDescriptor_e0b00a618705eca7, []int{17}
 }
 func (m *TxEncodeAminoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -989,7 +1180,7 @@ func (m *TxEncodeAminoResponse) Reset()         { *m = TxEncodeAminoResponse{} }
 func (m *TxEncodeAminoResponse) String() string { return proto.CompactTextString(m) }
 func (*TxEncodeAminoResponse) ProtoMessage()    {}
 func (*TxEncodeAminoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{15}
+	return fileDescriptor_e0b00a618705eca7, []int{18}
 }
 func (m *TxEncodeAminoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1037,7 +1228,7 @@ func (m *TxDecodeAminoRequest) Reset()         { *m = TxDecodeAminoRequest{} }
 func (m *TxDecodeAminoRequest) String() string { return proto.CompactTextString(m) }
 func (*TxDecodeAminoRequest) ProtoMessage()    {}
 func (*TxDecodeAminoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{16}
+	return fileDescriptor_e0b00a618705eca7, []int{19}
 }
 func (m *TxDecodeAminoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1085,7 +1276,7 @@ func (m *TxDecodeAminoResponse) Reset()         { *m = TxDecodeAminoResponse{} }
 func (m *TxDecodeAminoResponse) String() string { return proto.CompactTextString(m) }
 func (*TxDecodeAminoResponse) ProtoMessage()    {}
 func (*TxDecodeAminoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{17}
+	return fileDescriptor_e0b00a618705eca7, []int{20}
 }
 func (m *TxDecodeAminoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1130,6 +1321,9 @@ func init() {
 	proto.RegisterType((*BroadcastTxResponse)(nil), "cosmos.tx.v1beta1.BroadcastTxResponse")
 	proto.RegisterType((*SimulateRequest)(nil), "cosmos.tx.v1beta1.SimulateRequest")
 	proto.RegisterType((*SimulateResponse)(nil), "cosmos.tx.v1beta1.SimulateResponse")
+	proto.RegisterType((*SimulateBundleRequest)(nil), "cosmos.tx.v1beta1.SimulateBundleRequest")
+	proto.RegisterType((*SimulateBundleResponse)(nil), "cosmos.tx.v1beta1.SimulateBundleResponse")
+	proto.RegisterType((*SimulateBundleResult)(nil), "cosmos.tx.v1beta1.SimulateBundleResult")
 	proto.RegisterType((*GetTxRequest)(nil), "cosmos.tx.v1beta1.GetTxRequest")
 	proto.RegisterType((*GetTxResponse)(nil), "cosmos.tx.v1beta1.GetTxResponse")
 	proto.RegisterType((*GetBlockWithTxsRequest)(nil), "cosmos.tx.v1beta1.GetBlockWithTxsRequest")
@@ -1147,85 +1341,95 @@ func init() {
 func init() { proto.RegisterFile("cosmos/tx/v1beta1/service.proto", fileDescriptor_e0b00a618705eca7) }
 
 var fileDescriptor_e0b00a618705eca7 = []byte{
-	// 1237 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4d, 0x4f, 0x1b, 0x47,
-	0x18, 0x66, 0x6d, 0x83, 0xcd, 0x6b, 0x08, 0xce, 0x40, 0xc0, 0x38, 0xc4, 0x98, 0x0d, 0x1f, 0x8e,
-	0x55, 0xbc, 0x0a, 0x4d, 0xaa, 0x24, 0xaa, 0x54, 0xe1, 0x8f, 0x50, 0x92, 0x26, 0x44, 0x6b, 0xaa,
-	0x28, 0x55, 0x25, 0x6b, 0x6d, 0x4f, 0xec, 0x6d, 0xec, 0x5d, 0xe3, 0x19, 0xd0, 0x5a, 0x14, 0xb5,
-	0xea, 0xb1, 0x87, 0xaa, 0x52, 0x0f, 0xfd, 0x0f, 0xfd, 0x25, 0x3d, 0x46, 0xea, 0xa5, 0xc7, 0x0a,
-	0x7a, 0xea, 0xa9, 0x52, 0xff, 0x40, 0xb5, 0x33, 0xb3, 0xb6, 0xd7, 0xde, 0xc5, 0x90, 0x0b, 0xcc,
-	0xc7, 0xf3, 0xbe, 0xcf, 0x33, 0xef, 0xcc, 0x3c, 0x3b, 0x86, 0xd5, 0xaa, 0x49, 0x5a, 0x26, 0x51,
-	0xa8, 0xa5, 0x9c, 0xdc, 0xaf, 0x60, 0xaa, 0xdd, 0x57, 0x08, 0xee, 0x9c, 0xe8, 0x55, 0x9c, 0x6d,
-	0x77, 0x4c, 0x6a, 0xa2, 0x9b, 0x1c, 0x90, 0xa5, 0x56, 0x56, 0x00, 0x12, 0x2b, 0x75, 0xd3, 0xac,
-	0x37, 0xb1, 0xa2, 0xb5, 0x75, 0x45, 0x33, 0x0c, 0x93, 0x6a, 0x54, 0x37, 0x0d, 0xc2, 0x03, 0x12,
-	0x77, 0x45, 0xc6, 0x8a, 0x46, 0xb0, 0xa2, 0x55, 0xaa, 0x7a, 0x2f, 0xb1, 0xdd, 0x11, 0xa0, 0xc4,
-	0x28, 0x2d, 0xb5, 0xc4, 0x5c, 0x66, 0x30, 0xc1, 0xd1, 0x31, 0xee, 0x74, 0x7b, 0x98, 0xb6, 0x56,
-	0xd7, 0x0d, 0xc6, 0x26, 0xb0, 0x2b, 0x14, 0x1b, 0x35, 0xdc, 0x69, 0xe9, 0x06, 0x55, 0x68, 0xb7,
-	0x8d, 0x89, 0x52, 0x69, 0x9a, 0xd5, 0x77, 0xbe, 0xb3, 0xec, 0x2f, 0x9f, 0x95, 0xff, 0x93, 0x00,
-	0xed, 0x61, 0x7a, 0x68, 0x91, 0xe2, 0x09, 0x36, 0xa8, 0x8a, 0x8f, 0x8e, 0x31, 0xa1, 0x28, 0x01,
-	0x53, 0xd8, 0xee, 0x93, 0xb8, 0x94, 0x0a, 0xa6, 0xa7, 0x73, 0x81, 0xb8, 0xa4, 0x8a, 0x11, 0xf4,
-	0x0c, 0xa0, 0x2f, 0x21, 0x1e, 0x48, 0x49, 0xe9, 0xe8, 0xce, 0x66, 0x56, 0x54, 0xc8, 0xd6, 0x9b,
-	0x65, 0x7a, 0x9d, 0x4a, 0x65, 0x5f, 0x69, 0x75, 0x2c, 0xf2, 0xb2, 0x3c, 0x03, 0xd1, 0xe8, 0x21,
-	0x44, 0xcc, 0x4e, 0x0d, 0x77, 0xca, 0x95, 0x6e, 0x3c, 0x98, 0x92, 0xd2, 0x37, 0x76, 0x12, 0xd9,
-	0x91, 0x5a, 0x67, 0x0f, 0x6c, 0x48, 0xae, 0xab, 0x86, 0x4d, 0xde, 0x40, 0x08, 0x42, 0x6d, 0xad,
-	0x8e, 0xe3, 0xa1, 0x94, 0x94, 0x0e, 0xa9, 0xac, 0x8d, 0x16, 0x60, 0xb2, 0xa9, 0xb7, 0x74, 0x1a,
-	0x9f, 0x64, 0x83, 0xbc, 0x63, 0x8f, 0x32, 0x35, 0xf1, 0xa9, 0x94, 0x94, 0x9e, 0x56, 0x79, 0x47,
-	0xfe, 0x47, 0x82, 0x79, 0xd7, 0xaa, 0x49, 0xdb, 0x34, 0x08, 0x46, 0x5b, 0x10, 0xa4, 0x16, 0x5f,
-	0x73, 0x74, 0xe7, 0x96, 0x87, 0x92, 0x43, 0x4b, 0xb5, 0x11, 0x68, 0x0f, 0x66, 0xa8, 0x55, 0xee,
-	0x88, 0x38, 0x12, 0x0f, 0xb0, 0x88, 0x75, 0x57, 0x15, 0xd8, 0x4e, 0x0f, 0x04, 0x0a, 0xb0, 0x1a,
-	0xa5, 0xbd, 0x36, 0x41, 0xcf, 0x5d, 0xc5, 0x0c, 0xb2, 0x62, 0x6e, 0x8d, 0x2d, 0x26, 0x8f, 0x1e,
-	0xa9, 0xe6, 0x02, 0x4c, 0x52, 0x93, 0x6a, 0x4d, 0x51, 0x17, 0xde, 0x91, 0x31, 0xa0, 0x5c, 0xc7,
-	0xd4, 0x6a, 0x55, 0x8d, 0x50, 0x5b, 0x06, 0xdf, 0xe1, 0x65, 0x88, 0x50, 0xab, 0x5c, 0xe9, 0x52,
-	0x6c, 0xaf, 0x57, 0x4a, 0xcf, 0xa8, 0x61, 0x6a, 0xe5, 0xec, 0x2e, 0x7a, 0x00, 0xa1, 0x96, 0x59,
-	0xc3, 0x6c, 0x6b, 0x6f, 0xec, 0xa4, 0x3c, 0xca, 0xd0, 0xcb, 0xf7, 0xc2, 0xac, 0x61, 0x95, 0xa1,
-	0xe5, 0xaf, 0x61, 0xde, 0x45, 0x23, 0x4a, 0x5a, 0x84, 0xe8, 0x40, 0xa5, 0x18, 0xd5, 0x55, 0x0b,
-	0x05, 0xfd, 0x42, 0xc9, 0xaf, 0x61, 0xae, 0xa4, 0xb7, 0x8e, 0x9b, 0x1a, 0x75, 0xce, 0x12, 0xba,
-	0x07, 0x01, 0x6a, 0x89, 0x84, 0xde, 0x7b, 0xc5, 0x0a, 0x14, 0xa0, 0x96, 0x6b, 0xb1, 0x01, 0xd7,
-	0x62, 0xe5, 0x1f, 0x25, 0x88, 0xf5, 0x33, 0x0b, 0xd1, 0x9f, 0x42, 0xa4, 0xae, 0x91, 0xb2, 0x6e,
-	0xbc, 0x35, 0x05, 0xc1, 0x9a, 0xbf, 0xe2, 0x3d, 0x8d, 0xec, 0x1b, 0x6f, 0x4d, 0x35, 0x5c, 0xe7,
-	0x0d, 0xf4, 0x08, 0xa6, 0x3a, 0x98, 0x1c, 0x37, 0xa9, 0xb8, 0x1c, 0x29, 0xff, 0x58, 0x95, 0xe1,
-	0x54, 0x81, 0x97, 0x65, 0x98, 0x61, 0xc7, 0xd2, 0x59, 0x22, 0x82, 0x50, 0x43, 0x23, 0x0d, 0xa6,
-	0x61, 0x5a, 0x65, 0x6d, 0xf9, 0x0c, 0x66, 0x05, 0x46, 0x88, 0xdd, 0x18, 0x5b, 0x07, 0x56, 0x83,
-	0xa1, 0x8d, 0x08, 0x7c, 0xe0, 0x46, 0x58, 0xb0, 0xb8, 0x87, 0x69, 0xce, 0x36, 0x98, 0xd7, 0x3a,
-	0x6d, 0x1c, 0x5a, 0xc4, 0x11, 0xbb, 0x08, 0x53, 0x0d, 0xac, 0xd7, 0x1b, 0x94, 0x69, 0x09, 0xaa,
-	0xa2, 0x87, 0x9e, 0x7e, 0xb8, 0x5f, 0x0c, 0x9e, 0x6e, 0xf9, 0x5f, 0x09, 0x96, 0x46, 0xa8, 0xaf,
-	0x7b, 0x71, 0x1f, 0x40, 0x84, 0x99, 0x63, 0x59, 0xaf, 0x09, 0x29, 0xcb, 0xd9, 0xbe, 0x41, 0x66,
-	0xb9, 0x35, 0x32, 0x8a, 0xfd, 0x82, 0x1a, 0x66, 0xd0, 0xfd, 0x1a, 0xda, 0x86, 0x49, 0xd6, 0x14,
-	0x17, 0x74, 0xc9, 0x27, 0x44, 0xe5, 0x28, 0xb4, 0xe7, 0x5a, 0x71, 0xe8, 0x5a, 0x97, 0xda, 0xb5,
-	0xe4, 0x8f, 0x60, 0xee, 0xd0, 0x2a, 0xe0, 0xaa, 0x7d, 0xcb, 0xc6, 0xde, 0x5b, 0xf9, 0x31, 0xc4,
-	0xfa, 0xe8, 0x6b, 0x1d, 0x0e, 0xf9, 0x91, 0x4d, 0x54, 0x34, 0x06, 0x89, 0xae, 0x18, 0xb9, 0x6d,
-	0x93, 0x3a, 0x91, 0x82, 0xf4, 0x12, 0x8d, 0x0f, 0x61, 0xc1, 0x81, 0xef, 0xb6, 0x74, 0xc3, 0x74,
-	0xd8, 0xee, 0x00, 0x68, 0x76, 0xbf, 0xfc, 0x0d, 0x31, 0x0d, 0x71, 0xde, 0xa7, 0xd9, 0xc8, 0x33,
-	0x62, 0x1a, 0xf2, 0x13, 0xb8, 0x35, 0x14, 0x26, 0xa8, 0xd6, 0x60, 0x86, 0xc7, 0x55, 0x74, 0x43,
-	0xeb, 0x74, 0x05, 0x5d, 0x94, 0x8d, 0xe5, 0xd8, 0x90, 0xfc, 0xd8, 0xa6, 0xe4, 0x65, 0x71, 0x51,
-	0x5e, 0x21, 0xf4, 0x13, 0x9b, 0xd6, 0x15, 0x2a, 0x68, 0x2f, 0x97, 0x9b, 0xf9, 0x1c, 0xc2, 0xe2,
-	0x9b, 0x85, 0xe2, 0xb0, 0x70, 0xa0, 0x16, 0x8a, 0x6a, 0x39, 0xf7, 0xa6, 0xfc, 0xe5, 0xcb, 0xd2,
-	0xab, 0x62, 0x7e, 0xff, 0xe9, 0x7e, 0xb1, 0x10, 0x9b, 0x40, 0x31, 0x98, 0xe9, 0xcd, 0xec, 0x96,
-	0xf2, 0x31, 0x09, 0xdd, 0x84, 0xd9, 0xde, 0x48, 0xa1, 0x58, 0xca, 0xc7, 0x02, 0x99, 0xef, 0x25,
-	0x98, 0x75, 0xb9, 0x2d, 0x4a, 0x42, 0x22, 0xa7, 0x1e, 0xec, 0x16, 0xf2, 0xbb, 0xa5, 0xc3, 0xf2,
-	0x8b, 0x83, 0x42, 0x71, 0x28, 0xed, 0x0a, 0x2c, 0x0c, 0xcd, 0xe7, 0xbe, 0x38, 0xc8, 0x3f, 0x8f,
-	0x49, 0x89, 0x40, 0x44, 0x42, 0x4b, 0x30, 0x3f, 0x34, 0x5b, 0x7a, 0xf3, 0x32, 0x1f, 0x0b, 0xd8,
-	0x3a, 0x87, 0x26, 0x76, 0xd9, 0x4c, 0x70, 0xe7, 0xb7, 0x69, 0x08, 0x97, 0xf8, 0x73, 0x08, 0x9d,
-	0x42, 0xc4, 0x31, 0x4b, 0x24, 0x7b, 0x1c, 0x8a, 0x21, 0x8f, 0x4e, 0xdc, 0xbd, 0x14, 0x23, 0x2c,
-	0x65, 0xf3, 0x87, 0x3f, 0xfe, 0xfe, 0x25, 0x90, 0x7a, 0x22, 0x65, 0xe4, 0xdb, 0x8a, 0xc7, 0x53,
-	0xcc, 0x21, 0x3c, 0x82, 0x49, 0xe6, 0x7c, 0x68, 0xd5, 0x23, 0xeb, 0xa0, 0x6f, 0x26, 0x52, 0xfe,
-	0x00, 0xc1, 0xb9, 0xc1, 0x38, 0x57, 0xd1, 0x1d, 0xc5, 0xeb, 0x11, 0x46, 0x94, 0x53, 0xdb, 0x6b,
-	0xcf, 0xd0, 0x77, 0x10, 0x1d, 0xf8, 0xa8, 0xa1, 0x8d, 0xcb, 0xbe, 0x85, 0x7d, 0xfa, 0xcd, 0x71,
-	0x30, 0x21, 0x62, 0x8d, 0x89, 0xb8, 0x6d, 0x2f, 0x7c, 0xd1, 0x5b, 0x07, 0xfa, 0x16, 0xa2, 0x03,
-	0x0f, 0x15, 0x4f, 0x01, 0xa3, 0xcf, 0x37, 0x4f, 0x01, 0x1e, 0xef, 0x1d, 0x39, 0xc9, 0x04, 0xc4,
-	0x91, 0x1f, 0xfb, 0xaf, 0x12, 0xcc, 0x0d, 0x59, 0x2e, 0xba, 0xe7, 0x9d, 0xdb, 0xe3, 0x8b, 0x90,
-	0xc8, 0x5c, 0x05, 0x2a, 0xa4, 0x6c, 0x33, 0x29, 0x5b, 0x68, 0xc3, 0x67, 0x43, 0x98, 0xb3, 0x2a,
-	0xa7, 0xfc, 0x9b, 0x72, 0x86, 0xba, 0x10, 0x71, 0x6e, 0xa6, 0xe7, 0x41, 0x1c, 0xb2, 0x4d, 0xcf,
-	0x83, 0x38, 0x6c, 0x96, 0xf2, 0x3a, 0xd3, 0x90, 0xb4, 0xf7, 0x63, 0xd9, 0x43, 0x46, 0x8d, 0xd3,
-	0x31, 0x6a, 0xee, 0x45, 0x3e, 0xd4, 0x2e, 0x23, 0xf5, 0xa1, 0x76, 0x5b, 0xe6, 0x38, 0x6a, 0xcc,
-	0xe9, 0x7e, 0x92, 0x60, 0xd6, 0xe5, 0x83, 0x68, 0xeb, 0x92, 0xe4, 0x83, 0x6e, 0x97, 0x48, 0x8f,
-	0x07, 0x0a, 0x29, 0x19, 0x26, 0x65, 0xdd, 0x96, 0xb2, 0xea, 0x2b, 0x45, 0x61, 0x66, 0x27, 0x04,
-	0x0d, 0x38, 0xa4, 0x8f, 0xa0, 0x51, 0xfb, 0xf5, 0x11, 0xe4, 0x61, 0xb6, 0xe3, 0x04, 0xf1, 0x6d,
-	0xe1, 0x82, 0x72, 0x9f, 0xfd, 0x7e, 0x9e, 0x94, 0xde, 0x9f, 0x27, 0xa5, 0xbf, 0xce, 0x93, 0xd2,
-	0xcf, 0x17, 0xc9, 0x89, 0xf7, 0x17, 0xc9, 0x89, 0x3f, 0x2f, 0x92, 0x13, 0x5f, 0x6d, 0xd4, 0x75,
-	0xda, 0x38, 0xae, 0x64, 0xab, 0x66, 0xcb, 0x49, 0xc2, 0xff, 0x6d, 0x93, 0xda, 0x3b, 0xe7, 0x97,
-	0x91, 0x55, 0x99, 0x62, 0xbf, 0x8b, 0x3e, 0xfe, 0x3f, 0x00, 0x00, 0xff, 0xff, 0x42, 0xa8, 0xd7,
-	0x97, 0x14, 0x0e, 0x00, 0x00,
+	// 1399 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xd8, 0x49, 0xec, 0x3c, 0x3b, 0xad, 0x3b, 0x75, 0x52, 0x77, 0xdb, 0x3a, 0xee, 0x36,
+	0x1f, 0xae, 0x45, 0xbc, 0x6a, 0x68, 0x51, 0x5b, 0x21, 0xa1, 0xf8, 0xa3, 0x21, 0xfd, 0x4a, 0xb5,
+	0x0e, 0x54, 0x45, 0x48, 0xd6, 0xda, 0x9e, 0xda, 0x4b, 0xed, 0xdd, 0x74, 0x67, 0x52, 0xad, 0x55,
+	0x2a, 0x10, 0x47, 0x0e, 0x08, 0x09, 0x24, 0xfe, 0x01, 0xfe, 0x0b, 0x4e, 0xdc, 0x38, 0x56, 0xe2,
+	0xc2, 0x11, 0xb5, 0x9c, 0x38, 0x21, 0xf1, 0x0f, 0xa0, 0x9d, 0x9d, 0xb5, 0xbd, 0xf6, 0xfa, 0x23,
+	0xbd, 0x70, 0xb1, 0xe7, 0xe3, 0xf7, 0xde, 0xef, 0xf7, 0xde, 0xcc, 0xbc, 0x99, 0x85, 0xb5, 0xba,
+	0x49, 0x3b, 0x26, 0x55, 0x98, 0xad, 0xbc, 0xb8, 0x56, 0x23, 0x4c, 0xbb, 0xa6, 0x50, 0x62, 0xbd,
+	0xd0, 0xeb, 0x24, 0x7f, 0x64, 0x99, 0xcc, 0xc4, 0x67, 0x5c, 0x40, 0x9e, 0xd9, 0x79, 0x01, 0x90,
+	0x2e, 0x36, 0x4d, 0xb3, 0xd9, 0x26, 0x8a, 0x76, 0xa4, 0x2b, 0x9a, 0x61, 0x98, 0x4c, 0x63, 0xba,
+	0x69, 0x50, 0xd7, 0x40, 0xba, 0x22, 0x3c, 0xd6, 0x34, 0x4a, 0x14, 0xad, 0x56, 0xd7, 0x7b, 0x8e,
+	0x9d, 0x8e, 0x00, 0x49, 0xa3, 0xb4, 0xcc, 0x16, 0x73, 0xb9, 0x41, 0x07, 0xcf, 0x8f, 0x89, 0xd5,
+	0xed, 0x61, 0x8e, 0xb4, 0xa6, 0x6e, 0x70, 0x36, 0x81, 0x5d, 0x17, 0x58, 0xca, 0x4c, 0x8b, 0xf4,
+	0x60, 0x6d, 0x9d, 0x32, 0x62, 0xe8, 0x46, 0x53, 0xa0, 0x2e, 0x32, 0x62, 0x34, 0x88, 0xd5, 0xd1,
+	0x0d, 0xa6, 0xb0, 0xee, 0x11, 0xa1, 0x4a, 0xad, 0x6d, 0xd6, 0x9f, 0x8d, 0x9d, 0xe5, 0xbf, 0xee,
+	0xac, 0xfc, 0x2f, 0x02, 0xbc, 0x47, 0xd8, 0xa1, 0x4d, 0xcb, 0x2f, 0x88, 0xc1, 0x54, 0xf2, 0xfc,
+	0x98, 0x50, 0x86, 0x25, 0x58, 0x24, 0x4e, 0x9f, 0xa6, 0x50, 0x26, 0x9c, 0x5d, 0x2a, 0x84, 0x52,
+	0x48, 0x15, 0x23, 0xf8, 0x2e, 0x40, 0x5f, 0x68, 0x2a, 0x94, 0x41, 0xd9, 0xd8, 0xce, 0x66, 0x5e,
+	0xe4, 0xd1, 0x89, 0x2a, 0xcf, 0xa3, 0xf2, 0xf2, 0x99, 0x7f, 0xa4, 0x35, 0x89, 0xf0, 0xcb, 0xfd,
+	0x0c, 0x58, 0xe3, 0x1b, 0x10, 0x35, 0xad, 0x06, 0xb1, 0xaa, 0xb5, 0x6e, 0x2a, 0x9c, 0x41, 0xd9,
+	0x53, 0x3b, 0x52, 0x7e, 0x64, 0x45, 0xf2, 0x07, 0x0e, 0xa4, 0xd0, 0x55, 0x23, 0xa6, 0xdb, 0xc0,
+	0x18, 0xe6, 0x8f, 0xb4, 0x26, 0x49, 0xcd, 0x67, 0x50, 0x76, 0x5e, 0xe5, 0x6d, 0x9c, 0x84, 0x85,
+	0xb6, 0xde, 0xd1, 0x59, 0x6a, 0x81, 0x0f, 0xba, 0x1d, 0x67, 0x94, 0xab, 0x49, 0x2d, 0x66, 0x50,
+	0x76, 0x49, 0x75, 0x3b, 0xf2, 0xdf, 0x08, 0xce, 0xfa, 0xa2, 0xa6, 0x47, 0xa6, 0x41, 0x09, 0xde,
+	0x82, 0x30, 0xb3, 0xdd, 0x98, 0x63, 0x3b, 0x2b, 0x01, 0x4a, 0x0e, 0x6d, 0xd5, 0x41, 0xe0, 0x3d,
+	0x88, 0x33, 0xbb, 0x6a, 0x09, 0x3b, 0x9a, 0x0a, 0x71, 0x8b, 0x75, 0x5f, 0x16, 0xf8, 0x7e, 0x18,
+	0x30, 0x14, 0x60, 0x35, 0xc6, 0x7a, 0x6d, 0x8a, 0xef, 0xf9, 0x92, 0x19, 0xe6, 0xc9, 0xdc, 0x9a,
+	0x9a, 0x4c, 0xd7, 0x7a, 0x24, 0x9b, 0x49, 0x58, 0x60, 0x26, 0xd3, 0xda, 0x22, 0x2f, 0x6e, 0x47,
+	0x26, 0x80, 0x0b, 0x96, 0xa9, 0x35, 0xea, 0x1a, 0x65, 0x87, 0xb6, 0x58, 0x09, 0x7c, 0x1e, 0xa2,
+	0xcc, 0xae, 0xd6, 0xba, 0x8c, 0x38, 0xf1, 0xa2, 0x6c, 0x5c, 0x8d, 0x30, 0xbb, 0xe0, 0x74, 0xf1,
+	0x75, 0x98, 0xef, 0x98, 0x0d, 0xc2, 0x97, 0xf6, 0xd4, 0x4e, 0x26, 0x20, 0x0d, 0x3d, 0x7f, 0x0f,
+	0xcc, 0x06, 0x51, 0x39, 0x5a, 0xfe, 0x1c, 0xce, 0xfa, 0x68, 0x44, 0x4a, 0xcb, 0x10, 0x1b, 0xc8,
+	0x14, 0xa7, 0x9a, 0x35, 0x51, 0xd0, 0x4f, 0x94, 0xfc, 0x18, 0x4e, 0x57, 0xf4, 0xce, 0x71, 0x5b,
+	0x63, 0xde, 0x5e, 0xc2, 0x57, 0x21, 0xc4, 0x6c, 0xe1, 0x30, 0x78, 0xad, 0x78, 0x82, 0x42, 0xcc,
+	0xf6, 0x05, 0x1b, 0xf2, 0x05, 0x2b, 0x7f, 0x8b, 0x20, 0xd1, 0xf7, 0x2c, 0x44, 0x7f, 0x08, 0xd1,
+	0xa6, 0x46, 0xab, 0xba, 0xf1, 0xd4, 0x14, 0x04, 0x97, 0xc7, 0x2b, 0xde, 0xd3, 0xe8, 0xbe, 0xf1,
+	0xd4, 0x54, 0x23, 0x4d, 0xb7, 0x81, 0x6f, 0xc2, 0xa2, 0x45, 0xe8, 0x71, 0x9b, 0x89, 0xc3, 0x91,
+	0x19, 0x6f, 0xab, 0x72, 0x9c, 0x2a, 0xf0, 0xf2, 0x7d, 0x58, 0xf1, 0xb4, 0x14, 0x8e, 0x8d, 0x46,
+	0xbb, 0x17, 0xeb, 0x05, 0x58, 0x62, 0x36, 0xed, 0x2d, 0x57, 0x38, 0x1b, 0x57, 0xa3, 0xcc, 0xa6,
+	0xee, 0x7a, 0xad, 0xc2, 0x62, 0x8b, 0xe8, 0xcd, 0x96, 0xcb, 0x17, 0x56, 0x45, 0x4f, 0xfe, 0x05,
+	0xc1, 0xea, 0xb0, 0x3b, 0x11, 0xe0, 0x2e, 0x44, 0x5c, 0x4a, 0x6f, 0xb3, 0x6f, 0x05, 0x24, 0x70,
+	0xc4, 0xd6, 0x91, 0xea, 0xd9, 0xe1, 0x3b, 0xb0, 0x4c, 0x99, 0xc6, 0x48, 0xb5, 0xde, 0xd2, 0x8c,
+	0x66, 0xef, 0x0c, 0xf4, 0x12, 0xc5, 0x6b, 0x56, 0xdf, 0x97, 0xd3, 0xbb, 0xf7, 0xe9, 0x23, 0x4d,
+	0xb7, 0xd4, 0x38, 0xb7, 0x2b, 0xba, 0x66, 0x03, 0xea, 0xc3, 0x3e, 0xf5, 0x3f, 0x23, 0x48, 0x06,
+	0x29, 0xf8, 0xbf, 0x16, 0xc7, 0x39, 0x5d, 0xc4, 0xb2, 0x4c, 0x8b, 0xeb, 0x5c, 0x52, 0xdd, 0x8e,
+	0x2c, 0x43, 0x9c, 0x57, 0x12, 0x6f, 0xa5, 0x30, 0xcc, 0xb7, 0x34, 0xda, 0xe2, 0xca, 0x96, 0x54,
+	0xde, 0x96, 0x5f, 0xc1, 0xb2, 0xc0, 0x88, 0xf4, 0x6f, 0x4c, 0xdd, 0xba, 0x7c, 0xdb, 0x0e, 0x9d,
+	0x9d, 0xd0, 0x3b, 0x9e, 0x1d, 0x1b, 0x56, 0xf7, 0x08, 0x2b, 0x38, 0x77, 0xc2, 0x63, 0x9d, 0xb5,
+	0x0e, 0x6d, 0xea, 0x89, 0xed, 0xe7, 0x1e, 0x0d, 0xe6, 0x1e, 0xdf, 0x79, 0xf7, 0x12, 0x3f, 0x58,
+	0x90, 0xe4, 0x7f, 0x10, 0x9c, 0x1b, 0xa1, 0x3e, 0x69, 0xad, 0xbd, 0x0e, 0x51, 0x7e, 0x9f, 0x55,
+	0xf5, 0x86, 0x90, 0x72, 0x3e, 0xdf, 0xbf, 0xd3, 0xf2, 0xee, 0x6d, 0xc6, 0x29, 0xf6, 0x4b, 0x6a,
+	0x84, 0x43, 0xf7, 0x1b, 0x78, 0x1b, 0x16, 0x78, 0x53, 0xd4, 0xd4, 0x73, 0x63, 0x4c, 0x54, 0x17,
+	0x85, 0xf7, 0x7c, 0x11, 0xcf, 0x9f, 0xa8, 0x0e, 0xfb, 0x42, 0x7e, 0x0f, 0x4e, 0x1f, 0xda, 0x25,
+	0x52, 0x37, 0x1b, 0x5e, 0x46, 0x26, 0x94, 0x5a, 0xf9, 0x16, 0x24, 0xfa, 0xe8, 0x13, 0x6d, 0x0e,
+	0xf9, 0xa6, 0x43, 0x54, 0x36, 0x06, 0x89, 0x66, 0xb4, 0xdc, 0x86, 0x44, 0xdf, 0x52, 0x90, 0x4e,
+	0xd0, 0x78, 0x03, 0x92, 0x1e, 0x7c, 0xb7, 0xa3, 0x1b, 0xa6, 0xc7, 0x76, 0x09, 0x40, 0x73, 0xfa,
+	0xd5, 0x2f, 0xa8, 0x69, 0x88, 0xfd, 0xbe, 0xc4, 0x47, 0xee, 0x52, 0xd3, 0x90, 0x6f, 0xc3, 0xca,
+	0x90, 0x99, 0xa0, 0xba, 0x0c, 0x71, 0xd7, 0xae, 0xa6, 0x1b, 0x9a, 0xd5, 0x15, 0x74, 0x31, 0x3e,
+	0x56, 0xe0, 0x43, 0xf2, 0x2d, 0x48, 0x7a, 0x69, 0xf1, 0x51, 0xce, 0x60, 0xfa, 0x01, 0xac, 0x0c,
+	0x99, 0x0a, 0xda, 0xc9, 0x72, 0x73, 0x1f, 0x43, 0x44, 0x3c, 0x33, 0x70, 0x0a, 0x92, 0x07, 0x6a,
+	0xa9, 0xac, 0x56, 0x0b, 0x4f, 0xaa, 0x9f, 0x3c, 0xac, 0x3c, 0x2a, 0x17, 0xf7, 0xef, 0xec, 0x97,
+	0x4b, 0x89, 0x39, 0x9c, 0x80, 0x78, 0x6f, 0x66, 0xb7, 0x52, 0x4c, 0x20, 0x7c, 0x06, 0x96, 0x7b,
+	0x23, 0xa5, 0x72, 0xa5, 0x98, 0x08, 0xe5, 0xbe, 0x46, 0xb0, 0xec, 0xbb, 0x20, 0x71, 0x1a, 0xa4,
+	0x82, 0x7a, 0xb0, 0x5b, 0x2a, 0xee, 0x56, 0x0e, 0xab, 0x0f, 0x0e, 0x4a, 0xe5, 0x21, 0xb7, 0x17,
+	0x21, 0x39, 0x34, 0x5f, 0xb8, 0x7f, 0x50, 0xbc, 0x97, 0x40, 0x52, 0x28, 0x8a, 0xf0, 0x39, 0x38,
+	0x3b, 0x34, 0x5b, 0x79, 0xf2, 0xb0, 0x98, 0x08, 0x39, 0x3a, 0x87, 0x26, 0x76, 0xf9, 0x4c, 0x78,
+	0xe7, 0x57, 0x80, 0x48, 0xc5, 0x7d, 0xe7, 0xe2, 0x97, 0x10, 0xf5, 0xca, 0x28, 0x96, 0x27, 0x54,
+	0x79, 0x91, 0x63, 0xe9, 0xca, 0x44, 0x8c, 0x28, 0x29, 0x9b, 0xdf, 0xfc, 0xfe, 0xd7, 0x0f, 0xa1,
+	0x8c, 0x7c, 0x41, 0x09, 0x78, 0x60, 0x0b, 0xf0, 0x6d, 0x94, 0xc3, 0x3f, 0x22, 0x38, 0xe5, 0x2f,
+	0xe2, 0x38, 0x3b, 0xc3, 0x4d, 0xe3, 0x2a, 0xb9, 0x3a, 0x03, 0x52, 0xe8, 0xd9, 0xe6, 0x7a, 0xb6,
+	0x6e, 0xa3, 0x9c, 0x2c, 0x4f, 0x90, 0x54, 0xad, 0xb9, 0x1a, 0x9e, 0xc3, 0x02, 0x2f, 0xc8, 0x78,
+	0x2d, 0x80, 0x62, 0xb0, 0x9c, 0x4b, 0x99, 0xf1, 0x00, 0x41, 0xbd, 0xc1, 0xa9, 0xd7, 0xf0, 0x25,
+	0x25, 0xe8, 0xd1, 0x4f, 0x95, 0x97, 0xce, 0x15, 0xf0, 0x0a, 0x7f, 0x05, 0xb1, 0x81, 0xe7, 0x11,
+	0xde, 0x98, 0xf4, 0xaa, 0xea, 0xd3, 0x6f, 0x4e, 0x83, 0x09, 0x11, 0x97, 0xb9, 0x88, 0x0b, 0x4e,
+	0xfc, 0xab, 0xc1, 0x3a, 0xf0, 0x97, 0x10, 0x1b, 0x78, 0xf2, 0x06, 0x0a, 0x18, 0xfd, 0x10, 0x90,
+	0x36, 0xa7, 0xc1, 0x84, 0x80, 0x34, 0x17, 0x90, 0xc2, 0xe3, 0xd8, 0x7f, 0x42, 0x70, 0x7a, 0xe8,
+	0x26, 0xc0, 0x57, 0x83, 0x7d, 0x07, 0x5c, 0x54, 0x52, 0x6e, 0x16, 0xa8, 0x7f, 0x2f, 0xe0, 0x8d,
+	0x31, 0x0b, 0xc2, 0x0b, 0xbe, 0xf2, 0xd2, 0xbd, 0xea, 0x5e, 0xe1, 0x2e, 0x44, 0xbd, 0x82, 0x11,
+	0x78, 0x3e, 0x86, 0xaa, 0xb9, 0x74, 0x65, 0x22, 0x46, 0x68, 0x58, 0xe7, 0x1a, 0xd2, 0xce, 0x7a,
+	0x9c, 0x0f, 0x90, 0xd1, 0x70, 0xe9, 0x38, 0x75, 0xd9, 0x98, 0x40, 0x5d, 0x36, 0xa6, 0x53, 0x97,
+	0x8d, 0x20, 0xea, 0x40, 0x5e, 0xc2, 0xa1, 0xce, 0xc1, 0xfc, 0x0e, 0xc1, 0xb2, 0xaf, 0x3c, 0xe3,
+	0xad, 0x09, 0xce, 0x07, 0x8b, 0xb0, 0x94, 0x9d, 0x0e, 0x14, 0x52, 0x72, 0x5c, 0xca, 0xba, 0xbc,
+	0x36, 0x56, 0x8a, 0xc2, 0x0b, 0x70, 0x5f, 0x50, 0x89, 0x4c, 0x13, 0x54, 0x22, 0x33, 0x0a, 0x2a,
+	0x91, 0x93, 0x09, 0x6a, 0x10, 0x9f, 0xa0, 0xc2, 0x47, 0xbf, 0xbd, 0x49, 0xa3, 0xd7, 0x6f, 0xd2,
+	0xe8, 0xcf, 0x37, 0x69, 0xf4, 0xfd, 0xdb, 0xf4, 0xdc, 0xeb, 0xb7, 0xe9, 0xb9, 0x3f, 0xde, 0xa6,
+	0xe7, 0x3e, 0xdb, 0x68, 0xea, 0xac, 0x75, 0x5c, 0xcb, 0xd7, 0xcd, 0x8e, 0xe7, 0xc7, 0xfd, 0xdb,
+	0xa6, 0x8d, 0x67, 0xde, 0x37, 0xb6, 0x5d, 0x5b, 0xe4, 0x5f, 0xd8, 0xef, 0xff, 0x37, 0x00, 0x2d,
+	0x89, 0xb2, 0x25, 0x84, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1242,6 +1446,10 @@ const _ = grpc.SupportPackageIsVersion4
 type ServiceClient interface {
 	// Simulate simulates executing a transaction for estimating gas usage.
 	Simulate(ctx context.Context, in *SimulateRequest, opts ...grpc.CallOption) (*SimulateResponse, error)
+	// SimulateBundle simulates executing an ordered bundle of transactions on top
+	// of the state of a height, each transaction seeing the writes of the
+	// previous ones. The transactions are not broadcast.
+	SimulateBundle(ctx context.Context, in *SimulateBundleRequest, opts ...grpc.CallOption) (*SimulateBundleResponse, error)
 	// GetTx fetches a tx by hash.
 	GetTx(ctx context.Context, in *GetTxRequest, opts ...grpc.CallOption) (*GetTxResponse, error)
 	// BroadcastTx broadcast transaction.
@@ -1287,6 +1495,15 @@ func (c *serviceClient) Simulate(ctx context.Context, in *SimulateRequest, opts
 	return out, nil
 }
 
+func (c *serviceClient) SimulateBundle(ctx context.Context, in *SimulateBundleRequest, opts ...grpc.CallOption) (*SimulateBundleResponse, error) {
+	out := new(SimulateBundleResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.tx.v1beta1.Service/SimulateBundle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *serviceClient) GetTx(ctx context.Context, in *GetTxRequest, opts ...grpc.CallOption) (*GetTxResponse, error) {
 	out := new(GetTxResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.tx.v1beta1.Service/GetTx", in, out, opts...)
@@ -1363,6 +1580,10 @@ func (c *serviceClient) TxDecodeAmino(ctx context.Context, in *TxDecodeAminoRequ
 type ServiceServer interface {
 	// Simulate simulates executing a transaction for estimating gas usage.
 	Simulate(context.Context, *SimulateRequest) (*SimulateResponse, error)
+	// SimulateBundle simulates executing an ordered bundle of transactions on top
+	// of the state of a height, each transaction seeing the writes of the
+	// previous ones. The transactions are not broadcast.
+	SimulateBundle(context.Context, *SimulateBundleRequest) (*SimulateBundleResponse, error)
 	// GetTx fetches a tx by hash.
 	GetTx(context.Context, *GetTxRequest) (*GetTxResponse, error)
 	// BroadcastTx broadcast transaction.
@@ -1398,6 +1619,9 @@ type UnimplementedServiceServer struct {
 func (*UnimplementedServiceServer) Simulate(ctx context.Context, req *SimulateRequest) (*SimulateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Simulate not implemented")
 }
+func (*UnimplementedServiceServer) SimulateBundle(ctx context.Context, req *SimulateBundleRequest) (*SimulateBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateBundle not implemented")
+}
 func (*UnimplementedServiceServer) GetTx(ctx context.Context, req *GetTxRequest) (*GetTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTx not implemented")
 }
@@ -1445,6 +1669,24 @@ func _Service_Simulate_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_SimulateBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).SimulateBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.tx.v1beta1.Service/SimulateBundle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SimulateBundle(ctx, req.(*SimulateBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Service_GetTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTxRequest)
 	if err := dec(in); err != nil {
@@ -1597,6 +1839,10 @@ var _Service_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Simulate",
 			Handler:    _Service_Simulate_Handler,
 		},
+		{
+			MethodName: "SimulateBundle",
+			Handler:    _Service_SimulateBundle_Handler,
+		},
 		{
 			MethodName: "GetTx",
 			Handler:    _Service_GetTx_Handler,
@@ -1927,7 +2173,7 @@ func (m *SimulateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GetTxRequest) Marshal() (dAtA []byte, err error) {
+func (m *SimulateBundleRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1937,27 +2183,34 @@ func (m *GetTxRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetTxRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SimulateBundleRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SimulateBundleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintService(dAtA, i, uint64(len(m.Hash)))
+	if m.Height != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Height))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x10
+	}
+	if len(m.TxsBytes) > 0 {
+		for iNdEx := len(m.TxsBytes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TxsBytes[iNdEx])
+			copy(dAtA[i:], m.TxsBytes[iNdEx])
+			i = encodeVarintService(dAtA, i, uint64(len(m.TxsBytes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetTxResponse) Marshal() (dAtA []byte, err error) {
+func (m *SimulateBundleResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1967,44 +2220,53 @@ func (m *GetTxResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetTxResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SimulateBundleResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SimulateBundleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TxResponse != nil {
-		{
-			size, err := m.TxResponse.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if m.Height != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.StateChanges) > 0 {
+		for iNdEx := len(m.StateChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StateChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if m.Tx != nil {
-		{
-			size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetBlockWithTxsRequest) Marshal() (dAtA []byte, err error) {
+func (m *SimulateBundleResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2014,19 +2276,26 @@ func (m *GetBlockWithTxsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetBlockWithTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SimulateBundleResult) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetBlockWithTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SimulateBundleResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Result != nil {
 		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2036,15 +2305,22 @@ func (m *GetBlockWithTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Height != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.Height))
+	if m.GasInfo != nil {
+		{
+			size, err := m.GasInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetBlockWithTxsResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetTxRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2054,27 +2330,144 @@ func (m *GetBlockWithTxsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetBlockWithTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetTxRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetBlockWithTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Hash)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxResponse != nil {
+		{
+			size, err := m.TxResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Tx != nil {
+		{
+			size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBlockWithTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockWithTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBlockWithTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBlockWithTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockWithTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBlockWithTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
 	if m.Block != nil {
 		{
@@ -2501,6 +2894,69 @@ func (m *SimulateResponse) Size() (n int) {
 	return n
 }
 
+func (m *SimulateBundleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TxsBytes) > 0 {
+		for _, b := range m.TxsBytes {
+			l = len(b)
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovService(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *SimulateBundleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if len(m.StateChanges) > 0 {
+		for _, e := range m.StateChanges {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovService(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *SimulateBundleResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasInfo != nil {
+		l = m.GasInfo.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
 func (m *GetTxRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3495,6 +3951,398 @@ func (m *SimulateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SimulateBundleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateBundleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateBundleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxsBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxsBytes = append(m.TxsBytes, make([]byte, postIndex-iNdEx))
+			copy(m.TxsBytes[len(m.TxsBytes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateBundleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateBundleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateBundleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &SimulateBundleResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateChanges = append(m.StateChanges, &types1.StoreKVPair{})
+			if err := m.StateChanges[len(m.StateChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateBundleResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateBundleResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateBundleResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GasInfo == nil {
+				m.GasInfo = &types.GasInfo{}
+			}
+			if err := m.GasInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &types.Result{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GetTxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3897,7 +4745,7 @@ func (m *GetBlockWithTxsResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.BlockId == nil {
-				m.BlockId = &types1.BlockID{}
+				m.BlockId = &types2.BlockID{}
 			}
 			if err := m.BlockId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -3933,7 +4781,7 @@ func (m *GetBlockWithTxsResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Block == nil {
-				m.Block = &types1.Block{}
+				m.Block = &types2.Block{}
 			}
 			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -72,6 +72,14 @@ type (
 
 		GetTimeoutHeight() uint64
 	}
+
+	// TxWithUnordered extends the Tx interface by allowing a transaction to set
+	// the unordered field, which implicitly relies on TxWithTimeoutHeight.
+	TxWithUnordered interface {
+		TxWithTimeoutHeight
+
+		GetUnordered() bool
+	}
 )
 
 // TxDecoder unmarshals transaction bytes
@@ -0,0 +1,87 @@
+// Package accountstd exports the types and functions that are used by
+// developers to implement smart accounts.
+package accountstd
+
+import (
+	"context"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/x/accounts/internal/implementation"
+)
+
+// Interface is the exported interface of an Account.
+type Interface = implementation.Account
+
+// ExecuteBuilder is the exported type of ExecuteBuilder.
+type ExecuteBuilder = implementation.ExecuteBuilder
+
+// QueryBuilder is the exported type of QueryBuilder.
+type QueryBuilder = implementation.QueryBuilder
+
+// InitBuilder is the exported type of InitBuilder.
+type InitBuilder = implementation.InitBuilder
+
+// AccountCreatorFunc is the exported type of AccountCreatorFunc.
+type AccountCreatorFunc = implementation.AccountCreatorFunc
+
+// Dependencies is the exported type of Dependencies.
+type Dependencies = implementation.Dependencies
+
+// RegisterExecuteHandler registers an execution handler for a smart account that uses protobuf.
+func RegisterExecuteHandler[
+	Req any, ProtoReq implementation.ProtoMsg[Req], Resp any, ProtoResp implementation.ProtoMsg[Resp],
+](router *ExecuteBuilder, handler func(ctx context.Context, req ProtoReq) (ProtoResp, error),
+) {
+	implementation.RegisterExecuteHandler(router, handler)
+}
+
+// RegisterQueryHandler registers a query handler for a smart account that uses protobuf.
+func RegisterQueryHandler[
+	Req any, ProtoReq implementation.ProtoMsg[Req], Resp any, ProtoResp implementation.ProtoMsg[Resp],
+](router *QueryBuilder, handler func(ctx context.Context, req ProtoReq) (ProtoResp, error),
+) {
+	implementation.RegisterQueryHandler(router, handler)
+}
+
+// RegisterInitHandler registers an initialisation handler for a smart account that uses protobuf.
+func RegisterInitHandler[
+	Req any, ProtoReq implementation.ProtoMsg[Req], Resp any, ProtoResp implementation.ProtoMsg[Resp],
+](router *InitBuilder, handler func(ctx context.Context, req ProtoReq) (ProtoResp, error),
+) {
+	implementation.RegisterInitHandler(router, handler)
+}
+
+// AddAccount is a helper function to add a smart account to the list of smart accounts.
+func AddAccount[A Interface](name string, constructor func(deps Dependencies) (A, error)) AccountCreatorFunc {
+	return func(deps Dependencies) (string, Interface, error) {
+		acc, err := constructor(deps)
+		return name, acc, err
+	}
+}
+
+// Whoami returns the address of the account being invoked.
+func Whoami(ctx context.Context) []byte {
+	return implementation.Whoami(ctx)
+}
+
+// Sender returns the sender of the execution request.
+func Sender(ctx context.Context) []byte {
+	return implementation.Sender(ctx)
+}
+
+// ExecModule executes a module message on behalf of the account, which is
+// the only signer of the message. It cannot be used from query handlers.
+func ExecModule(ctx context.Context, msg proto.Message) (proto.Message, error) {
+	return implementation.ExecModule(ctx, msg)
+}
+
+// DepinjectAccount wraps an AccountCreatorFunc so that account types can be
+// provided to the accounts module through depinject.
+type DepinjectAccount struct {
+	MakeAccount AccountCreatorFunc
+}
+
+// IsManyPerContainerType implements the depinject.ManyPerContainerType
+// interface.
+func (DepinjectAccount) IsManyPerContainerType() {}
@@ -0,0 +1,219 @@
+package cli
+
+import (
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/x/accounts/types"
+)
+
+// NewTxCmd returns a root CLI command handler for all x/accounts transaction commands.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Accounts transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewInitCmd(),
+		NewExecuteCmd(),
+	)
+
+	return txCmd
+}
+
+// NewInitCmd returns a CLI command handler for creating a MsgInit transaction.
+func NewInitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "init [account-type] [json-message]",
+		Short: "Initialize a new account",
+		Long: `Initialize a new account of the given account type. The init message is
+provided as JSON, along with its type URL, e.g.:
+
+$ <appd> tx accounts init counter '{"@type":"/cosmos.accounts.testing.counter.v1.MsgInit","initial_value":"1"}' --from mykey`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := parseMessage(clientCtx.Codec, args[1])
+			if err != nil {
+				return err
+			}
+
+			initMsg := types.NewMsgInit(clientCtx.GetFromAddress().String(), args[0], msg)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), initMsg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewExecuteCmd returns a CLI command handler for creating a MsgExecute
+// transaction.
+func NewExecuteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "execute [account-address] [json-message]",
+		Short: "Execute a message on an account",
+		Long: `Execute a message on the given account. The message is provided as JSON,
+along with its type URL, e.g.:
+
+$ <appd> tx accounts execute cosmos1... '{"@type":"/cosmos.accounts.testing.counter.v1.MsgIncreaseCounter","amount":"1"}' --from mykey`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := parseMessage(clientCtx.Codec, args[1])
+			if err != nil {
+				return err
+			}
+
+			execMsg := types.NewMsgExecute(clientCtx.GetFromAddress().String(), args[0], msg)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), execMsg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetQueryCmd returns the cli query commands for the x/accounts module.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the accounts module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		GetAccountQueryCmd(),
+		GetAccountTypeCmd(),
+		GetSchemaCmd(),
+	)
+
+	return queryCmd
+}
+
+// GetAccountQueryCmd returns a CLI command handler for querying an account.
+func GetAccountQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query [account-address] [json-request]",
+		Short: "Query an account",
+		Long: `Query the given account. The request is provided as JSON, along with its
+type URL, e.g.:
+
+$ <appd> query accounts query cosmos1... '{"@type":"/cosmos.accounts.testing.counter.v1.QueryCounterRequest"}'`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req, err := parseMessage(clientCtx.Codec, args[1])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AccountQuery(cmd.Context(), &types.AccountQueryRequest{
+				Target:  args[0],
+				Request: req,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetAccountTypeCmd returns a CLI command handler for querying the account
+// type of an account.
+func GetAccountTypeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-type [account-address]",
+		Short: "Query the account type of an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AccountType(cmd.Context(), &types.AccountTypeRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetSchemaCmd returns a CLI command handler for querying the handlers
+// schema of an account type.
+func GetSchemaCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schema [account-type]",
+		Short: "Query the messages accepted by an account type",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Schema(cmd.Context(), &types.SchemaRequest{AccountType: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseMessage parses a JSON encoded message, along with its type URL, into
+// an Any.
+func parseMessage(cdc codec.Codec, jsonMsg string) (*codectypes.Any, error) {
+	var msg proto.Message
+	if err := cdc.UnmarshalInterfaceJSON([]byte(jsonMsg), &msg); err != nil {
+		return nil, err
+	}
+
+	return codectypes.NewAnyWithValue(msg)
+}
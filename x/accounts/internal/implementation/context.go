@@ -0,0 +1,82 @@
+package implementation
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/core/store"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+var errQueryContext = errors.New("module execution is not allowed in a query context")
+
+type contextKey struct{}
+
+type contextValue struct {
+	store           store.KVStore   // store is the prefixed store for the account.
+	sender          []byte          // sender is the address of the entity invoking the account action.
+	whoami          []byte          // whoami is the address of the account being invoked.
+	originalContext context.Context // originalContext is the original context that was passed to the account.
+	moduleExec      ModuleExecFunc  // moduleExec is a function that executes a module message, nil in query contexts.
+}
+
+// ModuleExecFunc executes the provided module message on behalf of the
+// provided sender, which is always the address of the account executing it.
+type ModuleExecFunc = func(ctx context.Context, sender []byte, msg proto.Message) (proto.Message, error)
+
+// MakeAccountContext creates a new account execution context given:
+// accountStore: the account's own prefixed store.
+// accountAddr: the address of the account being invoked.
+// sender: the address of the entity invoking the account action.
+// moduleExec: a function that executes a module message on behalf of the
+// account, nil for query contexts.
+func MakeAccountContext(
+	ctx context.Context,
+	accountStore store.KVStore,
+	accountAddr, sender []byte,
+	moduleExec ModuleExecFunc,
+) context.Context {
+	return context.WithValue(ctx, contextKey{}, contextValue{
+		store:           accountStore,
+		sender:          sender,
+		whoami:          accountAddr,
+		originalContext: ctx,
+		moduleExec:      moduleExec,
+	})
+}
+
+// OpenKVStore returns the prefixed store for the account given the context.
+// It is used as the store accessor of the collections declared by accounts.
+func OpenKVStore(ctx context.Context) store.KVStore {
+	return getCtx(ctx).store
+}
+
+// Sender returns the address of the entity invoking the account action.
+func Sender(ctx context.Context) []byte {
+	return getCtx(ctx).sender
+}
+
+// Whoami returns the address of the account being invoked.
+func Whoami(ctx context.Context) []byte {
+	return getCtx(ctx).whoami
+}
+
+// ExecModule executes a module message on behalf of the account, the account
+// is the sender of the message. It fails when called from a query context.
+func ExecModule(ctx context.Context, msg proto.Message) (proto.Message, error) {
+	v := getCtx(ctx)
+	if v.moduleExec == nil {
+		return nil, errQueryContext
+	}
+
+	return v.moduleExec(v.originalContext, v.whoami, msg)
+}
+
+func getCtx(ctx context.Context) contextValue {
+	v, ok := ctx.Value(contextKey{}).(contextValue)
+	if !ok {
+		panic("context is not an account context")
+	}
+
+	return v
+}
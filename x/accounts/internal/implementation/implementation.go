@@ -0,0 +1,220 @@
+package implementation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+var (
+	errInvalidMessage = errors.New("invalid message")
+	errNoInitHandler  = errors.New("account does not have an init handler")
+
+	// ErrUnknownMessage is returned when an account receives a message it
+	// has no handler for.
+	ErrUnknownMessage = errors.New("unknown message")
+)
+
+// Account defines a smart account interface.
+type Account interface {
+	// RegisterInitHandler allows the smart account to register an initialisation handler, using
+	// the provided InitBuilder. The handler will be called when the smart account is initialized
+	// (deployed).
+	RegisterInitHandler(builder *InitBuilder)
+
+	// RegisterExecuteHandlers allows the smart account to register execution handlers.
+	// The smart account might also decide to not register any execution handler.
+	RegisterExecuteHandlers(builder *ExecuteBuilder)
+
+	// RegisterQueryHandlers allows the smart account to register query handlers. The smart account
+	// might also decide to not register any query handler.
+	RegisterQueryHandlers(builder *QueryBuilder)
+}
+
+// Dependencies are passed to the constructor of a smart account.
+type Dependencies struct {
+	// SchemaBuilder is used to declare the account's state, the collections
+	// declared with it are scoped to the account being invoked.
+	SchemaBuilder *collections.SchemaBuilder
+}
+
+// AccountCreatorFunc is a function that creates an account. It returns the
+// name of the account type along with the account implementation.
+type AccountCreatorFunc = func(deps Dependencies) (string, Account, error)
+
+// MakeAccountsMap creates a map of account type names to implementations from
+// a list of account creator functions.
+func MakeAccountsMap(accounts []AccountCreatorFunc) (map[string]Implementation, error) {
+	accountsMap := make(map[string]Implementation, len(accounts))
+	for _, makeAccount := range accounts {
+		sb := collections.NewSchemaBuilderFromAccessor(OpenKVStore)
+		name, acc, err := makeAccount(Dependencies{SchemaBuilder: sb})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create account %T: %w", acc, err)
+		}
+		if _, ok := accountsMap[name]; ok {
+			return nil, fmt.Errorf("duplicate account type %s", name)
+		}
+
+		impl, err := NewImplementation(sb, acc)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create implementation for account type %s: %w", name, err)
+		}
+		accountsMap[name] = impl
+	}
+
+	return accountsMap, nil
+}
+
+// NewImplementation creates a new Implementation instance given an Account
+// and the SchemaBuilder its state was declared with.
+func NewImplementation(sb *collections.SchemaBuilder, account Account) (Implementation, error) {
+	ib := &InitBuilder{}
+	account.RegisterInitHandler(ib)
+	if ib.handler == nil {
+		return Implementation{}, errNoInitHandler
+	}
+
+	eb := NewExecuteBuilder()
+	account.RegisterExecuteHandlers(eb)
+	executeHandler, err := eb.makeHandler()
+	if err != nil {
+		return Implementation{}, err
+	}
+
+	qb := NewQueryBuilder()
+	account.RegisterQueryHandlers(qb)
+	queryHandler, err := qb.makeHandler()
+	if err != nil {
+		return Implementation{}, err
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		return Implementation{}, err
+	}
+
+	return Implementation{
+		Init:                  ib.handler,
+		Execute:               executeHandler,
+		Query:                 queryHandler,
+		CollectionsSchema:     schema,
+		InitHandlerSchema:     ib.schema,
+		ExecuteHandlersSchema: eb.handlersSchema,
+		QueryHandlersSchema:   qb.er.handlersSchema,
+	}, nil
+}
+
+// Implementation wraps an Account implementation. It contains the init,
+// execute and query handlers of the account along with their schemas.
+type Implementation struct {
+	// Init defines the initialisation handler for the smart account.
+	Init func(ctx context.Context, msg proto.Message) (resp proto.Message, err error)
+	// Execute defines the execution handler for the smart account.
+	Execute func(ctx context.Context, msg proto.Message) (resp proto.Message, err error)
+	// Query defines the query handler for the smart account.
+	Query func(ctx context.Context, msg proto.Message) (resp proto.Message, err error)
+	// CollectionsSchema represents the state schema.
+	CollectionsSchema collections.Schema
+	// InitHandlerSchema represents the init handler schema.
+	InitHandlerSchema HandlerSchema
+	// ExecuteHandlersSchema is the schema of the execute handlers, keyed by
+	// request message name.
+	ExecuteHandlersSchema map[string]HandlerSchema
+	// QueryHandlersSchema is the schema of the query handlers, keyed by
+	// request message name.
+	QueryHandlersSchema map[string]HandlerSchema
+}
+
+// HandlerSchema defines the schema of a handler.
+type HandlerSchema struct {
+	// RequestSchema defines the schema of the request.
+	RequestSchema MessageSchema
+	// ResponseSchema defines the schema of the response.
+	ResponseSchema MessageSchema
+}
+
+// MessageSchema defines the schema of a message.
+type MessageSchema struct {
+	// Name identifies the message name, this must be queryable from some
+	// reflection service.
+	Name string
+	// New returns a new instance of the message.
+	New func() proto.Message
+}
+
+// InitBuilder is passed to the smart account's RegisterInitHandler method, it
+// allows the smart account to register its init handler.
+type InitBuilder struct {
+	// handler is the handler function that will be called when the smart
+	// account is initialized. Although the function here is generic, the
+	// builder will make sure that the request and response types are the
+	// ones expected by the account.
+	handler func(ctx context.Context, initRequest proto.Message) (initResponse proto.Message, err error)
+
+	// schema is the schema of the message that will be passed to the handler
+	// function.
+	schema HandlerSchema
+}
+
+// NewExecuteBuilder creates a new ExecuteBuilder instance.
+func NewExecuteBuilder() *ExecuteBuilder {
+	return &ExecuteBuilder{
+		handlers:       make(map[string]func(ctx context.Context, executeRequest proto.Message) (executeResponse proto.Message, err error)),
+		handlersSchema: make(map[string]HandlerSchema),
+	}
+}
+
+// ExecuteBuilder defines a smart account's execution router, it will be used
+// to map an execution message to a handler function for a specific account.
+type ExecuteBuilder struct {
+	// handlers is a map of handler functions that will be called when the
+	// smart account is executed, keyed by request message name.
+	handlers map[string]func(ctx context.Context, executeRequest proto.Message) (executeResponse proto.Message, err error)
+
+	// handlersSchema is a map of schemas for the messages that will be passed
+	// to the handler functions and the messages that will be returned by the
+	// handler functions.
+	handlersSchema map[string]HandlerSchema
+
+	// err is the error that occurred while registering the handlers.
+	err error
+}
+
+func (r *ExecuteBuilder) makeHandler() (func(ctx context.Context, executeRequest proto.Message) (executeResponse proto.Message, err error), error) {
+	// if an error happened during registration, return it
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return func(ctx context.Context, executeRequest proto.Message) (executeResponse proto.Message, err error) {
+		messageName := proto.MessageName(executeRequest)
+		handler, ok := r.handlers[messageName]
+		if !ok {
+			return nil, fmt.Errorf("%w: no handler for message %s", ErrUnknownMessage, messageName)
+		}
+		return handler(ctx, executeRequest)
+	}, nil
+}
+
+// NewQueryBuilder creates a new QueryBuilder instance.
+func NewQueryBuilder() *QueryBuilder {
+	return &QueryBuilder{
+		er: NewExecuteBuilder(),
+	}
+}
+
+// QueryBuilder defines a smart account's query router, it will be used to map
+// a query message to a handler function for a specific account.
+type QueryBuilder struct {
+	// er is the ExecuteBuilder, since there's no difference between the
+	// execution and query handlers API.
+	er *ExecuteBuilder
+}
+
+func (r *QueryBuilder) makeHandler() (func(ctx context.Context, queryRequest proto.Message) (queryResponse proto.Message, err error), error) {
+	return r.er.makeHandler()
+}
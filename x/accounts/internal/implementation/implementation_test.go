@@ -0,0 +1,67 @@
+package implementation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/accounts/internal/implementation"
+	"github.com/cosmos/cosmos-sdk/x/accounts/testing/counter"
+	counterv1 "github.com/cosmos/cosmos-sdk/x/accounts/testing/counter/v1"
+)
+
+// noInitAccount is an account which does not register an init handler.
+type noInitAccount struct{}
+
+func (noInitAccount) RegisterInitHandler(*implementation.InitBuilder)        {}
+func (noInitAccount) RegisterExecuteHandlers(*implementation.ExecuteBuilder) {}
+func (noInitAccount) RegisterQueryHandlers(*implementation.QueryBuilder)     {}
+
+// duplicateExecuteAccount is an account which registers the same execute
+// handler twice.
+type duplicateExecuteAccount struct{ counter.Account }
+
+func (a duplicateExecuteAccount) RegisterExecuteHandlers(builder *implementation.ExecuteBuilder) {
+	implementation.RegisterExecuteHandler(builder, a.IncreaseCounter)
+	implementation.RegisterExecuteHandler(builder, func(context.Context, *counterv1.MsgIncreaseCounter) (*counterv1.MsgIncreaseCounterResponse, error) {
+		return nil, nil
+	})
+}
+
+func makeCounter(name string) implementation.AccountCreatorFunc {
+	return func(deps implementation.Dependencies) (string, implementation.Account, error) {
+		acc, err := counter.NewAccount(deps)
+		return name, acc, err
+	}
+}
+
+func TestMakeAccountsMap(t *testing.T) {
+	accounts, err := implementation.MakeAccountsMap([]implementation.AccountCreatorFunc{makeCounter("counter1"), makeCounter("counter2")})
+	require.NoError(t, err)
+	require.Len(t, accounts, 2)
+
+	impl := accounts["counter1"]
+	require.Equal(t, "cosmos.accounts.testing.counter.v1.MsgInit", impl.InitHandlerSchema.RequestSchema.Name)
+	require.Equal(t, &counterv1.MsgInit{}, impl.InitHandlerSchema.RequestSchema.New())
+	require.Contains(t, impl.ExecuteHandlersSchema, "cosmos.accounts.testing.counter.v1.MsgIncreaseCounter")
+	require.Contains(t, impl.QueryHandlersSchema, "cosmos.accounts.testing.counter.v1.QueryCounterRequest")
+
+	_, err = implementation.MakeAccountsMap([]implementation.AccountCreatorFunc{makeCounter("counter"), makeCounter("counter")})
+	require.ErrorContains(t, err, "duplicate account type counter")
+
+	_, err = implementation.MakeAccountsMap([]implementation.AccountCreatorFunc{
+		func(implementation.Dependencies) (string, implementation.Account, error) {
+			return "no_init", noInitAccount{}, nil
+		},
+	})
+	require.ErrorContains(t, err, "does not have an init handler")
+
+	_, err = implementation.MakeAccountsMap([]implementation.AccountCreatorFunc{
+		func(deps implementation.Dependencies) (string, implementation.Account, error) {
+			acc, err := counter.NewAccount(deps)
+			return "duplicate", duplicateExecuteAccount{acc}, err
+		},
+	})
+	require.ErrorContains(t, err, "handler already registered")
+}
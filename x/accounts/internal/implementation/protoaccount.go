@@ -0,0 +1,86 @@
+package implementation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// ProtoMsg is a generic interface for protobuf messages, it allows to
+// instantiate the message type from its non-pointer type.
+type ProtoMsg[T any] interface {
+	*T
+	proto.Message
+}
+
+// RegisterInitHandler registers an initialisation handler for a smart account
+// that uses protobuf.
+func RegisterInitHandler[
+	Req any, ProtoReq ProtoMsg[Req], Resp any, ProtoResp ProtoMsg[Resp],
+](router *InitBuilder, handler func(ctx context.Context, req ProtoReq) (ProtoResp, error),
+) {
+	reqName := messageName[Req, ProtoReq]()
+
+	router.handler = func(ctx context.Context, initRequest proto.Message) (initResponse proto.Message, err error) {
+		concrete, ok := initRequest.(ProtoReq)
+		if !ok {
+			return nil, fmt.Errorf("%w: wanted %s, got %T", errInvalidMessage, reqName, initRequest)
+		}
+		return handler(ctx, concrete)
+	}
+
+	router.schema = HandlerSchema{
+		RequestSchema:  newMessageSchema[Req, ProtoReq](),
+		ResponseSchema: newMessageSchema[Resp, ProtoResp](),
+	}
+}
+
+// RegisterExecuteHandler registers an execution handler for a smart account
+// that uses protobuf.
+func RegisterExecuteHandler[
+	Req any, ProtoReq ProtoMsg[Req], Resp any, ProtoResp ProtoMsg[Resp],
+](router *ExecuteBuilder, handler func(ctx context.Context, req ProtoReq) (ProtoResp, error),
+) {
+	reqName := messageName[Req, ProtoReq]()
+	// check if not registered already
+	if _, ok := router.handlers[reqName]; ok {
+		router.err = fmt.Errorf("handler already registered for message %s", reqName)
+		return
+	}
+
+	router.handlers[reqName] = func(ctx context.Context, executeRequest proto.Message) (executeResponse proto.Message, err error) {
+		concrete, ok := executeRequest.(ProtoReq)
+		if !ok {
+			return nil, fmt.Errorf("%w: wanted %s, got %T", errInvalidMessage, reqName, executeRequest)
+		}
+		return handler(ctx, concrete)
+	}
+
+	router.handlersSchema[reqName] = HandlerSchema{
+		RequestSchema:  newMessageSchema[Req, ProtoReq](),
+		ResponseSchema: newMessageSchema[Resp, ProtoResp](),
+	}
+}
+
+// RegisterQueryHandler registers a query handler for a smart account that
+// uses protobuf.
+func RegisterQueryHandler[
+	Req any, ProtoReq ProtoMsg[Req], Resp any, ProtoResp ProtoMsg[Resp],
+](router *QueryBuilder, handler func(ctx context.Context, req ProtoReq) (ProtoResp, error),
+) {
+	RegisterExecuteHandler(router.er, handler)
+}
+
+func messageName[T any, PT ProtoMsg[T]]() string {
+	return proto.MessageName(PT(new(T)))
+}
+
+func newMessageSchema[T any, PT ProtoMsg[T]]() MessageSchema {
+	return MessageSchema{
+		Name: messageName[T, PT](),
+		New: func() proto.Message {
+			return PT(new(T))
+		},
+	}
+}
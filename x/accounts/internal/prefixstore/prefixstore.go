@@ -0,0 +1,198 @@
+// Package prefixstore provides a store.KVStore which prefixes all the keys
+// of an underlying store.KVStore.
+package prefixstore
+
+import (
+	"bytes"
+	"errors"
+
+	"cosmossdk.io/core/store"
+)
+
+var _ store.KVStore = Store{}
+
+// Store is a store.KVStore which namespaces all its keys under a prefix of the
+// parent store.
+type Store struct {
+	parent store.KVStore
+	prefix []byte
+}
+
+// New returns a store.KVStore which prefixes all the keys of parent with the
+// provided prefix.
+func New(parent store.KVStore, prefix []byte) store.KVStore {
+	return Store{
+		parent: parent,
+		prefix: prefix,
+	}
+}
+
+func (s Store) key(key []byte) []byte {
+	if key == nil {
+		panic("nil key on prefix store")
+	}
+
+	res := make([]byte, len(s.prefix)+len(key))
+	copy(res, s.prefix)
+	copy(res[len(s.prefix):], key)
+	return res
+}
+
+// Get implements store.KVStore.
+func (s Store) Get(key []byte) ([]byte, error) {
+	return s.parent.Get(s.key(key))
+}
+
+// Has implements store.KVStore.
+func (s Store) Has(key []byte) (bool, error) {
+	return s.parent.Has(s.key(key))
+}
+
+// Set implements store.KVStore.
+func (s Store) Set(key, value []byte) error {
+	return s.parent.Set(s.key(key), value)
+}
+
+// Delete implements store.KVStore.
+func (s Store) Delete(key []byte) error {
+	return s.parent.Delete(s.key(key))
+}
+
+// Iterator implements store.KVStore.
+func (s Store) Iterator(start, end []byte) (store.Iterator, error) {
+	newStart, newEnd := s.bounds(start, end)
+	iter, err := s.parent.Iterator(newStart, newEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	return newPrefixIterator(s.prefix, start, end, iter), nil
+}
+
+// ReverseIterator implements store.KVStore.
+func (s Store) ReverseIterator(start, end []byte) (store.Iterator, error) {
+	newStart, newEnd := s.bounds(start, end)
+	iter, err := s.parent.ReverseIterator(newStart, newEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	return newPrefixIterator(s.prefix, start, end, iter), nil
+}
+
+// bounds returns the parent store iteration bounds for the provided prefix
+// store bounds.
+func (s Store) bounds(start, end []byte) (newStart, newEnd []byte) {
+	if start == nil {
+		newStart = s.prefix
+	} else {
+		newStart = s.key(start)
+	}
+
+	if end == nil {
+		newEnd = prefixEndBytes(s.prefix)
+	} else {
+		newEnd = s.key(end)
+	}
+
+	return newStart, newEnd
+}
+
+var _ store.Iterator = (*prefixIterator)(nil)
+
+// prefixIterator strips the prefix from the keys of the parent iterator.
+type prefixIterator struct {
+	prefix     []byte
+	start, end []byte
+	iter       store.Iterator
+	valid      bool
+}
+
+func newPrefixIterator(prefix, start, end []byte, parent store.Iterator) *prefixIterator {
+	return &prefixIterator{
+		prefix: prefix,
+		start:  start,
+		end:    end,
+		iter:   parent,
+		valid:  parent.Valid() && bytes.HasPrefix(parent.Key(), prefix),
+	}
+}
+
+// Domain implements store.Iterator.
+func (pi *prefixIterator) Domain() ([]byte, []byte) {
+	return pi.start, pi.end
+}
+
+// Valid implements store.Iterator.
+func (pi *prefixIterator) Valid() bool {
+	return pi.valid && pi.iter.Valid()
+}
+
+// Next implements store.Iterator.
+func (pi *prefixIterator) Next() {
+	if !pi.valid {
+		panic("prefixIterator invalid, cannot call Next()")
+	}
+
+	if pi.iter.Next(); !pi.iter.Valid() || !bytes.HasPrefix(pi.iter.Key(), pi.prefix) {
+		pi.valid = false
+	}
+}
+
+// Key implements store.Iterator.
+func (pi *prefixIterator) Key() []byte {
+	if !pi.valid {
+		panic("prefixIterator invalid, cannot call Key()")
+	}
+
+	return pi.iter.Key()[len(pi.prefix):]
+}
+
+// Value implements store.Iterator.
+func (pi *prefixIterator) Value() []byte {
+	if !pi.valid {
+		panic("prefixIterator invalid, cannot call Value()")
+	}
+
+	return pi.iter.Value()
+}
+
+// Close implements store.Iterator.
+func (pi *prefixIterator) Close() error {
+	return pi.iter.Close()
+}
+
+// Error implements store.Iterator.
+func (pi *prefixIterator) Error() error {
+	if !pi.Valid() {
+		return errors.New("invalid prefixIterator")
+	}
+
+	return nil
+}
+
+// prefixEndBytes returns the []byte that would end a range query for all
+// []byte with a certain prefix.
+func prefixEndBytes(prefix []byte) []byte {
+	if len(prefix) == 0 {
+		return nil
+	}
+
+	end := make([]byte, len(prefix))
+	copy(end, prefix)
+
+	for {
+		if end[len(end)-1] != byte(255) {
+			end[len(end)-1]++
+			break
+		}
+
+		end = end[:len(end)-1]
+		if len(end) == 0 {
+			end = nil
+			break
+		}
+	}
+
+	return end
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/accounts/types"
+)
+
+// InitGenesis initializes the accounts module's state from a given genesis
+// state. Accounts are restored along with their raw state, their init
+// handlers are not invoked.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	if err := k.AccountNumber.Set(ctx, genState.AccountNumber); err != nil {
+		panic(err)
+	}
+
+	for _, acc := range genState.Accounts {
+		addr, err := sdk.AccAddressFromBech32(acc.Address)
+		if err != nil {
+			panic(err)
+		}
+
+		if _, ok := k.accounts[acc.AccountType]; !ok {
+			panic(types.ErrAccountTypeNotFound.Wrapf("%s", acc.AccountType))
+		}
+
+		if err := k.AccountsByType.Set(ctx, addr, acc.AccountType); err != nil {
+			panic(err)
+		}
+
+		store := k.accountStore(ctx, addr)
+		for _, kv := range acc.State {
+			if err := store.Set(kv.Key, kv.Value); err != nil {
+				panic(err)
+			}
+		}
+	}
+}
+
+// ExportGenesis returns the accounts module's genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	accNum, err := k.AccountNumber.Peek(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		panic(err)
+	}
+
+	var accounts []*types.GenesisAccount
+	err = k.AccountsByType.Walk(ctx, nil, func(addr []byte, accType string) bool {
+		state, err := k.exportAccountState(ctx, addr)
+		if err != nil {
+			panic(err)
+		}
+
+		accounts = append(accounts, &types.GenesisAccount{
+			Address:     sdk.AccAddress(addr).String(),
+			AccountType: accType,
+			State:       state,
+		})
+		return false
+	})
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+		panic(err)
+	}
+
+	return &types.GenesisState{
+		AccountNumber: accNum,
+		Accounts:      accounts,
+	}
+}
+
+// exportAccountState returns the raw state of the given account.
+func (k Keeper) exportAccountState(ctx sdk.Context, addr []byte) ([]*types.KVPair, error) {
+	iter, err := k.accountStore(ctx, addr).Iterator(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var state []*types.KVPair
+	for ; iter.Valid(); iter.Next() {
+		state = append(state, &types.KVPair{
+			Key:   append([]byte{}, iter.Key()...),
+			Value: append([]byte{}, iter.Value()...),
+		})
+	}
+
+	return state, nil
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	counterv1 "github.com/cosmos/cosmos-sdk/x/accounts/testing/counter/v1"
+)
+
+func TestGenesis(t *testing.T) {
+	f := newFixture(t, nil)
+
+	// an empty state can be exported
+	genState := f.k.ExportGenesis(f.ctx)
+	require.NoError(t, genState.Validate())
+	require.Empty(t, genState.Accounts)
+
+	_, addr1, err := f.k.Init(f.ctx, "counter", sdk.AccAddress("sender"), &counterv1.MsgInit{InitialValue: 1})
+	require.NoError(t, err)
+	_, addr2, err := f.k.Init(f.ctx, "counter", sdk.AccAddress("sender"), &counterv1.MsgInit{InitialValue: 2})
+	require.NoError(t, err)
+
+	genState = f.k.ExportGenesis(f.ctx)
+	require.NoError(t, genState.Validate())
+	require.Equal(t, uint64(3), genState.AccountNumber)
+	require.Len(t, genState.Accounts, 2)
+
+	f2 := newFixture(t, nil)
+	f2.k.InitGenesis(f2.ctx, genState)
+	require.Equal(t, genState, f2.k.ExportGenesis(f2.ctx))
+
+	for addr, value := range map[string]uint64{string(addr1): 1, string(addr2): 2} {
+		resp, err := f2.k.Query(f2.ctx, []byte(addr), &counterv1.QueryCounterRequest{})
+		require.NoError(t, err)
+		require.Equal(t, &counterv1.QueryCounterResponse{Value: value}, resp)
+	}
+
+	// new accounts do not collide with the imported ones
+	_, addr3, err := f2.k.Init(f2.ctx, "counter", sdk.AccAddress("sender"), &counterv1.MsgInit{})
+	require.NoError(t, err)
+	require.NotEqual(t, addr1, addr3)
+	require.NotEqual(t, addr2, addr3)
+}
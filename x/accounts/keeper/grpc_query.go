@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/accounts/internal/implementation"
+	"github.com/cosmos/cosmos-sdk/x/accounts/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper
+type Querier struct {
+	Keeper
+}
+
+// NewQuerier constructor for the Querier struct
+func NewQuerier(keeper Keeper) Querier {
+	return Querier{Keeper: keeper}
+}
+
+// AccountQuery runs a query against an account.
+func (q Querier) AccountQuery(ctx context.Context, req *types.AccountQueryRequest) (*types.AccountQueryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	target, err := sdk.AccAddressFromBech32(req.Target)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	impl, err := q.getImplementation(ctx, target)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	queryRequest, err := decodeRequest(req.Request, impl.QueryHandlersSchema)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	queryResponse, err := q.Query(ctx, target, queryRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := packResponse(queryResponse)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.AccountQueryResponse{Response: resp}, nil
+}
+
+// Schema returns the handlers schema of an account type.
+func (q Querier) Schema(_ context.Context, req *types.SchemaRequest) (*types.SchemaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	impl, ok := q.accounts[req.AccountType]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "account type %s not found", req.AccountType)
+	}
+
+	return &types.SchemaResponse{
+		InitSchema:      handlerSchema(impl.InitHandlerSchema),
+		ExecuteHandlers: handlersSchema(impl.ExecuteHandlersSchema),
+		QueryHandlers:   handlersSchema(impl.QueryHandlersSchema),
+	}, nil
+}
+
+// AccountType returns the account type of an account created by x/accounts.
+func (q Querier) AccountType(ctx context.Context, req *types.AccountTypeRequest) (*types.AccountTypeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	accType, err := q.AccountsByType.Get(ctx, addr)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "account %s not found", req.Address)
+	}
+
+	return &types.AccountTypeResponse{AccountType: accType}, nil
+}
+
+func handlerSchema(schema implementation.HandlerSchema) *types.SchemaResponse_Handler {
+	return &types.SchemaResponse_Handler{
+		Request:  schema.RequestSchema.Name,
+		Response: schema.ResponseSchema.Name,
+	}
+}
+
+// handlersSchema returns the schema of the provided handlers, sorted by
+// request name.
+func handlersSchema(schemas map[string]implementation.HandlerSchema) []*types.SchemaResponse_Handler {
+	handlers := make([]*types.SchemaResponse_Handler, 0, len(schemas))
+	for _, schema := range schemas {
+		handlers = append(handlers, handlerSchema(schema))
+	}
+
+	sort.Slice(handlers, func(i, j int) bool {
+		return handlers[i].Request < handlers[j].Request
+	})
+
+	return handlers
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	counterv1 "github.com/cosmos/cosmos-sdk/x/accounts/testing/counter/v1"
+	"github.com/cosmos/cosmos-sdk/x/accounts/types"
+)
+
+func TestQuerier(t *testing.T) {
+	f := newFixture(t, nil)
+	q := NewQuerier(f.k)
+
+	_, addr, err := f.k.Init(f.ctx, "counter", sdk.AccAddress("sender"), &counterv1.MsgInit{InitialValue: 7})
+	require.NoError(t, err)
+	target := sdk.AccAddress(addr).String()
+
+	req, err := codectypes.NewAnyWithValue(&counterv1.QueryCounterRequest{})
+	require.NoError(t, err)
+	queryResp, err := q.AccountQuery(f.ctx, &types.AccountQueryRequest{Target: target, Request: req})
+	require.NoError(t, err)
+
+	var resp counterv1.QueryCounterResponse
+	require.NoError(t, resp.Unmarshal(queryResp.Response.Value))
+	require.Equal(t, uint64(7), resp.Value)
+
+	typeResp, err := q.AccountType(f.ctx, &types.AccountTypeRequest{Address: target})
+	require.NoError(t, err)
+	require.Equal(t, "counter", typeResp.AccountType)
+
+	_, err = q.AccountType(f.ctx, &types.AccountTypeRequest{Address: sdk.AccAddress("unknown").String()})
+	require.ErrorContains(t, err, "not found")
+
+	schema, err := q.Schema(f.ctx, &types.SchemaRequest{AccountType: "counter"})
+	require.NoError(t, err)
+	require.Equal(t, &types.SchemaResponse{
+		InitSchema: &types.SchemaResponse_Handler{
+			Request:  "cosmos.accounts.testing.counter.v1.MsgInit",
+			Response: "cosmos.accounts.testing.counter.v1.MsgInitResponse",
+		},
+		ExecuteHandlers: []*types.SchemaResponse_Handler{{
+			Request:  "cosmos.accounts.testing.counter.v1.MsgIncreaseCounter",
+			Response: "cosmos.accounts.testing.counter.v1.MsgIncreaseCounterResponse",
+		}},
+		QueryHandlers: []*types.SchemaResponse_Handler{{
+			Request:  "cosmos.accounts.testing.counter.v1.QueryCounterRequest",
+			Response: "cosmos.accounts.testing.counter.v1.QueryCounterResponse",
+		}},
+	}, schema)
+}
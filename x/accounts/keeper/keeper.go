@@ -0,0 +1,282 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	"github.com/cosmos/gogoproto/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/accounts/accountstd"
+	"github.com/cosmos/cosmos-sdk/x/accounts/internal/implementation"
+	"github.com/cosmos/cosmos-sdk/x/accounts/internal/prefixstore"
+	"github.com/cosmos/cosmos-sdk/x/accounts/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// Keeper defines the accounts module's keeper.
+type Keeper struct {
+	storeService  store.KVStoreService
+	accountKeeper types.AccountKeeper
+	router        types.MsgRouter
+
+	// accounts are the registered account implementations, keyed by account
+	// type.
+	accounts map[string]implementation.Implementation
+
+	Schema collections.Schema
+	// AccountNumber is the last global account number.
+	AccountNumber collections.Sequence
+	// AccountsByType maps account address to their implementation.
+	AccountsByType collections.Map[[]byte, string]
+}
+
+// NewKeeper constructs a new accounts Keeper instance. The request and
+// response messages of the provided account implementations are registered
+// in the interface registry, so that transactions and queries carrying them
+// can be decoded.
+func NewKeeper(
+	storeService store.KVStoreService,
+	accountKeeper types.AccountKeeper,
+	router types.MsgRouter,
+	registry codectypes.InterfaceRegistry,
+	accounts ...accountstd.AccountCreatorFunc,
+) (Keeper, error) {
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		storeService:   storeService,
+		accountKeeper:  accountKeeper,
+		router:         router,
+		AccountNumber:  collections.NewSequence(sb, types.AccountNumberKey, "account_number"),
+		AccountsByType: collections.NewMap(sb, types.AccountTypeByAddrKey, "accounts_by_type", collections.BytesKey, collections.StringValue),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		return Keeper{}, err
+	}
+	k.Schema = schema
+
+	k.accounts, err = implementation.MakeAccountsMap(accounts)
+	if err != nil {
+		return Keeper{}, err
+	}
+
+	registerAccountMessages(registry, k.accounts)
+
+	return k, nil
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// Init creates a new account of the given type, its init handler is invoked
+// with the provided request on behalf of creator. It returns the init
+// response and the address of the new account.
+func (k Keeper) Init(
+	ctx context.Context,
+	accountType string,
+	creator []byte,
+	initRequest proto.Message,
+) (proto.Message, []byte, error) {
+	impl, ok := k.accounts[accountType]
+	if !ok {
+		return nil, nil, errorsmod.Wrapf(types.ErrAccountTypeNotFound, "%s", accountType)
+	}
+
+	num, err := k.AccountNumber.Next(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	accountAddr := makeAddress(num)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if k.accountKeeper.HasAccount(sdkCtx, accountAddr) {
+		return nil, nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", sdk.AccAddress(accountAddr))
+	}
+
+	initResp, err := impl.Init(k.makeAccountContext(ctx, accountAddr, creator, false), initRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := k.AccountsByType.Set(ctx, accountAddr, accountType); err != nil {
+		return nil, nil, err
+	}
+
+	// register the account in x/auth, which gives it an account number and
+	// a sequence, so it can pay fees and be a signer of transactions.
+	k.accountKeeper.SetAccount(sdkCtx, k.accountKeeper.NewAccountWithAddress(sdkCtx, accountAddr))
+
+	return initResp, accountAddr, nil
+}
+
+// Execute executes a state transition on the given account, on behalf of
+// sender.
+func (k Keeper) Execute(
+	ctx context.Context,
+	accountAddr []byte,
+	sender []byte,
+	execRequest proto.Message,
+) (proto.Message, error) {
+	impl, err := k.getImplementation(ctx, accountAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return impl.Execute(k.makeAccountContext(ctx, accountAddr, sender, false), execRequest)
+}
+
+// Query queries the given account. Accounts cannot execute module messages
+// while handling queries.
+func (k Keeper) Query(
+	ctx context.Context,
+	accountAddr []byte,
+	queryRequest proto.Message,
+) (proto.Message, error) {
+	impl, err := k.getImplementation(ctx, accountAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return impl.Query(k.makeAccountContext(ctx, accountAddr, nil, true), queryRequest)
+}
+
+// IsAccountsModuleAccount returns true if the provided address is an account
+// created by x/accounts.
+func (k Keeper) IsAccountsModuleAccount(ctx context.Context, accountAddr []byte) bool {
+	has, err := k.AccountsByType.Has(ctx, accountAddr)
+	return err == nil && has
+}
+
+// AuthenticateAccount authenticates the given account as the signer at
+// signerIndex of a transaction, by sending it a MsgAuthenticate on behalf of
+// the x/accounts module. Authentication succeeds when the account handles
+// the message without returning an error.
+func (k Keeper) AuthenticateAccount(ctx context.Context, accountAddr []byte, signerIndex uint32, signBytes, signature []byte) error {
+	_, err := k.Execute(ctx, accountAddr, authtypes.NewModuleAddress(types.ModuleName), &types.MsgAuthenticate{
+		SignBytes:   signBytes,
+		Signature:   signature,
+		SignerIndex: signerIndex,
+	})
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "account %s: %s", sdk.AccAddress(accountAddr), err)
+	}
+
+	return nil
+}
+
+func (k Keeper) getImplementation(ctx context.Context, accountAddr []byte) (implementation.Implementation, error) {
+	accountType, err := k.AccountsByType.Get(ctx, accountAddr)
+	if err != nil {
+		return implementation.Implementation{}, errorsmod.Wrapf(types.ErrAccountNotFound, "%s: %s", sdk.AccAddress(accountAddr), err)
+	}
+
+	impl, ok := k.accounts[accountType]
+	if !ok {
+		return implementation.Implementation{}, errorsmod.Wrapf(types.ErrAccountTypeNotFound, "%s", accountType)
+	}
+
+	return impl, nil
+}
+
+// makeAccountContext makes a new context for the given account. Query
+// contexts cannot execute module messages.
+func (k Keeper) makeAccountContext(ctx context.Context, accountAddr, sender []byte, isQuery bool) context.Context {
+	var moduleExec implementation.ModuleExecFunc
+	if !isQuery {
+		moduleExec = k.sendModuleMessage
+	}
+
+	return implementation.MakeAccountContext(ctx, k.accountStore(ctx, accountAddr), accountAddr, sender, moduleExec)
+}
+
+// accountStore returns the store holding the state of the given account.
+func (k Keeper) accountStore(ctx context.Context, accountAddr []byte) store.KVStore {
+	return prefixstore.New(k.storeService.OpenKVStore(ctx), accountStatePrefix(accountAddr))
+}
+
+// sendModuleMessage executes a module message on behalf of an account, the
+// account must be the only signer of the message.
+func (k Keeper) sendModuleMessage(ctx context.Context, sender []byte, msg proto.Message) (proto.Message, error) {
+	sdkMsg, ok := msg.(sdk.Msg)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "%T is not a sdk.Msg", msg)
+	}
+
+	signers := sdkMsg.GetSigners()
+	if len(signers) != 1 || !bytes.Equal(signers[0], sender) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "account %s must be the only signer of %s", sdk.AccAddress(sender), sdk.MsgTypeURL(sdkMsg))
+	}
+
+	handler := k.router.Handler(sdkMsg)
+	if handler == nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", sdk.MsgTypeURL(sdkMsg))
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	res, err := handler(sdkCtx, sdkMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkEvents := make([]sdk.Event, 0, len(res.Events))
+	for _, event := range res.Events {
+		sdkEvents = append(sdkEvents, sdk.Event(event))
+	}
+	sdkCtx.EventManager().EmitEvents(sdkEvents)
+
+	if len(res.MsgResponses) == 0 {
+		return nil, nil
+	}
+
+	resp, ok := res.MsgResponses[0].GetCachedValue().(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response type for %s", sdk.MsgTypeURL(sdkMsg))
+	}
+
+	return resp, nil
+}
+
+// makeAddress derives the address of an account from its account number.
+func makeAddress(accNum uint64) []byte {
+	return address.Module(types.ModuleName, binary.BigEndian.AppendUint64(nil, accNum))
+}
+
+// accountStatePrefix returns the prefix of the state of the given account.
+func accountStatePrefix(accountAddr []byte) []byte {
+	return append(append([]byte{}, types.AccountStatePrefix...), address.MustLengthPrefix(accountAddr)...)
+}
+
+// registerAccountMessages registers the request and response messages of the
+// provided account implementations in the interface registry.
+func registerAccountMessages(registry codectypes.InterfaceRegistry, accounts map[string]implementation.Implementation) {
+	var msgs []proto.Message
+	addSchema := func(schema implementation.HandlerSchema) {
+		msgs = append(msgs, schema.RequestSchema.New(), schema.ResponseSchema.New())
+	}
+
+	for _, impl := range accounts {
+		addSchema(impl.InitHandlerSchema)
+		for _, schema := range impl.ExecuteHandlersSchema {
+			addSchema(schema)
+		}
+		for _, schema := range impl.QueryHandlersSchema {
+			addSchema(schema)
+		}
+	}
+
+	registry.RegisterImplementations((*proto.Message)(nil), msgs...)
+}
@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	counterv1 "github.com/cosmos/cosmos-sdk/x/accounts/testing/counter/v1"
+	keyauthv1 "github.com/cosmos/cosmos-sdk/x/accounts/testing/keyauth/v1"
+	"github.com/cosmos/cosmos-sdk/x/accounts/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestKeeper_Init(t *testing.T) {
+	f := newFixture(t, nil)
+	creator := sdk.AccAddress("creator")
+
+	resp, addr, err := f.k.Init(f.ctx, "counter", creator, &counterv1.MsgInit{InitialValue: 10})
+	require.NoError(t, err)
+	require.Equal(t, &counterv1.MsgInitResponse{}, resp)
+
+	// the account is tracked by x/accounts and registered in x/auth
+	accType, err := f.k.AccountsByType.Get(f.ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, "counter", accType)
+	require.True(t, f.k.IsAccountsModuleAccount(f.ctx, addr))
+	require.True(t, f.ak.HasAccount(f.ctx, addr))
+
+	// a second account gets a different address
+	_, addr2, err := f.k.Init(f.ctx, "counter", creator, &counterv1.MsgInit{InitialValue: 20})
+	require.NoError(t, err)
+	require.NotEqual(t, addr, addr2)
+
+	// unknown account type
+	_, _, err = f.k.Init(f.ctx, "unknown", creator, &counterv1.MsgInit{})
+	require.ErrorIs(t, err, types.ErrAccountTypeNotFound)
+
+	// wrong init message
+	_, _, err = f.k.Init(f.ctx, "counter", creator, &counterv1.MsgIncreaseCounter{})
+	require.ErrorContains(t, err, "invalid message")
+}
+
+func TestKeeper_ExecuteAndQuery(t *testing.T) {
+	f := newFixture(t, nil)
+	sender := sdk.AccAddress("sender")
+
+	_, addr1, err := f.k.Init(f.ctx, "counter", sender, &counterv1.MsgInit{InitialValue: 10})
+	require.NoError(t, err)
+	_, addr2, err := f.k.Init(f.ctx, "counter", sender, &counterv1.MsgInit{InitialValue: 100})
+	require.NoError(t, err)
+
+	resp, err := f.k.Execute(f.ctx, addr1, sender, &counterv1.MsgIncreaseCounter{Amount: 5})
+	require.NoError(t, err)
+	require.Equal(t, &counterv1.MsgIncreaseCounterResponse{NewAmount: 15}, resp)
+
+	// account states are isolated from each other
+	resp, err = f.k.Query(f.ctx, addr1, &counterv1.QueryCounterRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &counterv1.QueryCounterResponse{Value: 15}, resp)
+
+	resp, err = f.k.Query(f.ctx, addr2, &counterv1.QueryCounterRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &counterv1.QueryCounterResponse{Value: 100}, resp)
+
+	// unknown messages
+	_, err = f.k.Execute(f.ctx, addr1, sender, &counterv1.QueryCounterRequest{})
+	require.ErrorContains(t, err, "unknown message")
+	_, err = f.k.Query(f.ctx, addr1, &counterv1.MsgIncreaseCounter{})
+	require.ErrorContains(t, err, "unknown message")
+
+	// unknown account
+	_, err = f.k.Execute(f.ctx, sdk.AccAddress("unknown"), sender, &counterv1.MsgIncreaseCounter{Amount: 5})
+	require.ErrorIs(t, err, types.ErrAccountNotFound)
+}
+
+func TestKeeper_AuthenticateAccount(t *testing.T) {
+	f := newFixture(t, nil)
+
+	priv := secp256k1.GenPrivKey()
+	_, addr, err := f.k.Init(f.ctx, "keyauth", sdk.AccAddress("creator"), &keyauthv1.MsgInit{PubKey: priv.PubKey().Bytes()})
+	require.NoError(t, err)
+
+	signBytes := []byte("sign bytes")
+	sig, err := priv.Sign(signBytes)
+	require.NoError(t, err)
+
+	require.NoError(t, f.k.AuthenticateAccount(f.ctx, addr, 0, signBytes, sig))
+
+	// signature over different bytes
+	err = f.k.AuthenticateAccount(f.ctx, addr, 0, []byte("other bytes"), sig)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// accounts which do not handle MsgAuthenticate cannot be authenticated
+	_, counterAddr, err := f.k.Init(f.ctx, "counter", sdk.AccAddress("creator"), &counterv1.MsgInit{})
+	require.NoError(t, err)
+	err = f.k.AuthenticateAccount(f.ctx, counterAddr, 0, signBytes, sig)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// the key can only be rotated by the account itself
+	newPriv := secp256k1.GenPrivKey()
+	rotate := &keyauthv1.MsgRotateKey{NewPubKey: newPriv.PubKey().Bytes()}
+	_, err = f.k.Execute(f.ctx, addr, sdk.AccAddress("creator"), rotate)
+	require.ErrorContains(t, err, "unauthorized")
+
+	_, err = f.k.Execute(f.ctx, addr, addr, rotate)
+	require.NoError(t, err)
+
+	require.ErrorIs(t, f.k.AuthenticateAccount(f.ctx, addr, 0, signBytes, sig), types.ErrUnauthorized)
+	newSig, err := newPriv.Sign(signBytes)
+	require.NoError(t, err)
+	require.NoError(t, f.k.AuthenticateAccount(f.ctx, addr, 0, signBytes, newSig))
+}
+
+func TestKeeper_SendModuleMessage(t *testing.T) {
+	var executed []sdk.Msg
+	f := newFixture(t, func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error) {
+		executed = append(executed, req)
+		resp, err := codectypes.NewAnyWithValue(&banktypes.MsgSendResponse{})
+		if err != nil {
+			return nil, err
+		}
+		return &sdk.Result{
+			Events:       sdk.Events{sdk.NewEvent("test")}.ToABCIEvents(),
+			MsgResponses: []*codectypes.Any{resp},
+		}, nil
+	})
+
+	account := sdk.AccAddress("account")
+	msg := banktypes.NewMsgSend(account, sdk.AccAddress("recipient"), sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+
+	resp, err := f.k.sendModuleMessage(f.ctx, account, msg)
+	require.NoError(t, err)
+	require.Equal(t, &banktypes.MsgSendResponse{}, resp)
+	require.Equal(t, []sdk.Msg{msg}, executed)
+	require.Len(t, f.ctx.EventManager().Events(), 1)
+
+	// accounts can only execute messages they are the signer of
+	_, err = f.k.sendModuleMessage(f.ctx, sdk.AccAddress("other"), msg)
+	require.ErrorContains(t, err, "must be the only signer")
+	require.Len(t, executed, 1)
+}
@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/gogoproto/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/accounts/internal/implementation"
+	"github.com/cosmos/cosmos-sdk/x/accounts/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the accounts MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// Init creates a new account of the requested type.
+func (k msgServer) Init(ctx context.Context, msg *types.MsgInit) (*types.MsgInitResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	impl, ok := k.accounts[msg.AccountType]
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrAccountTypeNotFound, "%s", msg.AccountType)
+	}
+
+	initRequest, err := decodeRequest(msg.Message, map[string]implementation.HandlerSchema{
+		impl.InitHandlerSchema.RequestSchema.Name: impl.InitHandlerSchema,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	initResponse, accountAddr, err := k.Keeper.Init(ctx, msg.AccountType, sender, initRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := packResponse(initResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgInitResponse{
+		AccountAddress: sdk.AccAddress(accountAddr).String(),
+		Response:       resp,
+	}, nil
+}
+
+// Execute executes a message on the target account. MsgAuthenticate can only
+// be sent to accounts by the module itself.
+func (k msgServer) Execute(ctx context.Context, msg *types.MsgExecute) (*types.MsgExecuteResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := sdk.AccAddressFromBech32(msg.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Message != nil && strings.TrimPrefix(msg.Message.TypeUrl, "/") == proto.MessageName(&types.MsgAuthenticate{}) {
+		return nil, errorsmod.Wrap(types.ErrInvalidMessage, "MsgAuthenticate can only be sent by the accounts module")
+	}
+
+	impl, err := k.getImplementation(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+
+	executeRequest, err := decodeRequest(msg.Message, impl.ExecuteHandlersSchema)
+	if err != nil {
+		return nil, err
+	}
+
+	executeResponse, err := k.Keeper.Execute(ctx, target, sender, executeRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := packResponse(executeResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgExecuteResponse{Response: resp}, nil
+}
+
+// decodeRequest decodes the provided message into the request type of the
+// matching handler schema.
+func decodeRequest(msg *codectypes.Any, schemas map[string]implementation.HandlerSchema) (proto.Message, error) {
+	if msg == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidMessage, "message cannot be empty")
+	}
+
+	schema, ok := schemas[strings.TrimPrefix(msg.TypeUrl, "/")]
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrInvalidMessage, "account does not accept %s", msg.TypeUrl)
+	}
+
+	req := schema.RequestSchema.New()
+	if err := proto.Unmarshal(msg.Value, req); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidMessage, "failed to decode %s: %s", msg.TypeUrl, err)
+	}
+
+	return req, nil
+}
+
+// packResponse packs an account handler response into an Any, nil responses
+// are left empty.
+func packResponse(resp proto.Message) (*codectypes.Any, error) {
+	if resp == nil {
+		return nil, nil
+	}
+
+	return codectypes.NewAnyWithValue(resp)
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	counterv1 "github.com/cosmos/cosmos-sdk/x/accounts/testing/counter/v1"
+	"github.com/cosmos/cosmos-sdk/x/accounts/types"
+)
+
+func TestMsgServer(t *testing.T) {
+	f := newFixture(t, nil)
+	ms := NewMsgServerImpl(f.k)
+	sender := sdk.AccAddress("sender").String()
+
+	initMsg, err := codectypes.NewAnyWithValue(&counterv1.MsgInit{InitialValue: 1})
+	require.NoError(t, err)
+
+	initResp, err := ms.Init(f.ctx, types.NewMsgInit(sender, "counter", initMsg))
+	require.NoError(t, err)
+	require.NotEmpty(t, initResp.AccountAddress)
+	require.Equal(t, "/cosmos.accounts.testing.counter.v1.MsgInitResponse", initResp.Response.TypeUrl)
+
+	// the init message must match the account type init message
+	wrongMsg, err := codectypes.NewAnyWithValue(&counterv1.MsgIncreaseCounter{Amount: 1})
+	require.NoError(t, err)
+	_, err = ms.Init(f.ctx, types.NewMsgInit(sender, "counter", wrongMsg))
+	require.ErrorIs(t, err, types.ErrInvalidMessage)
+
+	execResp, err := ms.Execute(f.ctx, types.NewMsgExecute(sender, initResp.AccountAddress, wrongMsg))
+	require.NoError(t, err)
+
+	var resp counterv1.MsgIncreaseCounterResponse
+	require.NoError(t, resp.Unmarshal(execResp.Response.Value))
+	require.Equal(t, uint64(2), resp.NewAmount)
+
+	// MsgAuthenticate can only be sent by the module
+	authMsg, err := codectypes.NewAnyWithValue(&types.MsgAuthenticate{})
+	require.NoError(t, err)
+	_, err = ms.Execute(f.ctx, types.NewMsgExecute(sender, initResp.AccountAddress, authMsg))
+	require.ErrorIs(t, err, types.ErrInvalidMessage)
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/accounts/accountstd"
+	"github.com/cosmos/cosmos-sdk/x/accounts/testing/counter"
+	"github.com/cosmos/cosmos-sdk/x/accounts/testing/keyauth"
+	"github.com/cosmos/cosmos-sdk/x/accounts/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// mockAccountKeeper is an in-memory x/auth account keeper.
+type mockAccountKeeper map[string]sdk.AccountI
+
+func (m mockAccountKeeper) NewAccountWithAddress(_ sdk.Context, addr sdk.AccAddress) sdk.AccountI {
+	acc := authtypes.NewBaseAccountWithAddress(addr)
+	_ = acc.SetAccountNumber(uint64(len(m)))
+	return acc
+}
+
+func (m mockAccountKeeper) SetAccount(_ sdk.Context, acc sdk.AccountI) {
+	m[acc.GetAddress().String()] = acc
+}
+
+func (m mockAccountKeeper) HasAccount(_ sdk.Context, addr sdk.AccAddress) bool {
+	_, ok := m[addr.String()]
+	return ok
+}
+
+// mockRouter routes every message to a single handler.
+type mockRouter func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error)
+
+func (m mockRouter) Handler(sdk.Msg) func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error) {
+	return m
+}
+
+type fixture struct {
+	ctx sdk.Context
+	k   Keeper
+	ak  mockAccountKeeper
+}
+
+func newFixture(t *testing.T, router mockRouter) fixture {
+	t.Helper()
+
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+	ak := mockAccountKeeper{}
+
+	k, err := NewKeeper(
+		runtime.NewKVStoreService(key),
+		ak,
+		router,
+		encCfg.InterfaceRegistry,
+		accountstd.AddAccount("counter", counter.NewAccount),
+		accountstd.AddAccount("keyauth", keyauth.NewAccount),
+	)
+	require.NoError(t, err)
+
+	return fixture{ctx: ctx, k: k, ak: ak}
+}
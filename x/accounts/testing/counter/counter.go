@@ -0,0 +1,84 @@
+// Package counter implements a simple smart account used to test x/accounts.
+// It holds a counter which can be increased by anyone, and queried.
+package counter
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	"github.com/cosmos/cosmos-sdk/x/accounts/accountstd"
+	counterv1 "github.com/cosmos/cosmos-sdk/x/accounts/testing/counter/v1"
+)
+
+var (
+	CounterPrefix = collections.NewPrefix(0)
+	OwnerPrefix   = collections.NewPrefix(1)
+)
+
+var _ accountstd.Interface = (*Account)(nil)
+
+// NewAccount creates a new counter Account.
+func NewAccount(d accountstd.Dependencies) (Account, error) {
+	return Account{
+		Owner:   collections.NewItem(d.SchemaBuilder, OwnerPrefix, "owner", collections.BytesValue),
+		Counter: collections.NewItem(d.SchemaBuilder, CounterPrefix, "counter", collections.Uint64Value),
+	}, nil
+}
+
+// Account implements the counter account.
+type Account struct {
+	// Owner is the address of the account which created the counter.
+	Owner collections.Item[[]byte]
+	// Counter is the counter value.
+	Counter collections.Item[uint64]
+}
+
+// Init sets the owner and the initial value of the counter.
+func (a Account) Init(ctx context.Context, msg *counterv1.MsgInit) (*counterv1.MsgInitResponse, error) {
+	if err := a.Owner.Set(ctx, accountstd.Sender(ctx)); err != nil {
+		return nil, err
+	}
+	if err := a.Counter.Set(ctx, msg.InitialValue); err != nil {
+		return nil, err
+	}
+
+	return &counterv1.MsgInitResponse{}, nil
+}
+
+// IncreaseCounter increases the counter by the given amount.
+func (a Account) IncreaseCounter(ctx context.Context, msg *counterv1.MsgIncreaseCounter) (*counterv1.MsgIncreaseCounterResponse, error) {
+	counter, err := a.Counter.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	newValue := counter + msg.Amount
+	if err := a.Counter.Set(ctx, newValue); err != nil {
+		return nil, err
+	}
+
+	return &counterv1.MsgIncreaseCounterResponse{NewAmount: newValue}, nil
+}
+
+// QueryCounter returns the counter value.
+func (a Account) QueryCounter(ctx context.Context, _ *counterv1.QueryCounterRequest) (*counterv1.QueryCounterResponse, error) {
+	counter, err := a.Counter.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &counterv1.QueryCounterResponse{Value: counter}, nil
+}
+
+func (a Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, a.IncreaseCounter)
+}
+
+func (a Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, a.QueryCounter)
+}
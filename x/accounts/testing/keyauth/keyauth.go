@@ -0,0 +1,93 @@
+// Package keyauth implements a smart account used to test x/accounts
+// authentication. The account authorizes transactions signed by a secp256k1
+// key stored in its state, which can be rotated without changing the account
+// address, as session keys would.
+package keyauth
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/x/accounts/accountstd"
+	keyauthv1 "github.com/cosmos/cosmos-sdk/x/accounts/testing/keyauth/v1"
+	accountstypes "github.com/cosmos/cosmos-sdk/x/accounts/types"
+)
+
+var (
+	PubKeyPrefix = collections.NewPrefix(0)
+
+	// ErrInvalidSignature is returned when a transaction is not signed by
+	// the authorized key.
+	ErrInvalidSignature = errors.New("invalid signature")
+	// ErrUnauthorized is returned when the key is rotated by someone else
+	// than the account itself.
+	ErrUnauthorized = errors.New("unauthorized")
+)
+
+var _ accountstd.Interface = (*Account)(nil)
+
+// NewAccount creates a new key authenticated Account.
+func NewAccount(d accountstd.Dependencies) (Account, error) {
+	return Account{
+		PubKey: collections.NewItem(d.SchemaBuilder, PubKeyPrefix, "pub_key", collections.BytesValue),
+	}, nil
+}
+
+// Account implements the key authenticated account.
+type Account struct {
+	// PubKey is the compressed secp256k1 public key authorized to sign on
+	// behalf of the account.
+	PubKey collections.Item[[]byte]
+}
+
+// Init sets the authorized key.
+func (a Account) Init(ctx context.Context, msg *keyauthv1.MsgInit) (*keyauthv1.MsgInitResponse, error) {
+	if len(msg.PubKey) != secp256k1.PubKeySize {
+		return nil, errors.New("invalid public key")
+	}
+
+	return &keyauthv1.MsgInitResponse{}, a.PubKey.Set(ctx, msg.PubKey)
+}
+
+// RotateKey replaces the authorized key, it must be sent by the account
+// itself, that is signed by the current key.
+func (a Account) RotateKey(ctx context.Context, msg *keyauthv1.MsgRotateKey) (*keyauthv1.MsgRotateKeyResponse, error) {
+	if !bytes.Equal(accountstd.Sender(ctx), accountstd.Whoami(ctx)) {
+		return nil, ErrUnauthorized
+	}
+	if len(msg.NewPubKey) != secp256k1.PubKeySize {
+		return nil, errors.New("invalid public key")
+	}
+
+	return &keyauthv1.MsgRotateKeyResponse{}, a.PubKey.Set(ctx, msg.NewPubKey)
+}
+
+// Authenticate verifies that the transaction is signed by the authorized key.
+func (a Account) Authenticate(ctx context.Context, msg *accountstypes.MsgAuthenticate) (*accountstypes.MsgAuthenticateResponse, error) {
+	pubKey, err := a.PubKey.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pk := &secp256k1.PubKey{Key: pubKey}
+	if !pk.VerifySignature(msg.SignBytes, msg.Signature) {
+		return nil, ErrInvalidSignature
+	}
+
+	return &accountstypes.MsgAuthenticateResponse{}, nil
+}
+
+func (a Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, a.RotateKey)
+	accountstd.RegisterExecuteHandler(builder, a.Authenticate)
+}
+
+func (a Account) RegisterQueryHandlers(*accountstd.QueryBuilder) {}
@@ -0,0 +1,543 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/accounts/v1/account_abstraction.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgAuthenticate is sent by the x/accounts module to an account when the
+// account is a signer of a transaction. Accounts which wish to authorize
+// transactions must register an execute handler for this message, replacing
+// the public key based signature verification of x/auth.
+//
+// Since: cosmos-sdk 0.48
+type MsgAuthenticate struct {
+	// sign_bytes are the bytes the signer is expected to have signed, built
+	// according to the sign mode of the signature.
+	SignBytes []byte `protobuf:"bytes,1,opt,name=sign_bytes,json=signBytes,proto3" json:"sign_bytes,omitempty"`
+	// signature is the signature provided by the signer in the transaction.
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	// signer_index is the index of the account in the signers of the
+	// transaction.
+	SignerIndex uint32 `protobuf:"varint,3,opt,name=signer_index,json=signerIndex,proto3" json:"signer_index,omitempty"`
+}
+
+func (m *MsgAuthenticate) Reset()         { *m = MsgAuthenticate{} }
+func (m *MsgAuthenticate) String() string { return proto.CompactTextString(m) }
+func (*MsgAuthenticate) ProtoMessage()    {}
+func (*MsgAuthenticate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f9bcc910ad46d4b, []int{0}
+}
+func (m *MsgAuthenticate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAuthenticate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAuthenticate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAuthenticate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAuthenticate.Merge(m, src)
+}
+func (m *MsgAuthenticate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAuthenticate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAuthenticate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAuthenticate proto.InternalMessageInfo
+
+func (m *MsgAuthenticate) GetSignBytes() []byte {
+	if m != nil {
+		return m.SignBytes
+	}
+	return nil
+}
+
+func (m *MsgAuthenticate) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *MsgAuthenticate) GetSignerIndex() uint32 {
+	if m != nil {
+		return m.SignerIndex
+	}
+	return 0
+}
+
+// MsgAuthenticateResponse is the response to MsgAuthenticate. Authentication
+// is considered successful when the handler returns no error.
+type MsgAuthenticateResponse struct {
+}
+
+func (m *MsgAuthenticateResponse) Reset()         { *m = MsgAuthenticateResponse{} }
+func (m *MsgAuthenticateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAuthenticateResponse) ProtoMessage()    {}
+func (*MsgAuthenticateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f9bcc910ad46d4b, []int{1}
+}
+func (m *MsgAuthenticateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAuthenticateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAuthenticateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAuthenticateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAuthenticateResponse.Merge(m, src)
+}
+func (m *MsgAuthenticateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAuthenticateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAuthenticateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAuthenticateResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgAuthenticate)(nil), "cosmos.accounts.v1.MsgAuthenticate")
+	proto.RegisterType((*MsgAuthenticateResponse)(nil), "cosmos.accounts.v1.MsgAuthenticateResponse")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/accounts/v1/account_abstraction.proto", fileDescriptor_9f9bcc910ad46d4b)
+}
+
+var fileDescriptor_9f9bcc910ad46d4b = []byte{
+	// 236 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x49, 0xce, 0x2f, 0xce,
+	0xcd, 0x2f, 0xd6, 0x4f, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b, 0x29, 0xd6, 0x2f, 0x33, 0x84, 0xb1,
+	0xe3, 0x13, 0x93, 0x8a, 0x4b, 0x8a, 0x12, 0x93, 0x4b, 0x32, 0xf3, 0xf3, 0xf4, 0x0a, 0x8a, 0xf2,
+	0x4b, 0xf2, 0x85, 0x84, 0x20, 0xaa, 0xf5, 0x60, 0xaa, 0xf5, 0xca, 0x0c, 0x95, 0x0a, 0xb9, 0xf8,
+	0x7d, 0x8b, 0xd3, 0x1d, 0x4b, 0x4b, 0x32, 0x52, 0xf3, 0x4a, 0x32, 0x93, 0x13, 0x4b, 0x52, 0x85,
+	0x64, 0xb9, 0xb8, 0x8a, 0x33, 0xd3, 0xf3, 0xe2, 0x93, 0x2a, 0x4b, 0x52, 0x8b, 0x25, 0x18, 0x15,
+	0x18, 0x35, 0x78, 0x82, 0x38, 0x41, 0x22, 0x4e, 0x20, 0x01, 0x21, 0x19, 0x2e, 0x30, 0x27, 0xb1,
+	0xa4, 0xb4, 0x28, 0x55, 0x82, 0x09, 0x21, 0x0b, 0x16, 0x10, 0x52, 0xe4, 0xe2, 0x01, 0x71, 0x52,
+	0x8b, 0xe2, 0x33, 0xf3, 0x52, 0x52, 0x2b, 0x24, 0x98, 0x15, 0x18, 0x35, 0x78, 0x83, 0xb8, 0x21,
+	0x62, 0x9e, 0x20, 0x21, 0x25, 0x49, 0x2e, 0x71, 0x34, 0x2b, 0x83, 0x52, 0x8b, 0x0b, 0xf2, 0xf3,
+	0x8a, 0x53, 0x9d, 0xdc, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39,
+	0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x37,
+	0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0xea, 0x69, 0x08, 0xa5, 0x5b,
+	0x9c, 0x92, 0xad, 0x5f, 0x81, 0x08, 0x81, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x8f,
+	0x8d, 0x01, 0x03, 0x00, 0x82, 0x07, 0xa1, 0xb9, 0x21, 0x01, 0x00, 0x00,
+}
+
+func (m *MsgAuthenticate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAuthenticate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAuthenticate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SignerIndex != 0 {
+		i = encodeVarintAccountAbstraction(dAtA, i, uint64(m.SignerIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintAccountAbstraction(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SignBytes) > 0 {
+		i -= len(m.SignBytes)
+		copy(dAtA[i:], m.SignBytes)
+		i = encodeVarintAccountAbstraction(dAtA, i, uint64(len(m.SignBytes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAuthenticateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAuthenticateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAuthenticateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAccountAbstraction(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAccountAbstraction(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAuthenticate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignBytes)
+	if l > 0 {
+		n += 1 + l + sovAccountAbstraction(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovAccountAbstraction(uint64(l))
+	}
+	if m.SignerIndex != 0 {
+		n += 1 + sovAccountAbstraction(uint64(m.SignerIndex))
+	}
+	return n
+}
+
+func (m *MsgAuthenticateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovAccountAbstraction(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAccountAbstraction(x uint64) (n int) {
+	return sovAccountAbstraction(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAuthenticate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccountAbstraction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAuthenticate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAuthenticate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountAbstraction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAccountAbstraction
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountAbstraction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignBytes = append(m.SignBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.SignBytes == nil {
+				m.SignBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountAbstraction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAccountAbstraction
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountAbstraction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerIndex", wireType)
+			}
+			m.SignerIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountAbstraction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignerIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccountAbstraction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccountAbstraction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAuthenticateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccountAbstraction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAuthenticateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAuthenticateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccountAbstraction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccountAbstraction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAccountAbstraction(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAccountAbstraction
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAccountAbstraction
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAccountAbstraction
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAccountAbstraction
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAccountAbstraction
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAccountAbstraction
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAccountAbstraction        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAccountAbstraction          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAccountAbstraction = fmt.Errorf("proto: unexpected end of group")
+)
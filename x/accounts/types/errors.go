@@ -0,0 +1,11 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/accounts module sentinel errors
+var (
+	ErrAccountTypeNotFound = errors.Register(ModuleName, 2, "account type not found")
+	ErrAccountNotFound     = errors.Register(ModuleName, 3, "account not found")
+	ErrInvalidMessage      = errors.Register(ModuleName, 4, "invalid account message")
+	ErrUnauthorized        = errors.Register(ModuleName, 5, "account authentication failed")
+)
@@ -0,0 +1,20 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AccountKeeper defines the x/auth keeper methods used by x/accounts to
+// register the accounts it creates, so they can pay fees and sign
+// transactions.
+type AccountKeeper interface {
+	NewAccountWithAddress(ctx sdk.Context, addr sdk.AccAddress) sdk.AccountI
+	SetAccount(ctx sdk.Context, acc sdk.AccountI)
+	HasAccount(ctx sdk.Context, addr sdk.AccAddress) bool
+}
+
+// MsgRouter defines the router used by accounts to execute module messages,
+// it is implemented by the baseapp.MsgServiceRouter.
+type MsgRouter interface {
+	Handler(msg sdk.Msg) func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error)
+}
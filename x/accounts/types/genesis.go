@@ -0,0 +1,34 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultGenesisState returns a default accounts module genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs *GenesisState) Validate() error {
+	seen := make(map[string]struct{}, len(gs.Accounts))
+	for _, acc := range gs.Accounts {
+		if _, err := sdk.AccAddressFromBech32(acc.Address); err != nil {
+			return fmt.Errorf("invalid account address %s: %w", acc.Address, err)
+		}
+
+		if _, ok := seen[acc.Address]; ok {
+			return fmt.Errorf("duplicate account %s", acc.Address)
+		}
+		seen[acc.Address] = struct{}{}
+
+		if acc.AccountType == "" {
+			return fmt.Errorf("empty account type for account %s", acc.Address)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,18 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "accounts"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+// KVStore keys
+var (
+	AccountNumberKey     = collections.NewPrefix(0)
+	AccountTypeByAddrKey = collections.NewPrefix(1)
+	AccountStatePrefix   = collections.NewPrefix(255)
+)
@@ -0,0 +1,75 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _, _ sdk.Msg = &MsgInit{}, &MsgExecute{}
+
+// NewMsgInit creates a new MsgInit instance.
+func NewMsgInit(sender, accountType string, msg *codectypes.Any) *MsgInit {
+	return &MsgInit{
+		Sender:      sender,
+		AccountType: accountType,
+		Message:     msg,
+	}
+}
+
+// GetSigners returns the expected signers for a MsgInit.
+func (m MsgInit) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgInit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+
+	if m.AccountType == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "account type cannot be empty")
+	}
+
+	return validateMessage(m.Message)
+}
+
+// NewMsgExecute creates a new MsgExecute instance.
+func NewMsgExecute(sender, target string, msg *codectypes.Any) *MsgExecute {
+	return &MsgExecute{
+		Sender:  sender,
+		Target:  target,
+		Message: msg,
+	}
+}
+
+// GetSigners returns the expected signers for a MsgExecute.
+func (m MsgExecute) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgExecute) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Target); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid target address: %s", err)
+	}
+
+	return validateMessage(m.Message)
+}
+
+func validateMessage(msg *codectypes.Any) error {
+	if msg == nil || msg.TypeUrl == "" {
+		return errorsmod.Wrap(ErrInvalidMessage, "message cannot be empty")
+	}
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante/unorderedtx"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -21,6 +22,12 @@ type HandlerOptions struct {
 	SignModeHandler        authsigning.SignModeHandler
 	SigGasConsumer         func(meter storetypes.GasMeter, sig signing.SignatureV2, params types.Params) error
 	TxFeeChecker           TxFeeChecker
+	// AccountAbstractionKeeper authenticates the signers which are x/accounts
+	// accounts. When nil, all signers are verified against their public key.
+	AccountAbstractionKeeper AccountAbstractionKeeper
+	// UnorderedTxManager tracks the unordered transactions included in blocks
+	// for replay protection. When nil, unordered transactions are rejected.
+	UnorderedTxManager *unorderedtx.Manager
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -49,8 +56,9 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
 		NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		NewValidateSigCountDecorator(options.AccountKeeper),
-		NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
-		NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
+		NewSigGasConsumeDecoratorWithAccountAbstraction(options.AccountKeeper, options.SigGasConsumer, options.AccountAbstractionKeeper),
+		NewSigVerificationDecoratorWithAccountAbstraction(options.AccountKeeper, options.SignModeHandler, options.AccountAbstractionKeeper),
+		NewUnorderedTxDecorator(unorderedtx.DefaultMaxUnOrderedTTL, options.UnorderedTxManager), // UnorderedTxDecorator must be called after the fee deduction and all signature verification decorators
 		NewIncrementSequenceDecorator(options.AccountKeeper),
 	}
 
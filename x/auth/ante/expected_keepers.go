@@ -1,6 +1,8 @@
 package ante
 
 import (
+	"context"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -18,3 +20,11 @@ type AccountKeeper interface {
 type FeegrantKeeper interface {
 	UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
 }
+
+// AccountAbstractionKeeper defines the expected x/accounts keeper, which
+// authenticates the signers that are accounts created by x/accounts in place
+// of public key based signature verification.
+type AccountAbstractionKeeper interface {
+	IsAccountsModuleAccount(ctx context.Context, addr []byte) bool
+	AuthenticateAccount(ctx context.Context, addr []byte, signerIndex uint32, signBytes, signature []byte) error
+}
@@ -130,9 +130,15 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 // before calling the next AnteHandler
 // CONTRACT: Pubkeys are set in context for all signers before this decorator runs
 // CONTRACT: Tx must implement SigVerifiableTx interface
+//
+// Signers which are accounts created by x/accounts have no public key, their
+// authentication consumes gas as it executes. No signature gas is consumed for
+// them when the AccountAbstractionKeeper provided to
+// NewSigGasConsumeDecoratorWithAccountAbstraction reports them as such.
 type SigGasConsumeDecorator struct {
 	ak             AccountKeeper
 	sigGasConsumer SignatureVerificationGasConsumer
+	aaKeeper       AccountAbstractionKeeper
 }
 
 func NewSigGasConsumeDecorator(ak AccountKeeper, sigGasConsumer SignatureVerificationGasConsumer) SigGasConsumeDecorator {
@@ -146,6 +152,16 @@ func NewSigGasConsumeDecorator(ak AccountKeeper, sigGasConsumer SignatureVerific
 	}
 }
 
+// NewSigGasConsumeDecoratorWithAccountAbstraction returns a
+// SigGasConsumeDecorator which consumes no signature gas for the signers created
+// by x/accounts, as reported by the provided AccountAbstractionKeeper. A nil
+// AccountAbstractionKeeper treats all signers as regular accounts.
+func NewSigGasConsumeDecoratorWithAccountAbstraction(ak AccountKeeper, sigGasConsumer SignatureVerificationGasConsumer, aaKeeper AccountAbstractionKeeper) SigGasConsumeDecorator {
+	sgcd := NewSigGasConsumeDecorator(ak, sigGasConsumer)
+	sgcd.aaKeeper = aaKeeper
+	return sgcd
+}
+
 func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	sigTx, ok := tx.(authsigning.SigVerifiableTx)
 	if !ok {
@@ -178,6 +194,11 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 			pubKey = simSecp256k1Pubkey
 		}
 
+		// accounts created by x/accounts consume gas as they authenticate
+		if pubKey == nil && sgcd.aaKeeper != nil && sgcd.aaKeeper.IsAccountsModuleAccount(ctx, signerAddrs[i]) {
+			continue
+		}
+
 		// make a SignatureV2 with PubKey filled in from above
 		sig = signing.SignatureV2{
 			PubKey:   pubKey,
@@ -199,9 +220,15 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 //
 // CONTRACT: Pubkeys are set in context for all signers before this decorator runs
 // CONTRACT: Tx must implement SigVerifiableTx interface
+//
+// Signers which are accounts created by x/accounts are not verified against a
+// public key, they are authenticated by their account implementation through
+// the AccountAbstractionKeeper provided to
+// NewSigVerificationDecoratorWithAccountAbstraction instead.
 type SigVerificationDecorator struct {
 	ak              AccountKeeper
 	signModeHandler authsigning.SignModeHandler
+	aaKeeper        AccountAbstractionKeeper
 }
 
 func NewSigVerificationDecorator(ak AccountKeeper, signModeHandler authsigning.SignModeHandler) SigVerificationDecorator {
@@ -211,6 +238,16 @@ func NewSigVerificationDecorator(ak AccountKeeper, signModeHandler authsigning.S
 	}
 }
 
+// NewSigVerificationDecoratorWithAccountAbstraction returns a
+// SigVerificationDecorator which also authenticates the signers created by
+// x/accounts through the provided AccountAbstractionKeeper. A nil
+// AccountAbstractionKeeper disables such authentication.
+func NewSigVerificationDecoratorWithAccountAbstraction(ak AccountKeeper, signModeHandler authsigning.SignModeHandler, aaKeeper AccountAbstractionKeeper) SigVerificationDecorator {
+	svd := NewSigVerificationDecorator(ak, signModeHandler)
+	svd.aaKeeper = aaKeeper
+	return svd
+}
+
 // OnlyLegacyAminoSigners checks SignatureData to see if all
 // signers are using SIGN_MODE_LEGACY_AMINO_JSON. If this is the case
 // then the corresponding SignatureV2 struct will not have account sequence
@@ -252,20 +289,32 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		return ctx, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(signerAddrs), len(sigs))
 	}
 
+	// unordered transactions are protected against replay by the
+	// UnorderedTxDecorator instead of the account sequence
+	unordered := isUnorderedTx(tx)
+
 	for i, sig := range sigs {
 		acc, err := GetSignerAcc(ctx, svd.ak, signerAddrs[i])
 		if err != nil {
 			return ctx, err
 		}
 
+		// accounts created by x/accounts authenticate transactions themselves
+		isAccountsModuleAccount := svd.aaKeeper != nil && svd.aaKeeper.IsAccountsModuleAccount(ctx, signerAddrs[i])
+
 		// retrieve pubkey
 		pubKey := acc.GetPubKey()
-		if !simulate && pubKey == nil {
+		if !simulate && !isAccountsModuleAccount && pubKey == nil {
 			return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey on account is not set")
 		}
 
 		// Check account sequence number.
-		if sig.Sequence != acc.GetSequence() {
+		seq := acc.GetSequence()
+		if unordered {
+			// the signer committed to the sequence provided in the signature,
+			// which is not checked against the account for unordered transactions
+			seq = sig.Sequence
+		} else if sig.Sequence != acc.GetSequence() {
 			return ctx, errorsmod.Wrapf(
 				sdkerrors.ErrWrongSequence,
 				"account sequence mismatch, expected %d, got %d", acc.GetSequence(), sig.Sequence,
@@ -283,19 +332,23 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 			Address:       acc.GetAddress().String(),
 			ChainID:       chainID,
 			AccountNumber: accNum,
-			Sequence:      acc.GetSequence(),
+			Sequence:      seq,
 			PubKey:        pubKey,
 		}
 
 		// no need to verify signatures on recheck tx
-		if !simulate && !ctx.IsReCheckTx() {
+		if !simulate && !ctx.IsReCheckTx() && isAccountsModuleAccount {
+			if err := svd.authenticate(ctx, tx, signerAddrs[i], uint32(i), signerData, sig.Data); err != nil {
+				return ctx, err
+			}
+		} else if !simulate && !ctx.IsReCheckTx() {
 			err := authsigning.VerifySignature(ctx, pubKey, signerData, sig.Data, svd.signModeHandler, tx)
 			if err != nil {
 				var errMsg string
 				if OnlyLegacyAminoSigners(sig.Data) {
 					// If all signers are using SIGN_MODE_LEGACY_AMINO, we rely on VerifySignature to check account sequence number,
 					// and therefore communicate sequence number as a potential cause of error.
-					errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d), sequence (%d) and chain-id (%s)", accNum, acc.GetSequence(), chainID)
+					errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d), sequence (%d) and chain-id (%s)", accNum, seq, chainID)
 				} else {
 					errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d) and chain-id (%s)", accNum, chainID)
 				}
@@ -308,6 +361,22 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 	return next(ctx, tx, simulate)
 }
 
+// authenticate authenticates an x/accounts account signer by sending it the
+// bytes it was expected to sign along with its signature.
+func (svd SigVerificationDecorator) authenticate(ctx sdk.Context, tx sdk.Tx, addr sdk.AccAddress, signerIndex uint32, signerData authsigning.SignerData, sigData signing.SignatureData) error {
+	data, ok := sigData.(*signing.SingleSignatureData)
+	if !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "expected %T signature data for account %s, got %T", &signing.SingleSignatureData{}, addr, sigData)
+	}
+
+	signBytes, err := authsigning.GetSignBytesWithContext(svd.signModeHandler, ctx, data.SignMode, signerData, tx)
+	if err != nil {
+		return err
+	}
+
+	return svd.aaKeeper.AuthenticateAccount(ctx, addr, signerIndex, signBytes, data.Signature)
+}
+
 // IncrementSequenceDecorator handles incrementing sequences of all signers.
 // Use the IncrementSequenceDecorator decorator to prevent replay attacks. Note,
 // there is need to execute IncrementSequenceDecorator on RecheckTx since
@@ -316,7 +385,8 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 // NOTE: Since CheckTx and DeliverTx state are managed separately, subsequent and
 // sequential txs orginating from the same account cannot be handled correctly in
 // a reliable way unless sequence numbers are managed and tracked manually by a
-// client. It is recommended to instead use multiple messages in a tx.
+// client. It is recommended to instead use multiple messages in a tx, or to
+// send unordered transactions, whose sequences are not incremented.
 type IncrementSequenceDecorator struct {
 	ak AccountKeeper
 }
@@ -333,6 +403,11 @@ func (isd IncrementSequenceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
 	}
 
+	// unordered transactions do not use account sequences
+	if isUnorderedTx(tx) {
+		return next(ctx, tx, simulate)
+	}
+
 	// increment sequence of all signers
 	for _, addr := range sigTx.GetSigners() {
 		acc := isd.ak.GetAccount(ctx, addr)
@@ -1,6 +1,7 @@
 package ante_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -18,9 +19,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	txmodule "github.com/cosmos/cosmos-sdk/x/auth/tx/config"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -231,6 +234,95 @@ func TestSigVerification(t *testing.T) {
 	}
 }
 
+// mockAccountAbstractionKeeper authenticates the accounts it knows by
+// verifying their signature against the private key they were created with.
+type mockAccountAbstractionKeeper struct {
+	accounts map[string]cryptotypes.PrivKey
+}
+
+func (m mockAccountAbstractionKeeper) IsAccountsModuleAccount(_ context.Context, addr []byte) bool {
+	_, ok := m.accounts[string(addr)]
+	return ok
+}
+
+func (m mockAccountAbstractionKeeper) AuthenticateAccount(_ context.Context, addr []byte, _ uint32, signBytes, signature []byte) error {
+	priv, ok := m.accounts[string(addr)]
+	if !ok || !priv.PubKey().VerifySignature(signBytes, signature) {
+		return sdkerrors.ErrUnauthorized
+	}
+	return nil
+}
+
+func TestSigVerificationAccountAbstraction(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+
+	// the account is never given a pubkey, it is authenticated by the
+	// AccountAbstractionKeeper instead.
+	priv, _, addr := testdata.KeyTestPubAddr()
+	acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	require.NoError(t, acc.SetAccountNumber(0))
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+	aaKeeper := mockAccountAbstractionKeeper{accounts: map[string]cryptotypes.PrivKey{string(addr): priv}}
+	signModeHandler := suite.clientCtx.TxConfig.SignModeHandler()
+
+	testCases := []struct {
+		name     string
+		aaKeeper ante.AccountAbstractionKeeper
+		signer   cryptotypes.PrivKey
+		sequence uint64
+		expErr   error
+	}{
+		{"valid signature", aaKeeper, priv, 0, nil},
+		{"invalid signature", aaKeeper, secp256k1.GenPrivKey(), 0, sdkerrors.ErrUnauthorized},
+		{"wrong sequence", aaKeeper, priv, 1, sdkerrors.ErrWrongSequence},
+		{"no account abstraction keeper", nil, priv, 0, sdkerrors.ErrInvalidPubKey},
+		{"not an x/accounts account", mockAccountAbstractionKeeper{}, priv, 0, sdkerrors.ErrInvalidPubKey},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+			suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+			suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+			// set an empty signature first, so that the signer infos are part
+			// of the sign bytes.
+			sig := signing.SignatureV2{
+				Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+				Sequence: tc.sequence,
+			}
+			require.NoError(t, suite.txBuilder.SetSignatures(sig))
+
+			signerData := authsigning.SignerData{
+				Address:       addr.String(),
+				ChainID:       suite.ctx.ChainID(),
+				AccountNumber: acc.GetAccountNumber(),
+				Sequence:      tc.sequence,
+			}
+			signBytes, err := authsigning.GetSignBytesWithContext(signModeHandler, suite.ctx, signing.SignMode_SIGN_MODE_DIRECT, signerData, suite.txBuilder.GetTx())
+			require.NoError(t, err)
+			sig.Data.(*signing.SingleSignatureData).Signature, err = tc.signer.Sign(signBytes)
+			require.NoError(t, err)
+			require.NoError(t, suite.txBuilder.SetSignatures(sig))
+
+			spkd := ante.NewSetPubKeyDecorator(suite.accountKeeper)
+			sgcd := ante.NewSigGasConsumeDecoratorWithAccountAbstraction(suite.accountKeeper, ante.DefaultSigVerificationGasConsumer, tc.aaKeeper)
+			svd := ante.NewSigVerificationDecoratorWithAccountAbstraction(suite.accountKeeper, signModeHandler, tc.aaKeeper)
+			antehandler := sdk.ChainAnteDecorators(spkd, sgcd, svd)
+
+			_, err = antehandler(suite.ctx, suite.txBuilder.GetTx(), false)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestSigIntegration(t *testing.T) {
 	// generate private keys
 	privs := []cryptotypes.PrivKey{
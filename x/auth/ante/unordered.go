@@ -0,0 +1,99 @@
+package ante
+
+import (
+	"crypto/sha256"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante/unorderedtx"
+)
+
+var _ sdk.AnteDecorator = (*UnorderedTxDecorator)(nil)
+
+// UnorderedTxDecorator defines an AnteHandler decorator that is responsible for
+// checking if a transaction is intended to be unordered and if so, evaluates
+// the transaction accordingly. An unordered transaction will bypass having its
+// sequence checked and incremented, which allows fire-and-forget along with
+// possible parallel transaction processing, without having to deal with
+// sequences.
+//
+// The transaction sender must ensure that unordered=true and a timeout_height
+// is appropriately set. An unordered transaction without a timeout_height, or
+// with a timeout_height which has passed or is more than the maximum TTL
+// blocks past the current block height, is rejected. The TxBody has no timeout
+// timestamp, the timeout of unordered transactions is expressed in blocks only.
+// The AnteHandler will check that the transaction is not a duplicate and will
+// evict it from memory when the timeout is reached.
+//
+// The UnorderedTxDecorator must be placed after the fee deduction and the
+// signature verification decorators in the AnteHandler chain, so that the hash
+// of a transaction is only recorded once it is authenticated and has paid its
+// fees. Otherwise, a replay with an invalid signature or unpaid fees would
+// record the hash and block the genuine transaction. The transaction is only
+// added when the context is in ExecModeDeliver.
+type UnorderedTxDecorator struct {
+	// maxUnOrderedTTL defines the maximum TTL a transaction can define.
+	maxUnOrderedTTL uint64
+	txManager       *unorderedtx.Manager
+}
+
+// NewUnorderedTxDecorator returns a new UnorderedTxDecorator. A nil manager
+// results in all unordered transactions being rejected.
+func NewUnorderedTxDecorator(maxTTL uint64, m *unorderedtx.Manager) *UnorderedTxDecorator {
+	return &UnorderedTxDecorator{
+		maxUnOrderedTTL: maxTTL,
+		txManager:       m,
+	}
+}
+
+func (d *UnorderedTxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	unorderedTx, ok := tx.(sdk.TxWithUnordered)
+	if !ok || !unorderedTx.GetUnordered() {
+		// If the transaction does not implement unordered capabilities or has the
+		// unordered value as false, we bypass.
+		return next(ctx, tx, simulate)
+	}
+
+	if d.txManager == nil {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrNotSupported, "unordered transactions are not supported")
+	}
+
+	// TTL is defined as a specific block height at which this tx is no longer valid
+	ttl := unorderedTx.GetTimeoutHeight()
+
+	if ttl == 0 {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unordered transaction must have timeout_height set")
+	}
+	if ttl < uint64(ctx.BlockHeight()) {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unordered transaction has a timeout_height that has already passed")
+	}
+	if ttl > uint64(ctx.BlockHeight())+d.maxUnOrderedTTL {
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unordered transaction has a timeout_height %d more than %d blocks past the current block height %d", ttl, d.maxUnOrderedTTL, ctx.BlockHeight())
+	}
+
+	// in order to create a deterministic hash based on the tx, we need to hash the raw tx bytes
+	txHash := sha256.Sum256(ctx.TxBytes())
+
+	// check for duplicates
+	if d.txManager.Contains(txHash) {
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "tx %X is duplicated", txHash)
+	}
+
+	if ctx.ExecMode() == sdk.ExecModeDeliver && !simulate {
+		// a new tx included in the block, add the hash to the unordered tx
+		// manager. The txs of the proposals are not added, they are only
+		// included in a block once delivered.
+		d.txManager.Add(txHash, ttl)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// isUnorderedTx returns true if the provided transaction has its unordered
+// field set.
+func isUnorderedTx(tx sdk.Tx) bool {
+	unorderedTx, ok := tx.(sdk.TxWithUnordered)
+	return ok && unorderedTx.GetUnordered()
+}
@@ -0,0 +1,255 @@
+package ante_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante/unorderedtx"
+)
+
+const testMaxUnOrderedTTL = 100
+
+func TestUnorderedTxDecorator_OrderedTx(t *testing.T) {
+	txm := unorderedtx.NewManager(t.TempDir())
+	txm.Start()
+	defer func() {
+		require.NoError(t, txm.Close())
+	}()
+
+	chain := sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(testMaxUnOrderedTTL, txm))
+
+	tx, txBz := genUnorderedTx(t, false, 0)
+	ctx := sdk.Context{}.WithTxBytes(txBz)
+
+	_, err := chain(ctx, tx, false)
+	require.NoError(t, err)
+	require.Zero(t, txm.Size())
+}
+
+func TestUnorderedTxDecorator_UnorderedTx(t *testing.T) {
+	testCases := []struct {
+		name      string
+		ttl       uint64
+		execMode  sdk.ExecMode
+		simulate  bool
+		duplicate bool
+		expErr    string
+		expAdded  bool
+	}{
+		{"no ttl", 0, sdk.ExecModeDeliver, false, false, "unordered transaction must have timeout_height set", false},
+		{"ttl already passed", 9, sdk.ExecModeDeliver, false, false, "unordered transaction has a timeout_height that has already passed", false},
+		{"ttl too large", 10 + testMaxUnOrderedTTL + 1, sdk.ExecModeDeliver, false, false, "more than 100 blocks past the current block height 10", false},
+		{"duplicate", 15, sdk.ExecModeDeliver, false, true, "is duplicated", false},
+		{"check tx", 15, sdk.ExecModeCheck, false, false, "", false},
+		{"recheck tx", 15, sdk.ExecModeReCheck, false, false, "", false},
+		{"simulate", 15, sdk.ExecModeSimulate, true, false, "", false},
+		{"prepare proposal", 15, sdk.ExecModePrepareProposal, false, false, "", false},
+		{"process proposal", 15, sdk.ExecModeProcessProposal, false, false, "", false},
+		{"deliver tx", 15, sdk.ExecModeDeliver, false, false, "", true},
+		{"deliver tx with max ttl", 10 + testMaxUnOrderedTTL, sdk.ExecModeDeliver, false, false, "", true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			txm := unorderedtx.NewManager(t.TempDir())
+			txm.Start()
+			defer func() {
+				require.NoError(t, txm.Close())
+			}()
+
+			chain := sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(testMaxUnOrderedTTL, txm))
+
+			tx, txBz := genUnorderedTx(t, true, tc.ttl)
+			txHash := sha256.Sum256(txBz)
+			if tc.duplicate {
+				txm.Add(txHash, tc.ttl)
+			}
+
+			ctx := sdk.Context{}.WithTxBytes(txBz).WithBlockHeight(10).WithExecMode(tc.execMode)
+
+			_, err := chain(ctx, tx, tc.simulate)
+			if tc.expErr != "" {
+				require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.expAdded || tc.duplicate, txm.Contains(txHash))
+		})
+	}
+}
+
+func TestUnorderedTxDecorator_ProcessProposalThenDeliver(t *testing.T) {
+	txm := unorderedtx.NewManager(t.TempDir())
+	txm.Start()
+	defer func() {
+		require.NoError(t, txm.Close())
+	}()
+
+	chain := sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(testMaxUnOrderedTTL, txm))
+
+	tx, txBz := genUnorderedTx(t, true, 15)
+	ctx := sdk.Context{}.WithTxBytes(txBz).WithBlockHeight(10)
+
+	// the proposal is verified first, which does not record the tx
+	_, err := chain(ctx.WithExecMode(sdk.ExecModeProcessProposal), tx, false)
+	require.NoError(t, err)
+	require.Zero(t, txm.Size())
+
+	// the tx is then delivered, and recorded
+	_, err = chain(ctx.WithExecMode(sdk.ExecModeDeliver), tx, false)
+	require.NoError(t, err)
+	require.True(t, txm.Contains(sha256.Sum256(txBz)))
+
+	// it can not be delivered again
+	_, err = chain(ctx.WithExecMode(sdk.ExecModeDeliver), tx, false)
+	require.ErrorContains(t, err, "is duplicated")
+}
+
+func TestUnorderedTxDecorator_NoManager(t *testing.T) {
+	chain := sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(testMaxUnOrderedTTL, nil))
+
+	tx, txBz := genUnorderedTx(t, true, 15)
+	ctx := sdk.Context{}.WithTxBytes(txBz).WithBlockHeight(10)
+
+	_, err := chain(ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrNotSupported)
+
+	// ordered transactions are not affected
+	tx, txBz = genUnorderedTx(t, false, 0)
+	_, err = chain(ctx.WithTxBytes(txBz), tx, false)
+	require.NoError(t, err)
+}
+
+func TestUnorderedTx_SkipsSequence(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	accs := suite.CreateTestAccounts(1)
+	acc := accs[0]
+
+	msg := testdata.NewTestMsg(acc.acc.GetAddress())
+	require.NoError(t, suite.txBuilder.SetMsgs(msg))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	suite.txBuilder.SetTimeoutHeight(10)
+	suite.txBuilder.SetUnordered(true)
+
+	// sign with a sequence which does not match the account's
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{acc.priv}, []uint64{acc.acc.GetAccountNumber()}, []uint64{5}
+	tx, err := suite.CreateTestTx(suite.ctx, privs, accNums, accSeqs, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	chain := sdk.ChainAnteDecorators(
+		ante.NewSetPubKeyDecorator(suite.accountKeeper),
+		ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler()),
+		ante.NewIncrementSequenceDecorator(suite.accountKeeper),
+	)
+
+	_, err = chain(suite.ctx, tx, false)
+	require.NoError(t, err)
+	require.Zero(t, suite.accountKeeper.GetAccount(suite.ctx, acc.acc.GetAddress()).GetSequence())
+
+	// the same transaction without the unordered flag is rejected
+	suite.txBuilder.SetUnordered(false)
+	tx, err = suite.CreateTestTx(suite.ctx, privs, accNums, accSeqs, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	_, err = chain(suite.ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrWrongSequence)
+}
+
+func TestUnorderedTx_InvalidSignatureNotRecorded(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	txm := unorderedtx.NewManager(t.TempDir())
+	txm.Start()
+	defer func() {
+		require.NoError(t, txm.Close())
+	}()
+
+	anteHandler, err := ante.NewAnteHandler(ante.HandlerOptions{
+		AccountKeeper:      suite.accountKeeper,
+		BankKeeper:         suite.bankKeeper,
+		FeegrantKeeper:     suite.feeGrantKeeper,
+		SignModeHandler:    suite.encCfg.TxConfig.SignModeHandler(),
+		SigGasConsumer:     ante.DefaultSigVerificationGasConsumer,
+		UnorderedTxManager: txm,
+	})
+	require.NoError(t, err)
+
+	accs := suite.CreateTestAccounts(1)
+	suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	msg := testdata.NewTestMsg(accs[0].acc.GetAddress())
+	require.NoError(t, suite.txBuilder.SetMsgs(msg))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	suite.txBuilder.SetTimeoutHeight(10)
+	suite.txBuilder.SetUnordered(true)
+
+	ctx := suite.ctx.WithExecMode(sdk.ExecModeDeliver)
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}
+
+	// a tx signed for another chain is rejected without being recorded
+	tx, err := suite.CreateTestTx(ctx, privs, accNums, accSeqs, "other-chain", signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	txBz, err := suite.encCfg.TxConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	_, err = anteHandler(ctx.WithTxBytes(txBz), tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.Zero(t, txm.Size())
+
+	// the valid tx is recorded
+	tx, err = suite.CreateTestTx(ctx, privs, accNums, accSeqs, ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	txBz, err = suite.encCfg.TxConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	_, err = anteHandler(ctx.WithTxBytes(txBz), tx, false)
+	require.NoError(t, err)
+	require.True(t, txm.Contains(sha256.Sum256(txBz)))
+}
+
+func genUnorderedTx(t *testing.T, unordered bool, ttl uint64) (sdk.Tx, []byte) {
+	t.Helper()
+
+	s := SetupTestSuite(t, true)
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	// msg and signatures
+	msg := testdata.NewTestMsg(addr1)
+	feeAmount := testdata.NewTestFeeAmount()
+	gasLimit := testdata.NewTestGasLimit()
+	require.NoError(t, s.txBuilder.SetMsgs(msg))
+
+	s.txBuilder.SetFeeAmount(feeAmount)
+	s.txBuilder.SetGasLimit(gasLimit)
+	s.txBuilder.SetUnordered(unordered)
+	s.txBuilder.SetTimeoutHeight(ttl)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	txBz, err := s.encCfg.TxConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	return tx, txBz
+}
@@ -0,0 +1,318 @@
+package unorderedtx
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+const (
+	// DefaultMaxUnOrderedTTL defines the default maximum TTL, in blocks, an
+	// un-ordered transaction can set.
+	DefaultMaxUnOrderedTTL = 1024
+
+	dirName  = "unordered_txs"
+	fileName = "data"
+
+	// chunkSize is the size of a single persisted entry: the tx hash followed
+	// by its big-endian encoded TTL.
+	chunkSize = 32 + 8
+)
+
+// TxHash defines a transaction hash type alias, which is a fixed array of 32 bytes.
+type TxHash [32]byte
+
+// Manager contains the tx hash dictionary for duplicates checking, and expires
+// them when block production progresses.
+type Manager struct {
+	// blockCh defines a channel to receive newly committed block heights
+	blockCh chan uint64
+	// doneCh allows us to ensure the purgeLoop has gracefully terminated prior to closing
+	doneCh chan struct{}
+
+	// dataDir defines the directory to store unexpired unordered transactions
+	//
+	// XXX: Note, ideally we avoid the need to store unexpired unordered transactions
+	// directly to file. However, store v1 does not allow such a primitive. But,
+	// once store v2 is fully integrated, we can remove manual file handling and
+	// store everything in the same store.
+	dataDir string
+
+	mu sync.RWMutex
+	// txHashes defines a map from tx hash -> TTL value, which is used for duplicate
+	// checking and replay protection, as well as purging the map when the TTL is
+	// expired.
+	txHashes map[TxHash]uint64
+}
+
+// NewManager returns a new Manager persisting its unexpired transactions under
+// the provided data directory. The directory is only created when there are
+// unexpired transactions to flush.
+func NewManager(dataDir string) *Manager {
+	return &Manager{
+		dataDir:  filepath.Join(dataDir, dirName),
+		blockCh:  make(chan uint64, 16),
+		doneCh:   make(chan struct{}),
+		txHashes: make(map[TxHash]uint64),
+	}
+}
+
+// Start starts the background loop purging expired transactions. It must be
+// called before Close.
+func (m *Manager) Start() {
+	go m.purgeLoop()
+}
+
+// Close must be called when a node gracefully shuts down. Typically, this should
+// be called in an application's Close() function, which is called by the server.
+// It stops the purge loop and flushes all unexpired unordered transactions to
+// disk, so they can be loaded back by OnInit on the next start.
+func (m *Manager) Close() error {
+	close(m.blockCh)
+	<-m.doneCh
+	m.blockCh = nil
+
+	return m.flushToFile()
+}
+
+// OnCommit must be called once a block is committed, e.g. in an application's
+// PrepareCheckStater. It flushes all unexpired unordered transactions to disk,
+// so that they are loaded back by OnInit if the node does not shut down
+// gracefully.
+func (m *Manager) OnCommit() error {
+	return m.flushToFile()
+}
+
+// Contains returns true if the provided tx hash is tracked by the manager.
+func (m *Manager) Contains(hash TxHash) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	_, ok := m.txHashes[hash]
+	return ok
+}
+
+// Size returns the number of tracked tx hashes.
+func (m *Manager) Size() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return len(m.txHashes)
+}
+
+// Add tracks the provided tx hash until the block height reaches the given TTL.
+func (m *Manager) Add(txHash TxHash, ttl uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.txHashes[txHash] = ttl
+}
+
+// OnInit must be called when a node starts up. Typically, this should be called
+// in an application's constructor, which is called by the server. It loads the
+// unexpired unordered transactions flushed by OnCommit or Close.
+func (m *Manager) OnInit() error {
+	f, err := os.Open(filepath.Join(m.dataDir, fileName))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			// the file does not exist, which means the node either shut down
+			// without any unexpired unordered transactions or never ran before
+			return nil
+		}
+
+		return fmt.Errorf("failed to open unconfirmed txs file: %w", err)
+	}
+	defer f.Close()
+
+	var (
+		r   = bufio.NewReader(f)
+		buf = make([]byte, chunkSize)
+	)
+	for {
+		n, err := io.ReadFull(r, buf)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			return fmt.Errorf("failed to read unconfirmed txs file: %w", err)
+		}
+		if n != chunkSize {
+			return fmt.Errorf("read unexpected number of bytes from unconfirmed txs file: %d", n)
+		}
+
+		var txHash TxHash
+		copy(txHash[:], buf[:32])
+
+		m.Add(txHash, binary.BigEndian.Uint64(buf[32:]))
+	}
+
+	return nil
+}
+
+// OnNewBlock sends the latest block height to the background purge loop, which
+// should be called once per block, e.g. in an application's EndBlocker. All
+// transactions with a TTL lower than or equal to the provided height are purged,
+// as they can no longer be included in a subsequent block.
+func (m *Manager) OnNewBlock(blockHeight uint64) {
+	m.blockCh <- blockHeight
+}
+
+// flushToFile writes all tracked tx hashes and their TTL to disk, sorted by
+// tx hash so that the file content is deterministic. The file is replaced
+// atomically, and removed if there are no tracked tx hashes.
+func (m *Manager) flushToFile() error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	path := filepath.Join(m.dataDir, fileName)
+	if len(m.txHashes) == 0 {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to remove unordered txs file: %w", err)
+		}
+		return nil
+	}
+
+	if err := os.MkdirAll(m.dataDir, 0o755); err != nil {
+		return fmt.Errorf("failed to create unordered txs directory: %w", err)
+	}
+
+	if err := m.writeFile(path + ".tmp"); err != nil {
+		return err
+	}
+
+	if err := os.Rename(path+".tmp", path); err != nil {
+		return fmt.Errorf("failed to replace unordered txs file: %w", err)
+	}
+
+	return nil
+}
+
+// writeFile writes all tracked tx hashes and their TTL to the file at path. The
+// caller must hold the lock.
+func (m *Manager) writeFile(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create unordered txs file: %w", err)
+	}
+	defer f.Close()
+
+	w := bufio.NewWriter(f)
+	for _, txHash := range m.sortedHashes() {
+		buf := make([]byte, chunkSize)
+		copy(buf[:32], txHash[:])
+		binary.BigEndian.PutUint64(buf[32:], m.txHashes[txHash])
+
+		if _, err = w.Write(buf); err != nil {
+			return fmt.Errorf("failed to write buffer to unordered txs file: %w", err)
+		}
+	}
+
+	if err = w.Flush(); err != nil {
+		return fmt.Errorf("failed to flush unordered txs buffer: %w", err)
+	}
+
+	return f.Sync()
+}
+
+// export returns all tracked tx hashes and their TTL, sorted by tx hash, in
+// the format of the persisted file.
+func (m *Manager) export() []byte {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	buf := make([]byte, 0, len(m.txHashes)*chunkSize)
+	for _, txHash := range m.sortedHashes() {
+		buf = append(buf, txHash[:]...)
+		buf = binary.BigEndian.AppendUint64(buf, m.txHashes[txHash])
+	}
+
+	return buf
+}
+
+// sortedHashes returns the tracked tx hashes in ascending order. The caller
+// must hold the lock.
+func (m *Manager) sortedHashes() []TxHash {
+	hashes := make([]TxHash, 0, len(m.txHashes))
+	for txHash := range m.txHashes {
+		hashes = append(hashes, txHash)
+	}
+
+	sort.Slice(hashes, func(i, j int) bool {
+		return bytes.Compare(hashes[i][:], hashes[j][:]) < 0
+	})
+
+	return hashes
+}
+
+// expiredTxs returns expired tx hashes based on the provided block height.
+func (m *Manager) expiredTxs(blockHeight uint64) []TxHash {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var result []TxHash
+	for txHash, ttl := range m.txHashes {
+		if blockHeight >= ttl {
+			result = append(result, txHash)
+		}
+	}
+
+	return result
+}
+
+func (m *Manager) purge(txHashes []TxHash) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, txHash := range txHashes {
+		delete(m.txHashes, txHash)
+	}
+}
+
+// purgeLoop removes expired tx hashes in the background.
+func (m *Manager) purgeLoop() {
+	for {
+		latestHeight, ok := m.batchReceive()
+		if !ok {
+			// channel closed
+			m.doneCh <- struct{}{}
+			return
+		}
+
+		hashes := m.expiredTxs(latestHeight)
+		if len(hashes) != 0 {
+			m.purge(hashes)
+		}
+	}
+}
+
+// batchReceive drains the block height channel and returns the latest height.
+// It returns false when the channel is closed.
+func (m *Manager) batchReceive() (uint64, bool) {
+	latestHeight, ok := <-m.blockCh
+	if !ok {
+		return 0, false
+	}
+
+	for {
+		select {
+		case height, ok := <-m.blockCh:
+			if !ok {
+				// the remaining heights are processed, the next receive
+				// reports the channel as closed
+				return latestHeight, true
+			}
+			latestHeight = height
+
+		default:
+			return latestHeight, true
+		}
+	}
+}
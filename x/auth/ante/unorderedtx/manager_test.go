@@ -0,0 +1,151 @@
+package unorderedtx_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/auth/ante/unorderedtx"
+)
+
+func TestUnorderedTxManager_Close(t *testing.T) {
+	txm := unorderedtx.NewManager(t.TempDir())
+	txm.Start()
+
+	require.NoError(t, txm.Close())
+	require.Panics(t, func() { txm.Close() })
+}
+
+func TestUnorderedTxManager_SimpleSize(t *testing.T) {
+	txm := unorderedtx.NewManager(t.TempDir())
+	defer func() {
+		require.NoError(t, txm.Close())
+	}()
+
+	txm.Start()
+
+	txm.Add([32]byte{0xFF}, 100)
+	txm.Add([32]byte{0xAA}, 100)
+	txm.Add([32]byte{0xCC}, 100)
+
+	require.Equal(t, 3, txm.Size())
+	require.True(t, txm.Contains([32]byte{0xAA}))
+	require.False(t, txm.Contains([32]byte{0xBB}))
+}
+
+func TestUnorderedTxManager_EmptyDataDir(t *testing.T) {
+	dataDir := t.TempDir()
+	txm := unorderedtx.NewManager(dataDir)
+	txm.Start()
+
+	require.NoError(t, txm.OnInit())
+	require.Equal(t, 0, txm.Size())
+	require.NoError(t, txm.Close())
+
+	// nothing is flushed, and loading an empty data dir again is a no-op
+	txm2 := unorderedtx.NewManager(dataDir)
+	require.NoError(t, txm2.OnInit())
+	require.Equal(t, 0, txm2.Size())
+}
+
+func TestUnorderedTxManager_CloseInit(t *testing.T) {
+	dataDir := t.TempDir()
+	txm := unorderedtx.NewManager(dataDir)
+	txm.Start()
+
+	// add a handful of unordered txs
+	for i := 0; i < 100; i++ {
+		txm.Add([32]byte{byte(i)}, 100)
+	}
+
+	// close the manager, which should flush all unexpired txs to file
+	require.NoError(t, txm.Close())
+
+	// create a new manager, start it
+	txm2 := unorderedtx.NewManager(dataDir)
+	defer func() {
+		require.NoError(t, txm2.Close())
+	}()
+
+	// start and execute OnInit, which should load the unexpired txs from file
+	txm2.Start()
+	require.NoError(t, txm2.OnInit())
+	require.Equal(t, 100, txm2.Size())
+
+	for i := 0; i < 100; i++ {
+		require.True(t, txm2.Contains([32]byte{byte(i)}))
+	}
+
+	// the file is kept once loaded, it is replaced by the next flush
+	txm3 := unorderedtx.NewManager(dataDir)
+	require.NoError(t, txm3.OnInit())
+	require.Equal(t, 100, txm3.Size())
+}
+
+func TestUnorderedTxManager_OnCommit(t *testing.T) {
+	dataDir := t.TempDir()
+	txm := unorderedtx.NewManager(dataDir)
+	txm.Start()
+	defer func() {
+		require.NoError(t, txm.Close())
+	}()
+
+	for i := 1; i <= 10; i++ {
+		txm.Add([32]byte{byte(i)}, uint64(i))
+	}
+	require.NoError(t, txm.OnCommit())
+
+	// the txs are loaded without the manager being closed, as after a crash
+	txm2 := unorderedtx.NewManager(dataDir)
+	require.NoError(t, txm2.OnInit())
+	require.Equal(t, 10, txm2.Size())
+
+	// once all the txs are expired, the next commit removes the file
+	txm.OnNewBlock(10)
+	require.Eventually(t, func() bool { return txm.Size() == 0 }, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, txm.OnCommit())
+
+	txm3 := unorderedtx.NewManager(dataDir)
+	require.NoError(t, txm3.OnInit())
+	require.Equal(t, 0, txm3.Size())
+}
+
+func TestUnorderedTxManager_Flow(t *testing.T) {
+	txm := unorderedtx.NewManager(t.TempDir())
+	defer func() {
+		require.NoError(t, txm.Close())
+	}()
+
+	txm.Start()
+
+	// Seed the manager with a txs, some of which should eventually be purged and
+	// the others will remain. Txs with TTL less than or equal to 50 should be purged.
+	for i := 1; i <= 100; i++ {
+		txHash := [32]byte{byte(i)}
+
+		if i <= 50 {
+			txm.Add(txHash, uint64(i))
+		} else {
+			txm.Add(txHash, 100)
+		}
+	}
+
+	// mimic new blocks being made
+	for height := uint64(1); height <= 50; height++ {
+		txm.OnNewBlock(height)
+	}
+
+	// Eventually all the txs with TTL less than or equal to 50 should be purged
+	// and the remaining txs should remain.
+	require.Eventually(
+		t,
+		func() bool { return txm.Size() == 50 },
+		5*time.Second,
+		10*time.Millisecond,
+	)
+
+	for i := 1; i <= 100; i++ {
+		require.Equal(t, i > 50, txm.Contains([32]byte{byte(i)}))
+	}
+}
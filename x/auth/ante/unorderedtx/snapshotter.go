@@ -0,0 +1,85 @@
+package unorderedtx
+
+import (
+	"encoding/binary"
+	"errors"
+
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+)
+
+const (
+	// SnapshotFormat defines the snapshot format of exported unordered transactions.
+	// No protobuf envelope, no metadata.
+	SnapshotFormat = 1
+
+	// SnapshotName defines the snapshot name of exported unordered transactions.
+	SnapshotName = "unordered_txs"
+)
+
+var _ snapshottypes.ExtensionSnapshotter = (*Snapshotter)(nil)
+
+// Snapshotter exports and imports the unexpired unordered transactions tracked
+// by a Manager as a state sync snapshot extension, so that a node restored from
+// a snapshot keeps rejecting the duplicates of the transactions included before
+// the snapshot height.
+type Snapshotter struct {
+	m *Manager
+}
+
+// NewSnapshotter returns a new Snapshotter of the provided manager.
+func NewSnapshotter(m *Manager) *Snapshotter {
+	return &Snapshotter{m: m}
+}
+
+// SnapshotName implements the ExtensionSnapshotter interface.
+func (s *Snapshotter) SnapshotName() string {
+	return SnapshotName
+}
+
+// SnapshotFormat implements the ExtensionSnapshotter interface.
+func (s *Snapshotter) SnapshotFormat() uint32 {
+	return SnapshotFormat
+}
+
+// SupportedFormats implements the ExtensionSnapshotter interface.
+func (s *Snapshotter) SupportedFormats() []uint32 {
+	return []uint32{SnapshotFormat}
+}
+
+// SnapshotExtension implements the ExtensionSnapshotter interface. All tracked
+// tx hashes and their TTL are exported as a single payload.
+func (s *Snapshotter) SnapshotExtension(_ uint64, payloadWriter snapshottypes.ExtensionPayloadWriter) error {
+	return payloadWriter(s.m.export())
+}
+
+// RestoreExtension implements the ExtensionSnapshotter interface. The tx hashes
+// which are expired at the snapshot height are skipped.
+func (s *Snapshotter) RestoreExtension(height uint64, format uint32, payloadReader snapshottypes.ExtensionPayloadReader) error {
+	if format != SnapshotFormat {
+		return snapshottypes.ErrUnknownFormat
+	}
+
+	payload, err := payloadReader()
+	if err != nil {
+		return err
+	}
+
+	if len(payload)%chunkSize != 0 {
+		return errors.New("invalid unordered txs snapshot payload length")
+	}
+
+	for i := 0; i < len(payload); i += chunkSize {
+		var txHash TxHash
+		copy(txHash[:], payload[i:i+32])
+
+		ttl := binary.BigEndian.Uint64(payload[i+32 : i+chunkSize])
+		if height >= ttl {
+			// the tx is expired, it can no longer be included in a block
+			continue
+		}
+
+		s.m.Add(txHash, ttl)
+	}
+
+	return nil
+}
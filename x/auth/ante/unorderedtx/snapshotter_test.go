@@ -0,0 +1,68 @@
+package unorderedtx_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+
+	"github.com/cosmos/cosmos-sdk/x/auth/ante/unorderedtx"
+)
+
+func TestSnapshotter(t *testing.T) {
+	txm := unorderedtx.NewManager(t.TempDir())
+	txm.Start()
+	defer func() {
+		require.NoError(t, txm.Close())
+	}()
+
+	for i := 0; i < 100; i++ {
+		txm.Add([32]byte{byte(i)}, uint64(100+i))
+	}
+
+	s := unorderedtx.NewSnapshotter(txm)
+
+	var payloads [][]byte
+	err := s.SnapshotExtension(100, func(payload []byte) error {
+		payloads = append(payloads, payload)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, payloads, 1)
+
+	// restore into a new manager, the txs expired at the snapshot height are skipped
+	txm2 := unorderedtx.NewManager(t.TempDir())
+	txm2.Start()
+	defer func() {
+		require.NoError(t, txm2.Close())
+	}()
+
+	s2 := unorderedtx.NewSnapshotter(txm2)
+	err = s2.RestoreExtension(150, unorderedtx.SnapshotFormat, payloadReader(payloads))
+	require.NoError(t, err)
+	require.Equal(t, 49, txm2.Size())
+	require.False(t, txm2.Contains([32]byte{50}))
+	require.True(t, txm2.Contains([32]byte{51}))
+	require.True(t, txm2.Contains([32]byte{99}))
+
+	// unknown formats and invalid payloads are rejected
+	err = s2.RestoreExtension(150, unorderedtx.SnapshotFormat+1, payloadReader(payloads))
+	require.ErrorIs(t, err, snapshottypes.ErrUnknownFormat)
+
+	err = s2.RestoreExtension(150, unorderedtx.SnapshotFormat, payloadReader([][]byte{payloads[0][:10]}))
+	require.Error(t, err)
+}
+
+// payloadReader returns an extension payload reader over the payloads.
+func payloadReader(payloads [][]byte) snapshottypes.ExtensionPayloadReader {
+	return func() ([]byte, error) {
+		if len(payloads) == 0 {
+			return nil, io.EOF
+		}
+		payload := payloads[0]
+		payloads = payloads[1:]
+		return payload, nil
+	}
+}
@@ -59,6 +59,9 @@ func (s *StdTxBuilder) SetTimeoutHeight(height uint64) {
 	s.TimeoutHeight = height
 }
 
+// SetUnordered does nothing for stdtx
+func (s *StdTxBuilder) SetUnordered(_ bool) {}
+
 // SetFeeGranter does nothing for stdtx
 func (s *StdTxBuilder) SetFeeGranter(_ sdk.AccAddress) {}
 
@@ -39,6 +39,7 @@ var (
 	_ authsigning.Tx             = &wrapper{}
 	_ client.TxBuilder           = &wrapper{}
 	_ tx.TipTx                   = &wrapper{}
+	_ sdk.TxWithUnordered        = &wrapper{}
 	_ ante.HasExtensionOptionsTx = &wrapper{}
 	_ ExtensionOptionsTxBuilder  = &wrapper{}
 	_ tx.TipTx                   = &wrapper{}
@@ -169,6 +170,10 @@ func (w *wrapper) GetTimeoutHeight() uint64 {
 	return w.tx.Body.TimeoutHeight
 }
 
+func (w *wrapper) GetUnordered() bool {
+	return w.tx.Body.Unordered
+}
+
 func (w *wrapper) GetSignaturesV2() ([]signing.SignatureV2, error) {
 	signerInfos := w.tx.AuthInfo.SignerInfos
 	sigs := w.tx.Signatures
@@ -227,6 +232,14 @@ func (w *wrapper) SetTimeoutHeight(height uint64) {
 	w.bodyBz = nil
 }
 
+// SetUnordered sets the transaction's unordered field.
+func (w *wrapper) SetUnordered(v bool) {
+	w.tx.Body.Unordered = v
+
+	// set bodyBz to nil because the cached bodyBz no longer matches tx.Body
+	w.bodyBz = nil
+}
+
 func (w *wrapper) SetMemo(memo string) {
 	w.tx.Body.Memo = memo
 
@@ -293,12 +306,19 @@ func (w *wrapper) SetSignatures(signatures ...signing.SignatureV2) error {
 	for i, sig := range signatures {
 		var modeInfo *tx.ModeInfo
 		modeInfo, rawSigs[i] = SignatureDataToModeInfoAndSig(sig.Data)
-		any, err := codectypes.NewAnyWithValue(sig.PubKey)
-		if err != nil {
-			return err
+
+		// the public key is optional, e.g. for signers which are authenticated
+		// by x/accounts instead of a public key
+		var pubKey *codectypes.Any
+		if sig.PubKey != nil {
+			var err error
+			pubKey, err = codectypes.NewAnyWithValue(sig.PubKey)
+			if err != nil {
+				return err
+			}
 		}
 		signerInfos[i] = &tx.SignerInfo{
-			PublicKey: any,
+			PublicKey: pubKey,
 			ModeInfo:  modeInfo,
 			Sequence:  sig.Sequence,
 		}
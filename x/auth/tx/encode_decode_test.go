@@ -72,8 +72,8 @@ func TestUnknownFields(t *testing.T) {
 		{
 			name: "critical fields in TxBody should error on decode",
 			body: &testdata.TestUpdatedTxBody{
-				Memo:         "foo",
-				SomeNewField: 10,
+				Memo:                 "foo",
+				SomeNewCriticalField: 10,
 			},
 			authInfo:  &testdata.TestUpdatedAuthInfo{},
 			shouldErr: true,
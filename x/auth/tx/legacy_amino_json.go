@@ -48,6 +48,12 @@ func (s signModeLegacyAminoJSONHandler) GetSignBytes(mode signingtypes.SignMode,
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "%s does not support protobuf extension options", signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
 	}
 
+	// the unordered field is not part of the StdSignDoc, hence it would not be
+	// covered by the signature
+	if body.Unordered {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "%s does not support unordered transactions", signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	}
+
 	addr := data.Address
 	if addr == "" {
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "got empty address in %s handler", signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
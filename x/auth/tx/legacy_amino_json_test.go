@@ -136,6 +136,14 @@ func TestLegacyAminoJSONHandler_GetSignBytes(t *testing.T) {
 	tx = bldr.GetTx()
 	_, err = handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signingData, tx)
 	require.Error(t, err)
+
+	// expect error with unordered transactions
+	bldr = newBuilder(nil)
+	buildTx(t, bldr)
+	bldr.SetUnordered(true)
+	tx = bldr.GetTx()
+	_, err = handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signingData, tx)
+	require.ErrorContains(t, err, "does not support unordered transactions")
 }
 
 func TestLegacyAminoJSONHandler_DefaultMode(t *testing.T) {
@@ -5,12 +5,16 @@ import (
 	"fmt"
 	"strings"
 
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/golang/protobuf/proto" //nolint:staticcheck
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -24,18 +28,28 @@ import (
 // baseAppSimulateFn is the signature of the Baseapp#Simulate function.
 type baseAppSimulateFn func(txBytes []byte) (sdk.GasInfo, *sdk.Result, error)
 
+// baseAppSimulateBundleFn is the signature of the Baseapp#SimulateBundle function.
+type baseAppSimulateBundleFn func(txsBytes [][]byte, header cmtproto.Header) ([]baseapp.SimulatedTx, []*storetypes.StoreKVPair, error)
+
 // txServer is the server for the protobuf Tx service.
 type txServer struct {
 	clientCtx         client.Context
 	simulate          baseAppSimulateFn
+	simulateBundle    baseAppSimulateBundleFn
 	interfaceRegistry codectypes.InterfaceRegistry
 }
 
 // NewTxServer creates a new Tx service server.
-func NewTxServer(clientCtx client.Context, simulate baseAppSimulateFn, interfaceRegistry codectypes.InterfaceRegistry) txtypes.ServiceServer {
+func NewTxServer(
+	clientCtx client.Context,
+	simulate baseAppSimulateFn,
+	simulateBundle baseAppSimulateBundleFn,
+	interfaceRegistry codectypes.InterfaceRegistry,
+) txtypes.ServiceServer {
 	return txServer{
 		clientCtx:         clientCtx,
 		simulate:          simulate,
+		simulateBundle:    simulateBundle,
 		interfaceRegistry: interfaceRegistry,
 	}
 }
@@ -106,6 +120,54 @@ func (s txServer) Simulate(ctx context.Context, req *txtypes.SimulateRequest) (*
 	}, nil
 }
 
+// SimulateBundle implements the ServiceServer.SimulateBundle RPC method.
+func (s txServer) SimulateBundle(ctx context.Context, req *txtypes.SimulateBundleRequest) (*txtypes.SimulateBundleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	if len(req.TxsBytes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty txsBytes is not allowed")
+	}
+
+	if req.Height < 0 {
+		return nil, status.Error(codes.InvalidArgument, "height cannot be negative")
+	}
+
+	// the bundle is simulated with the header of the block of its height
+	height := req.Height
+	if height == 0 {
+		height = sdk.UnwrapSDKContext(ctx).BlockHeight()
+	}
+	_, block, err := cmtservice.GetProtoBlock(ctx, s.clientCtx, &height)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to load the block header at height %d: %v", height, err)
+	}
+
+	simulated, changes, err := s.simulateBundle(req.TxsBytes, block.Header)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	results := make([]*txtypes.SimulateBundleResult, len(simulated))
+	for i, tx := range simulated {
+		gasInfo := tx.GasInfo
+		results[i] = &txtypes.SimulateBundleResult{
+			GasInfo: &gasInfo,
+			Result:  tx.Result,
+		}
+		if tx.Err != nil {
+			results[i].Error = tx.Err.Error()
+		}
+	}
+
+	return &txtypes.SimulateBundleResponse{
+		Results:      results,
+		StateChanges: changes,
+		Height:       height,
+	}, nil
+}
+
 // GetTx implements the ServiceServer.GetTx RPC method.
 func (s txServer) GetTx(ctx context.Context, req *txtypes.GetTxRequest) (*txtypes.GetTxResponse, error) {
 	if req == nil {
@@ -309,11 +371,12 @@ func RegisterTxService(
 	qrt gogogrpc.Server,
 	clientCtx client.Context,
 	simulateFn baseAppSimulateFn,
+	simulateBundleFn baseAppSimulateBundleFn,
 	interfaceRegistry codectypes.InterfaceRegistry,
 ) {
 	txtypes.RegisterServiceServer(
 		qrt,
-		NewTxServer(clientCtx, simulateFn, interfaceRegistry),
+		NewTxServer(clientCtx, simulateFn, simulateBundleFn, interfaceRegistry),
 	)
 }
 
@@ -54,6 +54,12 @@ func (h signModeTextualHandler) GetSignBytesWithContext(ctx context.Context, mod
 		return nil, fmt.Errorf("can only handle a protobuf Tx, got %T", tx)
 	}
 
+	// the unordered field is not rendered by SIGN_MODE_TEXTUAL, hence it would
+	// not be covered by the signature
+	if protoTx.GetUnordered() {
+		return nil, fmt.Errorf("%s does not support unordered transactions", signingtypes.SignMode_SIGN_MODE_TEXTUAL)
+	}
+
 	pbAny, err := codectypes.NewAnyWithValue(data.PubKey)
 	if err != nil {
 		return nil, err
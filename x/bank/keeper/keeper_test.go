@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"strings"
 	"testing"
@@ -176,8 +177,10 @@ func (suite *KeeperTestSuite) mockBurnCoins(moduleAcc *authtypes.ModuleAccount)
 func (suite *KeeperTestSuite) mockSendCoinsFromModuleToModule(sender *authtypes.ModuleAccount, receiver *authtypes.ModuleAccount) {
 	suite.authKeeper.EXPECT().GetModuleAddress(sender.Name).Return(sender.GetAddress())
 	suite.authKeeper.EXPECT().GetModuleAccount(suite.ctx, receiver.Name).Return(receiver)
-	suite.authKeeper.EXPECT().GetAccount(suite.ctx, sender.GetAddress()).Return(sender)
-	suite.authKeeper.EXPECT().HasAccount(suite.ctx, receiver.GetAddress()).Return(true)
+	// module to module sends bypass the send restrictions
+	bypassCtx := banktypes.WithSendRestrictionsBypass(suite.ctx)
+	suite.authKeeper.EXPECT().GetAccount(bypassCtx, sender.GetAddress()).Return(sender)
+	suite.authKeeper.EXPECT().HasAccount(bypassCtx, receiver.GetAddress()).Return(true)
 }
 
 func (suite *KeeperTestSuite) mockSendCoinsFromAccountToModule(acc *authtypes.BaseAccount, moduleAcc *authtypes.ModuleAccount) {
@@ -602,6 +605,148 @@ func (suite *KeeperTestSuite) TestSendCoins() {
 	require.Equal(newBarCoin(25), coins[0], "expected only bar coins in the account balance, got: %v", coins)
 }
 
+func (suite *KeeperTestSuite) TestSendCoins_WithRestriction() {
+	ctx := suite.ctx
+	require := suite.Require()
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+	sendAmt := sdk.NewCoins(newFooCoin(10))
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(suite.bankKeeper, ctx, accAddrs[0], balances))
+
+	var calls []sdk.AccAddress
+	suite.bankKeeper.AppendSendRestriction(func(_ sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		require.Equal(accAddrs[0], fromAddr)
+		require.Equal(sendAmt, amt)
+		calls = append(calls, toAddr)
+		if toAddr.Equals(accAddrs[3]) {
+			return nil, errors.New("restricted recipient")
+		}
+		// redirect everything sent to addr2 to addr3
+		if toAddr.Equals(accAddrs[1]) {
+			return accAddrs[2], nil
+		}
+		return toAddr, nil
+	})
+
+	// rejected sends do not move any funds
+	require.ErrorContains(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[3], sendAmt), "restricted recipient")
+	require.Equal(balances, suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+
+	// the recipient provided by the restriction receives the funds
+	suite.mockSendCoins(ctx, acc0, accAddrs[2])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sendAmt))
+	require.Empty(suite.bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+	require.Equal(sendAmt, suite.bankKeeper.GetAllBalances(ctx, accAddrs[2]))
+	require.Equal([]sdk.AccAddress{accAddrs[3], accAddrs[1]}, calls)
+
+	// the transfer event reports the recipient provided by the restriction
+	events := ctx.EventManager().ABCIEvents()
+	transfer := events[len(events)-2]
+	require.Equal(banktypes.EventTypeTransfer, transfer.Type)
+	require.Equal(accAddrs[2].String(), transfer.Attributes[0].Value)
+
+	// restrictions are not applied once cleared
+	suite.bankKeeper.ClearSendRestriction()
+	suite.mockSendCoins(ctx, acc0, accAddrs[3])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[3], sendAmt))
+	require.Equal(sendAmt, suite.bankKeeper.GetAllBalances(ctx, accAddrs[3]))
+	require.Len(calls, 2)
+}
+
+func (suite *KeeperTestSuite) TestSendCoins_RestrictionOrder() {
+	ctx := suite.ctx
+	require := suite.Require()
+	balances := sdk.NewCoins(newFooCoin(100))
+	sendAmt := sdk.NewCoins(newFooCoin(10))
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(suite.bankKeeper, ctx, accAddrs[0], balances))
+
+	var order []string
+	newRestriction := func(name string) banktypes.SendRestrictionFn {
+		return func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+			order = append(order, name)
+			return toAddr, nil
+		}
+	}
+	suite.bankKeeper.AppendSendRestriction(newRestriction("second"))
+	suite.bankKeeper.AppendSendRestriction(newRestriction("third"))
+	suite.bankKeeper.PrependSendRestriction(newRestriction("first"))
+
+	suite.mockSendCoins(ctx, acc0, accAddrs[1])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sendAmt))
+	require.Equal([]string{"first", "second", "third"}, order)
+}
+
+func (suite *KeeperTestSuite) TestInputOutputCoins_WithRestriction() {
+	ctx := suite.ctx
+	require := suite.Require()
+	balances := sdk.NewCoins(newFooCoin(90), newBarCoin(30))
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(suite.bankKeeper, ctx, accAddrs[0], balances))
+
+	suite.bankKeeper.AppendSendRestriction(func(_ sdk.Context, fromAddr, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		require.Equal(accAddrs[0], fromAddr)
+		switch {
+		case toAddr.Equals(accAddrs[3]):
+			return nil, errors.New("restricted recipient")
+		case toAddr.Equals(accAddrs[1]):
+			return accAddrs[2], nil
+		default:
+			return toAddr, nil
+		}
+	})
+
+	input := banktypes.Input{
+		Address: accAddrs[0].String(), Coins: sdk.NewCoins(newFooCoin(60), newBarCoin(20)),
+	}
+
+	// a single restricted output rejects the whole multi-send
+	restrictedOutputs := []banktypes.Output{
+		{Address: accAddrs[2].String(), Coins: sdk.NewCoins(newFooCoin(30), newBarCoin(10))},
+		{Address: accAddrs[3].String(), Coins: sdk.NewCoins(newFooCoin(30), newBarCoin(10))},
+	}
+	require.ErrorContains(suite.bankKeeper.InputOutputCoins(ctx, input, restrictedOutputs), "restricted recipient")
+	require.Equal(balances, suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+	require.Empty(suite.bankKeeper.GetAllBalances(ctx, accAddrs[2]))
+
+	outputs := []banktypes.Output{
+		{Address: accAddrs[1].String(), Coins: sdk.NewCoins(newFooCoin(30), newBarCoin(10))},
+		{Address: accAddrs[2].String(), Coins: sdk.NewCoins(newFooCoin(30), newBarCoin(10))},
+	}
+	suite.mockInputOutputCoins([]sdk.AccountI{acc0}, []sdk.AccAddress{accAddrs[2], accAddrs[2]})
+	require.NoError(suite.bankKeeper.InputOutputCoins(ctx, input, outputs))
+
+	require.Equal(sdk.NewCoins(newFooCoin(30), newBarCoin(10)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+	require.Empty(suite.bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+	require.Equal(sdk.NewCoins(newFooCoin(60), newBarCoin(20)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[2]))
+}
+
+func (suite *KeeperTestSuite) TestSendCoinsFromModuleToModule_BypassesRestriction() {
+	ctx := suite.ctx
+	require := suite.Require()
+
+	suite.bankKeeper.AppendSendRestriction(func(_ sdk.Context, _, _ sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		return nil, errors.New("restricted")
+	})
+
+	suite.mockMintCoins(minterAcc)
+	require.NoError(suite.bankKeeper.MintCoins(ctx, authtypes.Minter, initCoins))
+
+	suite.mockSendCoinsFromModuleToModule(minterAcc, holderAcc)
+	require.NoError(suite.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.Minter, holder, initCoins))
+	require.Equal(initCoins, suite.bankKeeper.GetAllBalances(ctx, holderAcc.GetAddress()))
+
+	// sends from a module to an account are still restricted
+	suite.authKeeper.EXPECT().GetModuleAddress(holderAcc.Name).Return(holderAcc.GetAddress())
+	require.ErrorContains(suite.bankKeeper.SendCoinsFromModuleToAccount(ctx, holder, accAddrs[0], initCoins), "restricted")
+}
+
 func (suite *KeeperTestSuite) TestSendCoins_Invalid_SendLockedCoins() {
 	balances := sdk.NewCoins(newFooCoin(50))
 
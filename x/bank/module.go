@@ -7,6 +7,7 @@ import (
 	"time"
 
 	modulev1 "cosmossdk.io/api/cosmos/bank/module/v1"
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/depinject"
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -36,9 +37,10 @@ import (
 const ConsensusVersion = 4
 
 var (
-	_ module.AppModule           = AppModule{}
-	_ module.AppModuleBasic      = AppModuleBasic{}
-	_ module.AppModuleSimulation = AppModule{}
+	_ module.AppModule            = AppModule{}
+	_ module.AppModuleBasic       = AppModuleBasic{}
+	_ module.HasCollectionsSchema = AppModule{}
+	_ module.AppModuleSimulation  = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the bank module.
@@ -114,6 +116,17 @@ func (am AppModule) IsOnePerModuleType() {}
 // IsAppModule implements the appmodule.AppModule interface.
 func (am AppModule) IsAppModule() {}
 
+// CollectionsSchema implements module.HasCollectionsSchema. The schema is empty
+// if the module keeper is not a keeper.BaseKeeper.
+func (am AppModule) CollectionsSchema() collections.Schema {
+	baseKeeper, ok := am.keeper.(keeper.BaseKeeper)
+	if !ok {
+		return collections.Schema{}
+	}
+
+	return baseKeeper.Schema
+}
+
 // RegisterServices registers module services.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
@@ -0,0 +1,80 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// A SendRestrictionFn can restrict sends and/or provide a new receiver address.
+// It is applied by SendCoins and, for each output, by InputOutputCoins before
+// any balance is moved. Returning an error rejects the transfer, returning a
+// different address redirects the funds to it.
+type SendRestrictionFn func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (newToAddr sdk.AccAddress, err error)
+
+var _ SendRestrictionFn = NoOpSendRestrictionFn
+
+// NoOpSendRestrictionFn is a no-op SendRestrictionFn.
+func NoOpSendRestrictionFn(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+	return toAddr, nil
+}
+
+// Then creates a composite restriction that runs this one then the provided second one.
+func (r SendRestrictionFn) Then(second SendRestrictionFn) SendRestrictionFn {
+	return ComposeSendRestrictions(r, second)
+}
+
+// ComposeSendRestrictions combines multiple SendRestrictionFn into one.
+// nil entries are ignored.
+// If all entries are nil, nil is returned.
+// If exactly one entry is not nil, it is returned.
+// Otherwise, a new SendRestrictionFn is returned that runs the non-nil restrictions in the order they are given.
+// The composition runs each send restriction until an error is encountered and returns that error,
+// otherwise it returns the toAddr of the last send restriction. Each restriction receives the
+// toAddr returned by the previous one.
+func ComposeSendRestrictions(restrictions ...SendRestrictionFn) SendRestrictionFn {
+	toRun := make([]SendRestrictionFn, 0, len(restrictions))
+	for _, r := range restrictions {
+		if r != nil {
+			toRun = append(toRun, r)
+		}
+	}
+
+	switch len(toRun) {
+	case 0:
+		return nil
+	case 1:
+		return toRun[0]
+	}
+
+	return func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		var err error
+		for _, r := range toRun {
+			toAddr, err = r(ctx, fromAddr, toAddr, amt)
+			if err != nil {
+				return toAddr, err
+			}
+		}
+		return toAddr, err
+	}
+}
+
+// sendRestrictionsBypassKey is the context key used to bypass the send restrictions.
+type sendRestrictionsBypassKey struct{}
+
+// WithSendRestrictionsBypass returns a new context that causes the send
+// restrictions to be skipped. It is used for module-to-module transfers.
+func WithSendRestrictionsBypass(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(sendRestrictionsBypassKey{}, true)
+}
+
+// WithoutSendRestrictionsBypass returns a new context that causes the send
+// restrictions to be applied again.
+func WithoutSendRestrictionsBypass(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(sendRestrictionsBypassKey{}, false)
+}
+
+// HasSendRestrictionsBypass returns true if the context requests the send
+// restrictions to be skipped.
+func HasSendRestrictionsBypass(ctx sdk.Context) bool {
+	bypass, ok := ctx.Value(sendRestrictionsBypassKey{}).(bool)
+	return ok && bypass
+}
@@ -0,0 +1,118 @@
+package types_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// restrictionTracker records the calls made to the send restrictions it creates.
+type restrictionTracker struct {
+	calls []string
+}
+
+// newRestriction creates a SendRestrictionFn that records its name, then
+// returns the provided toAddr (or the input one if nil) and error.
+func (r *restrictionTracker) newRestriction(name string, toAddr sdk.AccAddress, err error) types.SendRestrictionFn {
+	return func(_ sdk.Context, _, inToAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		r.calls = append(r.calls, name)
+		if toAddr == nil {
+			toAddr = inToAddr
+		}
+		return toAddr, err
+	}
+}
+
+func TestComposeSendRestrictions(t *testing.T) {
+	var (
+		ctx      = sdk.Context{}
+		fromAddr = sdk.AccAddress("from________________")
+		toAddr   = sdk.AccAddress("to__________________")
+		newAddr1 = sdk.AccAddress("new1________________")
+		newAddr2 = sdk.AccAddress("new2________________")
+		coins    = sdk.NewCoins(sdk.NewInt64Coin("foo", 10))
+	)
+
+	t.Run("no restrictions", func(t *testing.T) {
+		require.Nil(t, types.ComposeSendRestrictions())
+		require.Nil(t, types.ComposeSendRestrictions(nil, nil))
+	})
+
+	t.Run("single restriction is returned as is", func(t *testing.T) {
+		tracker := &restrictionTracker{}
+		fn := types.ComposeSendRestrictions(nil, tracker.newRestriction("first", newAddr1, nil), nil)
+		require.NotNil(t, fn)
+
+		addr, err := fn(ctx, fromAddr, toAddr, coins)
+		require.NoError(t, err)
+		require.Equal(t, newAddr1, addr)
+		require.Equal(t, []string{"first"}, tracker.calls)
+	})
+
+	t.Run("restrictions run in order and thread the address", func(t *testing.T) {
+		tracker := &restrictionTracker{}
+		var seen sdk.AccAddress
+		check := func(_ sdk.Context, _, inToAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+			tracker.calls = append(tracker.calls, "check")
+			seen = inToAddr
+			return inToAddr, nil
+		}
+
+		fn := types.ComposeSendRestrictions(
+			tracker.newRestriction("first", newAddr1, nil),
+			check,
+			tracker.newRestriction("third", newAddr2, nil),
+		)
+		addr, err := fn(ctx, fromAddr, toAddr, coins)
+		require.NoError(t, err)
+		require.Equal(t, newAddr2, addr)
+		require.Equal(t, newAddr1, seen)
+		require.Equal(t, []string{"first", "check", "third"}, tracker.calls)
+	})
+
+	t.Run("stops at the first error", func(t *testing.T) {
+		tracker := &restrictionTracker{}
+		fn := types.ComposeSendRestrictions(
+			tracker.newRestriction("first", nil, nil),
+			tracker.newRestriction("second", nil, errors.New("restricted")),
+			tracker.newRestriction("third", nil, nil),
+		)
+		_, err := fn(ctx, fromAddr, toAddr, coins)
+		require.EqualError(t, err, "restricted")
+		require.Equal(t, []string{"first", "second"}, tracker.calls)
+	})
+
+	t.Run("then", func(t *testing.T) {
+		tracker := &restrictionTracker{}
+		var none types.SendRestrictionFn
+		fn := none.Then(tracker.newRestriction("first", nil, nil)).Then(tracker.newRestriction("second", newAddr1, nil))
+
+		addr, err := fn(ctx, fromAddr, toAddr, coins)
+		require.NoError(t, err)
+		require.Equal(t, newAddr1, addr)
+		require.Equal(t, []string{"first", "second"}, tracker.calls)
+	})
+}
+
+func TestNoOpSendRestrictionFn(t *testing.T) {
+	toAddr := sdk.AccAddress("to__________________")
+	addr, err := types.NoOpSendRestrictionFn(sdk.Context{}, sdk.AccAddress("from"), toAddr, nil)
+	require.NoError(t, err)
+	require.Equal(t, toAddr, addr)
+}
+
+func TestSendRestrictionsBypass(t *testing.T) {
+	ctx := sdk.Context{}.WithContext(context.Background())
+	require.False(t, types.HasSendRestrictionsBypass(ctx))
+
+	ctx = types.WithSendRestrictionsBypass(ctx)
+	require.True(t, types.HasSendRestrictionsBypass(ctx))
+
+	ctx = types.WithoutSendRestrictionsBypass(ctx)
+	require.False(t, types.HasSendRestrictionsBypass(ctx))
+}
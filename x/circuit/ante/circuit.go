@@ -0,0 +1,72 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CircuitBreaker is the interface the CircuitBreakerDecorator uses to check
+// whether a Msg type URL is allowed to be executed.
+type CircuitBreaker interface {
+	IsAllowed(ctx sdk.Context, msgTypeURL string) (bool, error)
+}
+
+// nestedMsgs is implemented by the Msgs executing other Msgs, such as authz
+// MsgExec.
+type nestedMsgs interface {
+	GetMessages() ([]sdk.Msg, error)
+}
+
+// CircuitBreakerDecorator is an AnteDecorator that rejects transactions
+// containing a Msg for which the circuit breaker has been tripped, both when
+// entering the mempool and when being executed. The Msgs executed by a Msg
+// implementing GetMessages, such as authz MsgExec, are checked as well.
+type CircuitBreakerDecorator struct {
+	circuitKeeper CircuitBreaker
+}
+
+func NewCircuitBreakerDecorator(ck CircuitBreaker) CircuitBreakerDecorator {
+	return CircuitBreakerDecorator{
+		circuitKeeper: ck,
+	}
+}
+
+func (cbd CircuitBreakerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if err := cbd.checkMsgs(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// checkMsgs returns an error if the circuit breaker has been tripped for one
+// of the msgs or of the Msgs they execute.
+func (cbd CircuitBreakerDecorator) checkMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		msgTypeURL := sdk.MsgTypeURL(msg)
+
+		allowed, err := cbd.circuitKeeper.IsAllowed(ctx, msgTypeURL)
+		if err != nil {
+			return err
+		}
+
+		if !allowed {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "circuit breaker tripped for %s", msgTypeURL)
+		}
+
+		if nested, ok := msg.(nestedMsgs); ok {
+			innerMsgs, err := nested.GetMessages()
+			if err != nil {
+				return err
+			}
+
+			if err := cbd.checkMsgs(ctx, innerMsgs); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package ante_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/circuit/ante"
+)
+
+type mockCircuitBreaker struct {
+	disabled map[string]bool
+}
+
+func (m mockCircuitBreaker) IsAllowed(_ sdk.Context, msgTypeURL string) (bool, error) {
+	return !m.disabled[msgTypeURL], nil
+}
+
+type mockTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx mockTx) GetMsgs() []sdk.Msg   { return tx.msgs }
+func (tx mockTx) ValidateBasic() error { return nil }
+
+func TestCircuitBreakerDecorator(t *testing.T) {
+	key := storetypes.NewKVStoreKey("circuit")
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	msg := testdata.NewTestMsg(addr)
+	tx := mockTx{msgs: []sdk.Msg{msg}}
+
+	var nextCalled bool
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		nextCalled = true
+		return ctx, nil
+	}
+
+	// allowed msgs are passed to the next decorator
+	cbd := ante.NewCircuitBreakerDecorator(mockCircuitBreaker{disabled: map[string]bool{}})
+	_, err := cbd.AnteHandle(ctx, tx, false, next)
+	require.NoError(t, err)
+	require.True(t, nextCalled)
+
+	// tripped msgs are rejected
+	nextCalled = false
+	cbd = ante.NewCircuitBreakerDecorator(mockCircuitBreaker{disabled: map[string]bool{sdk.MsgTypeURL(msg): true}})
+	_, err = cbd.AnteHandle(ctx, tx, false, next)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.False(t, nextCalled)
+}
+
+func TestCircuitBreakerDecorator_NestedMsgs(t *testing.T) {
+	key := storetypes.NewKVStoreKey("circuit")
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	msg := testdata.NewTestMsg(addr)
+	execMsg := authz.NewMsgExec(addr, []sdk.Msg{msg})
+	tx := mockTx{msgs: []sdk.Msg{&execMsg}}
+
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		return ctx, nil
+	}
+
+	cbd := ante.NewCircuitBreakerDecorator(mockCircuitBreaker{disabled: map[string]bool{}})
+	_, err := cbd.AnteHandle(ctx, tx, false, next)
+	require.NoError(t, err)
+
+	// tripped msgs executed through authz are rejected
+	cbd = ante.NewCircuitBreakerDecorator(mockCircuitBreaker{disabled: map[string]bool{sdk.MsgTypeURL(msg): true}})
+	_, err = cbd.AnteHandle(ctx, tx, false, next)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
@@ -0,0 +1,18 @@
+package circuit
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	circuitv1 "cosmossdk.io/api/cosmos/circuit/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: circuitv1.Msg_ServiceDesc.ServiceName,
+		},
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: circuitv1.Query_ServiceDesc.ServiceName,
+		},
+	}
+}
@@ -83,5 +83,5 @@ Feature: MsgAuthorizeCircuitBreaker
           "level": "LEVEL_NONE_UNSPECIFIED",
         }
         """
-      Then expect sucesss
+      Then expect success
       And expect that "acct2" has no permissions
@@ -6,7 +6,7 @@ Feature: MsgResetCircuitBreaker
 
     Example: caller attempts to reset a disabled message
       Given "acct1" has permission "LEVEL_SUPER_ADMIN"
-      When "acct1" attempts to enable a disabled message
+      When "acct1" attempts to reset a disabled message
         """
         {
         	"msg": "cosmos.bank.v1beta1.MsgSend"
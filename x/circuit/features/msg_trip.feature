@@ -56,7 +56,7 @@ Feature: MsgTripCircuitBreaker
       Then expect an "unauthorized" error
 
     Example: user does not have permission for the message
-      Given "acct1" has permission to diable "cosmos.bank.v1beta1.MsgSend"
+      Given "acct1" has permission to disable "cosmos.bank.v1beta1.MsgSend"
       When "acct1" attempts to disable msg execution
         """
         {
@@ -66,11 +66,11 @@ Feature: MsgTripCircuitBreaker
       Then expect an "unauthorized" error
 
     Example: user tries to trip an already tripped circuit breaker
-      Given "acct1" has permission to diable "cosmos.bank.v1beta1.MsgSend" & is already tripped
+      Given "acct1" has permission to disable "cosmos.bank.v1beta1.MsgSend" & is already tripped
       When "acct1" attempts to disable msg execution
         """
         {
-        	"msg": "cosmos.bank.v1beta1.MultiSend"
+        	"msg": "cosmos.bank.v1beta1.MsgSend"
         }
         """
       Then expect an "msg disabled" error
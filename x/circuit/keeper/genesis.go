@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// InitGenesis initializes the circuit module's state from a given genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	for _, accPerms := range genState.AccountPermissions {
+		addr, err := sdk.AccAddressFromBech32(accPerms.Address)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := k.SetPermissions(ctx, addr, *accPerms.Permissions); err != nil {
+			panic(err)
+		}
+	}
+
+	urls, err := types.NormalizeMsgTypeURLs(genState.DisabledTypeUrls)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, url := range urls {
+		if err := k.DisableList.Set(ctx, url); err != nil {
+			panic(err)
+		}
+	}
+
+	if genState.DisableAll {
+		if err := k.DisableAll.Set(ctx, true); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns the circuit module's genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	var accPerms []*types.GenesisAccountPermissions
+	err := k.Permissions.Walk(ctx, nil, func(addr sdk.AccAddress, perms types.CircuitBreakerPermissions) bool {
+		accPerms = append(accPerms, &types.GenesisAccountPermissions{
+			Address:     addr.String(),
+			Permissions: &perms,
+		})
+		return false
+	})
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+		panic(err)
+	}
+
+	var disabledTypeURLs []string
+	err = k.DisableList.Walk(ctx, nil, func(url string) bool {
+		disabledTypeURLs = append(disabledTypeURLs, url)
+		return false
+	})
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+		panic(err)
+	}
+
+	disableAll, err := k.DisableAll.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		panic(err)
+	}
+
+	return types.NewGenesisState(accPerms, disabledTypeURLs, disableAll)
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+func TestGenesis(t *testing.T) {
+	f := initFixture(t)
+
+	// an empty state can be exported and queried
+	gs := f.k.ExportGenesis(f.sdkCtx)
+	assert.Equal(t, 0, len(gs.AccountPermissions))
+	assert.Equal(t, 0, len(gs.DisabledTypeUrls))
+
+	res, err := NewQuerier(f.k).DisabledList(f.ctx, &types.QueryDisabledListRequest{})
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(res.DisabledList))
+
+	gs = &types.GenesisState{
+		AccountPermissions: []*types.GenesisAccountPermissions{
+			{
+				Address:     f.addrs[0].String(),
+				Permissions: &types.CircuitBreakerPermissions{Level: types.CircuitBreakerPermissions_LEVEL_SUPER_ADMIN},
+			},
+		},
+		DisabledTypeUrls: []string{"/cosmos.bank.v1beta1.MsgSend"},
+	}
+	f.k.InitGenesis(f.sdkCtx, gs)
+
+	exported := f.k.ExportGenesis(f.sdkCtx)
+	assert.DeepEqual(t, gs.DisabledTypeUrls, exported.DisabledTypeUrls)
+	assert.Equal(t, 1, len(exported.AccountPermissions))
+	assert.Equal(t, gs.AccountPermissions[0].Address, exported.AccountPermissions[0].Address)
+	assert.Equal(t, types.CircuitBreakerPermissions_LEVEL_SUPER_ADMIN, exported.AccountPermissions[0].Permissions.Level)
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper
+type Querier struct {
+	Keeper
+}
+
+// NewQuerier constructor for the Querier struct
+func NewQuerier(keeper Keeper) Querier {
+	return Querier{Keeper: keeper}
+}
+
+// Account returns the circuit breaker permissions of an account.
+func (q Querier) Account(goCtx context.Context, req *types.QueryAccountRequest) (*types.QueryAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	perms, err := q.GetPermissions(sdk.UnwrapSDKContext(goCtx), addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAccountResponse{Permissions: &perms}, nil
+}
+
+// Accounts returns the circuit breaker permissions of all accounts.
+func (q Querier) Accounts(goCtx context.Context, req *types.QueryAccountsRequest) (*types.QueryAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	results, pageRes, err := query.CollectionPaginate[sdk.AccAddress, types.CircuitBreakerPermissions](goCtx, q.Permissions, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	accounts := make([]*types.GenesisAccountPermissions, len(results))
+	for i, kv := range results {
+		perms := kv.Value
+		accounts[i] = &types.GenesisAccountPermissions{
+			Address:     kv.Key.String(),
+			Permissions: &perms,
+		}
+	}
+
+	return &types.QueryAccountsResponse{Accounts: accounts, Pagination: pageRes}, nil
+}
+
+// DisabledList returns the Msg type URLs for which the circuit breaker has
+// been tripped.
+func (q Querier) DisabledList(goCtx context.Context, req *types.QueryDisabledListRequest) (*types.QueryDisabledListResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var urls []string
+	err := q.DisableList.Walk(ctx, nil, func(url string) bool {
+		urls = append(urls, url)
+		return false
+	})
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	disableAll, err := q.DisableAll.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDisabledListResponse{DisabledList: urls, DisableAll: disableAll}, nil
+}
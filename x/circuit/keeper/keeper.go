@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// exemptMsgTypeURLs are the Msg type URLs that are never blocked when the
+// circuit breaker is tripped for all Msgs, so that it can always be reset.
+var exemptMsgTypeURLs = map[string]struct{}{
+	sdk.MsgTypeURL(&types.MsgAuthorizeCircuitBreaker{}): {},
+	sdk.MsgTypeURL(&types.MsgTripCircuitBreaker{}):      {},
+	sdk.MsgTypeURL(&types.MsgResetCircuitBreaker{}):     {},
+}
+
+// Keeper defines the circuit module's keeper.
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+
+	// the address capable of executing every circuit breaker action,
+	// usually the gov module account.
+	authority string
+
+	Schema      collections.Schema
+	Permissions collections.Map[sdk.AccAddress, types.CircuitBreakerPermissions]
+	DisableList collections.KeySet[string]
+	DisableAll  collections.Item[bool]
+}
+
+// NewKeeper constructs a new circuit Keeper instance.
+func NewKeeper(cdc codec.BinaryCodec, storeKey *storetypes.KVStoreKey, authority string) Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Errorf("invalid circuit authority address: %w", err))
+	}
+
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(storeKey))
+	k := Keeper{
+		storeKey:    storeKey,
+		cdc:         cdc,
+		authority:   authority,
+		Permissions: collections.NewMap(sb, types.AccountPermissionPrefix, "permissions", sdk.AccAddressKey, codec.CollValue[types.CircuitBreakerPermissions](cdc)),
+		DisableList: collections.NewKeySet(sb, types.DisableListPrefix, "disable_list", collections.StringKey),
+		DisableAll:  collections.NewItem(sb, types.DisableAllKey, "disable_all", collections.BoolValue),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	return k
+}
+
+// GetAuthority returns the circuit module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetPermissions returns the circuit breaker permissions of the given account.
+// The module authority always has LEVEL_SUPER_ADMIN permissions, accounts
+// without stored permissions have LEVEL_NONE_UNSPECIFIED.
+func (k Keeper) GetPermissions(ctx sdk.Context, addr sdk.AccAddress) (types.CircuitBreakerPermissions, error) {
+	if addr.String() == k.authority {
+		return types.CircuitBreakerPermissions{Level: types.CircuitBreakerPermissions_LEVEL_SUPER_ADMIN}, nil
+	}
+
+	perms, err := k.Permissions.Get(ctx, addr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.CircuitBreakerPermissions{Level: types.CircuitBreakerPermissions_LEVEL_NONE_UNSPECIFIED}, nil
+	}
+
+	return perms, err
+}
+
+// SetPermissions sets the circuit breaker permissions of the given account,
+// LEVEL_NONE_UNSPECIFIED permissions remove the account's entry. The Msg type
+// URLs of the permissions are stored normalized.
+func (k Keeper) SetPermissions(ctx sdk.Context, addr sdk.AccAddress, perms types.CircuitBreakerPermissions) error {
+	if perms.Level == types.CircuitBreakerPermissions_LEVEL_NONE_UNSPECIFIED {
+		return k.Permissions.Remove(ctx, addr)
+	}
+
+	limitMsgTypes, err := types.NormalizeMsgTypeURLs(perms.LimitMsgTypes)
+	if err != nil {
+		return err
+	}
+	perms.LimitMsgTypes = limitMsgTypes
+
+	return k.Permissions.Set(ctx, addr, perms)
+}
+
+// IsAllowed returns false if the circuit breaker has been tripped for the
+// given Msg type URL. The Msgs of the circuit module are always allowed.
+func (k Keeper) IsAllowed(ctx sdk.Context, msgTypeURL string) (bool, error) {
+	if _, ok := exemptMsgTypeURLs[msgTypeURL]; ok {
+		return true, nil
+	}
+
+	disabled, err := k.DisableList.Has(ctx, msgTypeURL)
+	if err != nil || disabled {
+		return false, err
+	}
+
+	disableAll, err := k.DisableAll.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return true, nil
+	}
+
+	return !disableAll, err
+}
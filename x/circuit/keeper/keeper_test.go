@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+func TestIsAllowed(t *testing.T) {
+	f := initFixture(t)
+
+	sendTypeURL := "/cosmos.bank.v1beta1.MsgSend"
+	resetTypeURL := sdk.MsgTypeURL(&types.MsgResetCircuitBreaker{})
+
+	allowed, err := f.k.IsAllowed(f.sdkCtx, sendTypeURL)
+	assert.NilError(t, err)
+	assert.Assert(t, allowed)
+
+	// the Msgs of the circuit module are allowed even if they are disabled
+	assert.NilError(t, f.k.DisableList.Set(f.sdkCtx, sendTypeURL))
+	assert.NilError(t, f.k.DisableList.Set(f.sdkCtx, resetTypeURL))
+
+	allowed, err = f.k.IsAllowed(f.sdkCtx, sendTypeURL)
+	assert.NilError(t, err)
+	assert.Assert(t, !allowed)
+
+	allowed, err = f.k.IsAllowed(f.sdkCtx, resetTypeURL)
+	assert.NilError(t, err)
+	assert.Assert(t, allowed)
+
+	// and when all Msgs are disabled
+	assert.NilError(t, f.k.DisableAll.Set(f.sdkCtx, true))
+
+	allowed, err = f.k.IsAllowed(f.sdkCtx, "/cosmos.gov.v1.MsgVote")
+	assert.NilError(t, err)
+	assert.Assert(t, !allowed)
+
+	allowed, err = f.k.IsAllowed(f.sdkCtx, resetTypeURL)
+	assert.NilError(t, err)
+	assert.Assert(t, allowed)
+}
+
+func TestTripCircuitBreaker_TypeURLs(t *testing.T) {
+	f := initFixture(t)
+	msgServer := NewMsgServerImpl(f.k)
+
+	// the Msg type URLs are normalized to the form of sdk.MsgTypeURL
+	f.setPermissions("acct1", types.CircuitBreakerPermissions_LEVEL_SOME_MSGS.String(), "cosmos.bank.v1beta1.MsgSend")
+	perms, err := f.k.GetPermissions(f.sdkCtx, f.addr("acct1"))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []string{"/cosmos.bank.v1beta1.MsgSend"}, perms.LimitMsgTypes)
+
+	_, err = msgServer.TripCircuitBreaker(f.ctx, types.NewMsgTripCircuitBreaker(f.addr("acct1").String(), []string{"/cosmos.bank.v1beta1.MsgSend"}))
+	assert.NilError(t, err)
+
+	allowed, err := f.k.IsAllowed(f.sdkCtx, "/cosmos.bank.v1beta1.MsgSend")
+	assert.NilError(t, err)
+	assert.Assert(t, !allowed)
+
+	_, err = msgServer.TripCircuitBreaker(f.ctx, types.NewMsgTripCircuitBreaker(f.addr("acct1").String(), []string{"cosmos.bank.v1beta1.MsgSend"}))
+	assert.ErrorIs(t, err, types.ErrMsgDisabled)
+
+	// the state of the circuit breaker is not disclosed to unauthorized accounts
+	f.setPermissions("acct2", types.CircuitBreakerPermissions_LEVEL_SOME_MSGS.String(), "cosmos.staking.v1beta1.MsgDelegate")
+	_, err = msgServer.TripCircuitBreaker(f.ctx, types.NewMsgTripCircuitBreaker(f.addr("acct2").String(), []string{"/cosmos.bank.v1beta1.MsgSend"}))
+	assert.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// invalid and duplicate Msg type URLs are rejected
+	for _, urls := range [][]string{
+		{"cosmos bank"},
+		{"/"},
+		{"cosmos.bank.v1beta1.MsgSend", "/cosmos.bank.v1beta1.MsgSend"},
+	} {
+		_, err = msgServer.TripCircuitBreaker(f.ctx, types.NewMsgTripCircuitBreaker(f.addr("acct1").String(), urls))
+		assert.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	}
+}
@@ -5,10 +5,11 @@ import (
 
 	"github.com/regen-network/gocuke"
 	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
 )
 
 func TestAuthorize(t *testing.T) {
-	t.Skip("TODO: uncomment this after implementing")
 	gocuke.NewRunner(t, &authorizeSuite{}).Path("../features/msg_authorize.feature").Run()
 }
 
@@ -16,20 +17,28 @@ type authorizeSuite struct {
 	*baseFixture
 }
 
-func (s *authorizeSuite) Before(t *testing.T) {
+func (s *authorizeSuite) Before(t gocuke.TestingT) {
 	s.baseFixture = initFixture(t)
 }
 
 func (s *authorizeSuite) HasPermission(a string, b string) {
-	panic("PENDING")
+	s.setPermissions(a, b)
 }
 
 func (s *authorizeSuite) HasNoPermissions(a string) {
-	panic("PENDING")
+	s.assertNoPermissions(a)
 }
 
 func (s *authorizeSuite) AttemptsToGrantThePermissions(a string, b string, c gocuke.DocString) {
-	panic("PENDING")
+	_, s.err = NewMsgServerImpl(s.k).AuthorizeCircuitBreaker(s.ctx, &types.MsgAuthorizeCircuitBreaker{
+		Granter:     s.addr(a).String(),
+		Grantee:     s.addr(b).String(),
+		Permissions: s.parsePermissions(c),
+	})
+}
+
+func (s *authorizeSuite) AttemptsToRevokeThePermissions(a string, b string, c gocuke.DocString) {
+	s.AttemptsToGrantThePermissions(a, b, c)
 }
 
 func (s *authorizeSuite) ExpectSuccess() {
@@ -37,9 +46,9 @@ func (s *authorizeSuite) ExpectSuccess() {
 }
 
 func (s *authorizeSuite) ExpectAnError(a string) {
-	assert.Error(s.t, s.err, a)
+	s.expectError(a)
 }
 
 func (s *authorizeSuite) ExpectThatHasNoPermissions(a string) {
-	panic("PENDING")
+	s.assertNoPermissions(a)
 }
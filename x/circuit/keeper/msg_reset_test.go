@@ -5,31 +5,57 @@ import (
 
 	"github.com/regen-network/gocuke"
 	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
 )
 
 func TestReset(t *testing.T) {
-	t.Skip("TODO: uncomment this after implementing")
 	gocuke.NewRunner(t, &resetSuite{}).Path("../features/msg_reset.feature").Run()
 }
 
 type resetSuite struct {
 	*baseFixture
+
+	// enabled are the Msg type URLs that must not be tripped before the
+	// reset is attempted.
+	enabled map[string]bool
 }
 
-func (s *resetSuite) Before(t *testing.T) {
+func (s *resetSuite) Before(t gocuke.TestingT) {
 	s.baseFixture = initFixture(t)
+	s.enabled = map[string]bool{}
 }
 
 func (s *resetSuite) HasPermission(a string, b string) {
-	panic("PENDING")
+	s.setPermissions(a, b)
 }
 
 func (s *resetSuite) HasNoPermissions(a string) {
-	panic("PENDING")
+	s.assertNoPermissions(a)
 }
 
-func (s *resetSuite) AttemptsToResetCircuit(a string, b string, c gocuke.DocString) {
-	panic("PENDING")
+func (s *resetSuite) HasPermissionToTripCircuitBreakerFor(a string, b string) {
+	s.setPermissions(a, types.CircuitBreakerPermissions_LEVEL_SOME_MSGS.String(), b)
+}
+
+func (s *resetSuite) HasPermissionHasBeenEnabled(a string, b string, c string) {
+	s.setPermissions(a, b)
+	s.enabled[c] = true
+}
+
+func (s *resetSuite) AttemptsToResetADisabledMessage(a string, b gocuke.DocString) {
+	urls := parseMsgTypeURLs(b)
+	for _, url := range urls {
+		if !s.enabled[url] {
+			// the tripped Msg type URLs are stored normalized
+			assert.NilError(s.t, s.k.DisableList.Set(s.sdkCtx, "/"+url))
+		}
+	}
+
+	_, s.err = NewMsgServerImpl(s.k).ResetCircuitBreaker(s.ctx, &types.MsgResetCircuitBreaker{
+		Authority:   s.addr(a).String(),
+		MsgTypeUrls: urls,
+	})
 }
 
 func (s *resetSuite) ExpectSuccess() {
@@ -37,9 +63,5 @@ func (s *resetSuite) ExpectSuccess() {
 }
 
 func (s *resetSuite) ExpectAnError(a string) {
-	assert.Error(s.t, s.err, a)
-}
-
-func (s *resetSuite) ExpectThatHasNoPermissions(a string) {
-	panic("PENDING")
+	s.expectError(a)
 }
@@ -0,0 +1,252 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the circuit MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// AuthorizeCircuitBreaker grants (or revokes with LEVEL_NONE_UNSPECIFIED) the
+// grantee's circuit breaker permissions. The module authority and
+// LEVEL_SUPER_ADMIN accounts can grant any level, other accounts can only
+// grant a level strictly lower than their own.
+func (k msgServer) AuthorizeCircuitBreaker(goCtx context.Context, msg *types.MsgAuthorizeCircuitBreaker) (*types.MsgAuthorizeCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := msg.Permissions.Validate(); err != nil {
+		return nil, err
+	}
+
+	granterPerms, err := k.GetPermissions(ctx, granter)
+	if err != nil {
+		return nil, err
+	}
+
+	level := msg.Permissions.Level
+	if granterPerms.Level != types.CircuitBreakerPermissions_LEVEL_SUPER_ADMIN &&
+		(level == types.CircuitBreakerPermissions_LEVEL_NONE_UNSPECIFIED || level >= granterPerms.Level) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "account %s cannot grant %s", msg.Granter, level)
+	}
+
+	if err := k.SetPermissions(ctx, grantee, *msg.Permissions); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuthorizeCircuitBreaker,
+			sdk.NewAttribute(types.AttributeKeyGranter, msg.Granter),
+			sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee),
+			sdk.NewAttribute(types.AttributeKeyLevel, level.String()),
+		),
+	)
+
+	return &types.MsgAuthorizeCircuitBreakerResponse{}, nil
+}
+
+// TripCircuitBreaker disables the execution of the given Msg type URLs, or of
+// all Msgs if none are given.
+func (k msgServer) TripCircuitBreaker(goCtx context.Context, msg *types.MsgTripCircuitBreaker) (*types.MsgTripCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	perms, err := k.GetPermissions(ctx, authority)
+	if err != nil {
+		return nil, err
+	}
+
+	if perms.Level == types.CircuitBreakerPermissions_LEVEL_NONE_UNSPECIFIED {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "account %s has no circuit breaker permissions", msg.Authority)
+	}
+
+	if len(msg.MsgTypeUrls) == 0 {
+		if perms.Level < types.CircuitBreakerPermissions_LEVEL_ALL_MSGS {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "account %s cannot trip the circuit breaker for all msgs", msg.Authority)
+		}
+
+		disableAll, err := k.DisableAll.Get(ctx)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return nil, err
+		}
+
+		if disableAll {
+			return nil, errorsmod.Wrap(types.ErrMsgDisabled, "circuit breaker already tripped for all msgs")
+		}
+
+		if err := k.DisableAll.Set(ctx, true); err != nil {
+			return nil, err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeTripCircuitBreaker,
+				sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+			),
+		)
+
+		return &types.MsgTripCircuitBreakerResponse{}, nil
+	}
+
+	urls, err := types.NormalizeMsgTypeURLs(msg.MsgTypeUrls)
+	if err != nil {
+		return nil, err
+	}
+
+	// the permissions are checked first, so that the state of the circuit
+	// breaker is not disclosed to unauthorized accounts
+	for _, url := range urls {
+		if !perms.CanTrip(url) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "account %s cannot trip the circuit breaker for %s", msg.Authority, url)
+		}
+	}
+
+	for _, url := range urls {
+		allowed, err := k.IsAllowed(ctx, url)
+		if err != nil {
+			return nil, err
+		}
+
+		if !allowed {
+			return nil, errorsmod.Wrapf(types.ErrMsgDisabled, "circuit breaker already tripped for %s", url)
+		}
+	}
+
+	for _, url := range urls {
+		if err := k.DisableList.Set(ctx, url); err != nil {
+			return nil, err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeTripCircuitBreaker,
+				sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+				sdk.NewAttribute(types.AttributeKeyMsgTypeURL, url),
+			),
+		)
+	}
+
+	return &types.MsgTripCircuitBreakerResponse{}, nil
+}
+
+// ResetCircuitBreaker resumes the execution of the given Msg type URLs. Any
+// account holding circuit breaker permissions can reset a given Msg type URL.
+// If none are given, every Msg type URL the account is authorized to trip is
+// resumed.
+func (k msgServer) ResetCircuitBreaker(goCtx context.Context, msg *types.MsgResetCircuitBreaker) (*types.MsgResetCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	perms, err := k.GetPermissions(ctx, authority)
+	if err != nil {
+		return nil, err
+	}
+
+	if perms.Level == types.CircuitBreakerPermissions_LEVEL_NONE_UNSPECIFIED {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "account %s has no circuit breaker permissions", msg.Authority)
+	}
+
+	urls, err := types.NormalizeMsgTypeURLs(msg.MsgTypeUrls)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(urls) == 0 {
+		urls, err = k.resettableTypeURLs(ctx, perms)
+		if err != nil {
+			return nil, err
+		}
+
+		if perms.Level >= types.CircuitBreakerPermissions_LEVEL_ALL_MSGS {
+			if err := k.DisableAll.Remove(ctx); err != nil {
+				return nil, err
+			}
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeResetCircuitBreaker,
+					sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+				),
+			)
+		}
+	} else {
+		for _, url := range urls {
+			disabled, err := k.DisableList.Has(ctx, url)
+			if err != nil {
+				return nil, err
+			}
+
+			if !disabled {
+				return nil, errorsmod.Wrapf(types.ErrMsgEnabled, "circuit breaker not tripped for %s", url)
+			}
+		}
+	}
+
+	for _, url := range urls {
+		if err := k.DisableList.Remove(ctx, url); err != nil {
+			return nil, err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeResetCircuitBreaker,
+				sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+				sdk.NewAttribute(types.AttributeKeyMsgTypeURL, url),
+			),
+		)
+	}
+
+	return &types.MsgResetCircuitBreakerResponse{}, nil
+}
+
+// resettableTypeURLs returns the disabled Msg type URLs that the given
+// permissions allow to reset.
+func (k msgServer) resettableTypeURLs(ctx sdk.Context, perms types.CircuitBreakerPermissions) ([]string, error) {
+	var urls []string
+	err := k.DisableList.Walk(ctx, nil, func(url string) bool {
+		if perms.CanTrip(url) {
+			urls = append(urls, url)
+		}
+		return false
+	})
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+		return nil, err
+	}
+
+	return urls, nil
+}
@@ -5,10 +5,11 @@ import (
 
 	"github.com/regen-network/gocuke"
 	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
 )
 
 func TestTrip(t *testing.T) {
-	t.Skip("TODO: uncomment this after implementing")
 	gocuke.NewRunner(t, &tripSuite{}).Path("../features/msg_trip.feature").Run()
 }
 
@@ -16,20 +17,36 @@ type tripSuite struct {
 	*baseFixture
 }
 
-func (s *tripSuite) Before(t *testing.T) {
+func (s *tripSuite) Before(t gocuke.TestingT) {
 	s.baseFixture = initFixture(t)
 }
 
 func (s *tripSuite) HasPermission(a string, b string) {
-	panic("PENDING")
+	s.setPermissions(a, b)
 }
 
 func (s *tripSuite) HasNoPermissions(a string) {
-	panic("PENDING")
+	s.assertNoPermissions(a)
+}
+
+func (s *tripSuite) HasPermissionToDisable(a string, b string) {
+	s.setPermissions(a, types.CircuitBreakerPermissions_LEVEL_SOME_MSGS.String(), b)
+}
+
+func (s *tripSuite) HasPermissionToDisableAnd(a string, b string, c string) {
+	s.setPermissions(a, types.CircuitBreakerPermissions_LEVEL_SOME_MSGS.String(), b, c)
 }
 
-func (s *tripSuite) AttemptsToTripCircuit(a string, b string, c gocuke.DocString) {
-	panic("PENDING")
+func (s *tripSuite) HasPermissionToDisableIsAlreadyTripped(a string, b string) {
+	s.HasPermissionToDisable(a, b)
+	assert.NilError(s.t, s.k.DisableAll.Set(s.sdkCtx, true))
+}
+
+func (s *tripSuite) AttemptsToDisableMsgExecution(a string, b gocuke.DocString) {
+	_, s.err = NewMsgServerImpl(s.k).TripCircuitBreaker(s.ctx, &types.MsgTripCircuitBreaker{
+		Authority:   s.addr(a).String(),
+		MsgTypeUrls: parseMsgTypeURLs(b),
+	})
 }
 
 func (s *tripSuite) ExpectSuccess() {
@@ -37,9 +54,5 @@ func (s *tripSuite) ExpectSuccess() {
 }
 
 func (s *tripSuite) ExpectAnError(a string) {
-	assert.Error(s.t, s.err, a)
-}
-
-func (s *tripSuite) ExpectThatHasNoPermissions(a string) {
-	panic("PENDING")
+	s.expectError(a)
 }
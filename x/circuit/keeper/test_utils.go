@@ -2,25 +2,109 @@ package keeper
 
 import (
 	"context"
-	"testing"
+	"errors"
+	"regexp"
 
 	storetypes "cosmossdk.io/store/types"
+	"github.com/regen-network/gocuke"
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 type baseFixture struct {
-	t   *testing.T
+	t   gocuke.TestingT
 	err error
 	ctx context.Context
 
-	// k        Keeper //TODO uncomment this after implementing
+	k        Keeper
 	addrs    []sdk.AccAddress
 	storeKey *storetypes.KVStoreKey
 	sdkCtx   sdk.Context
 }
 
-func initFixture(t *testing.T) *baseFixture {
+func initFixture(t gocuke.TestingT) *baseFixture {
 	s := &baseFixture{t: t}
 
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	s.storeKey = storetypes.NewKVStoreKey(types.StoreKey)
+	s.sdkCtx = testutil.DefaultContext(s.storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+	s.k = NewKeeper(encCfg.Codec, s.storeKey, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	s.addrs = simtestutil.CreateIncrementalAccounts(2)
+
 	return s
 }
+
+// addr returns the address used for the named test account, "acct1" being the
+// first one.
+func (s *baseFixture) addr(name string) sdk.AccAddress {
+	switch name {
+	case "acct1":
+		return s.addrs[0]
+	case "acct2":
+		return s.addrs[1]
+	default:
+		s.t.Fatalf("unknown account %s", name)
+		return nil
+	}
+}
+
+var (
+	levelRegex      = regexp.MustCompile(`LEVEL_[A-Z_]+`)
+	msgTypeURLRegex = regexp.MustCompile(`[a-z]+\.[a-z]+\.v[0-9a-z]+\.[A-Za-z]+`)
+)
+
+// parseMsgTypeURLs extracts the Msg type URLs listed in a step doc string.
+func parseMsgTypeURLs(doc gocuke.DocString) []string {
+	return msgTypeURLRegex.FindAllString(doc.Content, -1)
+}
+
+// parsePermissions extracts the circuit breaker permissions described in a
+// step doc string.
+func (s *baseFixture) parsePermissions(doc gocuke.DocString) *types.CircuitBreakerPermissions {
+	level := levelRegex.FindString(doc.Content)
+	levelValue, ok := types.CircuitBreakerPermissions_Level_value[level]
+	assert.Assert(s.t, ok, "unknown level %q", level)
+
+	return &types.CircuitBreakerPermissions{
+		Level:         types.CircuitBreakerPermissions_Level(levelValue),
+		LimitMsgTypes: parseMsgTypeURLs(doc),
+	}
+}
+
+// setPermissions sets the permissions of the named account directly in state.
+func (s *baseFixture) setPermissions(name string, level string, limitMsgTypes ...string) {
+	levelValue, ok := types.CircuitBreakerPermissions_Level_value[level]
+	assert.Assert(s.t, ok, "unknown level %q", level)
+
+	err := s.k.SetPermissions(s.sdkCtx, s.addr(name), types.CircuitBreakerPermissions{
+		Level:         types.CircuitBreakerPermissions_Level(levelValue),
+		LimitMsgTypes: limitMsgTypes,
+	})
+	assert.NilError(s.t, err)
+}
+
+// expectError checks that the root cause of the error of the last step is the
+// registered error with the given description, e.g. "unauthorized".
+func (s *baseFixture) expectError(description string) {
+	err := s.err
+	for errors.Unwrap(err) != nil {
+		err = errors.Unwrap(err)
+	}
+
+	assert.Error(s.t, err, description)
+}
+
+// assertNoPermissions checks that the named account holds no permissions.
+func (s *baseFixture) assertNoPermissions(name string) {
+	perms, err := s.k.GetPermissions(s.sdkCtx, s.addr(name))
+	assert.NilError(s.t, err)
+	assert.Equal(s.t, types.CircuitBreakerPermissions_LEVEL_NONE_UNSPECIFIED, perms.Level)
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new GenesisState object.
+func NewGenesisState(accountPermissions []*GenesisAccountPermissions, disabledTypeURLs []string, disableAll bool) *GenesisState {
+	return &GenesisState{
+		AccountPermissions: accountPermissions,
+		DisabledTypeUrls:   disabledTypeURLs,
+		DisableAll:         disableAll,
+	}
+}
+
+// DefaultGenesisState returns a default circuit module genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs *GenesisState) Validate() error {
+	seen := make(map[string]struct{}, len(gs.AccountPermissions))
+	for _, a := range gs.AccountPermissions {
+		if _, err := sdk.AccAddressFromBech32(a.Address); err != nil {
+			return fmt.Errorf("invalid account address %s: %w", a.Address, err)
+		}
+
+		if _, ok := seen[a.Address]; ok {
+			return fmt.Errorf("duplicate permissions for account %s", a.Address)
+		}
+		seen[a.Address] = struct{}{}
+
+		if err := a.Permissions.Validate(); err != nil {
+			return fmt.Errorf("invalid permissions for account %s: %w", a.Address, err)
+		}
+	}
+
+	return validateMsgTypeURLs(gs.DisabledTypeUrls)
+}
@@ -0,0 +1,162 @@
+package types
+
+import (
+	"regexp"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_, _, _ sdk.Msg            = &MsgAuthorizeCircuitBreaker{}, &MsgTripCircuitBreaker{}, &MsgResetCircuitBreaker{}
+	_, _, _ legacytx.LegacyMsg = &MsgAuthorizeCircuitBreaker{}, &MsgTripCircuitBreaker{}, &MsgResetCircuitBreaker{}
+)
+
+// NewMsgAuthorizeCircuitBreaker creates a new MsgAuthorizeCircuitBreaker instance.
+func NewMsgAuthorizeCircuitBreaker(granter, grantee string, permissions *CircuitBreakerPermissions) *MsgAuthorizeCircuitBreaker {
+	return &MsgAuthorizeCircuitBreaker{
+		Granter:     granter,
+		Grantee:     grantee,
+		Permissions: permissions,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgAuthorizeCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgAuthorizeCircuitBreaker.
+func (m MsgAuthorizeCircuitBreaker) GetSigners() []sdk.AccAddress {
+	granter, _ := sdk.AccAddressFromBech32(m.Granter)
+	return []sdk.AccAddress{granter}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgAuthorizeCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Granter); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid granter address: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Grantee); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid grantee address: %s", err)
+	}
+
+	return m.Permissions.Validate()
+}
+
+// NewMsgTripCircuitBreaker creates a new MsgTripCircuitBreaker instance.
+func NewMsgTripCircuitBreaker(authority string, urls []string) *MsgTripCircuitBreaker {
+	return &MsgTripCircuitBreaker{
+		Authority:   authority,
+		MsgTypeUrls: urls,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgTripCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgTripCircuitBreaker.
+func (m MsgTripCircuitBreaker) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgTripCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+
+	return validateMsgTypeURLs(m.MsgTypeUrls)
+}
+
+// NewMsgResetCircuitBreaker creates a new MsgResetCircuitBreaker instance.
+func NewMsgResetCircuitBreaker(authority string, urls []string) *MsgResetCircuitBreaker {
+	return &MsgResetCircuitBreaker{
+		Authority:   authority,
+		MsgTypeUrls: urls,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgResetCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgResetCircuitBreaker.
+func (m MsgResetCircuitBreaker) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgResetCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+
+	return validateMsgTypeURLs(m.MsgTypeUrls)
+}
+
+// msgTypeURLRegex matches a Msg type URL in the form returned by
+// sdk.MsgTypeURL, i.e. a fully-qualified protobuf message name with a leading
+// "/".
+var msgTypeURLRegex = regexp.MustCompile(`^/[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)+$`)
+
+// NormalizeMsgTypeURL returns the Msg type URL in the form returned by
+// sdk.MsgTypeURL, adding the leading "/" if it is missing, so that
+// "cosmos.bank.v1beta1.MsgSend" and "/cosmos.bank.v1beta1.MsgSend" refer to the
+// same Msg. It returns an error if the URL is not a fully-qualified protobuf
+// message name.
+func NormalizeMsgTypeURL(url string) (string, error) {
+	if url == "" {
+		return "", errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "msg type url cannot be empty")
+	}
+
+	if !strings.HasPrefix(url, "/") {
+		url = "/" + url
+	}
+
+	if !msgTypeURLRegex.MatchString(url) {
+		return "", errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid msg type url %s", url)
+	}
+
+	return url, nil
+}
+
+// NormalizeMsgTypeURLs normalizes the Msg type URLs with NormalizeMsgTypeURL,
+// and returns an error if a URL is invalid or listed twice.
+func NormalizeMsgTypeURLs(urls []string) ([]string, error) {
+	if len(urls) == 0 {
+		return nil, nil
+	}
+
+	normalized := make([]string, len(urls))
+	seen := make(map[string]struct{}, len(urls))
+	for i, url := range urls {
+		url, err := NormalizeMsgTypeURL(url)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := seen[url]; ok {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate msg type url %s", url)
+		}
+		seen[url] = struct{}{}
+		normalized[i] = url
+	}
+
+	return normalized, nil
+}
+
+func validateMsgTypeURLs(urls []string) error {
+	_, err := NormalizeMsgTypeURLs(urls)
+	return err
+}
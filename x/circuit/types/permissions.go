@@ -0,0 +1,56 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate checks that the permissions are well formed: limit_msg_types must
+// be set with LEVEL_SOME_MSGS and only with LEVEL_SOME_MSGS.
+func (p *CircuitBreakerPermissions) Validate() error {
+	if p == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "permissions cannot be nil")
+	}
+
+	if _, ok := CircuitBreakerPermissions_Level_name[int32(p.Level)]; !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unknown permission level %d", p.Level)
+	}
+
+	if p.Level == CircuitBreakerPermissions_LEVEL_SOME_MSGS {
+		if len(p.LimitMsgTypes) == 0 {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "limit_msg_types must be provided with LEVEL_SOME_MSGS")
+		}
+
+		return validateMsgTypeURLs(p.LimitMsgTypes)
+	}
+
+	if len(p.LimitMsgTypes) != 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "limit_msg_types cannot be used with %s", p.Level)
+	}
+
+	return nil
+}
+
+// CanTrip returns true if the permissions allow tripping or resetting the
+// circuit breaker for the given Msg type URL. The URLs are compared in their
+// normalized form.
+func (p CircuitBreakerPermissions) CanTrip(msgTypeURL string) bool {
+	switch p.Level {
+	case CircuitBreakerPermissions_LEVEL_SUPER_ADMIN, CircuitBreakerPermissions_LEVEL_ALL_MSGS:
+		return true
+	case CircuitBreakerPermissions_LEVEL_SOME_MSGS:
+		msgTypeURL, err := NormalizeMsgTypeURL(msgTypeURL)
+		if err != nil {
+			return false
+		}
+
+		for _, url := range p.LimitMsgTypes {
+			if url, err := NormalizeMsgTypeURL(url); err == nil && url == msgTypeURL {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -31,6 +31,7 @@ func (k Keeper) AllocateTokens(ctx sdk.Context, totalPreviousPower int64, bonded
 	if totalPreviousPower == 0 {
 		feePool.CommunityPool = feePool.CommunityPool.Add(feesCollected...)
 		k.SetFeePool(ctx, feePool)
+		k.allocateToExternalCommunityPool(ctx)
 		return
 	}
 
@@ -61,6 +62,27 @@ func (k Keeper) AllocateTokens(ctx sdk.Context, totalPreviousPower int64, bonded
 	// allocate community funding
 	feePool.CommunityPool = feePool.CommunityPool.Add(remaining...)
 	k.SetFeePool(ctx, feePool)
+	k.allocateToExternalCommunityPool(ctx)
+}
+
+// allocateToExternalCommunityPool sends the community pool funds to the
+// external community pool if any. If the transfer fails, the error is logged
+// and the funds are kept in the community pool, to be sent again at the next
+// block.
+func (k Keeper) allocateToExternalCommunityPool(ctx sdk.Context) {
+	if k.externalCommunityPool == nil {
+		return
+	}
+
+	// the transfer is executed in a branch of the state, so that a failure
+	// leaves no partial writes
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.sendCommunityPoolToExternalPool(cacheCtx); err != nil {
+		k.Logger(ctx).Error("failed to send the community pool to the external community pool, retrying at the next block", "err", err)
+		return
+	}
+
+	write()
 }
 
 // AllocateTokensToValidator allocate tokens to a particular validator,
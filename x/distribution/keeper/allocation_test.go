@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -264,3 +265,82 @@ func TestAllocateTokensTruncation(t *testing.T) {
 	require.True(t, distrKeeper.GetValidatorOutstandingRewards(ctx, valAddr1).Rewards.IsValid())
 	require.True(t, distrKeeper.GetValidatorOutstandingRewards(ctx, valAddr2).Rewards.IsValid())
 }
+
+func TestAllocateTokensToExternalCommunityPool(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := distrtestutil.NewMockExternalCommunityPoolKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress()).AnyTimes()
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+		keeper.WithExternalCommunityPool(poolKeeper),
+	)
+
+	// reset fee pool & set params
+	distrKeeper.SetParams(ctx, disttypes.DefaultParams())
+	distrKeeper.SetFeePool(ctx, disttypes.InitialFeePool())
+
+	// create validator with 0% commission
+	val0, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	val0.Commission = stakingtypes.NewCommission(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk0)).Return(val0).AnyTimes()
+
+	votes := []abci.VoteInfo{
+		{
+			Validator:       abci.Validator{Address: valConsPk0.Address(), Power: 100},
+			SignedLastBlock: true,
+		},
+	}
+
+	// 2% of the fees go to the community pool, the integral part is sent to
+	// the external community pool
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(125)))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+	poolKeeper.EXPECT().FundCommunityPool(gomock.Any(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 2)), distrAcc.GetAddress()).Return(nil)
+	distrKeeper.AllocateTokens(ctx, 100, votes)
+
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecWithPrec(5, 1)}}, distrKeeper.GetFeePool(ctx).CommunityPool)
+
+	// the decimal remainders accumulate until they are sent
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+	poolKeeper.EXPECT().FundCommunityPool(gomock.Any(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 3)), distrAcc.GetAddress()).Return(nil)
+	distrKeeper.AllocateTokens(ctx, 100, votes)
+
+	require.True(t, distrKeeper.GetFeePool(ctx).CommunityPool.IsZero())
+
+	// a failed transfer keeps the funds in the community pool
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+	poolKeeper.EXPECT().FundCommunityPool(gomock.Any(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 2)), distrAcc.GetAddress()).Return(errors.New("pool error"))
+	distrKeeper.AllocateTokens(ctx, 100, votes)
+
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecWithPrec(25, 1)}}, distrKeeper.GetFeePool(ctx).CommunityPool)
+
+	// and they are sent at the next block
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+	poolKeeper.EXPECT().FundCommunityPool(gomock.Any(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)), distrAcc.GetAddress()).Return(nil)
+	distrKeeper.AllocateTokens(ctx, 100, votes)
+
+	require.True(t, distrKeeper.GetFeePool(ctx).CommunityPool.IsZero())
+}
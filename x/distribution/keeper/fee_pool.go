@@ -1,13 +1,21 @@
 package keeper
 
 import (
+	errorsmod "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
 // DistributeFromFeePool distributes funds from the distribution module account to
-// a receiver address while updating the community pool
+// a receiver address while updating the community pool. It fails if the
+// community pool is managed by an external module.
 func (k Keeper) DistributeFromFeePool(ctx sdk.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) error {
+	if k.externalCommunityPool != nil {
+		return types.ErrExternalCommunityPool
+	}
+
 	feePool := k.GetFeePool(ctx)
 
 	// NOTE the community pool isn't a module account, however its coins
@@ -28,3 +36,36 @@ func (k Keeper) DistributeFromFeePool(ctx sdk.Context, amount sdk.Coins, receive
 	k.SetFeePool(ctx, feePool)
 	return nil
 }
+
+// MigrateFundsToExternalCommunityPool sends the community pool funds held by
+// the distribution module account to the external community pool. It is meant
+// to be called by the upgrade handler enabling the external community pool, the
+// funds allocated to the community pool afterwards are sent at every block.
+func (k Keeper) MigrateFundsToExternalCommunityPool(ctx sdk.Context) error {
+	if k.externalCommunityPool == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no external community pool is set")
+	}
+
+	return k.sendCommunityPoolToExternalPool(ctx)
+}
+
+// sendCommunityPoolToExternalPool sends the integral part of the fee pool
+// community coins to the external community pool. The decimal remainder is
+// kept in the fee pool, so that it can accumulate.
+func (k Keeper) sendCommunityPoolToExternalPool(ctx sdk.Context) error {
+	feePool := k.GetFeePool(ctx)
+	amount, remainder := feePool.CommunityPool.TruncateDecimal()
+	if amount.IsZero() {
+		return nil
+	}
+
+	moduleAddr := k.authKeeper.GetModuleAddress(types.ModuleName)
+	if err := k.externalCommunityPool.FundCommunityPool(ctx, amount, moduleAddr); err != nil {
+		return err
+	}
+
+	feePool.CommunityPool = remainder
+	k.SetFeePool(ctx, feePool)
+
+	return nil
+}
@@ -290,6 +290,10 @@ func (k Querier) DelegatorWithdrawAddress(c context.Context, req *types.QueryDel
 
 // CommunityPool queries the community pool coins
 func (k Querier) CommunityPool(c context.Context, req *types.QueryCommunityPoolRequest) (*types.QueryCommunityPoolResponse, error) {
+	if k.externalCommunityPool != nil {
+		return nil, status.Errorf(codes.Unimplemented, "the community pool is managed by the %s module", k.externalCommunityPool.GetCommunityPoolModule())
+	}
+
 	ctx := sdk.UnwrapSDKContext(c)
 	pool := k.GetFeePoolCommunityCoins(ctx)
 
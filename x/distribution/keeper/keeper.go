@@ -25,20 +25,37 @@ type Keeper struct {
 	authority string
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
+
+	// externalCommunityPool manages the community pool in place of the fee
+	// pool when set.
+	externalCommunityPool types.ExternalCommunityPoolKeeper
+}
+
+// InitOption is an optional parameter of NewKeeper.
+type InitOption func(*Keeper)
+
+// WithExternalCommunityPool delegates the community pool to an external module,
+// e.g. x/protocolpool. The funds allocated to the community pool are then sent
+// to it at every block, and the community pool spends of x/distribution are
+// disabled.
+func WithExternalCommunityPool(pool types.ExternalCommunityPoolKeeper) InitOption {
+	return func(k *Keeper) {
+		k.externalCommunityPool = pool
+	}
 }
 
 // NewKeeper creates a new distribution Keeper instance
 func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey,
 	ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper,
-	feeCollectorName string, authority string,
+	feeCollectorName string, authority string, opts ...InitOption,
 ) Keeper {
 	// ensure distribution module account is set
 	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
 	}
 
-	return Keeper{
+	k := Keeper{
 		storeKey:         key,
 		cdc:              cdc,
 		authKeeper:       ak,
@@ -47,6 +64,12 @@ func NewKeeper(
 		feeCollectorName: feeCollectorName,
 		authority:        authority,
 	}
+
+	for _, opt := range opts {
+		opt(&k)
+	}
+
+	return k
 }
 
 // GetAuthority returns the x/distribution module's authority.
@@ -152,8 +175,12 @@ func (k Keeper) GetTotalRewards(ctx sdk.Context) (totalRewards sdk.DecCoins) {
 // FundCommunityPool allows an account to directly fund the community fund pool.
 // The amount is first added to the distribution module account and then directly
 // added to the pool. An error is returned if the amount cannot be sent to the
-// module account.
+// module account. The amount is sent to the external community pool if any.
 func (k Keeper) FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	if k.externalCommunityPool != nil {
+		return k.externalCommunityPool.FundCommunityPool(ctx, amount, sender)
+	}
+
 	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, amount); err != nil {
 		return err
 	}
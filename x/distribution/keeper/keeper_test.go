@@ -201,3 +201,58 @@ func TestFundCommunityPool(t *testing.T) {
 
 	require.Equal(t, initPool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(amount...)...), distrKeeper.GetFeePool(ctx).CommunityPool)
 }
+
+func TestExternalCommunityPool(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Time: time.Now()})
+	addrs := simtestutil.CreateIncrementalAccounts(2)
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := distrtestutil.NewMockExternalCommunityPoolKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress()).AnyTimes()
+	bankKeeper.EXPECT().BlockedAddr(gomock.Any()).Return(false).AnyTimes()
+	poolKeeper.EXPECT().GetCommunityPoolModule().Return("protocolpool").AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+		keeper.WithExternalCommunityPool(poolKeeper),
+	)
+
+	// the existing community pool is migrated, the decimal remainder is kept
+	distrKeeper.SetFeePool(ctx, types.FeePool{
+		CommunityPool: sdk.DecCoins{sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1005, 1))},
+	})
+	poolKeeper.EXPECT().FundCommunityPool(gomock.Any(), sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), distrAcc.GetAddress()).Return(nil)
+	require.NoError(t, distrKeeper.MigrateFundsToExternalCommunityPool(ctx))
+	require.Equal(t, sdk.DecCoins{sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(5, 1))}, distrKeeper.GetFeePool(ctx).CommunityPool)
+
+	// funding is routed to the external community pool
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	poolKeeper.EXPECT().FundCommunityPool(gomock.Any(), amount, addrs[0]).Return(nil)
+	require.NoError(t, distrKeeper.FundCommunityPool(ctx, amount, addrs[0]))
+
+	// spending from the fee pool is disabled
+	require.ErrorIs(t, distrKeeper.DistributeFromFeePool(ctx, amount, addrs[1]), types.ErrExternalCommunityPool)
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+	_, err := msgServer.CommunityPoolSpend(ctx, &types.MsgCommunityPoolSpend{
+		Authority: authtypes.NewModuleAddress("gov").String(),
+		Recipient: addrs[1].String(),
+		Amount:    amount,
+	})
+	require.ErrorIs(t, err, types.ErrExternalCommunityPool)
+
+	_, err = keeper.NewQuerier(distrKeeper).CommunityPool(ctx, &types.QueryCommunityPoolRequest{})
+	require.ErrorContains(t, err, "managed by the protocolpool module")
+}
@@ -151,6 +151,10 @@ func (k msgServer) CommunityPoolSpend(goCtx context.Context, req *types.MsgCommu
 		return nil, errorsmod.Wrapf(errors.ErrUnauthorized, "%s is not allowed to receive external funds", req.Recipient)
 	}
 
+	if k.externalCommunityPool != nil {
+		return nil, errorsmod.Wrapf(types.ErrExternalCommunityPool, "use the %s module to spend from the community pool", k.externalCommunityPool.GetCommunityPoolModule())
+	}
+
 	if err := k.DistributeFromFeePool(ctx, req.Amount, recipient); err != nil {
 		return nil, err
 	}
@@ -222,6 +222,7 @@ type DistrInputs struct {
 	AccountKeeper types.AccountKeeper
 	BankKeeper    types.BankKeeper
 	StakingKeeper types.StakingKeeper
+	ExternalPool  types.ExternalCommunityPoolKeeper `optional:"true"`
 
 	// LegacySubspace is used solely for migration of x/params managed parameters
 	LegacySubspace exported.Subspace
@@ -247,6 +248,11 @@ func ProvideModule(in DistrInputs) DistrOutputs {
 		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
 	}
 
+	var opts []keeper.InitOption
+	if in.ExternalPool != nil {
+		opts = append(opts, keeper.WithExternalCommunityPool(in.ExternalPool))
+	}
+
 	k := keeper.NewKeeper(
 		in.Cdc,
 		in.Key,
@@ -255,6 +261,7 @@ func ProvideModule(in DistrInputs) DistrOutputs {
 		in.StakingKeeper,
 		feeCollectorName,
 		authority.String(),
+		opts...,
 	)
 
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.StakingKeeper, in.LegacySubspace)
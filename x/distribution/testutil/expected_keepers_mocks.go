@@ -327,6 +327,71 @@ func (mr *MockStakingKeeperMockRecorder) ValidatorByConsAddr(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorByConsAddr", reflect.TypeOf((*MockStakingKeeper)(nil).ValidatorByConsAddr), arg0, arg1)
 }
 
+// MockExternalCommunityPoolKeeper is a mock of ExternalCommunityPoolKeeper interface.
+type MockExternalCommunityPoolKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockExternalCommunityPoolKeeperMockRecorder
+}
+
+// MockExternalCommunityPoolKeeperMockRecorder is the mock recorder for MockExternalCommunityPoolKeeper.
+type MockExternalCommunityPoolKeeperMockRecorder struct {
+	mock *MockExternalCommunityPoolKeeper
+}
+
+// NewMockExternalCommunityPoolKeeper creates a new mock instance.
+func NewMockExternalCommunityPoolKeeper(ctrl *gomock.Controller) *MockExternalCommunityPoolKeeper {
+	mock := &MockExternalCommunityPoolKeeper{ctrl: ctrl}
+	mock.recorder = &MockExternalCommunityPoolKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExternalCommunityPoolKeeper) EXPECT() *MockExternalCommunityPoolKeeperMockRecorder {
+	return m.recorder
+}
+
+// DistributeFromCommunityPool mocks base method.
+func (m *MockExternalCommunityPoolKeeper) DistributeFromCommunityPool(ctx types.Context, amount types.Coins, receiver types.AccAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DistributeFromCommunityPool", ctx, amount, receiver)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeFromCommunityPool indicates an expected call of DistributeFromCommunityPool.
+func (mr *MockExternalCommunityPoolKeeperMockRecorder) DistributeFromCommunityPool(ctx, amount, receiver interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeFromCommunityPool", reflect.TypeOf((*MockExternalCommunityPoolKeeper)(nil).DistributeFromCommunityPool), ctx, amount, receiver)
+}
+
+// FundCommunityPool mocks base method.
+func (m *MockExternalCommunityPoolKeeper) FundCommunityPool(ctx types.Context, amount types.Coins, sender types.AccAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FundCommunityPool", ctx, amount, sender)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FundCommunityPool indicates an expected call of FundCommunityPool.
+func (mr *MockExternalCommunityPoolKeeperMockRecorder) FundCommunityPool(ctx, amount, sender interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FundCommunityPool", reflect.TypeOf((*MockExternalCommunityPoolKeeper)(nil).FundCommunityPool), ctx, amount, sender)
+}
+
+// GetCommunityPoolModule mocks base method.
+func (m *MockExternalCommunityPoolKeeper) GetCommunityPoolModule() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommunityPoolModule")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetCommunityPoolModule indicates an expected call of GetCommunityPoolModule.
+func (mr *MockExternalCommunityPoolKeeperMockRecorder) GetCommunityPoolModule() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommunityPoolModule", reflect.TypeOf((*MockExternalCommunityPoolKeeper)(nil).GetCommunityPoolModule))
+}
+
 // MockStakingHooks is a mock of StakingHooks interface.
 type MockStakingHooks struct {
 	ctrl     *gomock.Controller
@@ -50,6 +50,19 @@ type StakingKeeper interface {
 	GetAllDelegatorDelegations(ctx sdk.Context, delegator sdk.AccAddress) []stakingtypes.Delegation
 }
 
+// ExternalCommunityPoolKeeper defines the expected keeper of a module managing
+// the community pool in place of x/distribution, e.g. x/protocolpool.
+type ExternalCommunityPoolKeeper interface {
+	// GetCommunityPoolModule returns the name of the module account holding the
+	// community pool funds.
+	GetCommunityPoolModule() string
+	// FundCommunityPool sends the amount from the sender to the community pool.
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+	// DistributeFromCommunityPool sends the amount from the community pool to
+	// the receiver.
+	DistributeFromCommunityPool(ctx sdk.Context, amount sdk.Coins, receiver sdk.AccAddress) error
+}
+
 // StakingHooks event hooks for staking validator object (noalias)
 type StakingHooks interface {
 	AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) // Must be called when a validator is created
@@ -0,0 +1,21 @@
+package protocolpool
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool/keeper"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool/types"
+)
+
+// BeginBlocker pays the continuous funds from the community pool. It must run
+// after the distribution BeginBlocker, so that the funds allocated to the
+// community pool in the block are shared by the percentage funds.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	if err := k.PayContinuousFunds(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,18 @@
+package protocolpool
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	protocolpoolv1 "cosmossdk.io/api/cosmos/protocolpool/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: protocolpoolv1.Msg_ServiceDesc.ServiceName,
+		},
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: protocolpoolv1.Query_ServiceDesc.ServiceName,
+		},
+	}
+}
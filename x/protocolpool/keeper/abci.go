@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool/types"
+)
+
+// PayContinuousFunds removes the expired continuous funds and pays the others
+// from the community pool. The percentage funds share the inflow of the pool
+// since the previous call, which is then reset. The fixed amount funds are
+// skipped when the pool cannot pay them.
+func (k Keeper) PayContinuousFunds(ctx sdk.Context) error {
+	inflow, err := k.GetPendingInflow(ctx)
+	if err != nil {
+		return err
+	}
+
+	var (
+		expired []sdk.AccAddress
+		funds   []types.ContinuousFund
+	)
+	err = walk(ctx, k.ContinuousFunds, func(recipient sdk.AccAddress, fund types.ContinuousFund) bool {
+		if fund.IsExpired(ctx.BlockTime()) {
+			expired = append(expired, recipient)
+		} else {
+			funds = append(funds, fund)
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, recipient := range expired {
+		if err := k.ContinuousFunds.Remove(ctx, recipient); err != nil {
+			return err
+		}
+	}
+
+	for _, fund := range funds {
+		amount := fund.FixedAmount
+		if fund.FixedAmount.Empty() {
+			amount, _ = sdk.NewDecCoinsFromCoins(inflow...).MulDecTruncate(fund.Percentage).TruncateDecimal()
+		}
+
+		k.payContinuousFund(ctx, fund.Recipient, amount)
+	}
+
+	for _, coin := range inflow {
+		if err := k.PendingInflow.Remove(ctx, coin.Denom); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// payContinuousFund sends the amount from the community pool to the recipient
+// of a continuous fund, the payment is skipped if it fails.
+func (k Keeper) payContinuousFund(ctx sdk.Context, recipient string, amount sdk.Coins) {
+	if amount.IsZero() {
+		return
+	}
+
+	// the payment is made in a branch of the state, so that a failed payment
+	// does not leave partial writes behind
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.DistributeFromCommunityPool(cacheCtx, amount, sdk.MustAccAddressFromBech32(recipient)); err != nil {
+		k.Logger(ctx).Error("failed to pay continuous fund", "recipient", recipient, "amount", amount, "err", err)
+		return
+	}
+	write()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeContinuousFundPayout,
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+}
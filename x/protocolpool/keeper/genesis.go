@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool/types"
+)
+
+// InitGenesis initializes the protocolpool module's state from a provided
+// genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
+	// ensure the module account is set
+	k.authKeeper.GetModuleAccount(ctx, types.ModuleName)
+
+	for _, fund := range data.ContinuousFunds {
+		if err := k.ContinuousFunds.Set(ctx, sdk.MustAccAddressFromBech32(fund.Recipient), fund); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, budget := range data.Budgets {
+		if err := k.Budgets.Set(ctx, sdk.MustAccAddressFromBech32(budget.Recipient), budget); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, coin := range data.PendingInflow {
+		if err := k.PendingInflow.Set(ctx, coin.Denom, coin.Amount); err != nil {
+			panic(err)
+		}
+	}
+
+	if pool := k.GetCommunityPool(ctx); !pool.IsAllGTE(data.PendingInflow) {
+		panic(fmt.Sprintf("community pool %s is smaller than the pending inflow %s", pool, data.PendingInflow))
+	}
+}
+
+// ExportGenesis returns the protocolpool module's genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	gs := types.DefaultGenesisState()
+
+	err := walk(ctx, k.ContinuousFunds, func(_ sdk.AccAddress, fund types.ContinuousFund) bool {
+		gs.ContinuousFunds = append(gs.ContinuousFunds, fund)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = walk(ctx, k.Budgets, func(_ sdk.AccAddress, budget types.Budget) bool {
+		gs.Budgets = append(gs.Budgets, budget)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = walk(ctx, k.PendingInflow, func(denom string, amount math.Int) bool {
+		gs.PendingInflow = append(gs.PendingInflow, sdk.NewCoin(denom, amount))
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return gs
+}
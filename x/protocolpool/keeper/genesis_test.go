@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool/types"
+)
+
+func TestGenesis(t *testing.T) {
+	f := newFixture(t)
+
+	expiry := f.ctx.BlockTime().Add(time.Hour)
+	gs := &types.GenesisState{
+		ContinuousFunds: []types.ContinuousFund{
+			{Recipient: f.addrs[0].String(), Percentage: math.LegacyNewDecWithPrec(1, 1), Expiry: &expiry},
+			{Recipient: f.addrs[1].String(), Percentage: math.LegacyZeroDec(), FixedAmount: coins(10)},
+		},
+		Budgets: []types.Budget{
+			{
+				Recipient:     f.addrs[2].String(),
+				TotalBudget:   coins(100),
+				ClaimedAmount: coins(10),
+				StartTime:     f.ctx.BlockTime().UTC(),
+				Tranches:      10,
+				Period:        time.Hour,
+			},
+		},
+		PendingInflow: coins(5),
+	}
+	require.NoError(t, gs.Validate())
+
+	f.accountKeeper.EXPECT().GetModuleAccount(f.ctx, types.ModuleName).Return(poolAcc)
+	f.bankKeeper.EXPECT().GetAllBalances(f.ctx, poolAcc.GetAddress()).Return(coins(5))
+	f.keeper.InitGenesis(f.ctx, gs)
+
+	exported := f.keeper.ExportGenesis(f.ctx)
+	require.Equal(t, gs.Budgets, exported.Budgets)
+	require.Equal(t, gs.PendingInflow, exported.PendingInflow)
+	require.Len(t, exported.ContinuousFunds, 2)
+	for i, fund := range gs.ContinuousFunds {
+		require.Equal(t, fund.Recipient, exported.ContinuousFunds[i].Recipient)
+		require.True(t, fund.Percentage.Equal(exported.ContinuousFunds[i].Percentage))
+		require.Equal(t, fund.FixedAmount, exported.ContinuousFunds[i].FixedAmount)
+	}
+
+	// the pending inflow must be held by the pool
+	f = newFixture(t)
+	f.accountKeeper.EXPECT().GetModuleAccount(f.ctx, types.ModuleName).Return(poolAcc)
+	f.bankKeeper.EXPECT().GetAllBalances(f.ctx, poolAcc.GetAddress()).Return(sdk.NewCoins())
+	require.Panics(t, func() { f.keeper.InitGenesis(f.ctx, gs) })
+}
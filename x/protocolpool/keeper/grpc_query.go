@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper
+type Querier struct {
+	Keeper
+}
+
+// NewQuerier constructor for the Querier struct
+func NewQuerier(keeper Keeper) Querier {
+	return Querier{Keeper: keeper}
+}
+
+// CommunityPool queries the community pool coins.
+func (q Querier) CommunityPool(ctx context.Context, req *types.QueryCommunityPoolRequest) (*types.QueryCommunityPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.QueryCommunityPoolResponse{Pool: q.GetCommunityPool(sdk.UnwrapSDKContext(ctx))}, nil
+}
+
+// ContinuousFunds queries all the continuous funds.
+func (q Querier) ContinuousFunds(ctx context.Context, req *types.QueryContinuousFundsRequest) (*types.QueryContinuousFundsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	results, pageRes, err := query.CollectionPaginate[sdk.AccAddress, types.ContinuousFund](ctx, q.Keeper.ContinuousFunds, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	funds := make([]types.ContinuousFund, len(results))
+	for i, result := range results {
+		funds[i] = result.Value
+	}
+
+	return &types.QueryContinuousFundsResponse{ContinuousFunds: funds, Pagination: pageRes}, nil
+}
+
+// ContinuousFund queries the continuous fund of a recipient.
+func (q Querier) ContinuousFund(ctx context.Context, req *types.QueryContinuousFundRequest) (*types.QueryContinuousFundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(req.Recipient)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	fund, err := q.Keeper.ContinuousFunds.Get(ctx, recipient)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		return nil, status.Errorf(codes.NotFound, "no continuous fund for %s", req.Recipient)
+	case err != nil:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryContinuousFundResponse{ContinuousFund: fund}, nil
+}
+
+// Budget queries the budget of a recipient, along with the amount it can
+// currently claim.
+func (q Querier) Budget(ctx context.Context, req *types.QueryBudgetRequest) (*types.QueryBudgetResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(req.Recipient)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	budget, err := q.Keeper.Budgets.Get(ctx, recipient)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		return nil, status.Errorf(codes.NotFound, "no budget for %s", req.Recipient)
+	case err != nil:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBudgetResponse{
+		Budget:          budget,
+		ClaimableAmount: budget.ClaimableAmount(sdk.UnwrapSDKContext(ctx).BlockTime()),
+	}, nil
+}
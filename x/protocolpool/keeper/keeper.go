@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool/types"
+)
+
+// Keeper defines the protocolpool module's keeper. The community pool funds
+// are held by the protocolpool module account.
+type Keeper struct {
+	storeService store.KVStoreService
+	cdc          codec.BinaryCodec
+	authKeeper   types.AccountKeeper
+	bankKeeper   types.BankKeeper
+	// the address capable of spending from the community pool and managing the
+	// continuous funds and budgets. Typically, this should be the x/gov module
+	// account.
+	authority string
+
+	Schema collections.Schema
+	// ContinuousFunds maps the recipient address to its continuous fund.
+	ContinuousFunds collections.Map[sdk.AccAddress, types.ContinuousFund]
+	// Budgets maps the recipient address to its budget.
+	Budgets collections.Map[sdk.AccAddress, types.Budget]
+	// PendingInflow maps a denom to the amount which flowed into the pool since
+	// the last distribution to the percentage continuous funds.
+	PendingInflow collections.Map[string, math.Int]
+}
+
+// NewKeeper creates a new protocolpool Keeper instance.
+func NewKeeper(
+	cdc codec.BinaryCodec, storeService store.KVStoreService,
+	ak types.AccountKeeper, bk types.BankKeeper, authority string,
+) Keeper {
+	// ensure protocolpool module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		storeService:    storeService,
+		cdc:             cdc,
+		authKeeper:      ak,
+		bankKeeper:      bk,
+		authority:       authority,
+		ContinuousFunds: collections.NewMap(sb, types.ContinuousFundsKey, "continuous_funds", sdk.AccAddressKey, codec.CollValue[types.ContinuousFund](cdc)),
+		Budgets:         collections.NewMap(sb, types.BudgetsKey, "budgets", sdk.AccAddressKey, codec.CollValue[types.Budget](cdc)),
+		PendingInflow:   collections.NewMap(sb, types.PendingInflowKey, "pending_inflow", collections.StringKey, sdk.IntValue),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the x/protocolpool module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetCommunityPoolModule returns the name of the module account holding the
+// community pool funds.
+func (k Keeper) GetCommunityPoolModule() string {
+	return types.ModuleName
+}
+
+// GetCommunityPool returns the coins of the community pool.
+func (k Keeper) GetCommunityPool(ctx sdk.Context) sdk.Coins {
+	return k.bankKeeper.GetAllBalances(ctx, k.authKeeper.GetModuleAddress(types.ModuleName))
+}
+
+// FundCommunityPool sends the amount from the sender to the community pool.
+// The amount is accounted in the inflow shared by the percentage continuous
+// funds at the next block.
+func (k Keeper) FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, amount); err != nil {
+		return err
+	}
+
+	for _, coin := range amount {
+		inflow, err := k.PendingInflow.Get(ctx, coin.Denom)
+		switch {
+		case errors.Is(err, collections.ErrNotFound):
+			inflow = math.ZeroInt()
+		case err != nil:
+			return err
+		}
+
+		if err := k.PendingInflow.Set(ctx, coin.Denom, inflow.Add(coin.Amount)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DistributeFromCommunityPool sends the amount from the community pool to the
+// receiver.
+func (k Keeper) DistributeFromCommunityPool(ctx sdk.Context, amount sdk.Coins, receiver sdk.AccAddress) error {
+	if pool := k.GetCommunityPool(ctx); !pool.IsAllGTE(amount) {
+		return errorsmod.Wrapf(types.ErrInsufficientPool, "%s is smaller than %s", pool, amount)
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, amount)
+}
+
+// GetPendingInflow returns the amount which flowed into the pool since the
+// last distribution to the percentage continuous funds.
+func (k Keeper) GetPendingInflow(ctx sdk.Context) (sdk.Coins, error) {
+	inflow := sdk.NewCoins()
+	err := walk(ctx, k.PendingInflow, func(denom string, amount math.Int) bool {
+		inflow = inflow.Add(sdk.NewCoin(denom, amount))
+		return false
+	})
+
+	return inflow, err
+}
+
+// validateRecipient returns an error if the recipient cannot receive funds
+// from the community pool.
+func (k Keeper) validateRecipient(recipient sdk.AccAddress) error {
+	if k.bankKeeper.BlockedAddr(recipient) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", recipient)
+	}
+
+	return nil
+}
+
+// walk walks over all the entries of the map, an empty map is not an error.
+func walk[K, V any](ctx context.Context, m collections.Map[K, V], walkFunc func(K, V) bool) error {
+	err := m.Walk(ctx, nil, walkFunc)
+	if errors.Is(err, collections.ErrInvalidIterator) {
+		return nil
+	}
+
+	return err
+}
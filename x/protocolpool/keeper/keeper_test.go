@@ -0,0 +1,269 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool/keeper"
+	pooltestutil "github.com/cosmos/cosmos-sdk/x/protocolpool/testutil"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool/types"
+)
+
+var (
+	poolAcc   = authtypes.NewEmptyModuleAccount(types.ModuleName)
+	authority = authtypes.NewModuleAddress("gov")
+)
+
+type fixture struct {
+	ctx           sdk.Context
+	keeper        keeper.Keeper
+	msgServer     types.MsgServer
+	bankKeeper    *pooltestutil.MockBankKeeper
+	accountKeeper *pooltestutil.MockAccountKeeper
+	addrs         []sdk.AccAddress
+}
+
+func newFixture(t *testing.T) fixture {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(protocolpool.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Time: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)})
+
+	bankKeeper := pooltestutil.NewMockBankKeeper(ctrl)
+	accountKeeper := pooltestutil.NewMockAccountKeeper(ctrl)
+	accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(poolAcc.GetAddress()).AnyTimes()
+	bankKeeper.EXPECT().BlockedAddr(gomock.Any()).DoAndReturn(func(addr sdk.AccAddress) bool {
+		return addr.Equals(poolAcc.GetAddress())
+	}).AnyTimes()
+
+	k := keeper.NewKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(key),
+		accountKeeper,
+		bankKeeper,
+		authority.String(),
+	)
+
+	return fixture{
+		ctx:           ctx,
+		keeper:        k,
+		msgServer:     keeper.NewMsgServerImpl(k),
+		bankKeeper:    bankKeeper,
+		accountKeeper: accountKeeper,
+		addrs:         simtestutil.CreateIncrementalAccounts(3),
+	}
+}
+
+func coins(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+}
+
+func TestFundCommunityPool(t *testing.T) {
+	f := newFixture(t)
+
+	f.bankKeeper.EXPECT().SendCoinsFromAccountToModule(f.ctx, f.addrs[0], types.ModuleName, coins(100)).Return(nil).Times(2)
+
+	_, err := f.msgServer.FundCommunityPool(f.ctx, types.NewMsgFundCommunityPool(coins(100), f.addrs[0].String()))
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.FundCommunityPool(f.ctx, coins(100), f.addrs[0]))
+
+	inflow, err := f.keeper.GetPendingInflow(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, coins(200), inflow)
+}
+
+func TestCommunityPoolSpend(t *testing.T) {
+	f := newFixture(t)
+
+	f.bankKeeper.EXPECT().GetAllBalances(f.ctx, poolAcc.GetAddress()).Return(coins(100)).AnyTimes()
+
+	_, err := f.msgServer.CommunityPoolSpend(f.ctx, types.NewMsgCommunityPoolSpend(f.addrs[0].String(), f.addrs[1].String(), coins(10)))
+	require.ErrorContains(t, err, "invalid authority")
+
+	_, err = f.msgServer.CommunityPoolSpend(f.ctx, types.NewMsgCommunityPoolSpend(authority.String(), poolAcc.GetAddress().String(), coins(10)))
+	require.ErrorContains(t, err, "is not allowed to receive external funds")
+
+	_, err = f.msgServer.CommunityPoolSpend(f.ctx, types.NewMsgCommunityPoolSpend(authority.String(), f.addrs[1].String(), coins(101)))
+	require.ErrorIs(t, err, types.ErrInsufficientPool)
+
+	f.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(f.ctx, types.ModuleName, f.addrs[1], coins(100)).Return(nil)
+	_, err = f.msgServer.CommunityPoolSpend(f.ctx, types.NewMsgCommunityPoolSpend(authority.String(), f.addrs[1].String(), coins(100)))
+	require.NoError(t, err)
+}
+
+func TestCreateContinuousFund(t *testing.T) {
+	f := newFixture(t)
+
+	past := f.ctx.BlockTime().Add(-time.Hour)
+	_, err := f.msgServer.CreateContinuousFund(f.ctx, types.NewMsgCreateContinuousFund(authority.String(), f.addrs[0].String(), math.LegacyNewDecWithPrec(6, 1), nil, nil))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgCreateContinuousFund
+		expErr string
+	}{
+		{
+			name:   "invalid authority",
+			msg:    types.NewMsgCreateContinuousFund(f.addrs[1].String(), f.addrs[1].String(), math.LegacyNewDecWithPrec(1, 1), nil, nil),
+			expErr: "invalid authority",
+		},
+		{
+			name:   "percentage and fixed amount",
+			msg:    types.NewMsgCreateContinuousFund(authority.String(), f.addrs[1].String(), math.LegacyNewDecWithPrec(1, 1), coins(1), nil),
+			expErr: "cannot both be set",
+		},
+		{
+			name:   "no amount",
+			msg:    types.NewMsgCreateContinuousFund(authority.String(), f.addrs[1].String(), math.LegacyZeroDec(), nil, nil),
+			expErr: "either percentage or fixed amount must be set",
+		},
+		{
+			name:   "percentage greater than one",
+			msg:    types.NewMsgCreateContinuousFund(authority.String(), f.addrs[1].String(), math.LegacyNewDecWithPrec(11, 1), nil, nil),
+			expErr: "percentage must be in (0, 1]",
+		},
+		{
+			name:   "total percentage greater than one",
+			msg:    types.NewMsgCreateContinuousFund(authority.String(), f.addrs[1].String(), math.LegacyNewDecWithPrec(5, 1), nil, nil),
+			expErr: "total percentage of the continuous funds would exceed 1",
+		},
+		{
+			name:   "blocked recipient",
+			msg:    types.NewMsgCreateContinuousFund(authority.String(), poolAcc.GetAddress().String(), math.LegacyNewDecWithPrec(1, 1), nil, nil),
+			expErr: "is not allowed to receive external funds",
+		},
+		{
+			name:   "expired",
+			msg:    types.NewMsgCreateContinuousFund(authority.String(), f.addrs[1].String(), math.LegacyNewDecWithPrec(1, 1), nil, &past),
+			expErr: "is not after the block time",
+		},
+		{
+			name:   "existing fund",
+			msg:    types.NewMsgCreateContinuousFund(authority.String(), f.addrs[0].String(), math.LegacyNewDecWithPrec(1, 1), nil, nil),
+			expErr: types.ErrContinuousFundExists.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := f.msgServer.CreateContinuousFund(f.ctx, tc.msg)
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+
+	// the fixed amount funds do not count in the total percentage
+	_, err = f.msgServer.CreateContinuousFund(f.ctx, types.NewMsgCreateContinuousFund(authority.String(), f.addrs[1].String(), math.LegacyZeroDec(), coins(10), nil))
+	require.NoError(t, err)
+
+	_, err = f.msgServer.CancelContinuousFund(f.ctx, types.NewMsgCancelContinuousFund(authority.String(), f.addrs[0].String()))
+	require.NoError(t, err)
+	_, err = f.msgServer.CancelContinuousFund(f.ctx, types.NewMsgCancelContinuousFund(authority.String(), f.addrs[0].String()))
+	require.ErrorIs(t, err, types.ErrNoContinuousFund)
+
+	has, err := f.keeper.ContinuousFunds.Has(f.ctx, f.addrs[0])
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestPayContinuousFunds(t *testing.T) {
+	f := newFixture(t)
+
+	expiry := f.ctx.BlockTime().Add(time.Hour)
+	for _, msg := range []*types.MsgCreateContinuousFund{
+		types.NewMsgCreateContinuousFund(authority.String(), f.addrs[0].String(), math.LegacyNewDecWithPrec(2, 1), nil, nil),
+		types.NewMsgCreateContinuousFund(authority.String(), f.addrs[1].String(), math.LegacyNewDecWithPrec(3, 1), nil, &expiry),
+		types.NewMsgCreateContinuousFund(authority.String(), f.addrs[2].String(), math.LegacyZeroDec(), coins(40), nil),
+	} {
+		_, err := f.msgServer.CreateContinuousFund(f.ctx, msg)
+		require.NoError(t, err)
+	}
+
+	// the percentage funds share the inflow, the fixed amount fund is paid in
+	// full
+	f.bankKeeper.EXPECT().SendCoinsFromAccountToModule(f.ctx, f.addrs[0], types.ModuleName, coins(105)).Return(nil)
+	require.NoError(t, f.keeper.FundCommunityPool(f.ctx, coins(105), f.addrs[0]))
+
+	f.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), poolAcc.GetAddress()).Return(coins(105)).Times(3)
+	f.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, f.addrs[0], coins(21)).Return(nil)
+	f.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, f.addrs[1], coins(31)).Return(nil)
+	f.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, f.addrs[2], coins(40)).Return(nil)
+	require.NoError(t, f.keeper.PayContinuousFunds(f.ctx))
+
+	inflow, err := f.keeper.GetPendingInflow(f.ctx)
+	require.NoError(t, err)
+	require.True(t, inflow.IsZero())
+
+	// the expired fund is removed, the fixed amount fund is skipped when the
+	// pool cannot pay it
+	ctx := f.ctx.WithBlockTime(expiry)
+	f.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), poolAcc.GetAddress()).Return(coins(13))
+	require.NoError(t, f.keeper.PayContinuousFunds(ctx))
+
+	has, err := f.keeper.ContinuousFunds.Has(ctx, f.addrs[1])
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = f.keeper.ContinuousFunds.Has(ctx, f.addrs[2])
+	require.NoError(t, err)
+	require.True(t, has)
+}
+
+func TestClaimBudget(t *testing.T) {
+	f := newFixture(t)
+
+	start := f.ctx.BlockTime()
+	_, err := f.msgServer.SubmitBudgetProposal(f.ctx, types.NewMsgSubmitBudgetProposal(authority.String(), f.addrs[0].String(), coins(100), nil, 4, time.Hour))
+	require.NoError(t, err)
+
+	_, err = f.msgServer.SubmitBudgetProposal(f.ctx, types.NewMsgSubmitBudgetProposal(authority.String(), f.addrs[0].String(), coins(100), nil, 4, time.Hour))
+	require.ErrorIs(t, err, types.ErrBudgetExists)
+
+	_, err = f.msgServer.ClaimBudget(f.ctx.WithBlockTime(start.Add(30*time.Minute)), types.NewMsgClaimBudget(f.addrs[0].String()))
+	require.ErrorIs(t, err, types.ErrNothingToClaim)
+
+	_, err = f.msgServer.ClaimBudget(f.ctx, types.NewMsgClaimBudget(f.addrs[1].String()))
+	require.ErrorIs(t, err, types.ErrNoBudget)
+
+	f.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), poolAcc.GetAddress()).Return(coins(1000)).AnyTimes()
+
+	// one tranche vested after a period
+	ctx := f.ctx.WithBlockTime(start.Add(time.Hour))
+	f.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.ModuleName, f.addrs[0], coins(25)).Return(nil)
+	res, err := f.msgServer.ClaimBudget(ctx, types.NewMsgClaimBudget(f.addrs[0].String()))
+	require.NoError(t, err)
+	require.Equal(t, coins(25), res.Amount)
+
+	ctx = f.ctx.WithBlockTime(start.Add(150 * time.Minute))
+	queryRes, err := keeper.NewQuerier(f.keeper).Budget(ctx, &types.QueryBudgetRequest{Recipient: f.addrs[0].String()})
+	require.NoError(t, err)
+	require.Equal(t, coins(25), queryRes.Budget.ClaimedAmount)
+	require.Equal(t, coins(25), queryRes.ClaimableAmount)
+
+	// the budget is removed once fully claimed
+	ctx = f.ctx.WithBlockTime(start.Add(10 * time.Hour))
+	f.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.ModuleName, f.addrs[0], coins(75)).Return(nil)
+	res, err = f.msgServer.ClaimBudget(ctx, types.NewMsgClaimBudget(f.addrs[0].String()))
+	require.NoError(t, err)
+	require.Equal(t, coins(75), res.Amount)
+
+	has, err := f.keeper.Budgets.Has(ctx, f.addrs[0])
+	require.NoError(t, err)
+	require.False(t, has)
+}
@@ -0,0 +1,282 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the protocolpool MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+func (k msgServer) FundCommunityPool(goCtx context.Context, msg *types.MsgFundCommunityPool) (*types.MsgFundCommunityPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.FundCommunityPool(ctx, msg.Amount, depositor); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFundCommunityPool,
+			sdk.NewAttribute(types.AttributeKeyDepositor, msg.Depositor),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgFundCommunityPoolResponse{}, nil
+}
+
+func (k msgServer) CommunityPoolSpend(goCtx context.Context, msg *types.MsgCommunityPoolSpend) (*types.MsgCommunityPoolSpendResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.validateRecipient(recipient); err != nil {
+		return nil, err
+	}
+
+	if err := k.DistributeFromCommunityPool(ctx, msg.Amount, recipient); err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("transferred from the community pool to recipient", "amount", msg.Amount.String(), "recipient", msg.Recipient)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCommunityPoolSpend,
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgCommunityPoolSpendResponse{}, nil
+}
+
+func (k msgServer) CreateContinuousFund(goCtx context.Context, msg *types.MsgCreateContinuousFund) (*types.MsgCreateContinuousFundResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	fund := msg.ContinuousFund()
+	if err := fund.Validate(); err != nil {
+		return nil, err
+	}
+
+	recipient := sdk.MustAccAddressFromBech32(fund.Recipient)
+	if err := k.validateRecipient(recipient); err != nil {
+		return nil, err
+	}
+
+	if fund.IsExpired(ctx.BlockTime()) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidContinuousFund, "expiry %s is not after the block time", fund.Expiry)
+	}
+
+	has, err := k.ContinuousFunds.Has(ctx, recipient)
+	if err != nil {
+		return nil, err
+	}
+	if has {
+		return nil, errorsmod.Wrapf(types.ErrContinuousFundExists, "%s", fund.Recipient)
+	}
+
+	// the percentage funds cannot share more than the whole inflow
+	totalPercentage := fund.Percentage
+	err = walk(ctx, k.ContinuousFunds, func(_ sdk.AccAddress, fund types.ContinuousFund) bool {
+		totalPercentage = totalPercentage.Add(fund.Percentage)
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	if totalPercentage.GT(sdk.OneDec()) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidContinuousFund, "total percentage of the continuous funds would exceed 1: %s", totalPercentage)
+	}
+
+	if err := k.ContinuousFunds.Set(ctx, recipient, fund); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateContinuousFund,
+			sdk.NewAttribute(types.AttributeKeyRecipient, fund.Recipient),
+		),
+	)
+
+	return &types.MsgCreateContinuousFundResponse{}, nil
+}
+
+func (k msgServer) CancelContinuousFund(goCtx context.Context, msg *types.MsgCancelContinuousFund) (*types.MsgCancelContinuousFundResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	has, err := k.ContinuousFunds.Has(ctx, recipient)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, errorsmod.Wrapf(types.ErrNoContinuousFund, "%s", msg.Recipient)
+	}
+
+	if err := k.ContinuousFunds.Remove(ctx, recipient); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelContinuousFund,
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
+		),
+	)
+
+	return &types.MsgCancelContinuousFundResponse{}, nil
+}
+
+func (k msgServer) SubmitBudgetProposal(goCtx context.Context, msg *types.MsgSubmitBudgetProposal) (*types.MsgSubmitBudgetProposalResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.validateRecipient(recipient); err != nil {
+		return nil, err
+	}
+
+	startTime := ctx.BlockTime()
+	if msg.StartTime != nil {
+		startTime = *msg.StartTime
+	}
+
+	budget := types.Budget{
+		Recipient:     msg.Recipient,
+		TotalBudget:   msg.TotalBudget,
+		ClaimedAmount: sdk.NewCoins(),
+		StartTime:     startTime,
+		Tranches:      msg.Tranches,
+		Period:        msg.Period,
+	}
+	if err := budget.Validate(); err != nil {
+		return nil, err
+	}
+
+	has, err := k.Budgets.Has(ctx, recipient)
+	if err != nil {
+		return nil, err
+	}
+	if has {
+		return nil, errorsmod.Wrapf(types.ErrBudgetExists, "%s", msg.Recipient)
+	}
+
+	if err := k.Budgets.Set(ctx, recipient, budget); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSubmitBudget,
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.TotalBudget.String()),
+		),
+	)
+
+	return &types.MsgSubmitBudgetProposalResponse{}, nil
+}
+
+func (k msgServer) ClaimBudget(goCtx context.Context, msg *types.MsgClaimBudget) (*types.MsgClaimBudgetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	budget, err := k.Budgets.Get(ctx, recipient)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		return nil, errorsmod.Wrapf(types.ErrNoBudget, "%s", msg.Recipient)
+	case err != nil:
+		return nil, err
+	}
+
+	amount := budget.ClaimableAmount(ctx.BlockTime())
+	if amount.IsZero() {
+		return nil, types.ErrNothingToClaim
+	}
+
+	if err := k.DistributeFromCommunityPool(ctx, amount, recipient); err != nil {
+		return nil, err
+	}
+
+	// the budget is removed once fully claimed
+	budget.ClaimedAmount = budget.ClaimedAmount.Add(amount...)
+	if budget.ClaimedAmount.IsAllGTE(budget.TotalBudget) {
+		err = k.Budgets.Remove(ctx, recipient)
+	} else {
+		err = k.Budgets.Set(ctx, recipient, budget)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClaimBudget,
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return &types.MsgClaimBudgetResponse{Amount: amount}, nil
+}
+
+func (k msgServer) validateAuthority(authority string) error {
+	if k.authority != authority {
+		return errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+
+	return nil
+}
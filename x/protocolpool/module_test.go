@@ -0,0 +1,44 @@
+package protocolpool_test
+
+import (
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool/keeper"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool/testutil"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool/types"
+)
+
+func TestDistributionUsesProtocolPool(t *testing.T) {
+	var (
+		accountKeeper authkeeper.AccountKeeper
+		bankKeeper    bankkeeper.Keeper
+		distrKeeper   distrkeeper.Keeper
+		poolKeeper    keeper.Keeper
+	)
+	app, err := simtestutil.Setup(testutil.AppConfig, &accountKeeper, &bankKeeper, &distrKeeper, &poolKeeper)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(false, cmtproto.Header{})
+	require.NotNil(t, accountKeeper.GetAccount(ctx, accountKeeper.GetModuleAddress(types.ModuleName)))
+
+	// the community tax of the first block was sent to x/protocolpool
+	pool := poolKeeper.GetCommunityPool(ctx)
+	require.False(t, pool.IsZero())
+
+	addr := sdk.AccAddress("depositor")
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	require.NoError(t, banktestutil.FundAccount(bankKeeper, ctx, addr, amount))
+
+	// the community pool funding of x/distribution is sent to x/protocolpool
+	require.NoError(t, distrKeeper.FundCommunityPool(ctx, amount, addr))
+	require.Equal(t, pool.Add(amount...), poolKeeper.GetCommunityPool(ctx))
+}
@@ -0,0 +1,121 @@
+package testutil
+
+import (
+	_ "github.com/cosmos/cosmos-sdk/x/auth"           // import as blank for app wiring
+	_ "github.com/cosmos/cosmos-sdk/x/auth/tx/config" // import as blank for app wiring
+	_ "github.com/cosmos/cosmos-sdk/x/bank"           // import as blank for app wiring
+	_ "github.com/cosmos/cosmos-sdk/x/consensus"      // import as blank for app wiring
+	_ "github.com/cosmos/cosmos-sdk/x/distribution"   // import as blank for app wiring
+	_ "github.com/cosmos/cosmos-sdk/x/genutil"        // import as blank for app wiring
+	_ "github.com/cosmos/cosmos-sdk/x/mint"           // import as blank for app wiring
+	_ "github.com/cosmos/cosmos-sdk/x/params"         // import as blank for app wiring
+	_ "github.com/cosmos/cosmos-sdk/x/protocolpool"   // import as blank for app wiring
+	_ "github.com/cosmos/cosmos-sdk/x/staking"        // import as blank for app wiring
+
+	"cosmossdk.io/core/appconfig"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	consensustypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	protocolpooltypes "github.com/cosmos/cosmos-sdk/x/protocolpool/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	runtimev1alpha1 "cosmossdk.io/api/cosmos/app/runtime/v1alpha1"
+	appv1alpha1 "cosmossdk.io/api/cosmos/app/v1alpha1"
+	authmodulev1 "cosmossdk.io/api/cosmos/auth/module/v1"
+	bankmodulev1 "cosmossdk.io/api/cosmos/bank/module/v1"
+	consensusmodulev1 "cosmossdk.io/api/cosmos/consensus/module/v1"
+	distrmodulev1 "cosmossdk.io/api/cosmos/distribution/module/v1"
+	genutilmodulev1 "cosmossdk.io/api/cosmos/genutil/module/v1"
+	mintmodulev1 "cosmossdk.io/api/cosmos/mint/module/v1"
+	paramsmodulev1 "cosmossdk.io/api/cosmos/params/module/v1"
+	protocolpoolmodulev1 "cosmossdk.io/api/cosmos/protocolpool/module/v1"
+	stakingmodulev1 "cosmossdk.io/api/cosmos/staking/module/v1"
+	txconfigv1 "cosmossdk.io/api/cosmos/tx/config/v1"
+)
+
+var AppConfig = appconfig.Compose(&appv1alpha1.Config{
+	Modules: []*appv1alpha1.ModuleConfig{
+		{
+			Name: "runtime",
+			Config: appconfig.WrapAny(&runtimev1alpha1.Module{
+				AppName: "ProtocolPoolApp",
+				BeginBlockers: []string{
+					minttypes.ModuleName,
+					distrtypes.ModuleName,
+					protocolpooltypes.ModuleName,
+					stakingtypes.ModuleName,
+					genutiltypes.ModuleName,
+				},
+				EndBlockers: []string{
+					stakingtypes.ModuleName,
+					genutiltypes.ModuleName,
+				},
+				InitGenesis: []string{
+					authtypes.ModuleName,
+					banktypes.ModuleName,
+					distrtypes.ModuleName,
+					protocolpooltypes.ModuleName,
+					stakingtypes.ModuleName,
+					minttypes.ModuleName,
+					genutiltypes.ModuleName,
+					paramstypes.ModuleName,
+					consensustypes.ModuleName,
+				},
+			}),
+		},
+		{
+			Name: authtypes.ModuleName,
+			Config: appconfig.WrapAny(&authmodulev1.Module{
+				Bech32Prefix: "cosmos",
+				ModuleAccountPermissions: []*authmodulev1.ModuleAccountPermission{
+					{Account: authtypes.FeeCollectorName},
+					{Account: distrtypes.ModuleName},
+					{Account: protocolpooltypes.ModuleName},
+					{Account: minttypes.ModuleName, Permissions: []string{authtypes.Minter}},
+					{Account: stakingtypes.BondedPoolName, Permissions: []string{authtypes.Burner, stakingtypes.ModuleName}},
+					{Account: stakingtypes.NotBondedPoolName, Permissions: []string{authtypes.Burner, stakingtypes.ModuleName}},
+				},
+			}),
+		},
+		{
+			Name:   banktypes.ModuleName,
+			Config: appconfig.WrapAny(&bankmodulev1.Module{}),
+		},
+		{
+			Name:   stakingtypes.ModuleName,
+			Config: appconfig.WrapAny(&stakingmodulev1.Module{}),
+		},
+		{
+			Name:   paramstypes.ModuleName,
+			Config: appconfig.WrapAny(&paramsmodulev1.Module{}),
+		},
+		{
+			Name:   consensustypes.ModuleName,
+			Config: appconfig.WrapAny(&consensusmodulev1.Module{}),
+		},
+		{
+			Name:   "tx",
+			Config: appconfig.WrapAny(&txconfigv1.Config{}),
+		},
+		{
+			Name:   genutiltypes.ModuleName,
+			Config: appconfig.WrapAny(&genutilmodulev1.Module{}),
+		},
+		{
+			Name:   distrtypes.ModuleName,
+			Config: appconfig.WrapAny(&distrmodulev1.Module{}),
+		},
+		{
+			Name:   protocolpooltypes.ModuleName,
+			Config: appconfig.WrapAny(&protocolpoolmodulev1.Module{}),
+		},
+		{
+			Name:   minttypes.ModuleName,
+			Config: appconfig.WrapAny(&mintmodulev1.Module{}),
+		},
+	},
+})
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the necessary x/protocolpool interfaces
+// and concrete types on the provided LegacyAmino codec. These types are used
+// for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgFundCommunityPool{}, "cosmos-sdk/pool/MsgFundCommunityPool")
+	legacy.RegisterAminoMsg(cdc, &MsgCommunityPoolSpend{}, "cosmos-sdk/pool/MsgCommunityPoolSpend")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateContinuousFund{}, "cosmos-sdk/pool/MsgCreateContinuousFund")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelContinuousFund{}, "cosmos-sdk/pool/MsgCancelContinuousFund")
+	legacy.RegisterAminoMsg(cdc, &MsgSubmitBudgetProposal{}, "cosmos-sdk/pool/MsgSubmitBudgetProposal")
+	legacy.RegisterAminoMsg(cdc, &MsgClaimBudget{}, "cosmos-sdk/pool/MsgClaimBudget")
+}
+
+// RegisterInterfaces registers the x/protocolpool interfaces types with the
+// interface registry.
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgFundCommunityPool{},
+		&MsgCommunityPoolSpend{},
+		&MsgCreateContinuousFund{},
+		&MsgCancelContinuousFund{},
+		&MsgSubmitBudgetProposal{},
+		&MsgClaimBudget{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	sdk.RegisterLegacyAminoCodec(amino)
+}
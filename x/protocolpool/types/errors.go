@@ -0,0 +1,15 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/protocolpool module sentinel errors
+var (
+	ErrInvalidContinuousFund = errors.Register(ModuleName, 2, "invalid continuous fund")
+	ErrContinuousFundExists  = errors.Register(ModuleName, 3, "continuous fund already exists")
+	ErrNoContinuousFund      = errors.Register(ModuleName, 4, "continuous fund not found")
+	ErrInvalidBudget         = errors.Register(ModuleName, 5, "invalid budget")
+	ErrBudgetExists          = errors.Register(ModuleName, 6, "budget already exists")
+	ErrNoBudget              = errors.Register(ModuleName, 7, "budget not found")
+	ErrNothingToClaim        = errors.Register(ModuleName, 8, "no vested budget to claim")
+	ErrInsufficientPool      = errors.Register(ModuleName, 9, "community pool does not have sufficient coins")
+)
@@ -0,0 +1,15 @@
+package types
+
+// protocolpool module event types
+const (
+	EventTypeFundCommunityPool    = "fund_community_pool"
+	EventTypeCommunityPoolSpend   = "community_pool_spend"
+	EventTypeCreateContinuousFund = "create_continuous_fund"
+	EventTypeCancelContinuousFund = "cancel_continuous_fund"
+	EventTypeContinuousFundPayout = "continuous_fund_payout"
+	EventTypeSubmitBudget         = "submit_budget"
+	EventTypeClaimBudget          = "claim_budget"
+
+	AttributeKeyDepositor = "depositor"
+	AttributeKeyRecipient = "recipient"
+)
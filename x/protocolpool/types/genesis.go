@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultGenesisState returns a default protocolpool module genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		ContinuousFunds: []ContinuousFund{},
+		Budgets:         []Budget{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs *GenesisState) Validate() error {
+	seen := make(map[string]struct{}, len(gs.ContinuousFunds))
+	totalPercentage := sdk.ZeroDec()
+	for _, fund := range gs.ContinuousFunds {
+		if err := fund.Validate(); err != nil {
+			return fmt.Errorf("invalid continuous fund of %s: %w", fund.Recipient, err)
+		}
+
+		if _, ok := seen[fund.Recipient]; ok {
+			return fmt.Errorf("duplicate continuous fund of %s", fund.Recipient)
+		}
+		seen[fund.Recipient] = struct{}{}
+
+		if !fund.Percentage.IsNil() {
+			totalPercentage = totalPercentage.Add(fund.Percentage)
+		}
+	}
+
+	if totalPercentage.GT(sdk.OneDec()) {
+		return fmt.Errorf("total percentage of the continuous funds exceeds 1: %s", totalPercentage)
+	}
+
+	seen = make(map[string]struct{}, len(gs.Budgets))
+	for _, budget := range gs.Budgets {
+		if err := budget.Validate(); err != nil {
+			return fmt.Errorf("invalid budget of %s: %w", budget.Recipient, err)
+		}
+
+		if _, ok := seen[budget.Recipient]; ok {
+			return fmt.Errorf("duplicate budget of %s", budget.Recipient)
+		}
+		seen[budget.Recipient] = struct{}{}
+	}
+
+	if !gs.PendingInflow.IsValid() {
+		return fmt.Errorf("invalid pending inflow %s", gs.PendingInflow)
+	}
+
+	return nil
+}
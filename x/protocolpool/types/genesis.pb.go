@@ -0,0 +1,473 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/protocolpool/v1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the protocolpool module's genesis state.
+type GenesisState struct {
+	// continuous_funds are the active continuous funds.
+	ContinuousFunds []ContinuousFund `protobuf:"bytes,1,rep,name=continuous_funds,json=continuousFunds,proto3" json:"continuous_funds"`
+	// budgets are the budgets which are not fully claimed yet.
+	Budgets []Budget `protobuf:"bytes,2,rep,name=budgets,proto3" json:"budgets"`
+	// pending_inflow is the amount which flowed into the community pool since the
+	// last distribution to the percentage continuous funds.
+	PendingInflow github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=pending_inflow,json=pendingInflow,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pending_inflow"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72560a99455b4146, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetContinuousFunds() []ContinuousFund {
+	if m != nil {
+		return m.ContinuousFunds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBudgets() []Budget {
+	if m != nil {
+		return m.Budgets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingInflow() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PendingInflow
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "cosmos.protocolpool.v1.GenesisState")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/protocolpool/v1/genesis.proto", fileDescriptor_72560a99455b4146)
+}
+
+var fileDescriptor_72560a99455b4146 = []byte{
+	// 354 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xcd, 0x4a, 0xfb, 0x40,
+	0x14, 0xc5, 0x93, 0x16, 0xfe, 0x7f, 0x8c, 0xf5, 0x2b, 0x88, 0xd4, 0x2e, 0xa6, 0x52, 0x44, 0x4a,
+	0xc1, 0x19, 0xa2, 0x4f, 0x60, 0x0a, 0x8a, 0xb8, 0xab, 0x3b, 0x11, 0x4a, 0x3e, 0xa6, 0x71, 0x30,
+	0x9d, 0x1b, 0x9c, 0x49, 0xb5, 0x6b, 0x5f, 0xc0, 0xb5, 0x4f, 0x20, 0xae, 0xba, 0xf3, 0x15, 0xba,
+	0xec, 0xd2, 0x95, 0x4a, 0xbb, 0xe8, 0x6b, 0x48, 0x26, 0x53, 0x69, 0xc1, 0xba, 0x49, 0xc2, 0xb9,
+	0xe7, 0xfe, 0xce, 0xe5, 0xc4, 0xda, 0x0f, 0x40, 0x74, 0x41, 0x90, 0xe4, 0x0e, 0x24, 0x04, 0x10,
+	0x27, 0x00, 0x31, 0xe9, 0x39, 0x24, 0xa2, 0x9c, 0x0a, 0x26, 0xb0, 0xd2, 0xed, 0x9d, 0xdc, 0x85,
+	0xe7, 0x5d, 0xb8, 0xe7, 0x54, 0xb6, 0x23, 0x88, 0x40, 0x89, 0x24, 0xfb, 0xca, 0xe7, 0x15, 0xa4,
+	0x99, 0xbe, 0x27, 0x28, 0xe9, 0x39, 0x3e, 0x95, 0x9e, 0x43, 0x02, 0x60, 0x5c, 0xcf, 0x6b, 0x4b,
+	0x32, 0x65, 0x3f, 0xa1, 0x3a, 0xa3, 0xb2, 0xe5, 0x75, 0x19, 0x07, 0xa2, 0x9e, 0xb9, 0x54, 0x7b,
+	0x2b, 0x58, 0xa5, 0xb3, 0xfc, 0xac, 0x4b, 0xe9, 0x49, 0x6a, 0x5f, 0x5b, 0x9b, 0x01, 0x70, 0xc9,
+	0x78, 0x0a, 0xa9, 0x68, 0x77, 0x52, 0x1e, 0x8a, 0xb2, 0xb9, 0x57, 0xac, 0xaf, 0x1e, 0x1d, 0xe0,
+	0xdf, 0x0f, 0xc6, 0xcd, 0x1f, 0xff, 0x69, 0xca, 0x43, 0x77, 0x65, 0xf8, 0x51, 0x35, 0x5e, 0xa6,
+	0x83, 0x86, 0xd9, 0xda, 0x08, 0x16, 0x46, 0xc2, 0x6e, 0x5a, 0xff, 0xfd, 0x34, 0x8c, 0xa8, 0x14,
+	0xe5, 0x82, 0x82, 0xa2, 0x65, 0x50, 0x57, 0xd9, 0xe6, 0x61, 0xb3, 0x4d, 0xfb, 0xd1, 0xb4, 0xd6,
+	0x13, 0xca, 0x43, 0xc6, 0xa3, 0x36, 0xe3, 0x9d, 0x18, 0xee, 0xcb, 0x45, 0x05, 0xdb, 0x9d, 0xc1,
+	0xb2, 0x92, 0xb0, 0x2e, 0x09, 0x37, 0x81, 0x71, 0xf7, 0x24, 0xe3, 0xbc, 0x7e, 0x56, 0xeb, 0x11,
+	0x93, 0x37, 0xa9, 0x8f, 0x03, 0xe8, 0x12, 0xdd, 0x58, 0xfe, 0x3a, 0x14, 0xe1, 0xad, 0x2e, 0x2b,
+	0x5b, 0x10, 0xcf, 0xd3, 0x41, 0xa3, 0x14, 0xd3, 0xc8, 0x0b, 0xfa, 0xed, 0xac, 0x66, 0xd1, 0x5a,
+	0xd3, 0x99, 0xe7, 0x2a, 0xd2, 0xbd, 0x18, 0x8e, 0x91, 0x39, 0x1a, 0x23, 0xf3, 0x6b, 0x8c, 0xcc,
+	0xa7, 0x09, 0x32, 0x46, 0x13, 0x64, 0xbc, 0x4f, 0x90, 0x71, 0xe5, 0xfc, 0x99, 0xf1, 0xb0, 0xf8,
+	0x8b, 0x54, 0xa4, 0xff, 0x4f, 0x69, 0xc7, 0xdf, 0x03, 0x00, 0xaf, 0x28, 0x87, 0xbd, 0x3a, 0x02,
+	0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingInflow) > 0 {
+		for iNdEx := len(m.PendingInflow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingInflow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Budgets) > 0 {
+		for iNdEx := len(m.Budgets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Budgets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ContinuousFunds) > 0 {
+		for iNdEx := len(m.ContinuousFunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContinuousFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ContinuousFunds) > 0 {
+		for _, e := range m.ContinuousFunds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Budgets) > 0 {
+		for _, e := range m.Budgets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingInflow) > 0 {
+		for _, e := range m.PendingInflow {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinuousFunds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContinuousFunds = append(m.ContinuousFunds, ContinuousFund{})
+			if err := m.ContinuousFunds[len(m.ContinuousFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budgets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Budgets = append(m.Budgets, Budget{})
+			if err := m.Budgets[len(m.Budgets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingInflow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingInflow = append(m.PendingInflow, types.Coin{})
+			if err := m.PendingInflow[len(m.PendingInflow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,18 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "protocolpool"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+// KVStore keys
+var (
+	ContinuousFundsKey = collections.NewPrefix(0)
+	BudgetsKey         = collections.NewPrefix(1)
+	PendingInflowKey   = collections.NewPrefix(2)
+)
@@ -0,0 +1,227 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_, _, _, _, _, _ sdk.Msg = &MsgFundCommunityPool{}, &MsgCommunityPoolSpend{}, &MsgCreateContinuousFund{},
+		&MsgCancelContinuousFund{}, &MsgSubmitBudgetProposal{}, &MsgClaimBudget{}
+	_, _, _, _, _, _ legacytx.LegacyMsg = &MsgFundCommunityPool{}, &MsgCommunityPoolSpend{}, &MsgCreateContinuousFund{},
+		&MsgCancelContinuousFund{}, &MsgSubmitBudgetProposal{}, &MsgClaimBudget{}
+)
+
+// NewMsgFundCommunityPool creates a new MsgFundCommunityPool instance.
+func NewMsgFundCommunityPool(amount sdk.Coins, depositor string) *MsgFundCommunityPool {
+	return &MsgFundCommunityPool{
+		Depositor: depositor,
+		Amount:    amount,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgFundCommunityPool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgFundCommunityPool.
+func (m MsgFundCommunityPool) GetSigners() []sdk.AccAddress {
+	depositor, _ := sdk.AccAddressFromBech32(m.Depositor)
+	return []sdk.AccAddress{depositor}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgFundCommunityPool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Depositor); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid depositor address: %s", err)
+	}
+
+	if m.Amount.Empty() || !m.Amount.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, m.Amount.String())
+	}
+
+	return nil
+}
+
+// NewMsgCommunityPoolSpend creates a new MsgCommunityPoolSpend instance.
+func NewMsgCommunityPoolSpend(authority, recipient string, amount sdk.Coins) *MsgCommunityPoolSpend {
+	return &MsgCommunityPoolSpend{
+		Authority: authority,
+		Recipient: recipient,
+		Amount:    amount,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgCommunityPoolSpend) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgCommunityPoolSpend.
+func (m MsgCommunityPoolSpend) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgCommunityPoolSpend) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address: %s", err)
+	}
+
+	if m.Amount.Empty() || !m.Amount.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, m.Amount.String())
+	}
+
+	return nil
+}
+
+// NewMsgCreateContinuousFund creates a new MsgCreateContinuousFund instance.
+// Either the percentage must be zero or the fixed amount empty.
+func NewMsgCreateContinuousFund(authority, recipient string, percentage sdk.Dec, fixedAmount sdk.Coins, expiry *time.Time) *MsgCreateContinuousFund {
+	return &MsgCreateContinuousFund{
+		Authority:   authority,
+		Recipient:   recipient,
+		Percentage:  percentage,
+		FixedAmount: fixedAmount,
+		Expiry:      expiry,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgCreateContinuousFund) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgCreateContinuousFund.
+func (m MsgCreateContinuousFund) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgCreateContinuousFund) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+
+	return m.ContinuousFund().Validate()
+}
+
+// ContinuousFund returns the continuous fund created by the message.
+func (m MsgCreateContinuousFund) ContinuousFund() ContinuousFund {
+	percentage := m.Percentage
+	if percentage.IsNil() {
+		percentage = sdk.ZeroDec()
+	}
+
+	return ContinuousFund{
+		Recipient:   m.Recipient,
+		Percentage:  percentage,
+		FixedAmount: m.FixedAmount,
+		Expiry:      m.Expiry,
+	}
+}
+
+// NewMsgCancelContinuousFund creates a new MsgCancelContinuousFund instance.
+func NewMsgCancelContinuousFund(authority, recipient string) *MsgCancelContinuousFund {
+	return &MsgCancelContinuousFund{
+		Authority: authority,
+		Recipient: recipient,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgCancelContinuousFund) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgCancelContinuousFund.
+func (m MsgCancelContinuousFund) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgCancelContinuousFund) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address: %s", err)
+	}
+
+	return nil
+}
+
+// NewMsgSubmitBudgetProposal creates a new MsgSubmitBudgetProposal instance.
+func NewMsgSubmitBudgetProposal(authority, recipient string, totalBudget sdk.Coins, startTime *time.Time, tranches uint64, period time.Duration) *MsgSubmitBudgetProposal {
+	return &MsgSubmitBudgetProposal{
+		Authority:   authority,
+		Recipient:   recipient,
+		TotalBudget: totalBudget,
+		StartTime:   startTime,
+		Tranches:    tranches,
+		Period:      period,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSubmitBudgetProposal) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgSubmitBudgetProposal.
+func (m MsgSubmitBudgetProposal) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgSubmitBudgetProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address: %s", err)
+	}
+
+	return validateBudgetSchedule(m.TotalBudget, m.Tranches, m.Period)
+}
+
+// NewMsgClaimBudget creates a new MsgClaimBudget instance.
+func NewMsgClaimBudget(recipient string) *MsgClaimBudget {
+	return &MsgClaimBudget{Recipient: recipient}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgClaimBudget) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgClaimBudget.
+func (m MsgClaimBudget) GetSigners() []sdk.AccAddress {
+	recipient, _ := sdk.AccAddressFromBech32(m.Recipient)
+	return []sdk.AccAddress{recipient}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgClaimBudget) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate performs a stateless validation of the continuous fund, exactly
+// one of its percentage and fixed amount must be set.
+func (f ContinuousFund) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(f.Recipient); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address: %s", err)
+	}
+
+	return validateFundAmount(f.Percentage, f.FixedAmount)
+}
+
+// IsExpired returns true if the fund expired at the provided time.
+func (f ContinuousFund) IsExpired(now time.Time) bool {
+	return f.Expiry != nil && !now.Before(*f.Expiry)
+}
+
+func validateFundAmount(percentage sdk.Dec, fixedAmount sdk.Coins) error {
+	hasPercentage := !percentage.IsNil() && !percentage.IsZero()
+	switch {
+	case hasPercentage && !fixedAmount.Empty():
+		return errorsmod.Wrap(ErrInvalidContinuousFund, "percentage and fixed amount cannot both be set")
+	case hasPercentage:
+		if percentage.IsNegative() || percentage.GT(sdk.OneDec()) {
+			return errorsmod.Wrapf(ErrInvalidContinuousFund, "percentage must be in (0, 1], got %s", percentage)
+		}
+	case !fixedAmount.Empty():
+		if !fixedAmount.IsValid() {
+			return errorsmod.Wrapf(ErrInvalidContinuousFund, "invalid fixed amount %s", fixedAmount)
+		}
+	default:
+		return errorsmod.Wrap(ErrInvalidContinuousFund, "either percentage or fixed amount must be set")
+	}
+
+	return nil
+}
+
+// Validate performs a stateless validation of the budget.
+func (b Budget) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(b.Recipient); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address: %s", err)
+	}
+
+	if err := validateBudgetSchedule(b.TotalBudget, b.Tranches, b.Period); err != nil {
+		return err
+	}
+
+	if !b.ClaimedAmount.Empty() && (!b.ClaimedAmount.IsValid() || !b.ClaimedAmount.IsAllLTE(b.TotalBudget)) {
+		return errorsmod.Wrapf(ErrInvalidBudget, "claimed amount %s exceeds the total budget %s", b.ClaimedAmount, b.TotalBudget)
+	}
+
+	return nil
+}
+
+func validateBudgetSchedule(total sdk.Coins, tranches uint64, period time.Duration) error {
+	if total.Empty() || !total.IsValid() {
+		return errorsmod.Wrapf(ErrInvalidBudget, "invalid total budget %s", total)
+	}
+
+	if tranches == 0 {
+		return errorsmod.Wrap(ErrInvalidBudget, "tranches must be positive")
+	}
+
+	if period <= 0 {
+		return errorsmod.Wrap(ErrInvalidBudget, "period must be positive")
+	}
+
+	return nil
+}
+
+// VestedAmount returns the part of the total budget vested at the provided
+// time. A tranche vests at the end of each period from the start time.
+func (b Budget) VestedAmount(now time.Time) sdk.Coins {
+	if now.Before(b.StartTime) || b.Period <= 0 || b.Tranches == 0 {
+		return sdk.NewCoins()
+	}
+
+	vested := uint64(now.Sub(b.StartTime) / b.Period)
+	if vested >= b.Tranches {
+		return b.TotalBudget
+	}
+
+	amount := sdk.NewCoins()
+	for _, coin := range b.TotalBudget {
+		amount = amount.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(math.NewIntFromUint64(vested)).Quo(math.NewIntFromUint64(b.Tranches))))
+	}
+
+	return amount
+}
+
+// ClaimableAmount returns the vested part of the budget which has not been
+// claimed yet at the provided time.
+func (b Budget) ClaimableAmount(now time.Time) sdk.Coins {
+	claimable, _ := b.VestedAmount(now).SafeSub(b.ClaimedAmount...)
+	return claimable
+}
@@ -0,0 +1,70 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool/types"
+)
+
+func TestBudgetVestedAmount(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	budget := types.Budget{
+		Recipient:   sdk.AccAddress("recipient").String(),
+		TotalBudget: sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("atom", 10)),
+		StartTime:   start,
+		Tranches:    3,
+		Period:      time.Hour,
+	}
+	require.NoError(t, budget.Validate())
+
+	testCases := []struct {
+		name     string
+		now      time.Time
+		expected sdk.Coins
+	}{
+		{"before start", start.Add(-time.Hour), sdk.NewCoins()},
+		{"before first tranche", start.Add(time.Hour - 1), sdk.NewCoins()},
+		{"first tranche", start.Add(time.Hour), sdk.NewCoins(sdk.NewInt64Coin("stake", 33), sdk.NewInt64Coin("atom", 3))},
+		{"second tranche", start.Add(150 * time.Minute), sdk.NewCoins(sdk.NewInt64Coin("stake", 66), sdk.NewInt64Coin("atom", 6))},
+		{"last tranche", start.Add(3 * time.Hour), budget.TotalBudget},
+		{"after last tranche", start.Add(100 * time.Hour), budget.TotalBudget},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, budget.VestedAmount(tc.now))
+		})
+	}
+
+	budget.ClaimedAmount = sdk.NewCoins(sdk.NewInt64Coin("stake", 33))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 33), sdk.NewInt64Coin("atom", 6)), budget.ClaimableAmount(start.Add(2*time.Hour)))
+
+	budget.ClaimedAmount = sdk.NewCoins(sdk.NewInt64Coin("stake", 101))
+	require.ErrorIs(t, budget.Validate(), types.ErrInvalidBudget)
+}
+
+func TestGenesisStateValidate(t *testing.T) {
+	recipient := sdk.AccAddress("recipient").String()
+	other := sdk.AccAddress("other").String()
+
+	require.NoError(t, types.DefaultGenesisState().Validate())
+
+	gs := &types.GenesisState{
+		ContinuousFunds: []types.ContinuousFund{
+			{Recipient: recipient, Percentage: sdk.NewDecWithPrec(6, 1)},
+			{Recipient: other, Percentage: sdk.NewDecWithPrec(5, 1)},
+		},
+	}
+	require.ErrorContains(t, gs.Validate(), "total percentage of the continuous funds exceeds 1")
+
+	gs.ContinuousFunds[1].Recipient = recipient
+	require.ErrorContains(t, gs.Validate(), "duplicate continuous fund")
+
+	gs.ContinuousFunds = gs.ContinuousFunds[:1]
+	gs.Budgets = []types.Budget{{Recipient: other, TotalBudget: sdk.NewCoins(sdk.NewInt64Coin("stake", 1))}}
+	require.ErrorIs(t, gs.Validate(), types.ErrInvalidBudget)
+}
@@ -0,0 +1,966 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/protocolpool/v1/types.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ContinuousFund defines a funding stream paid to a recipient from the
+// community pool at every block, until its expiry.
+type ContinuousFund struct {
+	// recipient is the address receiving the funds.
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// percentage is the share of the funds flowing into the community pool
+	// paid to the recipient at every block. It is zero for fixed amount funds.
+	Percentage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=percentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"percentage"`
+	// fixed_amount is the amount paid to the recipient at every block. It is
+	// empty for percentage funds.
+	FixedAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=fixed_amount,json=fixedAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fixed_amount"`
+	// expiry is the time after which the fund is removed. The fund never
+	// expires if it is not set.
+	Expiry *time.Time `protobuf:"bytes,4,opt,name=expiry,proto3,stdtime" json:"expiry,omitempty"`
+}
+
+func (m *ContinuousFund) Reset()         { *m = ContinuousFund{} }
+func (m *ContinuousFund) String() string { return proto.CompactTextString(m) }
+func (*ContinuousFund) ProtoMessage()    {}
+func (*ContinuousFund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b7d0ea246d7f44, []int{0}
+}
+func (m *ContinuousFund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContinuousFund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContinuousFund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContinuousFund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContinuousFund.Merge(m, src)
+}
+func (m *ContinuousFund) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContinuousFund) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContinuousFund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContinuousFund proto.InternalMessageInfo
+
+func (m *ContinuousFund) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *ContinuousFund) GetFixedAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FixedAmount
+	}
+	return nil
+}
+
+func (m *ContinuousFund) GetExpiry() *time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return nil
+}
+
+// Budget defines an amount of the community pool granted to a recipient, which
+// vests in equal tranches at every period from its start time.
+type Budget struct {
+	// recipient is the address which can claim the budget.
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// total_budget is the total amount granted to the recipient.
+	TotalBudget github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_budget,json=totalBudget,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_budget"`
+	// claimed_amount is the amount already claimed by the recipient.
+	ClaimedAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=claimed_amount,json=claimedAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimed_amount"`
+	// start_time is the time from which the tranches vest.
+	StartTime time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// tranches is the number of tranches the total budget is split into.
+	Tranches uint64 `protobuf:"varint,5,opt,name=tranches,proto3" json:"tranches,omitempty"`
+	// period is the time between two tranches.
+	Period time.Duration `protobuf:"bytes,6,opt,name=period,proto3,stdduration" json:"period"`
+}
+
+func (m *Budget) Reset()         { *m = Budget{} }
+func (m *Budget) String() string { return proto.CompactTextString(m) }
+func (*Budget) ProtoMessage()    {}
+func (*Budget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b7d0ea246d7f44, []int{1}
+}
+func (m *Budget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Budget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Budget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Budget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Budget.Merge(m, src)
+}
+func (m *Budget) XXX_Size() int {
+	return m.Size()
+}
+func (m *Budget) XXX_DiscardUnknown() {
+	xxx_messageInfo_Budget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Budget proto.InternalMessageInfo
+
+func (m *Budget) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *Budget) GetTotalBudget() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalBudget
+	}
+	return nil
+}
+
+func (m *Budget) GetClaimedAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ClaimedAmount
+	}
+	return nil
+}
+
+func (m *Budget) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *Budget) GetTranches() uint64 {
+	if m != nil {
+		return m.Tranches
+	}
+	return 0
+}
+
+func (m *Budget) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ContinuousFund)(nil), "cosmos.protocolpool.v1.ContinuousFund")
+	proto.RegisterType((*Budget)(nil), "cosmos.protocolpool.v1.Budget")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/protocolpool/v1/types.proto", fileDescriptor_c1b7d0ea246d7f44)
+}
+
+var fileDescriptor_c1b7d0ea246d7f44 = []byte{
+	// 553 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0x4f, 0x6b, 0x13, 0x4f,
+	0x18, 0xc7, 0x33, 0x49, 0x7e, 0xe1, 0x97, 0x49, 0x5a, 0x70, 0x29, 0xb2, 0xc9, 0x61, 0x13, 0x72,
+	0x90, 0x50, 0xc8, 0x2e, 0xa9, 0x20, 0x1e, 0x4d, 0x1a, 0x8a, 0xe0, 0x2d, 0x7a, 0xf2, 0xb2, 0xcc,
+	0xce, 0x4e, 0xb7, 0x83, 0xbb, 0x33, 0xcb, 0xce, 0x6c, 0x48, 0x4e, 0x1e, 0xf4, 0x05, 0xf4, 0x28,
+	0xbe, 0x02, 0xf1, 0xd4, 0x43, 0x5f, 0x44, 0x8f, 0xa5, 0x27, 0xf1, 0xd0, 0x4a, 0x02, 0xf6, 0x15,
+	0x78, 0x97, 0x9d, 0x99, 0xd4, 0xa8, 0xa0, 0x45, 0xc1, 0xcb, 0xfe, 0x79, 0xfe, 0x7d, 0x9e, 0xe7,
+	0xfb, 0x30, 0x03, 0x7b, 0x98, 0x8b, 0x84, 0x0b, 0x2f, 0xcd, 0xb8, 0xe4, 0x98, 0xc7, 0x29, 0xe7,
+	0xb1, 0x37, 0x1b, 0x7a, 0x72, 0x91, 0x12, 0xe1, 0x2a, 0xab, 0x75, 0x57, 0xc7, 0xb8, 0x9b, 0x31,
+	0xee, 0x6c, 0xd8, 0xde, 0x89, 0x78, 0xc4, 0x95, 0xd1, 0x2b, 0xbe, 0xb4, 0xbf, 0xdd, 0xd2, 0xd1,
+	0xbe, 0x76, 0x6c, 0xa6, 0xb6, 0x1d, 0x03, 0x0b, 0x90, 0x20, 0xde, 0x6c, 0x18, 0x10, 0x89, 0x86,
+	0x1e, 0xe6, 0x94, 0x19, 0x7f, 0x27, 0xe2, 0x3c, 0x8a, 0x89, 0x6e, 0x26, 0xc8, 0x0f, 0x3d, 0x49,
+	0x13, 0x22, 0x24, 0x4a, 0xd2, 0x75, 0x81, 0x1f, 0x03, 0xc2, 0x3c, 0x43, 0x92, 0xf2, 0x75, 0x81,
+	0x3b, 0x28, 0xa1, 0x8c, 0x7b, 0xea, 0xa9, 0x4d, 0xbd, 0xcf, 0x65, 0xb8, 0xbd, 0xcf, 0x99, 0xa4,
+	0x2c, 0xe7, 0xb9, 0x38, 0xc8, 0x59, 0x68, 0x3d, 0x80, 0xf5, 0x8c, 0x60, 0x9a, 0x52, 0xc2, 0xa4,
+	0x0d, 0xba, 0xa0, 0x5f, 0x1f, 0xdb, 0x17, 0xa7, 0x83, 0x1d, 0xd3, 0xeb, 0x28, 0x0c, 0x33, 0x22,
+	0xc4, 0x53, 0x99, 0x51, 0x16, 0x4d, 0xbf, 0x85, 0x5a, 0x08, 0xc2, 0x94, 0x64, 0x98, 0x30, 0x89,
+	0x22, 0x62, 0x97, 0x55, 0xe2, 0xe8, 0xec, 0xb2, 0x53, 0xfa, 0x78, 0xd9, 0xb9, 0x17, 0x51, 0x79,
+	0x94, 0x07, 0x2e, 0xe6, 0x89, 0x99, 0xd9, 0xbc, 0x06, 0x22, 0x7c, 0x61, 0xd4, 0x9c, 0x10, 0x7c,
+	0x71, 0x3a, 0x80, 0x06, 0x33, 0x21, 0xf8, 0xdd, 0xf5, 0xc9, 0x2e, 0x98, 0x6e, 0x14, 0xb5, 0x5e,
+	0xc2, 0xe6, 0x21, 0x9d, 0x93, 0xd0, 0x47, 0x09, 0xcf, 0x99, 0xb4, 0x2b, 0xdd, 0x4a, 0xbf, 0xb1,
+	0xd7, 0x72, 0x4d, 0x4e, 0x21, 0x9c, 0x6b, 0x84, 0x73, 0xf7, 0x39, 0x65, 0x9a, 0xff, 0xfe, 0xaa,
+	0xd3, 0xbf, 0x05, 0xbf, 0x48, 0x10, 0x6f, 0xaf, 0x4f, 0x76, 0x9b, 0x31, 0x89, 0x10, 0x5e, 0xf8,
+	0x85, 0xf4, 0x62, 0xda, 0x50, 0xc4, 0x91, 0x02, 0x5a, 0x0f, 0x61, 0x8d, 0xcc, 0x53, 0x9a, 0x2d,
+	0xec, 0x6a, 0x17, 0xf4, 0x1b, 0x7b, 0x6d, 0x57, 0x4b, 0xee, 0xae, 0x25, 0x77, 0x9f, 0xad, 0x77,
+	0x32, 0xae, 0x1e, 0x5f, 0x75, 0xc0, 0xd4, 0xc4, 0xf7, 0xbe, 0x54, 0x60, 0x6d, 0x9c, 0x87, 0x11,
+	0x91, 0x7f, 0x2c, 0xf0, 0x6b, 0x00, 0x9b, 0x92, 0x4b, 0x14, 0xfb, 0x81, 0x2a, 0x64, 0x97, 0x7f,
+	0x37, 0xfe, 0xc1, 0x5f, 0x8f, 0xaf, 0x77, 0xd0, 0x50, 0x58, 0xd3, 0xfe, 0x2b, 0x00, 0xb7, 0x71,
+	0x8c, 0x68, 0xf2, 0x6f, 0xf7, 0xb0, 0x65, 0x98, 0x66, 0x13, 0x8f, 0x21, 0x14, 0x12, 0x65, 0xd2,
+	0x2f, 0x0e, 0xc1, 0x2d, 0xb6, 0xb1, 0x55, 0x74, 0x50, 0x6c, 0x44, 0x4f, 0x54, 0x57, 0xc9, 0x85,
+	0xdb, 0x6a, 0xc3, 0xff, 0x65, 0x86, 0x18, 0x3e, 0x22, 0xc2, 0xfe, 0xaf, 0x0b, 0xfa, 0xd5, 0xe9,
+	0xcd, 0xbf, 0xf5, 0x08, 0xd6, 0x52, 0x92, 0x51, 0x1e, 0xda, 0x35, 0x45, 0x68, 0xfd, 0x44, 0x98,
+	0x98, 0x23, 0xa6, 0x01, 0x6f, 0x6e, 0x00, 0x26, 0x6f, 0xfc, 0xe4, 0x6c, 0xe9, 0x80, 0xf3, 0xa5,
+	0x03, 0x3e, 0x2d, 0x1d, 0x70, 0xbc, 0x72, 0x4a, 0xe7, 0x2b, 0xa7, 0xf4, 0x61, 0xe5, 0x94, 0x9e,
+	0x0f, 0x7f, 0xa9, 0xc5, 0xfc, 0xfb, 0x3b, 0x47, 0x49, 0x13, 0xd4, 0x94, 0xed, 0xfe, 0xd7, 0x01,
+	0x00, 0xe0, 0x4c, 0xf7, 0x29, 0x97, 0x04, 0x00, 0x00,
+}
+
+func (m *ContinuousFund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContinuousFund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContinuousFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiry != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiry):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTypes(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FixedAmount) > 0 {
+		for iNdEx := len(m.FixedAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FixedAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.Percentage.Size()
+		i -= size
+		if _, err := m.Percentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Budget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Budget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Budget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTypes(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x32
+	if m.Tranches != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Tranches))
+		i--
+		dAtA[i] = 0x28
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTypes(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if len(m.ClaimedAmount) > 0 {
+		for iNdEx := len(m.ClaimedAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimedAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TotalBudget) > 0 {
+		for iNdEx := len(m.TotalBudget) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalBudget[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ContinuousFund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Percentage.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.FixedAmount) > 0 {
+		for _, e := range m.FixedAmount {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Expiry != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiry)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Budget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.TotalBudget) > 0 {
+		for _, e := range m.TotalBudget {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.ClaimedAmount) > 0 {
+		for _, e := range m.ClaimedAmount {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Tranches != 0 {
+		n += 1 + sovTypes(uint64(m.Tranches))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ContinuousFund) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContinuousFund: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContinuousFund: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Percentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FixedAmount = append(m.FixedAmount, types.Coin{})
+			if err := m.FixedAmount[len(m.FixedAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiry == nil {
+				m.Expiry = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Budget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Budget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Budget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBudget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalBudget = append(m.TotalBudget, types.Coin{})
+			if err := m.TotalBudget[len(m.TotalBudget)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimedAmount = append(m.ClaimedAmount, types.Coin{})
+			if err := m.ClaimedAmount[len(m.ClaimedAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tranches", wireType)
+			}
+			m.Tranches = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tranches |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTypes(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTypes
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTypes
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTypes
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTypes        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTypes          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTypes = fmt.Errorf("proto: unexpected end of group")
+)